@@ -111,6 +111,12 @@ func Cyan(text string) string {
 	return color.Sprintf(color.BrightCyan(text))
 }
 
+// Highlight returns text in reverse video, to make matches stand out.
+// Unlike the colors above, the text doesn't get formatted.
+func Highlight(text string) string {
+	return color.Sprintf("%s", color.Reverse(text))
+}
+
 func shouldUseColors() bool {
 	useColors := ForceColors || iostream.IsOutputTerminal()
 
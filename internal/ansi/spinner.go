@@ -18,7 +18,9 @@ const (
 )
 
 func Waiting(fn func() error) error {
-	return loading("", "", "", fn)
+	return loading("", "", "", func(*spinner.Spinner) error {
+		return fn()
+	})
 }
 
 func Spinner(text string, fn func() error) error {
@@ -26,25 +28,43 @@ func Spinner(text string, fn func() error) error {
 	doneMsg := initialMsg + spinnerTextDone + "\n"
 	failMsg := initialMsg + spinnerTextFailed + "\n"
 
-	return loading(initialMsg, doneMsg, failMsg, fn)
+	return loading(initialMsg, doneMsg, failMsg, func(*spinner.Spinner) error {
+		return fn()
+	})
 }
 
-func loading(initialMsg, doneMsg, failMsg string, fn func() error) error {
+// ProgressSpinner is a Spinner whose fn reports its progress, shown next to the spinner
+// while it runs, for long-running operations not to appear to hang.
+func ProgressSpinner(text string, fn func(progress func(status string)) error) error {
+	initialMsg := text + spinnerTextEllipsis + " "
+	doneMsg := initialMsg + spinnerTextDone + "\n"
+	failMsg := initialMsg + spinnerTextFailed + "\n"
+
+	return loading(initialMsg, doneMsg, failMsg, func(s *spinner.Spinner) error {
+		return fn(func(status string) {
+			s.Lock()
+			s.Suffix = " " + status
+			s.Unlock()
+		})
+	})
+}
+
+func loading(initialMsg, doneMsg, failMsg string, fn func(s *spinner.Spinner) error) error {
+	s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(iostream.Messages))
+	s.Prefix = initialMsg
+	s.FinalMSG = doneMsg
+	s.HideCursor = true
+	s.Writer = iostream.Messages
+
+	if err := s.Color(spinnerColor); err != nil {
+		panic(auth0.Error(err, "failed setting spinner color"))
+	}
+
 	done := make(chan struct{})
 	errc := make(chan error)
 	go func() {
 		defer close(done)
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(iostream.Messages))
-		s.Prefix = initialMsg
-		s.FinalMSG = doneMsg
-		s.HideCursor = true
-		s.Writer = iostream.Messages
-
-		if err := s.Color(spinnerColor); err != nil {
-			panic(auth0.Error(err, "failed setting spinner color"))
-		}
-
 		s.Start()
 		err := <-errc
 		if err != nil {
@@ -54,7 +74,7 @@ func loading(initialMsg, doneMsg, failMsg string, fn func() error) error {
 		s.Stop()
 	}()
 
-	err := fn()
+	err := fn(s)
 	errc <- err
 	<-done
 	return err
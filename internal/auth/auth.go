@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 	"time"
 
@@ -23,10 +24,11 @@ const (
 
 // Credentials is used to facilitate the login process.
 type Credentials struct {
-	Audience           string
-	ClientID           string
-	DeviceCodeEndpoint string
-	OauthTokenEndpoint string
+	Audience            string
+	ClientID            string
+	DeviceCodeEndpoint  string
+	OauthTokenEndpoint  string
+	OauthRevokeEndpoint string
 }
 
 type Result struct {
@@ -50,10 +52,11 @@ func (s *State) IntervalDuration() time.Duration {
 }
 
 var credentials = &Credentials{
-	Audience:           "https://*.auth0.com/api/v2/",
-	ClientID:           "2iZo3Uczt5LFHacKdM0zzgUO2eG2uDjT",
-	DeviceCodeEndpoint: "https://auth0.auth0.com/oauth/device/code",
-	OauthTokenEndpoint: "https://auth0.auth0.com/oauth/token",
+	Audience:            "https://*.auth0.com/api/v2/",
+	ClientID:            "2iZo3Uczt5LFHacKdM0zzgUO2eG2uDjT",
+	DeviceCodeEndpoint:  "https://auth0.auth0.com/oauth/device/code",
+	OauthTokenEndpoint:  "https://auth0.auth0.com/oauth/token",
+	OauthRevokeEndpoint: "https://auth0.auth0.com/oauth/revoke",
 }
 
 // WaitUntilUserLogsIn waits until the user is logged in on the browser.
@@ -141,15 +144,59 @@ var RequiredScopes = []string{
 	"read:attack_protection", "update:attack_protection",
 }
 
+// BaseScopes are always requested through the device code flow, as they're
+// needed to identify the user and to be able to renew the access token.
+var BaseScopes = []string{
+	"openid",
+	"offline_access",
+}
+
+// ExpandScopes expands wildcard scopes, such as "read:*", into all the matching
+// scopes within the RequiredScopes. Other scopes, as well as wildcards matching
+// no known scope, are kept as they are and left for Auth0 to validate.
+// Duplicates are removed while preserving the order of the scopes.
+func ExpandScopes(scopes []string) []string {
+	expandedScopes := make([]string, 0, len(scopes))
+	seen := make(map[string]bool, len(scopes))
+
+	add := func(scope string) {
+		if !seen[scope] {
+			seen[scope] = true
+			expandedScopes = append(expandedScopes, scope)
+		}
+	}
+
+	for _, scope := range scopes {
+		if !strings.Contains(scope, "*") {
+			add(scope)
+			continue
+		}
+
+		matchedAny := false
+		for _, knownScope := range RequiredScopes {
+			if matched, err := path.Match(scope, knownScope); err == nil && matched {
+				matchedAny = true
+				add(knownScope)
+			}
+		}
+
+		if !matchedAny {
+			add(scope)
+		}
+	}
+
+	return expandedScopes
+}
+
 // GetDeviceCode kicks-off the device authentication flow by requesting
-// a device code from Auth0. The returned state contains the
-// URI for the next step of the flow.
-func GetDeviceCode(ctx context.Context, httpClient *http.Client, additionalScopes []string, domain string) (State, error) {
+// a device code from Auth0 for the given scopes. The returned state
+// contains the URI for the next step of the flow.
+func GetDeviceCode(ctx context.Context, httpClient *http.Client, scopes []string, domain string) (State, error) {
 	a := credentials
 
 	data := url.Values{
 		"client_id": []string{a.ClientID},
-		"scope":     []string{strings.Join(append(RequiredScopes, additionalScopes...), " ")},
+		"scope":     []string{strings.Join(scopes, " ")},
 		"audience":  []string{domain},
 	}
 
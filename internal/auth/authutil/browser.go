@@ -8,6 +8,16 @@ import (
 	"time"
 )
 
+// CallbackError is the error an authorization request redirected back with.
+type CallbackError struct {
+	Code        string
+	Description string
+}
+
+func (e *CallbackError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
 // WaitForBrowserCallback lauches a new HTTP server listening on the provided
 // address and waits for a request. Once received, the code is extracted from
 // the query string (if any), and returned it to the caller.
@@ -60,7 +70,7 @@ func WaitForBrowserCallback(addr string) (code string, state string, err error)
 
 		var err error
 		if cb.err != "" {
-			err = fmt.Errorf("%s: %s", cb.err, cb.errDescription)
+			err = &CallbackError{Code: cb.err, Description: cb.errDescription}
 		}
 		return cb.code, cb.state, err
 	case err := <-errCh:
@@ -68,6 +78,41 @@ func WaitForBrowserCallback(addr string) (code string, state string, err error)
 	}
 }
 
+// WaitForBrowserLogout launches a new HTTP server listening on the provided
+// address and waits for the request the logout flow returns to.
+func WaitForBrowserLogout(addr string) error {
+	doneCh := make(chan struct{})
+	errCh := make(chan error)
+
+	m := http.NewServeMux()
+	s := &http.Server{Addr: addr, Handler: m}
+
+	m.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(resultPage("Logout Successful",
+			"You can close the window and go back to the CLI to see whether the session is gone.",
+			"success-lock")))
+
+		doneCh <- struct{}{}
+	})
+
+	go func() {
+		if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case <-doneCh:
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		defer func(c context.Context) { _ = s.Shutdown(ctx) }(ctx)
+
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
 //go:embed data/result-page.html
 var resultHTML string
 
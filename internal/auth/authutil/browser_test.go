@@ -53,7 +53,7 @@ func TestWaitForBrowserCallback(t *testing.T) {
 		})
 
 		code, state, callbackErr := WaitForBrowserCallback("localhost:1234")
-		assert.Error(t, callbackErr)
+		assert.EqualError(t, callbackErr, "foo: bar")
 		assert.Equal(t, "", code)
 		assert.Equal(t, "", state)
 	})
@@ -80,3 +80,25 @@ func TestWaitForBrowserCallback(t *testing.T) {
 		assert.Equal(t, "", state)
 	})
 }
+
+func TestWaitForBrowserLogout(t *testing.T) {
+	t.Run("Handle the return from the logout", func(t *testing.T) {
+		// Set a timer to wait for the server to have started and then call the URL and assert.
+		time.AfterFunc(1*time.Second, func() {
+			client := &http.Client{}
+			resp, err := client.Get("http://localhost:1235")
+			assert.NoError(t, err)
+
+			bytes, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			body := string(bytes)
+
+			defer func() {
+				_ = resp.Body.Close()
+			}()
+			assert.Contains(t, body, "Logout Successful")
+		})
+
+		assert.NoError(t, WaitForBrowserLogout("localhost:1235"))
+	})
+}
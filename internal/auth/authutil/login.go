@@ -8,6 +8,14 @@ import (
 // BuildLoginURL constructs a URL + query string that can be used to
 // initiate a user-facing login-flow from the CLI.
 func BuildLoginURL(domain, clientID, callbackURL, state, connectionName, audience, prompt string, scopes []string) (string, error) {
+	q := BuildLoginParams(clientID, callbackURL, state, connectionName, audience, prompt, scopes)
+
+	return BuildAuthorizeURL(domain, q), nil
+}
+
+// BuildLoginParams constructs the parameters of an authorization
+// request that initiates a user-facing login-flow from the CLI.
+func BuildLoginParams(clientID, callbackURL, state, connectionName, audience, prompt string, scopes []string) url.Values {
 	q := url.Values{}
 	q.Add("client_id", clientID)
 	q.Add("response_type", "code")
@@ -30,6 +38,11 @@ func BuildLoginURL(domain, clientID, callbackURL, state, connectionName, audienc
 		q.Add("scope", strings.Join(scopes, " "))
 	}
 
+	return q
+}
+
+// BuildAuthorizeURL constructs the URL of an authorization request with the given parameters.
+func BuildAuthorizeURL(domain string, q url.Values) string {
 	u := &url.URL{
 		Scheme:   "https",
 		Host:     domain,
@@ -37,5 +50,5 @@ func BuildLoginURL(domain, clientID, callbackURL, state, connectionName, audienc
 		RawQuery: q.Encode(),
 	}
 
-	return u.String(), nil
+	return u.String()
 }
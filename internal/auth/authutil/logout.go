@@ -0,0 +1,31 @@
+package authutil
+
+import (
+	"net/url"
+)
+
+// BuildLogoutURL constructs a URL + query string that can be used to
+// initiate a user-facing logout-flow from the CLI.
+func BuildLogoutURL(domain, clientID, returnTo string, federated bool) string {
+	q := url.Values{}
+	q.Add("client_id", clientID)
+
+	if returnTo != "" {
+		q.Add("returnTo", returnTo)
+	}
+
+	rawQuery := q.Encode()
+	if federated {
+		// The federated parameter is only checked for presence, so it doesn't get a value.
+		rawQuery += "&federated"
+	}
+
+	u := &url.URL{
+		Scheme:   "https",
+		Host:     domain,
+		Path:     "/v2/logout",
+		RawQuery: rawQuery,
+	}
+
+	return u.String()
+}
@@ -0,0 +1,39 @@
+package authutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildLogoutURL(t *testing.T) {
+	var tests = []struct {
+		name      string
+		returnTo  string
+		federated bool
+		expected  string
+	}{
+		{
+			name:     "it returns to the given URL",
+			returnTo: "http://localhost:8484",
+			expected: "https://example.auth0.com/v2/logout?client_id=some-client-id&returnTo=http%3A%2F%2Flocalhost%3A8484",
+		},
+		{
+			name:      "it logs out of the identity provider too when federated",
+			returnTo:  "http://localhost:8484",
+			federated: true,
+			expected:  "https://example.auth0.com/v2/logout?client_id=some-client-id&returnTo=http%3A%2F%2Flocalhost%3A8484&federated",
+		},
+		{
+			name:     "it omits the return URL when empty",
+			expected: "https://example.auth0.com/v2/logout?client_id=some-client-id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logoutURL := BuildLogoutURL("example.auth0.com", "some-client-id", test.returnTo, test.federated)
+			assert.Equal(t, test.expected, logoutURL)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package authutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// pushedAuthorizationResponse is the response of the /oauth/par endpoint.
+type pushedAuthorizationResponse struct {
+	RequestURI       string `json:"request_uri"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// PushAuthorizationRequest pushes the parameters of an authorization request to the
+// /oauth/par endpoint, and returns the request URI to initiate the request with.
+// Public applications have no client secret, so they don't authenticate.
+func PushAuthorizationRequest(httpClient *http.Client, domain, clientSecret string, params url.Values) (string, error) {
+	data := url.Values{}
+	for key, values := range params {
+		data[key] = values
+	}
+
+	if clientSecret != "" {
+		data.Set("client_secret", clientSecret)
+	}
+
+	u := url.URL{Scheme: "https", Host: domain, Path: "/oauth/par"}
+	r, err := httpClient.PostForm(u.String(), data)
+	if err != nil {
+		return "", fmt.Errorf("unable to push the authorization request: %w", err)
+	}
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	var res pushedAuthorizationResponse
+	if err := json.NewDecoder(r.Body).Decode(&res); err != nil {
+		return "", fmt.Errorf("unable to push the authorization request: %s", r.Status)
+	}
+
+	if r.StatusCode != http.StatusCreated {
+		if res.Error != "" {
+			return "", fmt.Errorf("unable to push the authorization request: %s: %s", res.Error, res.ErrorDescription)
+		}
+
+		return "", fmt.Errorf("unable to push the authorization request: %s", r.Status)
+	}
+
+	return res.RequestURI, nil
+}
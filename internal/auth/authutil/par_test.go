@@ -0,0 +1,64 @@
+package authutil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushAuthorizationRequest(t *testing.T) {
+	t.Run("it returns the request URI", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "/oauth/par", r.URL.Path)
+			assert.Equal(t, "some-client-id", r.PostForm.Get("client_id"))
+			assert.Equal(t, "some-secret", r.PostForm.Get("client_secret"))
+			assert.Equal(t, "code", r.PostForm.Get("response_type"))
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"request_uri": "urn:ietf:params:oauth:request_uri:some-uri", "expires_in": 30}`))
+		}))
+		defer server.Close()
+
+		params := url.Values{"client_id": {"some-client-id"}, "response_type": {"code"}}
+		requestURI, err := PushAuthorizationRequest(server.Client(), strings.TrimPrefix(server.URL, "https://"), "some-secret", params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "urn:ietf:params:oauth:request_uri:some-uri", requestURI)
+		assert.Empty(t, params.Get("client_secret"))
+	})
+
+	t.Run("it doesn't authenticate public applications", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			assert.Empty(t, r.PostForm.Get("client_secret"))
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"request_uri": "urn:ietf:params:oauth:request_uri:some-uri", "expires_in": 30}`))
+		}))
+		defer server.Close()
+
+		params := url.Values{"client_id": {"some-client-id"}}
+		_, err := PushAuthorizationRequest(server.Client(), strings.TrimPrefix(server.URL, "https://"), "", params)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("it returns the error of the endpoint", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_request", "error_description": "PAR is not enabled"}`))
+		}))
+		defer server.Close()
+
+		params := url.Values{"client_id": {"some-client-id"}}
+		_, err := PushAuthorizationRequest(server.Client(), strings.TrimPrefix(server.URL, "https://"), "", params)
+
+		assert.EqualError(t, err, "unable to push the authorization request: invalid_request: PAR is not enabled")
+	})
+}
@@ -0,0 +1,69 @@
+package authutil
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+// requestObjectLifetime is how long a request object is valid for.
+const requestObjectLifetime = 5 * time.Minute
+
+// ReadRequestObjectKey reads the PEM encoded RSA private key to sign request objects with.
+func ReadRequestObjectKey(path string) (jwk.Key, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the private key: %w", err)
+	}
+
+	key, err := jwk.ParseKey(content, jwk.WithPEM(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the private key: %w", err)
+	}
+
+	if _, ok := key.(jwk.RSAPrivateKey); !ok {
+		return nil, fmt.Errorf("failed to parse the private key: it must be an RSA private key")
+	}
+
+	return key, nil
+}
+
+// SignRequestObject signs the parameters of an authorization request into a request
+// object (JAR), to be sent through the request parameter instead of the query string.
+func SignRequestObject(domain string, params url.Values, key jwk.Key) (string, error) {
+	now := time.Now()
+
+	token := jwt.New()
+	for name := range params {
+		if err := token.Set(name, params.Get(name)); err != nil {
+			return "", fmt.Errorf("failed to build the request object: %w", err)
+		}
+	}
+
+	claims := map[string]interface{}{
+		jwt.IssuerKey:     params.Get("client_id"),
+		jwt.AudienceKey:   fmt.Sprintf("https://%s/", domain),
+		jwt.IssuedAtKey:   now,
+		jwt.NotBeforeKey:  now,
+		jwt.ExpirationKey: now.Add(requestObjectLifetime),
+		jwt.JwtIDKey:      uuid.NewString(),
+	}
+	for name, value := range claims {
+		if err := token.Set(name, value); err != nil {
+			return "", fmt.Errorf("failed to build the request object: %w", err)
+		}
+	}
+
+	signed, err := jwt.Sign(token, jwa.RS256, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign the request object: %w", err)
+	}
+
+	return string(signed), nil
+}
@@ -0,0 +1,61 @@
+package authutil
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignRequestObject(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "private.pem")
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0600))
+
+	key, err := ReadRequestObjectKey(keyFile)
+	require.NoError(t, err)
+
+	params := url.Values{"client_id": {"some-client-id"}, "response_type": {"code"}, "scope": {"openid profile"}}
+	requestObject, err := SignRequestObject("example.auth0.com", params, key)
+	require.NoError(t, err)
+
+	token, err := jwt.ParseString(requestObject, jwt.WithVerify(jwa.RS256, &privateKey.PublicKey))
+	require.NoError(t, err)
+
+	assert.Equal(t, "some-client-id", token.Issuer())
+	assert.Equal(t, []string{"https://example.auth0.com/"}, token.Audience())
+	assert.NotEmpty(t, token.JwtID())
+	assert.True(t, token.Expiration().After(token.IssuedAt()))
+
+	scope, _ := token.Get("scope")
+	assert.Equal(t, "openid profile", scope)
+	responseType, _ := token.Get("response_type")
+	assert.Equal(t, "code", responseType)
+}
+
+func TestReadRequestObjectKey(t *testing.T) {
+	t.Run("it fails when the key doesn't exist", func(t *testing.T) {
+		_, err := ReadRequestObjectKey(filepath.Join(t.TempDir(), "missing.pem"))
+		assert.ErrorContains(t, err, "failed to read the private key")
+	})
+
+	t.Run("it fails when the key isn't PEM encoded", func(t *testing.T) {
+		keyFile := filepath.Join(t.TempDir(), "private.pem")
+		require.NoError(t, os.WriteFile(keyFile, []byte("not a key"), 0600))
+
+		_, err := ReadRequestObjectKey(keyFile)
+		assert.ErrorContains(t, err, "failed to parse the private key")
+	})
+}
@@ -59,3 +59,43 @@ func strInArray(haystack []string, needle string) bool {
 
 	return false
 }
+
+func TestExpandScopes(t *testing.T) {
+	t.Run("it expands wildcard scopes", func(t *testing.T) {
+		expandedScopes := ExpandScopes([]string{"openid", "read:*"})
+
+		if expandedScopes[0] != "openid" {
+			t.Fatalf("wanted scope %q first, list: %+v", "openid", expandedScopes)
+		}
+
+		for _, scope := range RequiredScopes {
+			inExpandedScopes := strInArray(expandedScopes, scope)
+			isExpected := scope == "openid" || (len(scope) > 5 && scope[:5] == "read:")
+			if inExpandedScopes != isExpected {
+				t.Fatalf("unexpected presence of scope %q: %t, list: %+v", scope, inExpandedScopes, expandedScopes)
+			}
+		}
+	})
+
+	t.Run("it removes duplicate scopes", func(t *testing.T) {
+		expandedScopes := ExpandScopes([]string{"read:clients", "*:clients", "read:clients"})
+
+		expected := []string{"read:clients", "create:clients", "delete:clients", "update:clients"}
+		if len(expandedScopes) != len(expected) {
+			t.Fatalf("wanted scopes: %+v, list: %+v", expected, expandedScopes)
+		}
+		for i := range expected {
+			if expandedScopes[i] != expected[i] {
+				t.Fatalf("wanted scopes: %+v, list: %+v", expected, expandedScopes)
+			}
+		}
+	})
+
+	t.Run("it keeps wildcards that don't match any known scope", func(t *testing.T) {
+		expandedScopes := ExpandScopes([]string{"read:magazines*"})
+
+		if len(expandedScopes) != 1 || expandedScopes[0] != "read:magazines*" {
+			t.Fatalf("wanted scopes: %+v, list: %+v", []string{"read:magazines*"}, expandedScopes)
+		}
+	})
+}
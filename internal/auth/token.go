@@ -58,3 +58,32 @@ func RefreshAccessToken(httpClient *http.Client, tenant string) (TokenResponse,
 
 	return res, nil
 }
+
+// RevokeRefreshToken revokes the refresh token stored for the tenant
+// so that it can no longer be used to request new access tokens.
+// A missing refresh token is not considered an error as there is nothing to revoke.
+func RevokeRefreshToken(httpClient *http.Client, tenant string) error {
+	refreshToken, err := keyring.GetRefreshToken(tenant)
+	if err != nil || refreshToken == "" {
+		return nil
+	}
+
+	r, err := httpClient.PostForm(credentials.OauthRevokeEndpoint, url.Values{
+		"client_id": {credentials.ClientID},
+		"token":     {refreshToken},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot revoke the refresh token: %w", err)
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	if r.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(r.Body)
+		return fmt.Errorf("cannot revoke the refresh token: %s", string(b))
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
@@ -98,3 +99,86 @@ func TestTokenRetriever_Refresh(t *testing.T) {
 		}
 	})
 }
+
+func TestRevokeRefreshToken(t *testing.T) {
+	testTenantName := "auth0-cli-test.us.auth0.com"
+
+	oldCreds := credentials
+	defer func() {
+		credentials = oldCreds
+	}()
+	credentials = &Credentials{
+		ClientID:            "client-id",
+		OauthRevokeEndpoint: "https://test.com/oauth/revoke",
+	}
+
+	t.Run("it revokes the stored refresh token", func(t *testing.T) {
+		goKeyring.MockInit()
+		err := keyring.StoreRefreshToken(testTenantName, "refresh-token-here")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		transport := &testTransport{
+			withResponse: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(``))),
+			},
+		}
+
+		err = RevokeRefreshToken(&http.Client{Transport: transport}, testTenantName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := transport.requests[0]
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+
+		if want, got := "https://test.com/oauth/revoke", req.URL.String(); want != got {
+			t.Fatalf("wanted request URL: %v, got: %v", want, got)
+		}
+		if want, got := "client-id", req.Form["client_id"][0]; want != got {
+			t.Fatalf("wanted client_id: %v, got: %v", want, got)
+		}
+		if want, got := "refresh-token-here", req.Form["token"][0]; want != got {
+			t.Fatalf("wanted token: %v, got: %v", want, got)
+		}
+	})
+
+	t.Run("it does nothing if there is no refresh token", func(t *testing.T) {
+		goKeyring.MockInit()
+
+		transport := &testTransport{}
+
+		err := RevokeRefreshToken(&http.Client{Transport: transport}, testTenantName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(transport.requests) != 0 {
+			t.Fatalf("wanted no requests, got: %d", len(transport.requests))
+		}
+	})
+
+	t.Run("it returns an error if the revocation fails", func(t *testing.T) {
+		goKeyring.MockInit()
+		err := keyring.StoreRefreshToken(testTenantName, "refresh-token-here")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		transport := &testTransport{
+			withResponse: &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{"error":"invalid_request"}`))),
+			},
+		}
+
+		err = RevokeRefreshToken(&http.Client{Transport: transport}, testTenantName)
+		if want, got := `cannot revoke the refresh token: {"error":"invalid_request"}`, fmt.Sprint(err); want != got {
+			t.Fatalf("wanted error: %v, got: %v", want, got)
+		}
+	})
+}
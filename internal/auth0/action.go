@@ -44,8 +44,18 @@ type ActionAPI interface {
 	// See: https://auth0.com/docs/api/management/v2/#!/Actions/get_bindings
 	Bindings(ctx context.Context, triggerID string, opts ...management.RequestOption) (bl *management.ActionBindingList, err error)
 
+	// UpdateBindings updates the actions bound to a trigger, and their order.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Actions/patch_bindings
+	UpdateBindings(ctx context.Context, triggerID string, b []*management.ActionBinding, opts ...management.RequestOption) error
+
 	// Deploy an action.
 	//
 	// See: https://auth0.com/docs/api/management/v2/#!/Actions/post_deploy_action
 	Deploy(ctx context.Context, id string, opts ...management.RequestOption) (v *management.ActionVersion, err error)
+
+	// Execution retrieves the details of an action execution.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Actions/get_execution
+	Execution(ctx context.Context, executionID string, opts ...management.RequestOption) (v *management.ActionExecution, err error)
 }
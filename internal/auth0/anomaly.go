@@ -1,3 +1,5 @@
+//go:generate mockgen -source=anomaly.go -destination=mock/anomaly_mock.go -package=mock
+
 package auth0
 
 import (
@@ -1,3 +1,5 @@
+//go:generate mockgen -source=attack_protection.go -destination=mock/attack_protection_mock.go -package=mock
+
 package auth0
 
 import (
@@ -0,0 +1,57 @@
+//go:generate mockgen -source=connection_options.go -destination=mock/connection_options_mock.go -package=mock
+
+package auth0
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+// ConnectionOptionsAPI reads and updates the options of the connections as raw JSON objects.
+// The connection options of the SDK only hold the fields it knows of for each strategy, so
+// updating them through the SDK would drop the other fields, as the options are replaced as a whole.
+type ConnectionOptionsAPI interface {
+	// Read retrieves the options of a connection by its id.
+	Read(ctx context.Context, id string, opts ...management.RequestOption) (map[string]interface{}, error)
+
+	// Update replaces the options of a connection.
+	Update(ctx context.Context, id string, options map[string]interface{}, opts ...management.RequestOption) error
+}
+
+type connectionOptionsManager struct {
+	management *management.Management
+}
+
+func (m *connectionOptionsManager) Read(
+	ctx context.Context,
+	id string,
+	opts ...management.RequestOption,
+) (map[string]interface{}, error) {
+	var connection struct {
+		Options map[string]interface{} `json:"options"`
+	}
+
+	opts = append(opts, management.IncludeFields("options"))
+	if err := m.management.Request(ctx, http.MethodGet, m.management.URI("connections", id), &connection, opts...); err != nil {
+		return nil, err
+	}
+
+	if connection.Options == nil {
+		connection.Options = make(map[string]interface{})
+	}
+
+	return connection.Options, nil
+}
+
+func (m *connectionOptionsManager) Update(
+	ctx context.Context,
+	id string,
+	options map[string]interface{},
+	opts ...management.RequestOption,
+) error {
+	connection := map[string]interface{}{"options": options}
+
+	return m.management.Request(ctx, http.MethodPatch, m.management.URI("connections", id), &connection, opts...)
+}
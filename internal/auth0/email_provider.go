@@ -9,7 +9,15 @@ import (
 )
 
 type EmailProviderAPI interface {
+	// Create an email provider.
+	// See: https://auth0.com/docs/api/management/v2#!/Emails/post_provider
+	Create(ctx context.Context, ep *management.EmailProvider, opts ...management.RequestOption) (err error)
+
 	// Read email provider details.
 	// See: https://auth0.com/docs/api/management/v2#!/Emails/get_provider
 	Read(ctx context.Context, opts ...management.RequestOption) (ep *management.EmailProvider, err error)
+
+	// Update an email provider.
+	// See: https://auth0.com/docs/api/management/v2#!/Emails/patch_provider
+	Update(ctx context.Context, ep *management.EmailProvider, opts ...management.RequestOption) (err error)
 }
@@ -0,0 +1,18 @@
+//go:generate mockgen -source=hook.go -destination=mock/hook_mock.go -package=mock
+
+package auth0
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+type HookAPI interface {
+	// List hooks.
+	//
+	// Required scope: `read:hooks`
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Hooks/get_hooks
+	List(ctx context.Context, opts ...management.RequestOption) (l *management.HookList, err error)
+}
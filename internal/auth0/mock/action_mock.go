@@ -93,6 +93,26 @@ func (mr *MockActionAPIMockRecorder) Deploy(ctx, id interface{}, opts ...interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockActionAPI)(nil).Deploy), varargs...)
 }
 
+// Execution mocks base method.
+func (m *MockActionAPI) Execution(ctx context.Context, executionID string, opts ...management.RequestOption) (*management.ActionExecution, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, executionID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Execution", varargs...)
+	ret0, _ := ret[0].(*management.ActionExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execution indicates an expected call of Execution.
+func (mr *MockActionAPIMockRecorder) Execution(ctx, executionID interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, executionID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execution", reflect.TypeOf((*MockActionAPI)(nil).Execution), varargs...)
+}
+
 // List mocks base method.
 func (m *MockActionAPI) List(ctx context.Context, opts ...management.RequestOption) (*management.ActionList, error) {
 	m.ctrl.T.Helper()
@@ -173,6 +193,25 @@ func (mr *MockActionAPIMockRecorder) Bindings(ctx interface{}, triggerID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bindings", reflect.TypeOf((*MockActionAPI)(nil).Bindings), varargs...)
 }
 
+// UpdateBindings mocks base method.
+func (m *MockActionAPI) UpdateBindings(ctx context.Context, triggerID string, b []*management.ActionBinding, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, triggerID, b}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBindings", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBindings indicates an expected call of UpdateBindings.
+func (mr *MockActionAPIMockRecorder) UpdateBindings(ctx, triggerID, b interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, triggerID, b}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBindings", reflect.TypeOf((*MockActionAPI)(nil).UpdateBindings), varargs...)
+}
+
 // Update mocks base method.
 func (m *MockActionAPI) Update(ctx context.Context, id string, a *management.Action, opts ...management.RequestOption) error {
 	m.ctrl.T.Helper()
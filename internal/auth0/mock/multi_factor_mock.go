@@ -0,0 +1,76 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: multi_factor.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	management "github.com/auth0/go-auth0/management"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMultiFactorAPI is a mock of MultiFactorAPI interface.
+type MockMultiFactorAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMultiFactorAPIMockRecorder
+}
+
+// MockMultiFactorAPIMockRecorder is the mock recorder for MockMultiFactorAPI.
+type MockMultiFactorAPIMockRecorder struct {
+	mock *MockMultiFactorAPI
+}
+
+// NewMockMultiFactorAPI creates a new mock instance.
+func NewMockMultiFactorAPI(ctrl *gomock.Controller) *MockMultiFactorAPI {
+	mock := &MockMultiFactorAPI{ctrl: ctrl}
+	mock.recorder = &MockMultiFactorAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMultiFactorAPI) EXPECT() *MockMultiFactorAPIMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockMultiFactorAPI) List(ctx context.Context, opts ...management.RequestOption) ([]*management.MultiFactor, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*management.MultiFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMultiFactorAPIMockRecorder) List(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMultiFactorAPI)(nil).List), varargs...)
+}
+
+// Policy mocks base method.
+func (m *MockMultiFactorAPI) Policy(ctx context.Context, opts ...management.RequestOption) (*management.MultiFactorPolicies, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Policy", varargs...)
+	ret0, _ := ret[0].(*management.MultiFactorPolicies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Policy indicates an expected call of Policy.
+func (mr *MockMultiFactorAPIMockRecorder) Policy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Policy", reflect.TypeOf((*MockMultiFactorAPI)(nil).Policy), varargs...)
+}
@@ -35,6 +35,65 @@ func (m *MockOrganizationAPI) EXPECT() *MockOrganizationAPIMockRecorder {
 	return m.recorder
 }
 
+// AssociateClientGrant mocks base method.
+func (m *MockOrganizationAPI) AssociateClientGrant(ctx context.Context, id, grantID string, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, grantID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssociateClientGrant", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateClientGrant indicates an expected call of AssociateClientGrant.
+func (mr *MockOrganizationAPIMockRecorder) AssociateClientGrant(ctx, id, grantID interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id, grantID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateClientGrant", reflect.TypeOf((*MockOrganizationAPI)(nil).AssociateClientGrant), varargs...)
+}
+
+// ClientGrants mocks base method.
+func (m *MockOrganizationAPI) ClientGrants(ctx context.Context, id string, opts ...management.RequestOption) (*management.ClientGrantList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClientGrants", varargs...)
+	ret0, _ := ret[0].(*management.ClientGrantList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClientGrants indicates an expected call of ClientGrants.
+func (mr *MockOrganizationAPIMockRecorder) ClientGrants(ctx, id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientGrants", reflect.TypeOf((*MockOrganizationAPI)(nil).ClientGrants), varargs...)
+}
+
+// Connections mocks base method.
+func (m *MockOrganizationAPI) Connections(ctx context.Context, id string, opts ...management.RequestOption) (*management.OrganizationConnectionList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Connections", varargs...)
+	ret0, _ := ret[0].(*management.OrganizationConnectionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Connections indicates an expected call of Connections.
+func (mr *MockOrganizationAPIMockRecorder) Connections(ctx, id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connections", reflect.TypeOf((*MockOrganizationAPI)(nil).Connections), varargs...)
+}
+
 // Create mocks base method.
 func (m *MockOrganizationAPI) Create(ctx context.Context, o *management.Organization, opts ...management.RequestOption) error {
 	m.ctrl.T.Helper()
@@ -153,41 +212,60 @@ func (mr *MockOrganizationAPIMockRecorder) Read(ctx, id interface{}, opts ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockOrganizationAPI)(nil).Read), varargs...)
 }
 
-// Update mocks base method.
-func (m *MockOrganizationAPI) Update(ctx context.Context, id string, o *management.Organization, opts ...management.RequestOption) error {
+// ReadByName mocks base method.
+func (m *MockOrganizationAPI) ReadByName(ctx context.Context, name string, opts ...management.RequestOption) (*management.Organization, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, id, o}
+	varargs := []interface{}{ctx, name}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Update", varargs...)
+	ret := m.ctrl.Call(m, "ReadByName", varargs...)
+	ret0, _ := ret[0].(*management.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadByName indicates an expected call of ReadByName.
+func (mr *MockOrganizationAPIMockRecorder) ReadByName(ctx, name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByName", reflect.TypeOf((*MockOrganizationAPI)(nil).ReadByName), varargs...)
+}
+
+// RemoveClientGrant mocks base method.
+func (m *MockOrganizationAPI) RemoveClientGrant(ctx context.Context, id, grantID string, opts ...management.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id, grantID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveClientGrant", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Update indicates an expected call of Update.
-func (mr *MockOrganizationAPIMockRecorder) Update(ctx, id, o interface{}, opts ...interface{}) *gomock.Call {
+// RemoveClientGrant indicates an expected call of RemoveClientGrant.
+func (mr *MockOrganizationAPIMockRecorder) RemoveClientGrant(ctx, id, grantID interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, id, o}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationAPI)(nil).Update), varargs...)
+	varargs := append([]interface{}{ctx, id, grantID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveClientGrant", reflect.TypeOf((*MockOrganizationAPI)(nil).RemoveClientGrant), varargs...)
 }
 
-// Connections mocks base method.
-func (m *MockOrganizationAPI) Connections(ctx context.Context, id string, opts ...management.RequestOption) (*management.OrganizationConnectionList, error) {
+// Update mocks base method.
+func (m *MockOrganizationAPI) Update(ctx context.Context, id string, o *management.Organization, opts ...management.RequestOption) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, id}
+	varargs := []interface{}{ctx, id, o}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Connections", varargs...)
-	ret0, _ := ret[0].(*management.OrganizationConnectionList)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Update", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Connections indicates an expected call of Connections.
-func (mr *MockOrganizationAPIMockRecorder) Connections(ctx, id interface{}, opts ...interface{}) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockOrganizationAPIMockRecorder) Update(ctx, id, o interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connections", reflect.TypeOf((*MockOrganizationAPI)(nil).Connections), varargs...)
-}
\ No newline at end of file
+	varargs := append([]interface{}{ctx, id, o}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationAPI)(nil).Update), varargs...)
+}
@@ -189,3 +189,23 @@ func (mr *MockRoleAPIMockRecorder) Update(ctx, id, r interface{}, opts ...interf
 	varargs := append([]interface{}{ctx, id, r}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoleAPI)(nil).Update), varargs...)
 }
+
+// Users mocks base method.
+func (m *MockRoleAPI) Users(ctx context.Context, id string, opts ...management.RequestOption) (*management.UserList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Users", varargs...)
+	ret0, _ := ret[0].(*management.UserList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Users indicates an expected call of Users.
+func (mr *MockRoleAPIMockRecorder) Users(ctx, id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockRoleAPI)(nil).Users), varargs...)
+}
@@ -0,0 +1,25 @@
+//go:generate mockgen -source=multi_factor.go -destination=mock/multi_factor_mock.go -package=mock
+
+package auth0
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+type MultiFactorAPI interface {
+	// List retrieves all factors.
+	//
+	// Required scope: `read:guardian_factors`
+	//
+	// See: https://auth0.com/docs/api/management/v2#!/Guardian/get_factors
+	List(ctx context.Context, opts ...management.RequestOption) (mf []*management.MultiFactor, err error)
+
+	// Policy retrieves MFA policies.
+	//
+	// Required scope: `read:mfa_policies`
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Guardian/get_policies
+	Policy(ctx context.Context, opts ...management.RequestOption) (p *management.MultiFactorPolicies, err error)
+}
@@ -0,0 +1,213 @@
+//go:generate mockgen -source=network_acl.go -destination=mock/network_acl_mock.go -package=mock
+
+package auth0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+// NetworkACL is a tenant-level network access control rule.
+type NetworkACL struct {
+	ID          *string         `json:"id,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	Active      *bool           `json:"active,omitempty"`
+	Priority    *int            `json:"priority,omitempty"`
+	Rule        *NetworkACLRule `json:"rule,omitempty"`
+	CreatedAt   *string         `json:"created_at,omitempty"`
+	UpdatedAt   *string         `json:"updated_at,omitempty"`
+}
+
+// NetworkACLRule describes which requests a network ACL applies to and what to do with them.
+type NetworkACLRule struct {
+	Action   *NetworkACLRuleAction `json:"action,omitempty"`
+	Match    *NetworkACLRuleMatch  `json:"match,omitempty"`
+	NotMatch *NetworkACLRuleMatch  `json:"not_match,omitempty"`
+
+	// Scope is either "management", "authentication" or "tenant".
+	Scope *string `json:"scope,omitempty"`
+}
+
+// NetworkACLRuleAction is the action to take on the requests the rule applies to.
+// Only one of the actions can be set.
+type NetworkACLRuleAction struct {
+	Block       *bool   `json:"block,omitempty"`
+	Allow       *bool   `json:"allow,omitempty"`
+	Log         *bool   `json:"log,omitempty"`
+	Redirect    *bool   `json:"redirect,omitempty"`
+	RedirectURI *string `json:"redirect_uri,omitempty"`
+}
+
+// NetworkACLRuleMatch holds the criteria to match the requests against.
+type NetworkACLRuleMatch struct {
+	ASNs                []int    `json:"asns,omitempty"`
+	GeoCountryCodes     []string `json:"geo_country_codes,omitempty"`
+	GeoSubdivisionCodes []string `json:"geo_subdivision_codes,omitempty"`
+	IPv4CIDRs           []string `json:"ipv4_cidrs,omitempty"`
+	IPv6CIDRs           []string `json:"ipv6_cidrs,omitempty"`
+	JA3Fingerprints     []string `json:"ja3_fingerprints,omitempty"`
+	JA4Fingerprints     []string `json:"ja4_fingerprints,omitempty"`
+	UserAgents          []string `json:"user_agents,omitempty"`
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *NetworkACL) GetID() string {
+	if n == nil || n.ID == nil {
+		return ""
+	}
+	return *n.ID
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (n *NetworkACL) GetDescription() string {
+	if n == nil || n.Description == nil {
+		return ""
+	}
+	return *n.Description
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (n *NetworkACL) GetActive() bool {
+	if n == nil || n.Active == nil {
+		return false
+	}
+	return *n.Active
+}
+
+// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
+func (n *NetworkACL) GetPriority() int {
+	if n == nil || n.Priority == nil {
+		return 0
+	}
+	return *n.Priority
+}
+
+// GetRule returns the Rule field.
+func (n *NetworkACL) GetRule() *NetworkACLRule {
+	if n == nil {
+		return nil
+	}
+	return n.Rule
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (r *NetworkACLRule) GetScope() string {
+	if r == nil || r.Scope == nil {
+		return ""
+	}
+	return *r.Scope
+}
+
+// GetAction returns the Action field.
+func (r *NetworkACLRule) GetAction() *NetworkACLRuleAction {
+	if r == nil {
+		return nil
+	}
+	return r.Action
+}
+
+// Name returns the name of the action that's set, e.g. "block".
+func (a *NetworkACLRuleAction) Name() string {
+	switch {
+	case a == nil:
+		return ""
+	case a.Block != nil && *a.Block:
+		return "block"
+	case a.Allow != nil && *a.Allow:
+		return "allow"
+	case a.Log != nil && *a.Log:
+		return "log"
+	case a.Redirect != nil && *a.Redirect:
+		return "redirect"
+	default:
+		return ""
+	}
+}
+
+type NetworkACLAPI interface {
+	// Create a new network ACL.
+	//
+	// See: https://auth0.com/docs/api/management/v2/network-acls/post-network-acls
+	Create(ctx context.Context, n *NetworkACL, opts ...management.RequestOption) error
+
+	// Read a network ACL.
+	//
+	// See: https://auth0.com/docs/api/management/v2/network-acls/get-network-acls-by-id
+	Read(ctx context.Context, id string, opts ...management.RequestOption) (*NetworkACL, error)
+
+	// Update a network ACL.
+	//
+	// See: https://auth0.com/docs/api/management/v2/network-acls/patch-network-acls-by-id
+	Update(ctx context.Context, id string, n *NetworkACL, opts ...management.RequestOption) error
+
+	// Delete a network ACL.
+	//
+	// See: https://auth0.com/docs/api/management/v2/network-acls/delete-network-acls-by-id
+	Delete(ctx context.Context, id string, opts ...management.RequestOption) error
+
+	// List all the network ACLs of the tenant.
+	//
+	// See: https://auth0.com/docs/api/management/v2/network-acls/get-network-acls
+	List(ctx context.Context, opts ...management.RequestOption) ([]*NetworkACL, error)
+}
+
+// networkACLManager manages the network ACLs through the generic request
+// helpers of the Management SDK, as the SDK doesn't support them yet.
+type networkACLManager struct {
+	management *management.Management
+}
+
+func (m *networkACLManager) Create(ctx context.Context, n *NetworkACL, opts ...management.RequestOption) error {
+	return m.management.Request(ctx, http.MethodPost, m.management.URI("network-acls"), n, opts...)
+}
+
+func (m *networkACLManager) Read(ctx context.Context, id string, opts ...management.RequestOption) (*NetworkACL, error) {
+	var networkACL NetworkACL
+	err := m.management.Request(ctx, http.MethodGet, m.management.URI("network-acls", id), &networkACL, opts...)
+	return &networkACL, err
+}
+
+func (m *networkACLManager) Update(
+	ctx context.Context,
+	id string,
+	n *NetworkACL,
+	opts ...management.RequestOption,
+) error {
+	return m.management.Request(ctx, http.MethodPatch, m.management.URI("network-acls", id), n, opts...)
+}
+
+func (m *networkACLManager) Delete(ctx context.Context, id string, opts ...management.RequestOption) error {
+	return m.management.Request(ctx, http.MethodDelete, m.management.URI("network-acls", id), nil, opts...)
+}
+
+func (m *networkACLManager) List(ctx context.Context, opts ...management.RequestOption) ([]*NetworkACL, error) {
+	var response json.RawMessage
+	if err := m.management.Request(ctx, http.MethodGet, m.management.URI("network-acls"), &response, opts...); err != nil {
+		return nil, err
+	}
+
+	var networkACLs []*NetworkACL
+
+	// The network ACLs are wrapped in an envelope when the totals are requested.
+	if !bytes.HasPrefix(bytes.TrimSpace(response), []byte("[")) {
+		var list struct {
+			NetworkACLs []*NetworkACL `json:"network_acls"`
+		}
+		if len(response) > 0 {
+			if err := json.Unmarshal(response, &list); err != nil {
+				return nil, err
+			}
+		}
+		return list.NetworkACLs, nil
+	}
+
+	if err := json.Unmarshal(response, &networkACLs); err != nil {
+		return nil, err
+	}
+
+	return networkACLs, nil
+}
@@ -0,0 +1,60 @@
+package auth0
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkACLManager_List(t *testing.T) {
+	var tests = []struct {
+		name     string
+		response string
+	}{
+		{
+			name:     "it lists the network ACLs",
+			response: `[{"id": "acl_1", "priority": 1}, {"id": "acl_2", "priority": 2}]`,
+		},
+		{
+			name:     "it lists the network ACLs wrapped in an envelope",
+			response: `{"network_acls": [{"id": "acl_1", "priority": 1}, {"id": "acl_2", "priority": 2}], "total": 2}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v2/network-acls", r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.response))
+			}))
+			t.Cleanup(server.Close)
+
+			api, err := management.New(strings.TrimPrefix(server.URL, "http://"), management.WithInsecure())
+			require.NoError(t, err)
+
+			manager := &networkACLManager{management: api}
+
+			list, err := manager.List(context.Background())
+			require.NoError(t, err)
+			require.Len(t, list, 2)
+			assert.Equal(t, "acl_1", list[0].GetID())
+			assert.Equal(t, 2, list[1].GetPriority())
+		})
+	}
+}
+
+func TestNetworkACLRuleAction_Name(t *testing.T) {
+	var action *NetworkACLRuleAction
+	assert.Equal(t, "", action.Name())
+
+	assert.Equal(t, "allow", (&NetworkACLRuleAction{Allow: Bool(true)}).Name())
+	assert.Equal(t, "log", (&NetworkACLRuleAction{Log: Bool(true)}).Name())
+}
@@ -19,6 +19,11 @@ type OrganizationAPI interface {
 	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_organizations_by_id
 	Read(ctx context.Context, id string, opts ...management.RequestOption) (*management.Organization, error)
 
+	// ReadByName retrieves a specific organization by name.
+	//
+	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_name_by_name
+	ReadByName(ctx context.Context, name string, opts ...management.RequestOption) (*management.Organization, error)
+
 	// Update an organization.
 	//
 	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/patch_organizations_by_id
@@ -48,4 +53,19 @@ type OrganizationAPI interface {
 	//
 	// See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_enabled_connections
 	Connections(ctx context.Context, id string, opts ...management.RequestOption) (c *management.OrganizationConnectionList, err error)
+
+	// ClientGrants retrieves the client grants associated with an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/organizations/get-organization-client-grants
+	ClientGrants(ctx context.Context, id string, opts ...management.RequestOption) (g *management.ClientGrantList, err error)
+
+	// AssociateClientGrant assigns a client grant to an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/organizations/create-organization-client-grants
+	AssociateClientGrant(ctx context.Context, id string, grantID string, opts ...management.RequestOption) (err error)
+
+	// RemoveClientGrant removes a client grant from an organization.
+	//
+	// See: https://auth0.com/docs/api/management/v2/organizations/delete-client-grants-by-grant-id
+	RemoveClientGrant(ctx context.Context, id string, grantID string, opts ...management.RequestOption) (err error)
 }
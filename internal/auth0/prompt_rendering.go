@@ -0,0 +1,107 @@
+//go:generate mockgen -source=prompt_rendering.go -destination=mock/prompt_rendering_mock.go -package=mock
+
+package auth0
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+// PromptRendering holds the rendering settings of a Universal Login screen,
+// used by the Advanced Customizations for Universal Login (ACUL).
+type PromptRendering struct {
+	// Tenant, Prompt and Screen are set by the API and are read-only.
+	Tenant *string `json:"tenant,omitempty"`
+	Prompt *string `json:"prompt,omitempty"`
+	Screen *string `json:"screen,omitempty"`
+
+	// RenderingMode is either "standard" or "advanced".
+	RenderingMode *string `json:"rendering_mode,omitempty"`
+
+	// ContextConfiguration lists the context values made available to the screen.
+	ContextConfiguration *[]string `json:"context_configuration,omitempty"`
+
+	// DefaultHeadTagsDisabled overrides the default head tags of the screen.
+	DefaultHeadTagsDisabled *bool `json:"default_head_tags_disabled,omitempty"`
+
+	// HeadTags lists the tags to inject into the head of the screen.
+	HeadTags []interface{} `json:"head_tags,omitempty"`
+}
+
+// GetRenderingMode returns the RenderingMode field if it's non-nil, zero value otherwise.
+func (p *PromptRendering) GetRenderingMode() string {
+	if p == nil || p.RenderingMode == nil {
+		return ""
+	}
+	return *p.RenderingMode
+}
+
+// GetContextConfiguration returns the ContextConfiguration field if it's non-nil, zero value otherwise.
+func (p *PromptRendering) GetContextConfiguration() []string {
+	if p == nil || p.ContextConfiguration == nil {
+		return nil
+	}
+	return *p.ContextConfiguration
+}
+
+// GetDefaultHeadTagsDisabled returns the DefaultHeadTagsDisabled field if it's non-nil, zero value otherwise.
+func (p *PromptRendering) GetDefaultHeadTagsDisabled() bool {
+	if p == nil || p.DefaultHeadTagsDisabled == nil {
+		return false
+	}
+	return *p.DefaultHeadTagsDisabled
+}
+
+type PromptRenderingAPI interface {
+	// Read retrieves the rendering settings of a prompt screen.
+	//
+	// See: https://auth0.com/docs/api/management/v2/prompts/get-rendering
+	Read(ctx context.Context, prompt, screen string, opts ...management.RequestOption) (*PromptRendering, error)
+
+	// Update the rendering settings of a prompt screen.
+	//
+	// See: https://auth0.com/docs/api/management/v2/prompts/patch-rendering
+	Update(ctx context.Context, prompt, screen string, r *PromptRendering, opts ...management.RequestOption) error
+}
+
+// promptRenderingManager manages the rendering settings through the generic
+// request helpers of the Management SDK, as the SDK doesn't support them yet.
+type promptRenderingManager struct {
+	management *management.Management
+}
+
+func (m *promptRenderingManager) Read(
+	ctx context.Context,
+	prompt, screen string,
+	opts ...management.RequestOption,
+) (*PromptRendering, error) {
+	var rendering PromptRendering
+	uri := m.management.URI("prompts", prompt, "screen", screen, "rendering")
+	err := m.management.Request(ctx, http.MethodGet, uri, &rendering, opts...)
+	return &rendering, err
+}
+
+func (m *promptRenderingManager) Update(
+	ctx context.Context,
+	prompt, screen string,
+	r *PromptRendering,
+	opts ...management.RequestOption,
+) error {
+	// The read-only fields are rejected by the API.
+	payload := &PromptRendering{
+		RenderingMode:           r.RenderingMode,
+		ContextConfiguration:    r.ContextConfiguration,
+		DefaultHeadTagsDisabled: r.DefaultHeadTagsDisabled,
+		HeadTags:                r.HeadTags,
+	}
+
+	uri := m.management.URI("prompts", prompt, "screen", screen, "rendering")
+	if err := m.management.Request(ctx, http.MethodPatch, uri, payload, opts...); err != nil {
+		return err
+	}
+
+	*r = *payload
+	return nil
+}
@@ -0,0 +1,76 @@
+package auth0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromptRenderingManager(t *testing.T) {
+	var (
+		lastMethod  string
+		lastPath    string
+		lastPayload map[string]interface{}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastMethod = r.Method
+		lastPath = r.URL.Path
+
+		lastPayload = nil
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&lastPayload)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"tenant": "example",
+			"prompt": "login-id",
+			"screen": "login-id",
+			"rendering_mode": "advanced",
+			"context_configuration": ["branding.settings"],
+			"default_head_tags_disabled": false,
+			"head_tags": [{"tag": "script", "attributes": {"src": "https://cdn.example.com/login.js"}}]
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	api, err := management.New(strings.TrimPrefix(server.URL, "http://"), management.WithInsecure())
+	require.NoError(t, err)
+
+	manager := &promptRenderingManager{management: api}
+
+	t.Run("it reads the rendering settings of a screen", func(t *testing.T) {
+		rendering, err := manager.Read(context.Background(), "login-id", "login-id")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.MethodGet, lastMethod)
+		assert.Equal(t, "/api/v2/prompts/login-id/screen/login-id/rendering", lastPath)
+		assert.Equal(t, "advanced", rendering.GetRenderingMode())
+		assert.Equal(t, []string{"branding.settings"}, rendering.GetContextConfiguration())
+		assert.Len(t, rendering.HeadTags, 1)
+	})
+
+	t.Run("it updates the rendering settings of a screen without the read-only fields", func(t *testing.T) {
+		rendering := &PromptRendering{
+			Prompt:        String("login-id"),
+			Screen:        String("login-id"),
+			RenderingMode: String("advanced"),
+		}
+
+		err := manager.Update(context.Background(), "login-id", "login-id", rendering)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.MethodPatch, lastMethod)
+		assert.Equal(t, "/api/v2/prompts/login-id/screen/login-id/rendering", lastPath)
+		assert.Equal(t, map[string]interface{}{"rendering_mode": "advanced"}, lastPayload)
+		assert.Equal(t, []string{"branding.settings"}, rendering.GetContextConfiguration())
+	})
+}
@@ -40,6 +40,42 @@ func (q Quickstart) SamplePath(downloadPath string) (string, error) {
 	return path.Join(downloadPath, query.Get("path")), nil
 }
 
+// SampleRepoURL returns the URL of the sample application of the quickstart on GitHub.
+func (q Quickstart) SampleRepoURL() (string, error) {
+	link, err := url.Parse(q.DownloadLink)
+	if err != nil {
+		return "", err
+	}
+
+	query := link.Query()
+	if query.Get("repo") == "" {
+		return "", fmt.Errorf("failed to find the sample repository of the quickstart %q", q.Name)
+	}
+
+	repoURL := fmt.Sprintf("https://github.com/%s/%s", quickstartsOrg, query.Get("repo"))
+	if branch := query.Get("branch"); branch != "" {
+		repoURL += "/tree/" + path.Join(branch, query.Get("path"))
+	}
+
+	return repoURL, nil
+}
+
+// ApplicationType returns the type of the Auth0 applications the quickstart is meant for.
+func (q Quickstart) ApplicationType() string {
+	switch q.AppType {
+	case "native":
+		return "native"
+	case "spa":
+		return "spa"
+	case "webapp":
+		return "regular_web"
+	case "backend":
+		return "non_interactive"
+	default:
+		return ""
+	}
+}
+
 func (q Quickstart) Download(ctx context.Context, downloadPath string, client *management.Client) error {
 	quickstartEndpoint := fmt.Sprintf("https://auth0.com%s", q.DownloadLink)
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, quickstartEndpoint, nil)
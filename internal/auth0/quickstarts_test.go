@@ -71,3 +71,25 @@ func TestFindByStack(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf("failed to find any quickstarts for stack: %q", "some-non-existent-qs-type"), err.Error())
 	})
 }
+
+func TestSampleRepoURL(t *testing.T) {
+	t.Run("get the sample repository url of a quickstart", func(t *testing.T) {
+		res, err := mockQuickStarts[0].SampleRepoURL()
+		assert.NoError(t, err)
+		assert.Equal(t, "https://github.com/auth0-samples/auth0-express-webapp-sample/tree/master/01-Login", res)
+	})
+
+	t.Run("fail to get the sample repository url of a quickstart without download link", func(t *testing.T) {
+		res, err := Quickstart{Name: "Express"}.SampleRepoURL()
+		assert.Empty(t, res)
+		assert.EqualError(t, err, fmt.Sprintf("failed to find the sample repository of the quickstart %q", "Express"))
+	})
+}
+
+func TestApplicationType(t *testing.T) {
+	t.Run("get the application type of a quickstart", func(t *testing.T) {
+		assert.Equal(t, "regular_web", mockQuickStarts[0].ApplicationType())
+		assert.Equal(t, "native", mockQuickStarts[1].ApplicationType())
+		assert.Equal(t, "", Quickstart{AppType: "unknown"}.ApplicationType())
+	})
+}
@@ -24,6 +24,11 @@ type RoleAPI interface {
 	// Delete a role.
 	Delete(ctx context.Context, id string, opts ...management.RequestOption) (err error)
 
+	// Users retrieves users associated with a role.
+	//
+	// See: https://auth0.com/docs/api/management/v2#!/Roles/get_role_user
+	Users(ctx context.Context, id string, opts ...management.RequestOption) (u *management.UserList, err error)
+
 	// AssociatePermissions associates permissions to a role.
 	//
 	// See: https://auth0.com/docs/api/management/v2#!/Roles/post_role_permission_assignment
@@ -0,0 +1,18 @@
+//go:generate mockgen -source=rule_config.go -destination=mock/rule_config_mock.go -package=mock
+
+package auth0
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+type RuleConfigAPI interface {
+	// List all rule configuration variables. Their values are never returned.
+	//
+	// Required scope: `read:rules_configs`
+	//
+	// See: https://auth0.com/docs/api/management/v2#!/Rules_Configs/get_rules_configs
+	List(ctx context.Context, opts ...management.RequestOption) (r []*management.RuleConfig, err error)
+}
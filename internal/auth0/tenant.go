@@ -10,4 +10,9 @@ import (
 
 type TenantAPI interface {
 	Read(ctx context.Context, opts ...management.RequestOption) (t *management.Tenant, err error)
+
+	// Update settings for a tenant.
+	//
+	// See: https://auth0.com/docs/api/management/v2#!/Tenants/patch_settings
+	Update(ctx context.Context, t *management.Tenant, opts ...management.RequestOption) (err error)
 }
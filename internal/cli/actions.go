@@ -85,6 +85,8 @@ func actionsCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(deleteActionCmd(cli))
 	cmd.AddCommand(deployActionCmd(cli))
 	cmd.AddCommand(openActionCmd(cli))
+	cmd.AddCommand(exportActionsCmd(cli))
+	cmd.AddCommand(importActionsCmd(cli))
 
 	return cmd
 }
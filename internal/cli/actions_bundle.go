@@ -0,0 +1,703 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const (
+	actionBundleActionsDir   = "actions"
+	actionBundleActionFile   = "action.json"
+	actionBundleCodeFile     = "code.js"
+	actionBundleBindingsFile = "bindings.json"
+
+	actionConflictFail      = "fail"
+	actionConflictSkip      = "skip"
+	actionConflictOverwrite = "overwrite"
+
+	// actionBuildMaxPolls is how many times the status of an imported
+	// action is read while waiting for it to be built to deploy it.
+	actionBuildMaxPolls = 60
+)
+
+// actionBuildPollInterval is the time between the reads of the status of an
+// imported action while it's being built, it's overridden by the tests.
+var actionBuildPollInterval = time.Second
+
+var (
+	actionBundle = Flag{
+		Name:       "Bundle",
+		LongForm:   "bundle",
+		ShortForm:  "b",
+		Help:       "Directory of the actions bundle to import, as exported by 'auth0 actions export'.",
+		IsRequired: true,
+	}
+
+	actionOnConflict = Flag{
+		Name:     "On Conflict",
+		LongForm: "on-conflict",
+		Help: "What to do with the actions of the bundle whose name is already used by an action of the tenant: " +
+			"'fail' before importing anything, 'skip' them or 'overwrite' the existing actions.",
+	}
+
+	actionBundleSecret = Flag{
+		Name:      "Secret",
+		LongForm:  "secret",
+		ShortForm: "s",
+		Help: "Value of a secret of the imported actions, as the bundle only holds their names. " +
+			"The value is set for all the imported actions with a secret of that name.",
+	}
+
+	actionConflictOptions = []string{actionConflictFail, actionConflictSkip, actionConflictOverwrite}
+)
+
+type (
+	// actionsBundle is the portable representation of the actions of a tenant,
+	// along with the order they're bound to the triggers in.
+	actionsBundle struct {
+		Actions []actionsBundleAction
+		// Bindings are the names of the actions bound to each trigger, in their execution order.
+		Bindings map[string][]string
+	}
+
+	// actionsBundleAction is an action of the bundle. Its secrets only
+	// hold their names, as their values can't be read back.
+	actionsBundleAction struct {
+		Name         string                    `json:"name"`
+		Trigger      actionsBundleTrigger      `json:"trigger"`
+		Runtime      string                    `json:"runtime,omitempty"`
+		Dependencies []actionsBundleDependency `json:"dependencies,omitempty"`
+		Secrets      []string                  `json:"secrets,omitempty"`
+		Code         string                    `json:"-"`
+	}
+
+	actionsBundleTrigger struct {
+		ID      string `json:"id"`
+		Version string `json:"version"`
+	}
+
+	actionsBundleDependency struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+)
+
+func exportActionsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Output artifactOutput
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: "Export the actions to a bundle",
+		Long: "Export all the actions of the tenant to a directory bundle, to import them into another tenant " +
+			"with `auth0 actions import`.\n\n" +
+			"Each action gets a directory under `actions/` holding its code in `code.js` and its trigger, runtime, " +
+			"dependencies and the names of its secrets in `action.json`. The actions bound to each trigger are " +
+			"listed in `bindings.json`, in their execution order. The values of the secrets can't be read back, " +
+			"so they aren't exported.\n\n" +
+			"Without `--out`, the bundle is named after the tenant and the current time.",
+		Example: `  auth0 actions export
+  auth0 actions export --out ./actions-bundle
+  auth0 actions export -o ./actions-bundle --force-overwrite`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var bundle *actionsBundle
+			if err := ansi.Waiting(func() (err error) {
+				bundle, err = fetchActionsBundle(cmd.Context(), cli.api)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to export the actions: %w", err)
+			}
+
+			if len(bundle.Actions) == 0 {
+				cli.renderer.Warnf("There are no actions to export.")
+				return nil
+			}
+
+			dir := inputs.Output.dir(cli, "actions")
+			if err := inputs.Output.checkOverwrite(
+				cmd,
+				filepath.Join(dir, actionBundleActionsDir),
+				filepath.Join(dir, actionBundleBindingsFile),
+			); err != nil {
+				return err
+			}
+
+			if err := writeActionsBundle(dir, bundle); err != nil {
+				return fmt.Errorf("failed to write the actions bundle %q: %w", dir, err)
+			}
+
+			cli.renderer.Infof("Exported %d actions to %s", len(bundle.Actions), ansi.Bold(dir))
+
+			return nil
+		},
+	}
+
+	registerArtifactOutput(cmd, &inputs.Output, "Directory to write the actions bundle to.", "")
+
+	return cmd
+}
+
+func importActionsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Bundle     string
+		OnConflict string
+		Secrets    map[string]string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Args:  cobra.NoArgs,
+		Short: "Import the actions of a bundle",
+		Long: "Import the actions of a bundle exported by `auth0 actions export`, such as into another tenant.\n\n" +
+			"The actions are created, or updated when overwriting the existing ones with the same name, then " +
+			"deployed once built. The actions of the bundle then get bound to their triggers in the order of the " +
+			"bundle, ahead of the other actions bound to the triggers in the tenant.\n\n" +
+			"As the bundle doesn't hold the values of the secrets, they're supplied with `--secret`. They're " +
+			"required for the actions to create, while the overwritten actions keep their secrets unless all " +
+			"of them are supplied.",
+		Example: `  auth0 actions import
+  auth0 actions import --bundle ./actions-bundle
+  auth0 actions import -b ./actions-bundle --on-conflict skip
+  auth0 actions import -b ./actions-bundle --on-conflict overwrite --secret "API_KEY=value" --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !containsStr(actionConflictOptions, inputs.OnConflict) {
+				return fmt.Errorf(
+					"invalid value %q for --%s, it must be one of: %s",
+					inputs.OnConflict,
+					actionOnConflict.LongForm,
+					strings.Join(actionConflictOptions, ", "),
+				)
+			}
+
+			if err := actionBundle.Ask(cmd, &inputs.Bundle, nil); err != nil {
+				return err
+			}
+
+			bundle, err := readActionsBundle(inputs.Bundle)
+			if err != nil {
+				return err
+			}
+
+			var existing map[string]*management.Action
+			if err := ansi.Waiting(func() (err error) {
+				existing, err = actionsByName(cmd.Context(), cli.api)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to list actions: %w", err)
+			}
+
+			conflicts := bundle.conflicts(existing)
+			if len(conflicts) > 0 {
+				cli.renderer.Warnf("The tenant already has actions named %s.", strings.Join(conflicts, ", "))
+
+				defaultOnConflict := actionConflictFail
+				if err := actionOnConflict.Select(cmd, &inputs.OnConflict, actionConflictOptions, &defaultOnConflict); err != nil {
+					return err
+				}
+
+				if inputs.OnConflict == actionConflictFail {
+					return fmt.Errorf(
+						"the actions %s already exist, run the command with '--%s %s' or '--%s %s' to import the bundle",
+						strings.Join(conflicts, ", "),
+						actionOnConflict.LongForm, actionConflictSkip,
+						actionOnConflict.LongForm, actionConflictOverwrite,
+					)
+				}
+			}
+
+			if err := bundle.checkSecrets(existing, inputs.Secrets); err != nil {
+				return err
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				message := fmt.Sprintf("Are you sure you want to import %d actions into %s?", len(bundle.Actions), cli.tenant)
+				if confirmed := prompt.Confirm(message); !confirmed {
+					return nil
+				}
+			}
+
+			return importActionsBundle(cmd.Context(), cli.api, cli.renderer, bundle, existing, inputs.OnConflict, inputs.Secrets)
+		},
+	}
+
+	actionBundle.RegisterString(cmd, &inputs.Bundle, "")
+	actionOnConflict.RegisterString(cmd, &inputs.OnConflict, actionConflictFail)
+	actionOnConflict.RegisterCompletions(cmd, actionConflictOptions)
+	actionBundleSecret.RegisterStringMap(cmd, &inputs.Secrets, nil)
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// fetchActionsBundle reads all the actions of the tenant, along
+// with the actions bound to the current triggers, in their order.
+func fetchActionsBundle(ctx context.Context, api *auth0.API) (*actionsBundle, error) {
+	actions, err := listAllActions(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+
+	triggers, err := api.Action.Triggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make(map[string][]string)
+	for _, trigger := range filterOutDeprecatedActionTriggers(triggers.Triggers) {
+		list, err := api.Action.Bindings(ctx, trigger.GetID())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, binding := range list.Bindings {
+			bindings[trigger.GetID()] = append(bindings[trigger.GetID()], binding.GetAction().GetName())
+		}
+	}
+
+	return newActionsBundle(actions, bindings), nil
+}
+
+func newActionsBundle(actions []*management.Action, bindings map[string][]string) *actionsBundle {
+	bundle := &actionsBundle{Bindings: bindings}
+
+	for _, action := range actions {
+		bundleAction := actionsBundleAction{
+			Name:    action.GetName(),
+			Runtime: action.GetRuntime(),
+			Code:    action.GetCode(),
+		}
+
+		if len(action.SupportedTriggers) > 0 {
+			bundleAction.Trigger = actionsBundleTrigger{
+				ID:      action.SupportedTriggers[0].GetID(),
+				Version: action.SupportedTriggers[0].GetVersion(),
+			}
+		}
+
+		for _, dependency := range action.GetDependencies() {
+			bundleAction.Dependencies = append(bundleAction.Dependencies, actionsBundleDependency{
+				Name:    dependency.GetName(),
+				Version: dependency.GetVersion(),
+			})
+		}
+
+		for _, secret := range action.GetSecrets() {
+			bundleAction.Secrets = append(bundleAction.Secrets, secret.GetName())
+		}
+
+		bundle.Actions = append(bundle.Actions, bundleAction)
+	}
+
+	sort.SliceStable(bundle.Actions, func(i, j int) bool {
+		return bundle.Actions[i].Name < bundle.Actions[j].Name
+	})
+
+	return bundle
+}
+
+// writeActionsBundle writes the bundle to the directory, replacing the actions of a previous export.
+func writeActionsBundle(dir string, bundle *actionsBundle) error {
+	actionsDir := filepath.Join(dir, actionBundleActionsDir)
+	if err := os.RemoveAll(actionsDir); err != nil {
+		return err
+	}
+
+	used := make(map[string]int)
+	for _, action := range bundle.Actions {
+		name := sanitizeResourceName(action.Name)
+		if name == "" {
+			name = "action"
+		}
+		used[name]++
+		if used[name] > 1 {
+			name = fmt.Sprintf("%s_%d", name, used[name])
+		}
+
+		actionDir := filepath.Join(actionsDir, name)
+		if err := os.MkdirAll(actionDir, 0755); err != nil {
+			return err
+		}
+
+		if err := writeJSONFile(filepath.Join(actionDir, actionBundleActionFile), action); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(actionDir, actionBundleCodeFile), []byte(action.Code), 0644); err != nil {
+			return err
+		}
+	}
+
+	return writeJSONFile(filepath.Join(dir, actionBundleBindingsFile), bundle.Bindings)
+}
+
+func writeJSONFile(path string, value interface{}) error {
+	content, err := json.MarshalIndent(value, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(content, '\n'), 0644)
+}
+
+// readActionsBundle reads the bundle of the directory, checking that the names of the
+// actions are unique and that the bindings only reference the actions of the bundle.
+func readActionsBundle(dir string) (*actionsBundle, error) {
+	entries, err := os.ReadDir(filepath.Join(dir, actionBundleActionsDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the actions bundle: %w", err)
+	}
+
+	bundle := &actionsBundle{}
+	names := make(map[string]bool)
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		actionDir := filepath.Join(dir, actionBundleActionsDir, entry.Name())
+
+		content, err := os.ReadFile(filepath.Join(actionDir, actionBundleActionFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the actions bundle: %w", err)
+		}
+
+		var action actionsBundleAction
+		if err := json.Unmarshal(content, &action); err != nil {
+			return nil, fmt.Errorf("invalid action %q: %w", actionDir, err)
+		}
+
+		if action.Name == "" || action.Trigger.ID == "" {
+			return nil, fmt.Errorf("invalid action %q: its name and trigger are required", actionDir)
+		}
+
+		if names[action.Name] {
+			return nil, fmt.Errorf("invalid actions bundle: there are several actions named %q", action.Name)
+		}
+		names[action.Name] = true
+
+		code, err := os.ReadFile(filepath.Join(actionDir, actionBundleCodeFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the actions bundle: %w", err)
+		}
+		action.Code = string(code)
+
+		bundle.Actions = append(bundle.Actions, action)
+	}
+
+	if len(bundle.Actions) == 0 {
+		return nil, fmt.Errorf("invalid actions bundle: there are no actions in %q", dir)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, actionBundleBindingsFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read the actions bundle: %w", err)
+	}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &bundle.Bindings); err != nil {
+			return nil, fmt.Errorf("invalid bindings %q: %w", actionBundleBindingsFile, err)
+		}
+	}
+
+	for trigger, actionNames := range bundle.Bindings {
+		for _, name := range actionNames {
+			if !names[name] {
+				return nil, fmt.Errorf("invalid bindings: the action %q bound to %s isn't in the bundle", name, trigger)
+			}
+		}
+	}
+
+	return bundle, nil
+}
+
+func listAllActions(ctx context.Context, api *auth0.API) ([]*management.Action, error) {
+	var actions []*management.Action
+
+	var page int
+	for {
+		list, err := api.Action.List(ctx, management.Page(page), management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, list.Actions...)
+
+		if !list.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return actions, nil
+}
+
+// actionsByName lists all the actions of the tenant by name, to match them with the actions of a bundle.
+func actionsByName(ctx context.Context, api *auth0.API) (map[string]*management.Action, error) {
+	actions, err := listAllActions(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*management.Action, len(actions))
+	for _, action := range actions {
+		byName[action.GetName()] = action
+	}
+
+	return byName, nil
+}
+
+// conflicts returns the names of the actions of the bundle that are already used by actions of the tenant.
+func (b *actionsBundle) conflicts(existing map[string]*management.Action) []string {
+	var conflicts []string
+	for _, action := range b.Actions {
+		if _, ok := existing[action.Name]; ok {
+			conflicts = append(conflicts, action.Name)
+		}
+	}
+
+	return conflicts
+}
+
+// checkSecrets fails if the value of a secret of an action to create isn't supplied, before importing anything.
+func (b *actionsBundle) checkSecrets(existing map[string]*management.Action, values map[string]string) error {
+	var missing []string
+	for _, action := range b.Actions {
+		if _, ok := existing[action.Name]; ok {
+			continue
+		}
+
+		for _, secret := range action.Secrets {
+			if _, ok := values[secret]; !ok {
+				missing = append(missing, fmt.Sprintf("%s (%s)", secret, action.Name))
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf(
+			"the values of the secrets %s are missing, supply them with --%s NAME=value",
+			strings.Join(missing, ", "),
+			actionBundleSecret.LongForm,
+		)
+	}
+
+	return nil
+}
+
+// importActionsBundle creates or overwrites the actions of the bundle, deploys
+// them, and binds them to their triggers ahead of the other bound actions.
+func importActionsBundle(
+	ctx context.Context,
+	api *auth0.API,
+	renderer *display.Renderer,
+	bundle *actionsBundle,
+	existing map[string]*management.Action,
+	onConflict string,
+	secrets map[string]string,
+) error {
+	for _, bundleAction := range bundle.Actions {
+		existingAction, exists := existing[bundleAction.Name]
+		if exists && onConflict == actionConflictSkip {
+			renderer.Infof("Skipped the existing action %s", ansi.Bold(bundleAction.Name))
+			continue
+		}
+
+		action, complete := bundleAction.toAction(secrets)
+
+		var id string
+		if err := ansi.Waiting(func() error {
+			if exists {
+				id = existingAction.GetID()
+				if !complete {
+					action.Secrets = nil
+				}
+				if err := api.Action.Update(ctx, id, action); err != nil {
+					return fmt.Errorf("failed to update action %q: %w", bundleAction.Name, err)
+				}
+			} else {
+				if err := api.Action.Create(ctx, action); err != nil {
+					return fmt.Errorf("failed to create action %q: %w", bundleAction.Name, err)
+				}
+				id = action.GetID()
+			}
+
+			return deployActionOnceBuilt(ctx, api, id, bundleAction.Name)
+		}); err != nil {
+			return err
+		}
+
+		if exists {
+			renderer.Infof("Overwrote and deployed the action %s", ansi.Bold(bundleAction.Name))
+			if !complete && len(bundleAction.Secrets) > 0 {
+				renderer.Warnf("The action %s kept its secrets, as not all of them were supplied.", bundleAction.Name)
+			}
+		} else {
+			renderer.Infof("Created and deployed the action %s", ansi.Bold(bundleAction.Name))
+		}
+	}
+
+	triggers := make([]string, 0, len(bundle.Bindings))
+	for trigger := range bundle.Bindings {
+		triggers = append(triggers, trigger)
+	}
+	sort.Strings(triggers)
+
+	for _, trigger := range triggers {
+		if err := ansi.Waiting(func() error {
+			current, err := api.Action.Bindings(ctx, trigger)
+			if err != nil {
+				return fmt.Errorf("failed to read the bindings of %s: %w", trigger, err)
+			}
+
+			bindings := mergeActionBindings(bundle.Bindings[trigger], current.Bindings)
+			if err := api.Action.UpdateBindings(ctx, trigger, bindings); err != nil {
+				return fmt.Errorf("failed to bind the actions to %s: %w", trigger, err)
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		renderer.Infof("Bound %d actions to %s", len(bundle.Bindings[trigger]), ansi.Bold(trigger))
+	}
+
+	return nil
+}
+
+// toAction returns the action to create or update from the action of the bundle,
+// and whether the values of all its secrets were supplied. The secrets are left
+// out when the bundle lists none, so that the ones of an existing action are kept.
+func (a *actionsBundleAction) toAction(secrets map[string]string) (*management.Action, bool) {
+	action := &management.Action{
+		Name: auth0.String(a.Name),
+		SupportedTriggers: []management.ActionTrigger{
+			{
+				ID:      auth0.String(a.Trigger.ID),
+				Version: auth0.String(a.Trigger.Version),
+			},
+		},
+		Code: auth0.String(a.Code),
+	}
+
+	if a.Runtime != "" {
+		action.Runtime = auth0.String(a.Runtime)
+	}
+
+	dependencies := make([]management.ActionDependency, 0, len(a.Dependencies))
+	for _, dependency := range a.Dependencies {
+		dependencies = append(dependencies, management.ActionDependency{
+			Name:    auth0.String(dependency.Name),
+			Version: auth0.String(dependency.Version),
+		})
+	}
+	action.Dependencies = &dependencies
+
+	if len(a.Secrets) == 0 {
+		return action, true
+	}
+
+	complete := true
+	actionSecrets := make([]management.ActionSecret, 0, len(a.Secrets))
+	for _, name := range a.Secrets {
+		value, ok := secrets[name]
+		if !ok {
+			complete = false
+			continue
+		}
+
+		actionSecrets = append(actionSecrets, management.ActionSecret{
+			Name:  auth0.String(name),
+			Value: auth0.String(value),
+		})
+	}
+	action.Secrets = &actionSecrets
+
+	return action, complete
+}
+
+// deployActionOnceBuilt waits for the action to be built, as it can't be deployed before, then deploys it.
+func deployActionOnceBuilt(ctx context.Context, api *auth0.API, id, name string) error {
+	for poll := 0; ; poll++ {
+		action, err := api.Action.Read(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to read action %q: %w", name, err)
+		}
+
+		if action.GetStatus() == management.ActionStatusBuilt {
+			break
+		}
+
+		if action.GetStatus() == management.ActionStatusFailed {
+			return fmt.Errorf("failed to build action %q, run 'auth0 actions show %s' for details", name, id)
+		}
+
+		if poll == actionBuildMaxPolls {
+			return fmt.Errorf("timed out waiting for action %q to be built", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(actionBuildPollInterval):
+		}
+	}
+
+	if _, err := api.Action.Deploy(ctx, id); err != nil {
+		return fmt.Errorf("failed to deploy action %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// mergeActionBindings binds the actions of the bundle by name, in their order, ahead
+// of the other actions bound to the trigger, which keep their relative order.
+func mergeActionBindings(names []string, current []*management.ActionBinding) []*management.ActionBinding {
+	bundled := make(map[string]bool, len(names))
+
+	bindings := make([]*management.ActionBinding, 0, len(names)+len(current))
+	for _, name := range names {
+		bundled[name] = true
+		bindings = append(bindings, &management.ActionBinding{
+			Ref: &management.ActionBindingReference{
+				Type:  auth0.String(management.ActionBindingReferenceByName),
+				Value: auth0.String(name),
+			},
+			DisplayName: auth0.String(name),
+		})
+	}
+
+	for _, binding := range current {
+		if bundled[binding.GetAction().GetName()] {
+			continue
+		}
+
+		bindings = append(bindings, &management.ActionBinding{
+			Ref: &management.ActionBindingReference{
+				Type:  auth0.String(management.ActionBindingReferenceByID),
+				Value: auth0.String(binding.GetAction().GetID()),
+			},
+			DisplayName: auth0.String(binding.GetDisplayName()),
+		})
+	}
+
+	return bindings
+}
@@ -0,0 +1,367 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func testActionsBundle() *actionsBundle {
+	return &actionsBundle{
+		Actions: []actionsBundleAction{
+			{
+				Name:         "Add Roles",
+				Trigger:      actionsBundleTrigger{ID: "post-login", Version: "v3"},
+				Runtime:      "node18",
+				Dependencies: []actionsBundleDependency{{Name: "lodash", Version: "4.17.21"}},
+				Secrets:      []string{"API_KEY"},
+				Code:         "exports.onExecutePostLogin = async () => {};",
+			},
+			{
+				Name:    "Add-Roles",
+				Trigger: actionsBundleTrigger{ID: "post-login", Version: "v3"},
+				Code:    "exports.onExecutePostLogin = async () => {};",
+			},
+		},
+		Bindings: map[string][]string{"post-login": {"Add-Roles", "Add Roles"}},
+	}
+}
+
+func TestFetchActionsBundle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	actionAPI := mock.NewMockActionAPI(ctrl)
+	actionAPI.EXPECT().
+		List(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&management.ActionList{
+			Actions: []*management.Action{
+				{
+					ID:                auth0.String("action-2"),
+					Name:              auth0.String("Welcome Email"),
+					SupportedTriggers: []management.ActionTrigger{{ID: auth0.String("post-user-registration"), Version: auth0.String("v2")}},
+					Code:              auth0.String("// welcome"),
+				},
+				{
+					ID:                auth0.String("action-1"),
+					Name:              auth0.String("Add Roles"),
+					SupportedTriggers: []management.ActionTrigger{{ID: auth0.String("post-login"), Version: auth0.String("v3")}},
+					Runtime:           auth0.String("node18"),
+					Code:              auth0.String("// roles"),
+					Dependencies:      &[]management.ActionDependency{{Name: auth0.String("lodash"), Version: auth0.String("4.17.21")}},
+					Secrets:           &[]management.ActionSecret{{Name: auth0.String("API_KEY")}},
+				},
+			},
+		}, nil)
+	actionAPI.EXPECT().
+		Triggers(gomock.Any()).
+		Return(&management.ActionTriggerList{
+			Triggers: []*management.ActionTrigger{
+				{ID: auth0.String("post-login"), Status: auth0.String("CURRENT")},
+				{ID: auth0.String("credentials-exchange"), Status: auth0.String("DEPRECATED")},
+			},
+		}, nil)
+	actionAPI.EXPECT().
+		Bindings(gomock.Any(), "post-login").
+		Return(&management.ActionBindingList{
+			Bindings: []*management.ActionBinding{
+				{Action: &management.Action{Name: auth0.String("Add Roles")}},
+			},
+		}, nil)
+
+	bundle, err := fetchActionsBundle(context.Background(), &auth0.API{Action: actionAPI})
+	require.NoError(t, err)
+
+	expected := &actionsBundle{
+		Actions: []actionsBundleAction{
+			{
+				Name:         "Add Roles",
+				Trigger:      actionsBundleTrigger{ID: "post-login", Version: "v3"},
+				Runtime:      "node18",
+				Dependencies: []actionsBundleDependency{{Name: "lodash", Version: "4.17.21"}},
+				Secrets:      []string{"API_KEY"},
+				Code:         "// roles",
+			},
+			{
+				Name:    "Welcome Email",
+				Trigger: actionsBundleTrigger{ID: "post-user-registration", Version: "v2"},
+				Code:    "// welcome",
+			},
+		},
+		Bindings: map[string][]string{"post-login": {"Add Roles"}},
+	}
+	assert.Equal(t, expected, bundle)
+}
+
+func TestWriteAndReadActionsBundle(t *testing.T) {
+	t.Run("it reads back the written bundle", func(t *testing.T) {
+		dir := t.TempDir()
+		bundle := testActionsBundle()
+
+		err := writeActionsBundle(dir, bundle)
+		require.NoError(t, err)
+
+		// Both actions have the same sanitized name, so the second one gets suffixed.
+		assert.FileExists(t, filepath.Join(dir, "actions", "add_roles", "action.json"))
+		assert.FileExists(t, filepath.Join(dir, "actions", "add_roles_2", "code.js"))
+
+		read, err := readActionsBundle(dir)
+		require.NoError(t, err)
+		assert.Equal(t, bundle, read)
+	})
+
+	t.Run("it replaces the actions of a previous export", func(t *testing.T) {
+		dir := t.TempDir()
+
+		err := writeActionsBundle(dir, testActionsBundle())
+		require.NoError(t, err)
+
+		err = writeActionsBundle(dir, &actionsBundle{Actions: testActionsBundle().Actions[:1]})
+		require.NoError(t, err)
+
+		assert.NoDirExists(t, filepath.Join(dir, "actions", "add_roles_2"))
+	})
+
+	t.Run("it fails when a binding references an action outside the bundle", func(t *testing.T) {
+		dir := t.TempDir()
+		bundle := testActionsBundle()
+		bundle.Bindings["post-login"] = append(bundle.Bindings["post-login"], "Missing")
+
+		err := writeActionsBundle(dir, bundle)
+		require.NoError(t, err)
+
+		_, err = readActionsBundle(dir)
+		assert.EqualError(t, err, `invalid bindings: the action "Missing" bound to post-login isn't in the bundle`)
+	})
+
+	t.Run("it fails when several actions have the same name", func(t *testing.T) {
+		dir := t.TempDir()
+		bundle := testActionsBundle()
+		bundle.Actions[1].Name = bundle.Actions[0].Name
+		bundle.Bindings = nil
+
+		err := writeActionsBundle(dir, bundle)
+		require.NoError(t, err)
+
+		_, err = readActionsBundle(dir)
+		assert.EqualError(t, err, `invalid actions bundle: there are several actions named "Add Roles"`)
+	})
+
+	t.Run("it fails when there are no actions", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "actions"), 0755))
+
+		_, err := readActionsBundle(dir)
+		assert.ErrorContains(t, err, "invalid actions bundle: there are no actions")
+	})
+}
+
+func TestActionsBundle_CheckSecrets(t *testing.T) {
+	bundle := testActionsBundle()
+
+	t.Run("it requires the secrets of the actions to create", func(t *testing.T) {
+		err := bundle.checkSecrets(map[string]*management.Action{}, nil)
+		assert.EqualError(t, err, "the values of the secrets API_KEY (Add Roles) are missing, supply them with --secret NAME=value")
+	})
+
+	t.Run("it doesn't require the secrets of the existing actions", func(t *testing.T) {
+		existing := map[string]*management.Action{"Add Roles": {ID: auth0.String("action-1")}}
+
+		err := bundle.checkSecrets(existing, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it succeeds when the secrets are supplied", func(t *testing.T) {
+		err := bundle.checkSecrets(map[string]*management.Action{}, map[string]string{"API_KEY": "value"})
+		assert.NoError(t, err)
+	})
+}
+
+func TestMergeActionBindings(t *testing.T) {
+	current := []*management.ActionBinding{
+		{
+			DisplayName: auth0.String("Audit"),
+			Action:      &management.Action{ID: auth0.String("action-3"), Name: auth0.String("Audit")},
+		},
+		{
+			DisplayName: auth0.String("Add Roles"),
+			Action:      &management.Action{ID: auth0.String("action-1"), Name: auth0.String("Add Roles")},
+		},
+	}
+
+	bindings := mergeActionBindings([]string{"Add Roles"}, current)
+
+	expected := []*management.ActionBinding{
+		{
+			Ref: &management.ActionBindingReference{
+				Type:  auth0.String(management.ActionBindingReferenceByName),
+				Value: auth0.String("Add Roles"),
+			},
+			DisplayName: auth0.String("Add Roles"),
+		},
+		{
+			Ref: &management.ActionBindingReference{
+				Type:  auth0.String(management.ActionBindingReferenceByID),
+				Value: auth0.String("action-3"),
+			},
+			DisplayName: auth0.String("Audit"),
+		},
+	}
+	assert.Equal(t, expected, bindings)
+}
+
+func TestImportActionsBundle(t *testing.T) {
+	pollInterval := actionBuildPollInterval
+	actionBuildPollInterval = 0
+	t.Cleanup(func() {
+		actionBuildPollInterval = pollInterval
+	})
+
+	renderer := &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard}
+
+	t.Run("it creates, overwrites, deploys and binds the actions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bundle := testActionsBundle()
+		existing := map[string]*management.Action{"Add-Roles": {ID: auth0.String("action-2")}}
+
+		actionAPI := mock.NewMockActionAPI(ctrl)
+		actionAPI.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, action *management.Action, _ ...management.RequestOption) error {
+				assert.Equal(t, "Add Roles", action.GetName())
+				assert.Equal(t, []management.ActionSecret{{Name: auth0.String("API_KEY"), Value: auth0.String("value")}}, action.GetSecrets())
+				action.ID = auth0.String("action-1")
+				return nil
+			})
+		gomock.InOrder(
+			actionAPI.EXPECT().Read(gomock.Any(), "action-1").Return(&management.Action{Status: auth0.String("building")}, nil),
+			actionAPI.EXPECT().Read(gomock.Any(), "action-1").Return(&management.Action{Status: auth0.String("built")}, nil),
+			actionAPI.EXPECT().Deploy(gomock.Any(), "action-1").Return(nil, nil),
+		)
+		actionAPI.EXPECT().
+			Update(gomock.Any(), "action-2", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, action *management.Action, _ ...management.RequestOption) error {
+				assert.Equal(t, "Add-Roles", action.GetName())
+				return nil
+			})
+		actionAPI.EXPECT().Read(gomock.Any(), "action-2").Return(&management.Action{Status: auth0.String("built")}, nil)
+		actionAPI.EXPECT().Deploy(gomock.Any(), "action-2").Return(nil, nil)
+		actionAPI.EXPECT().
+			Bindings(gomock.Any(), "post-login").
+			Return(&management.ActionBindingList{}, nil)
+		actionAPI.EXPECT().
+			UpdateBindings(gomock.Any(), "post-login", mergeActionBindings([]string{"Add-Roles", "Add Roles"}, nil)).
+			Return(nil)
+
+		err := importActionsBundle(
+			context.Background(),
+			&auth0.API{Action: actionAPI},
+			renderer,
+			bundle,
+			existing,
+			actionConflictOverwrite,
+			map[string]string{"API_KEY": "value"},
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it keeps the secrets of an existing action when the bundle lists none", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bundle := testActionsBundle()
+		bundle.Actions = bundle.Actions[1:]
+		bundle.Bindings = nil
+		existing := map[string]*management.Action{
+			"Add-Roles": {
+				ID:      auth0.String("action-2"),
+				Secrets: &[]management.ActionSecret{{Name: auth0.String("API_KEY")}},
+			},
+		}
+
+		actionAPI := mock.NewMockActionAPI(ctrl)
+		actionAPI.EXPECT().
+			Update(gomock.Any(), "action-2", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, action *management.Action, _ ...management.RequestOption) error {
+				assert.Nil(t, action.Secrets)
+				return nil
+			})
+		actionAPI.EXPECT().Read(gomock.Any(), "action-2").Return(&management.Action{Status: auth0.String("built")}, nil)
+		actionAPI.EXPECT().Deploy(gomock.Any(), "action-2").Return(nil, nil)
+
+		err := importActionsBundle(
+			context.Background(),
+			&auth0.API{Action: actionAPI},
+			renderer,
+			bundle,
+			existing,
+			actionConflictOverwrite,
+			nil,
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it skips the existing actions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bundle := testActionsBundle()
+		bundle.Actions = bundle.Actions[1:]
+		bundle.Bindings = nil
+		existing := map[string]*management.Action{"Add-Roles": {ID: auth0.String("action-2")}}
+
+		actionAPI := mock.NewMockActionAPI(ctrl)
+
+		err := importActionsBundle(
+			context.Background(),
+			&auth0.API{Action: actionAPI},
+			renderer,
+			bundle,
+			existing,
+			actionConflictSkip,
+			nil,
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it fails when the action fails to build", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bundle := testActionsBundle()
+		bundle.Actions = bundle.Actions[1:]
+
+		actionAPI := mock.NewMockActionAPI(ctrl)
+		actionAPI.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, action *management.Action, _ ...management.RequestOption) error {
+				action.ID = auth0.String("action-2")
+				return nil
+			})
+		actionAPI.EXPECT().Read(gomock.Any(), "action-2").Return(&management.Action{Status: auth0.String("failed")}, nil)
+
+		err := importActionsBundle(
+			context.Background(),
+			&auth0.API{Action: actionAPI},
+			renderer,
+			bundle,
+			map[string]*management.Action{},
+			actionConflictFail,
+			nil,
+		)
+		assert.EqualError(t, err, `failed to build action "Add-Roles", run 'auth0 actions show action-2' for details`)
+	})
+}
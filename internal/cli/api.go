@@ -79,11 +79,14 @@ func apiCmd(cli *cli) *cobra.Command {
 
 Method argument is optional, defaults to %s for requests without data and %s for requests with data.
 
+Secrets such as client secrets are masked in the response, unless the %s flag is passed.
+
 Additional scopes may need to be requested during authentication step via the %s flag. For example: %s.`,
-			apiDocsURL, "`GET`", "`POST`", "`--scopes`", "`auth0 login --scopes read:client_grants`",
+			apiDocsURL, "`GET`", "`POST`", "`--reveal`", "`--scopes`", "`auth0 login --scopes read:client_grants`",
 		),
 		Example: `  auth0 api get "tenants/settings"
   auth0 api "stats/daily" -q "from=20221101" -q "to=20221118"
+  auth0 api get "clients/<client-id>" --reveal
   auth0 api delete "actions/actions/<action-id>" --force
   auth0 api clients --data "{\"name\":\"ssoTest\",\"app_type\":\"sso_integration\"}"
   cat data.json | auth0 api post clients`,
@@ -177,6 +180,10 @@ func apiCmdRun(cli *cli, inputs *apiCmdInputs) func(cmd *cobra.Command, args []s
 			return nil
 		}
 
+		if !cli.renderer.RevealSecrets {
+			rawBodyJSON = display.MaskSecrets(rawBodyJSON)
+		}
+
 		var prettyJSON bytes.Buffer
 		if err := json.Indent(&prettyJSON, rawBodyJSON, "", "  "); err != nil {
 			return fmt.Errorf("failed to prepare json output: %w", err)
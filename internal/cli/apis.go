@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 
-	"github.com/auth0/go-auth0"
 	"github.com/auth0/go-auth0/management"
 	"github.com/spf13/cobra"
 
 	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
 	"github.com/auth0/auth0-cli/internal/prompt"
 )
 
@@ -36,6 +38,13 @@ var (
 		Help:       "Identifier of the API. Cannot be changed once set.",
 		IsRequired: true,
 	}
+	apiOpenAPIDocument = Flag{
+		Name:     "OpenAPI Document",
+		LongForm: "from-openapi",
+		Help: "Path to an OpenAPI document, in YAML or JSON, to derive the name, identifier and scopes of the API from. " +
+			"The identifier is the URL of its first server, and the scopes are the ones its OAuth 2.0 security schemes " +
+			"define and its operations require. The other flags take precedence, except for the scopes which get added.",
+	}
 	apiScopes = Flag{
 		Name:         "Scopes",
 		LongForm:     "scopes",
@@ -101,6 +110,7 @@ func scopesCmd(cli *cli) *cobra.Command {
 
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(listScopesCmd(cli))
+	cmd.AddCommand(diffScopesCmd(cli))
 
 	return cmd
 }
@@ -110,6 +120,8 @@ func listApisCmd(cli *cli) *cobra.Command {
 		Number int
 	}
 
+	var multiTenant multiTenantInputs
+
 	cmd := &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
@@ -120,45 +132,50 @@ func listApisCmd(cli *cli) *cobra.Command {
   auth0 apis ls
   auth0 apis ls --number 100
   auth0 apis ls -n 100 --json
-  auth0 apis ls --csv`,
+  auth0 apis ls --csv
+  auth0 apis list --all-tenants`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if inputs.Number < 1 || inputs.Number > 1000 {
 				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
 			}
 
-			list, err := getWithPagination(
-				inputs.Number,
-				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
-					apiList, err := cli.api.ResourceServer.List(cmd.Context(), opts...)
-					if err != nil {
-						return nil, false, err
-					}
+			return runForTenants(cmd, cli, multiTenant, func(api *auth0.API, renderer *display.Renderer, waiting waitingFunc) error {
+				list, err := getWithPaginationWaiting(
+					waiting,
+					inputs.Number,
+					func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+						apiList, err := api.ResourceServer.List(cmd.Context(), opts...)
+						if err != nil {
+							return nil, false, err
+						}
 
-					for _, api := range apiList.ResourceServers {
-						result = append(result, api)
-					}
+						for _, resourceServer := range apiList.ResourceServers {
+							result = append(result, resourceServer)
+						}
 
-					return result, apiList.HasNext(), nil
-				},
-			)
-			if err != nil {
-				return fmt.Errorf("failed to list APIs: %w", err)
-			}
+						return result, apiList.HasNext(), nil
+					},
+				)
+				if err != nil {
+					return fmt.Errorf("failed to list APIs: %w", err)
+				}
 
-			var apis []*management.ResourceServer
-			for _, item := range list {
-				apis = append(apis, item.(*management.ResourceServer))
-			}
+				var apis []*management.ResourceServer
+				for _, item := range list {
+					apis = append(apis, item.(*management.ResourceServer))
+				}
 
-			cli.renderer.APIList(apis)
+				renderer.APIList(apis)
 
-			return nil
+				return nil
+			})
 		},
 	}
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
 	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+	multiTenant.register(cmd)
 
 	apiNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
 
@@ -216,6 +233,8 @@ func createAPICmd(cli *cli) *cobra.Command {
 		TokenLifetime      int
 		AllowOfflineAccess bool
 		SigningAlgorithm   string
+		IdempotentBy       string
+		OpenAPIDocument    string
 	}
 
 	cmd := &cobra.Command{
@@ -225,7 +244,9 @@ func createAPICmd(cli *cli) *cobra.Command {
 		Long: "Create a new API.\n\n" +
 			"To create interactively, use `auth0 apis create` with no flags.\n\n" +
 			"To create non-interactively, supply the name, identifier, scopes, " +
-			"token lifetime and whether to allow offline access through the flags.",
+			"token lifetime and whether to allow offline access through the flags.\n\n" +
+			"To keep the scopes of the API in sync with the contract of the service, use `--from-openapi` " +
+			"to derive its name, identifier and scopes from its OpenAPI document.",
 		Example: `  auth0 apis create 
   auth0 apis create --name myapi
   auth0 apis create --name myapi --identifier http://my-api
@@ -233,17 +254,89 @@ func createAPICmd(cli *cli) *cobra.Command {
   auth0 apis create --name myapi --identifier http://my-api --token-lifetime 6100 --offline-access=true
   auth0 apis create --name myapi --identifier http://my-api --token-lifetime 6100 --offline-access=false --scopes "letter:write,letter:read"
   auth0 apis create --name myapi --identifier http://my-api --token-lifetime 6100 --offline-access=false --scopes "letter:write,letter:read" --signing-alg "RS256"
-  auth0 apis create -n myapi -i http://my-api -t 6100 -o false -s "letter:write,letter:read" --signing-alg "RS256" --json`,
+  auth0 apis create -n myapi -i http://my-api -t 6100 -o false -s "letter:write,letter:read" --signing-alg "RS256" --json
+  auth0 apis create -n myapi -i http://my-api --idempotent-by identifier --json --no-input
+  auth0 apis create --from-openapi spec.yaml
+  auth0 apis create --from-openapi spec.yaml --name myapi --token-lifetime 6100 --json --no-input`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			// The name and identifier can be derived from the OpenAPI document instead.
+			if apiOpenAPIDocument.IsSet(cmd) {
+				for _, flag := range []Flag{apiName, apiIdentifier} {
+					_ = cmd.Flags().SetAnnotation(flag.LongForm, cobra.BashCompOneRequiredFlag, []string{"false"})
+				}
+			}
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := apiName.Ask(cmd, &inputs.Name, nil); err != nil {
+			if err := validateIdempotencyKey(inputs.IdempotentBy, idempotencyKeyName, idempotencyKeyIdentifier); err != nil {
 				return err
 			}
 
-			if err := apiIdentifier.Ask(cmd, &inputs.Identifier, nil); err != nil {
+			var (
+				document          *openAPIDocument
+				nameDefault       *string
+				identifierDefault *string
+				scopesDefault     *string
+			)
+			if inputs.OpenAPIDocument != "" {
+				var err error
+				if document, err = readOpenAPIDocument(inputs.OpenAPIDocument); err != nil {
+					return err
+				}
+
+				nameDefault = auth0.String(document.Info.Title)
+				if !apiName.IsSet(cmd) {
+					inputs.Name = document.Info.Title
+				}
+
+				identifierDefault = auth0.String(document.Identifier())
+				if !apiIdentifier.IsSet(cmd) {
+					inputs.Identifier = document.Identifier()
+				}
+
+				var scopes []string
+				for _, scope := range document.Scopes() {
+					scopes = append(scopes, scope.GetValue())
+				}
+				scopesDefault = auth0.String(strings.Join(scopes, ","))
+				inputs.Scopes = mergeScopes(inputs.Scopes, scopes)
+			}
+
+			if err := apiName.Ask(cmd, &inputs.Name, nameDefault); err != nil {
 				return err
 			}
 
-			if err := apiScopes.AskMany(cmd, &inputs.Scopes, nil); err != nil {
+			if err := apiIdentifier.Ask(cmd, &inputs.Identifier, identifierDefault); err != nil {
+				return err
+			}
+
+			if document != nil && (inputs.Name == "" || inputs.Identifier == "") {
+				return fmt.Errorf(
+					"failed to derive the name and identifier of the API from the OpenAPI document, " +
+						"supply them through the --name and --identifier flags",
+				)
+			}
+
+			if inputs.IdempotentBy != "" {
+				var existing *management.ResourceServer
+				if err := ansi.Waiting(func() (err error) {
+					existing, err = findAPIByKey(cmd.Context(), cli.api, inputs.IdempotentBy, inputs.Name, inputs.Identifier)
+					return err
+				}); err != nil {
+					return err
+				}
+
+				if existing != nil {
+					cli.renderer.Warnf(
+						"An API named %q with the identifier %q already exists, skipping its creation.",
+						existing.GetName(),
+						existing.GetIdentifier(),
+					)
+					cli.renderer.APIShow(existing, cli.json)
+					return nil
+				}
+			}
+
+			if err := apiScopes.AskMany(cmd, &inputs.Scopes, scopesDefault); err != nil {
 				return err
 			}
 
@@ -270,6 +363,10 @@ func createAPICmd(cli *cli) *cobra.Command {
 
 			if len(inputs.Scopes) > 0 {
 				api.Scopes = apiScopesFor(inputs.Scopes)
+
+				if document != nil {
+					document.DescribeScopes(*api.Scopes)
+				}
 			}
 
 			if inputs.TokenLifetime <= 0 {
@@ -302,6 +399,8 @@ func createAPICmd(cli *cli) *cobra.Command {
 	apiOfflineAccess.RegisterBool(cmd, &inputs.AllowOfflineAccess, false)
 	apiTokenLifetime.RegisterInt(cmd, &inputs.TokenLifetime, 0)
 	apiSigningAlgorithm.RegisterString(cmd, &inputs.SigningAlgorithm, "RS256")
+	idempotentBy.RegisterString(cmd, &inputs.IdempotentBy, "")
+	apiOpenAPIDocument.RegisterString(cmd, &inputs.OpenAPIDocument, "")
 
 	return cmd
 }
@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// openAPIDocument holds the parts of an OpenAPI document, or of
+// a Swagger 2.0 one, that an API gets derived from.
+type openAPIDocument struct {
+	OpenAPI string `yaml:"openapi"`
+	Swagger string `yaml:"swagger"`
+	Info    struct {
+		Title string `yaml:"title"`
+	} `yaml:"info"`
+
+	Servers []struct {
+		URL string `yaml:"url"`
+	} `yaml:"servers"`
+	Host     string   `yaml:"host"`
+	BasePath string   `yaml:"basePath"`
+	Schemes  []string `yaml:"schemes"`
+
+	Components struct {
+		SecuritySchemes map[string]openAPISecurityScheme `yaml:"securitySchemes"`
+	} `yaml:"components"`
+	SecurityDefinitions map[string]openAPISecurityScheme `yaml:"securityDefinitions"`
+
+	Security []map[string][]string      `yaml:"security"`
+	Paths    map[string]openAPIPathItem `yaml:"paths"`
+}
+
+type openAPISecurityScheme struct {
+	Flows map[string]struct {
+		Scopes map[string]string `yaml:"scopes"`
+	} `yaml:"flows"`
+
+	// Scopes are where Swagger 2.0 documents define the scopes of their OAuth 2.0 schemes.
+	Scopes map[string]string `yaml:"scopes"`
+}
+
+type openAPIPathItem struct {
+	Get     *openAPIOperation `yaml:"get"`
+	Put     *openAPIOperation `yaml:"put"`
+	Post    *openAPIOperation `yaml:"post"`
+	Delete  *openAPIOperation `yaml:"delete"`
+	Options *openAPIOperation `yaml:"options"`
+	Head    *openAPIOperation `yaml:"head"`
+	Patch   *openAPIOperation `yaml:"patch"`
+	Trace   *openAPIOperation `yaml:"trace"`
+}
+
+type openAPIOperation struct {
+	Security []map[string][]string `yaml:"security"`
+}
+
+// readOpenAPIDocument reads the OpenAPI document, in either YAML or JSON.
+func readOpenAPIDocument(path string) (*openAPIDocument, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the OpenAPI document: %w", err)
+	}
+
+	return parseOpenAPIDocument(content)
+}
+
+func parseOpenAPIDocument(content []byte) (*openAPIDocument, error) {
+	var document openAPIDocument
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse the OpenAPI document: %w", err)
+	}
+
+	if document.OpenAPI == "" && document.Swagger == "" {
+		return nil, fmt.Errorf("failed to parse the OpenAPI document: missing its openapi or swagger version")
+	}
+
+	return &document, nil
+}
+
+// Identifier returns the URL the API is served from, to use as the identifier of the API.
+func (d *openAPIDocument) Identifier() string {
+	for _, server := range d.Servers {
+		if serverURL, err := url.Parse(server.URL); err == nil && serverURL.IsAbs() {
+			return strings.TrimSuffix(server.URL, "/")
+		}
+	}
+
+	if d.Host != "" {
+		scheme := "https"
+		if len(d.Schemes) > 0 {
+			scheme = d.Schemes[0]
+		}
+
+		return strings.TrimSuffix(fmt.Sprintf("%s://%s%s", scheme, d.Host, d.BasePath), "/")
+	}
+
+	return ""
+}
+
+// Scopes returns the scopes the security schemes define and the operations require,
+// along with the description of the scopes the schemes define.
+func (d *openAPIDocument) Scopes() []management.ResourceServerScope {
+	descriptions := make(map[string]string)
+
+	schemes := make(map[string]openAPISecurityScheme)
+	for name, scheme := range d.SecurityDefinitions {
+		schemes[name] = scheme
+	}
+	for name, scheme := range d.Components.SecuritySchemes {
+		schemes[name] = scheme
+	}
+
+	for _, scheme := range schemes {
+		for scope, description := range scheme.Scopes {
+			descriptions[scope] = description
+		}
+		for _, flow := range scheme.Flows {
+			for scope, description := range flow.Scopes {
+				descriptions[scope] = description
+			}
+		}
+	}
+
+	requirements := d.Security
+	for _, item := range d.Paths {
+		for _, operation := range []*openAPIOperation{
+			item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch, item.Trace,
+		} {
+			if operation != nil {
+				requirements = append(requirements, operation.Security...)
+			}
+		}
+	}
+
+	for _, requirement := range requirements {
+		for _, scopes := range requirement {
+			for _, scope := range scopes {
+				if _, ok := descriptions[scope]; !ok {
+					descriptions[scope] = ""
+				}
+			}
+		}
+	}
+
+	values := make([]string, 0, len(descriptions))
+	for scope := range descriptions {
+		values = append(values, scope)
+	}
+	sort.Strings(values)
+
+	scopes := make([]management.ResourceServerScope, 0, len(values))
+	for _, value := range values {
+		scope := management.ResourceServerScope{Value: &value}
+		if description := descriptions[value]; description != "" {
+			scope.Description = &description
+		}
+		scopes = append(scopes, scope)
+	}
+
+	return scopes
+}
+
+// DescribeScopes sets the description of the scopes the document describes.
+func (d *openAPIDocument) DescribeScopes(scopes []management.ResourceServerScope) {
+	descriptions := make(map[string]*string)
+	for _, scope := range d.Scopes() {
+		descriptions[scope.GetValue()] = scope.Description
+	}
+
+	for i, scope := range scopes {
+		if scope.Description == nil {
+			scopes[i].Description = descriptions[scope.GetValue()]
+		}
+	}
+}
+
+// mergeScopes adds the scopes that are missing from the given ones.
+func mergeScopes(scopes []string, others []string) []string {
+	merged := append([]string{}, scopes...)
+	for _, scope := range others {
+		if !slices.Contains(merged, scope) {
+			merged = append(merged, scope)
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+const testOpenAPIDocument = `
+openapi: 3.0.3
+info:
+  title: Orders API
+servers:
+  - url: /relative
+  - url: https://api.example.com/orders/
+components:
+  securitySchemes:
+    oauth:
+      type: oauth2
+      flows:
+        clientCredentials:
+          tokenUrl: https://example.auth0.com/oauth/token
+          scopes:
+            read:orders: Read the orders
+            create:orders: Create orders
+security:
+  - oauth: [read:orders]
+paths:
+  /orders:
+    parameters:
+      - name: limit
+        in: query
+    get:
+      security:
+        - oauth: [read:orders]
+    post:
+      security:
+        - oauth: [create:orders, audit:orders]
+`
+
+func TestParseOpenAPIDocument(t *testing.T) {
+	t.Run("it derives the api from an openapi document", func(t *testing.T) {
+		document, err := parseOpenAPIDocument([]byte(testOpenAPIDocument))
+		require.NoError(t, err)
+
+		assert.Equal(t, "Orders API", document.Info.Title)
+		assert.Equal(t, "https://api.example.com/orders", document.Identifier())
+		assert.Equal(t, []management.ResourceServerScope{
+			{Value: auth0.String("audit:orders")},
+			{Value: auth0.String("create:orders"), Description: auth0.String("Create orders")},
+			{Value: auth0.String("read:orders"), Description: auth0.String("Read the orders")},
+		}, document.Scopes())
+	})
+
+	t.Run("it derives the api from a swagger document in json", func(t *testing.T) {
+		document, err := parseOpenAPIDocument([]byte(`{
+			"swagger": "2.0",
+			"info": {"title": "Petstore"},
+			"host": "petstore.example.com",
+			"basePath": "/v1",
+			"schemes": ["http"],
+			"securityDefinitions": {
+				"petstore_auth": {"type": "oauth2", "scopes": {"write:pets": "Modify pets"}}
+			}
+		}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "http://petstore.example.com/v1", document.Identifier())
+		assert.Equal(t, []management.ResourceServerScope{
+			{Value: auth0.String("write:pets"), Description: auth0.String("Modify pets")},
+		}, document.Scopes())
+	})
+
+	t.Run("it fails when the document isn't an openapi one", func(t *testing.T) {
+		_, err := parseOpenAPIDocument([]byte(`name: not an openapi document`))
+		assert.EqualError(t, err, "failed to parse the OpenAPI document: missing its openapi or swagger version")
+	})
+
+	t.Run("it returns no identifier without absolute server urls", func(t *testing.T) {
+		document, err := parseOpenAPIDocument([]byte("openapi: 3.1.0\nservers:\n  - url: /api\n"))
+		require.NoError(t, err)
+		assert.Empty(t, document.Identifier())
+	})
+}
+
+func TestOpenAPIDocument_DescribeScopes(t *testing.T) {
+	document, err := parseOpenAPIDocument([]byte(testOpenAPIDocument))
+	require.NoError(t, err)
+
+	scopes := *apiScopesFor(mergeScopes([]string{"read:orders", "admin"}, []string{"admin", "create:orders"}))
+	document.DescribeScopes(scopes)
+
+	assert.Equal(t, []management.ResourceServerScope{
+		{Value: auth0.String("read:orders"), Description: auth0.String("Read the orders")},
+		{Value: auth0.String("admin")},
+		{Value: auth0.String("create:orders"), Description: auth0.String("Create orders")},
+	}, scopes)
+}
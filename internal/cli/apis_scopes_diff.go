@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+var scopesDefinitionFile = Flag{
+	Name:      "File",
+	LongForm:  "file",
+	ShortForm: "f",
+	Help: "Path to the YAML or JSON definition of the scopes, either a list of scopes or an object with a " +
+		"'scopes' list. Each scope is either its value, or an object with a 'value' and a 'description'.",
+	IsRequired: true,
+}
+
+// errScopesOutOfSync fails the diff command when the scopes differ, for it to be used as a CI check.
+var errScopesOutOfSync = errors.New("the scopes of the API are out of sync with their definition")
+
+// scopeDefinition is a scope of the definition file, whose description is only compared when set.
+type scopeDefinition struct {
+	Value       string `yaml:"value"`
+	Description string `yaml:"description"`
+}
+
+func (s *scopeDefinition) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&s.Value)
+	}
+
+	type plain scopeDefinition
+	return node.Decode((*plain)(s))
+}
+
+func diffScopesCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID   string
+		File string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Compare the scopes of an API with their definition",
+		Long: "Compare the live scopes of an API with their definition in a local YAML or JSON file, listing " +
+			"the scopes to add to the API and to remove from it, and the ones whose description changed.\n\n" +
+			"The command fails when the scopes differ, to check in CI that the scopes defined by a service " +
+			"and the ones of its API stay in sync.",
+		Example: `  auth0 apis scopes diff
+  auth0 apis scopes diff <api-id|api-audience> --file scopes.yaml
+  auth0 apis scopes diff <api-id|api-audience> -f scopes.json --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := apiID.Pick(cmd, &inputs.ID, cli.apiPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			if err := scopesDefinitionFile.Ask(cmd, &inputs.File, nil); err != nil {
+				return err
+			}
+
+			definitions, err := readScopeDefinitions(inputs.File)
+			if err != nil {
+				return err
+			}
+
+			var api *management.ResourceServer
+			if err := ansi.Waiting(func() (err error) {
+				api, err = cli.api.ResourceServer.Read(cmd.Context(), url.PathEscape(inputs.ID))
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read scopes for API with ID %q: %w", inputs.ID, err)
+			}
+
+			changes := diffScopes(api.GetScopes(), definitions)
+			cli.renderer.ScopesDiff(api.GetName(), inputs.File, changes)
+
+			if len(changes) > 0 {
+				return errScopesOutOfSync
+			}
+
+			return nil
+		},
+	}
+
+	scopesDefinitionFile.RegisterString(cmd, &inputs.File, "")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+
+	return cmd
+}
+
+func readScopeDefinitions(path string) ([]scopeDefinition, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the scopes definition: %w", err)
+	}
+
+	// JSON being a subset of YAML, both get parsed as YAML.
+	var node yaml.Node
+	if err := yaml.Unmarshal(content, &node); err != nil {
+		return nil, fmt.Errorf("invalid scopes definition %q: %w", path, err)
+	}
+
+	var definitions []scopeDefinition
+	if len(node.Content) > 0 && node.Content[0].Kind == yaml.SequenceNode {
+		err = node.Decode(&definitions)
+	} else {
+		var document struct {
+			Scopes []scopeDefinition `yaml:"scopes"`
+		}
+		err = node.Decode(&document)
+		definitions = document.Scopes
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid scopes definition %q: %w", path, err)
+	}
+
+	for _, definition := range definitions {
+		if definition.Value == "" {
+			return nil, fmt.Errorf("invalid scopes definition %q: a scope has no value", path)
+		}
+	}
+
+	return definitions, nil
+}
+
+// diffScopes returns the scopes to add to the API and to remove from it for its scopes to
+// match their definitions, along with the ones whose description differs, sorted by scope.
+func diffScopes(live []management.ResourceServerScope, definitions []scopeDefinition) []display.ScopeChange {
+	liveDescriptions := make(map[string]string, len(live))
+	for _, scope := range live {
+		liveDescriptions[scope.GetValue()] = scope.GetDescription()
+	}
+
+	defined := make(map[string]bool, len(definitions))
+
+	var changes []display.ScopeChange
+	for _, definition := range definitions {
+		if defined[definition.Value] {
+			continue
+		}
+		defined[definition.Value] = true
+
+		liveDescription, ok := liveDescriptions[definition.Value]
+		switch {
+		case !ok:
+			changes = append(changes, display.ScopeChange{
+				Change:      display.ScopeAdded,
+				Scope:       definition.Value,
+				Description: definition.Description,
+			})
+		case definition.Description != "" && definition.Description != liveDescription:
+			changes = append(changes, display.ScopeChange{
+				Change:          display.ScopeChanged,
+				Scope:           definition.Value,
+				Description:     definition.Description,
+				LiveDescription: liveDescription,
+			})
+		}
+	}
+
+	for _, scope := range live {
+		if !defined[scope.GetValue()] {
+			changes = append(changes, display.ScopeChange{
+				Change:          display.ScopeRemoved,
+				Scope:           scope.GetValue(),
+				LiveDescription: scope.GetDescription(),
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Scope < changes[j].Scope
+	})
+
+	return changes
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestReadScopeDefinitions(t *testing.T) {
+	expected := []scopeDefinition{
+		{Value: "read:letters", Description: "Read letters"},
+		{Value: "write:letters"},
+	}
+
+	var testCases = []struct {
+		name       string
+		file       string
+		definition string
+	}{
+		{
+			name: "yaml object",
+			file: "scopes.yaml",
+			definition: `scopes:
+  - value: read:letters
+    description: Read letters
+  - write:letters
+`,
+		},
+		{
+			name: "yaml list",
+			file: "scopes.yml",
+			definition: `- value: read:letters
+  description: Read letters
+- value: write:letters
+`,
+		},
+		{
+			name:       "json object",
+			file:       "scopes.json",
+			definition: `{"scopes": [{"value": "read:letters", "description": "Read letters"}, "write:letters"]}`,
+		},
+		{
+			name:       "json list",
+			file:       "scopes.json",
+			definition: `[{"value": "read:letters", "description": "Read letters"}, {"value": "write:letters"}]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), testCase.file)
+			require.NoError(t, os.WriteFile(path, []byte(testCase.definition), 0600))
+
+			definitions, err := readScopeDefinitions(path)
+			require.NoError(t, err)
+			assert.Equal(t, expected, definitions)
+		})
+	}
+
+	t.Run("it fails on a scope without value", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "scopes.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("scopes:\n  - description: Read letters\n"), 0600))
+
+		_, err := readScopeDefinitions(path)
+		assert.EqualError(t, err, `invalid scopes definition "`+path+`": a scope has no value`)
+	})
+}
+
+func TestDiffScopes(t *testing.T) {
+	live := []management.ResourceServerScope{
+		{Value: auth0.String("read:letters"), Description: auth0.String("Read letters")},
+		{Value: auth0.String("write:letters"), Description: auth0.String("Write letters")},
+		{Value: auth0.String("delete:letters"), Description: auth0.String("Delete letters")},
+	}
+
+	definitions := []scopeDefinition{
+		{Value: "read:letters"},
+		{Value: "write:letters", Description: "Write and send letters"},
+		{Value: "archive:letters", Description: "Archive letters"},
+	}
+
+	assert.Equal(t, []display.ScopeChange{
+		{Change: display.ScopeAdded, Scope: "archive:letters", Description: "Archive letters"},
+		{Change: display.ScopeRemoved, Scope: "delete:letters", LiveDescription: "Delete letters"},
+		{
+			Change:          display.ScopeChanged,
+			Scope:           "write:letters",
+			Description:     "Write and send letters",
+			LiveDescription: "Write letters",
+		},
+	}, diffScopes(live, definitions))
+
+	assert.Empty(t, diffScopes(live[:1], definitions[:1]))
+}
+
+func TestDiffScopesCmd(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scopes.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("scopes:\n  - read:letters\n  - write:letters\n"), 0600))
+
+	run := func(t *testing.T, scopes []management.ResourceServerScope) (string, error) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceServerAPI := mock.NewMockResourceServerAPI(ctrl)
+		resourceServerAPI.EXPECT().
+			Read(gomock.Any(), "api-id").
+			Return(&management.ResourceServer{Name: auth0.String("Letters"), Scopes: &scopes}, nil)
+
+		result := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: result, Format: display.OutputFormatJSON},
+			api:      &auth0.API{ResourceServer: resourceServerAPI},
+		}
+
+		cmd := diffScopesCmd(cli)
+		cmd.SetArgs([]string{"api-id", "--file", path})
+
+		err := cmd.Execute()
+		return result.String(), err
+	}
+
+	t.Run("it fails when the scopes are out of sync", func(t *testing.T) {
+		output, err := run(t, []management.ResourceServerScope{{Value: auth0.String("read:letters")}})
+
+		assert.ErrorIs(t, err, errScopesOutOfSync)
+		assert.JSONEq(t, `[{"change": "added", "scope": "write:letters"}]`, output)
+	})
+
+	t.Run("it succeeds when the scopes are in sync", func(t *testing.T) {
+		output, err := run(t, []management.ResourceServerScope{
+			{Value: auth0.String("read:letters")},
+			{Value: auth0.String("write:letters")},
+		})
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[]`, output)
+	})
+}
@@ -63,7 +63,8 @@ var (
 		"Regular Web Application",
 		"Machine to Machine",
 	}
-	appDescription = Flag{
+	appTypeCompletions = []string{"native", "spa", "regular", "m2m"}
+	appDescription     = Flag{
 		Name:       "Description",
 		LongForm:   "description",
 		ShortForm:  "d",
@@ -115,18 +116,67 @@ var (
 		Help:       "Defines the requested authentication method for the token endpoint. Possible values are 'None' (public application without a client secret), 'Post' (application uses HTTP POST parameters) or 'Basic' (application uses HTTP Basic).",
 		IsRequired: false,
 	}
-	appGrants = Flag{
+	appAuthMethodCompletions = []string{"None", "Post", "Basic"}
+	appGrants                = Flag{
 		Name:       "Grants",
 		LongForm:   "grants",
 		ShortForm:  "g",
 		Help:       "List of grant types supported for this application. Can include code, implicit, refresh-token, credentials, password, password-realm, mfa-oob, mfa-otp, mfa-recovery-code, and device-code.",
 		IsRequired: false,
 	}
+	appOrganizationUsage = Flag{
+		Name:     "Organization Usage",
+		LongForm: "organization-usage",
+		Help: "Defines how to proceed during an authentication transaction with regards to an organization. " +
+			"Possible values are 'deny' (users can't log in with an organization), 'allow' (users can log in " +
+			"with or without an organization) or 'require' (users must log in with an organization).",
+	}
+	appOrganizationBehavior = Flag{
+		Name:     "Organization Behavior",
+		LongForm: "organization-behavior",
+		Help: "Defines how to proceed during an authentication transaction when the organization usage is 'require'. " +
+			"Possible values are 'no_prompt' (the organization is given by the application), 'pre_login_prompt' " +
+			"(users are prompted for the organization before logging in) or 'post_login_prompt' (users are prompted " +
+			"for the organization after logging in).",
+	}
+	appOrganizationUsageOptions = []string{
+		"deny",
+		"allow",
+		"require",
+	}
+	appOrganizationBehaviorOptions = []string{
+		"no_prompt",
+		"pre_login_prompt",
+		"post_login_prompt",
+	}
 	revealSecrets = Flag{
 		Name:      "Reveal",
 		LongForm:  "reveal-secrets",
 		ShortForm: "r",
-		Help:      "Display the application secrets ('signing_keys', 'client_secret') as part of the command output.",
+		Help:      "Display the application secrets ('signing_keys', 'client_secret') as part of the command output. Same as the global --reveal flag.",
+	}
+	appTypesFilter = Flag{
+		Name:      "Types",
+		LongForm:  "type",
+		ShortForm: "t",
+		Help:      "Only list the applications of the given types, e.g. m2m,spa. Can include native, spa, regular and m2m.",
+	}
+	appGrantsFilter = Flag{
+		Name:      "Grants",
+		LongForm:  "grant",
+		ShortForm: "g",
+		Help: "Only list the applications supporting all the given grant types, e.g. refresh_token. " +
+			"Can include the same values as the --grants flag of 'auth0 apps create', or the grant type identifiers.",
+	}
+	appMetadataFilter = Flag{
+		Name:     "Metadata",
+		LongForm: "meta",
+		Help:     "Only list the applications with all the given metadata key-value pairs, e.g. team=payments.",
+	}
+	appTagsFilter = Flag{
+		Name:     "Tags",
+		LongForm: "tag",
+		Help:     "Only include the applications with all the given tags, e.g. env=prod. See 'auth0 tags'.",
 	}
 	appNumber = Flag{
 		Name:      "Number",
@@ -154,6 +204,8 @@ func appsCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(updateAppCmd(cli))
 	cmd.AddCommand(deleteAppCmd(cli))
 	cmd.AddCommand(openAppCmd(cli))
+	cmd.AddCommand(fapiCheckAppCmd(cli))
+	cmd.AddCommand(snippetAppCmd(cli))
 
 	return cmd
 }
@@ -210,80 +262,195 @@ func listAppsCmd(cli *cli) *cobra.Command {
 	var inputs struct {
 		RevealSecrets bool
 		Number        int
+		Filters       appListFilters
 	}
 
+	var multiTenant multiTenantInputs
+
 	cmd := &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		Short:   "List your applications",
-		Long:    "List your existing applications. To create one, run: `auth0 apps create`.",
+		Long: "List your existing applications. To create one, run: `auth0 apps create`.\n\n" +
+			"The applications can be filtered by type, grant type, metadata and tags. " +
+			"The type is filtered on by the Management API, while filtering by grant type, metadata or tags " +
+			"requires fetching all the applications, before keeping the requested number of them.",
 		Example: `  auth0 apps list
   auth0 apps ls
   auth0 apps list --reveal-secrets
   auth0 apps list --reveal-secrets --number 100
   auth0 apps ls -r -n 100 --json
-  auth0 apps ls --csv`,
+  auth0 apps ls --csv
+  auth0 apps list --type m2m,spa
+  auth0 apps list --grant refresh_token --grant client_credentials
+  auth0 apps list --meta team=payments --json
+  auth0 apps list --tag env=prod
+  auth0 apps list --tenants "example.us.auth0.com,example.eu.auth0.com"
+  auth0 apps list --all-tenants --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if inputs.Number < 1 || inputs.Number > 1000 {
 				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
 			}
 
-			list, err := getWithPagination(
-				inputs.Number,
-				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
-					opts = append(opts, management.Parameter("is_global", "false"))
-					res, apiErr := cli.api.Client.List(cmd.Context(), opts...)
-					if apiErr != nil {
-						return nil, false, apiErr
-					}
-					var output []interface{}
-					for _, client := range res.Clients {
-						output = append(output, client)
-					}
-					return output, res.HasNext(), nil
-				})
+			appTypes, err := inputs.Filters.apiAppTypes()
 			if err != nil {
-				return fmt.Errorf("failed to list applications: %w", err)
+				return err
 			}
 
-			var typedList []*management.Client
-			for _, item := range list {
-				typedList = append(typedList, item.(*management.Client))
+			// The grant types, metadata and tags can't be filtered on by the Management API,
+			// so all the applications need to be fetched to find enough matching ones.
+			limit := inputs.Number
+			if inputs.Filters.hasLocalFilters() {
+				limit = 0
 			}
 
-			cli.renderer.ApplicationList(typedList, inputs.RevealSecrets)
+			return runForTenants(cmd, cli, multiTenant, func(api *auth0.API, renderer *display.Renderer, waiting waitingFunc) error {
+				var total int
+				list, err := getWithPaginationWaiting(
+					waiting,
+					limit,
+					func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+						opts = append(opts, management.Parameter("is_global", "false"))
+						if appTypes != "" {
+							opts = append(opts, management.Parameter("app_type", appTypes))
+						}
+						res, apiErr := api.Client.List(cmd.Context(), opts...)
+						if apiErr != nil {
+							return nil, false, apiErr
+						}
+						var output []interface{}
+						for _, client := range res.Clients {
+							output = append(output, client)
+						}
+						total = res.Total
+						return output, res.HasNext(), nil
+					})
+				if err != nil {
+					return fmt.Errorf("failed to list applications: %w", err)
+				}
+
+				var typedList []*management.Client
+				var matching int
+				for _, item := range list {
+					client := item.(*management.Client)
+					if !inputs.Filters.matches(client) {
+						continue
+					}
 
-			return nil
+					matching++
+					if len(typedList) < inputs.Number {
+						typedList = append(typedList, client)
+					}
+				}
+
+				// All the applications were fetched when filtering locally,
+				// so the total is the number of the matching ones.
+				if inputs.Filters.hasLocalFilters() {
+					total = matching
+				}
+
+				renderer.ApplicationList(typedList, inputs.RevealSecrets)
+				renderer.Truncated("applications", len(typedList), total)
+
+				return nil
+			})
 		},
 	}
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
 	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+	multiTenant.register(cmd)
 
 	revealSecrets.RegisterBool(cmd, &inputs.RevealSecrets, false)
 	appNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+	appTypesFilter.RegisterStringSlice(cmd, &inputs.Filters.Types, nil)
+	appTypesFilter.RegisterCompletions(cmd, appTypeCompletions)
+	appGrantsFilter.RegisterStringSlice(cmd, &inputs.Filters.Grants, nil)
+	appMetadataFilter.RegisterStringMap(cmd, &inputs.Filters.Metadata, nil)
+	appTagsFilter.RegisterStringMap(cmd, &inputs.Filters.Tags, nil)
 
 	return cmd
 }
 
+// appListFilters narrow down the applications to list.
+type appListFilters struct {
+	Types    []string
+	Grants   []string
+	Metadata map[string]string
+	Tags     map[string]string
+}
+
+// apiAppTypes returns the app types to filter on, as expected by the Management API.
+func (f *appListFilters) apiAppTypes() (string, error) {
+	appTypes := make([]string, 0, len(f.Types))
+	for _, t := range f.Types {
+		appType := apiTypeFor(strings.TrimSpace(t))
+		switch appType {
+		case appTypeNative, appTypeSPA, appTypeRegularWeb, appTypeNonInteractive:
+			appTypes = append(appTypes, appType)
+		default:
+			return "", fmt.Errorf("invalid application type %q, possible values: native, spa, regular, m2m", t)
+		}
+	}
+
+	return strings.Join(appTypes, ","), nil
+}
+
+func (f *appListFilters) hasLocalFilters() bool {
+	return len(f.Grants) > 0 || len(f.Metadata) > 0 || len(f.Tags) > 0
+}
+
+// matches checks whether the application supports all the grant
+// types and has all the metadata and tags given to filter on.
+func (f *appListFilters) matches(client *management.Client) bool {
+	for _, grant := range f.Grants {
+		if !containsStr(client.GetGrantTypes(), apiGrantFor(grant)) {
+			return false
+		}
+	}
+
+	metadata := client.GetClientMetadata()
+	for _, filter := range []map[string]string{f.Metadata, tagsMetadataFilter(f.Tags)} {
+		for key, value := range filter {
+			if metadataValue, ok := metadata[key]; !ok || fmt.Sprint(metadataValue) != value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func showAppCmd(cli *cli) *cobra.Command {
 	var inputs struct {
 		ID            string
 		RevealSecrets bool
+		Effective     bool
+		Audience      string
 	}
 
 	cmd := &cobra.Command{
 		Use:   "show",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Show an application",
-		Long:  "Display the name, description, app type, and other information about an application.",
+		Long: "Display the name, description, app type, and other information about an application.\n\n" +
+			"The raw settings of an application alone can be misleading, as they fall back to the API settings, " +
+			"the tenant settings and the defaults of Auth0. Use `--effective` to show the token lifetimes, " +
+			"signing algorithms and session behavior a login through the application actually gets, " +
+			"along with where each of them comes from.",
 		Example: `  auth0 apps show
   auth0 apps show <app-id>
   auth0 apps show <app-id> --reveal-secrets
-  auth0 apps show <app-id> -r --json`,
+  auth0 apps show <app-id> -r --json
+  auth0 apps show <app-id> --effective
+  auth0 apps show <app-id> --effective --audience <api-identifier> --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Audience != "" && !inputs.Effective {
+				return fmt.Errorf("the --audience flag can only be used along with --effective")
+			}
+
 			if len(args) == 0 {
 				err := appID.Pick(cmd, &inputs.ID, cli.appPickerOptions())
 				if err != nil {
@@ -305,6 +472,28 @@ func showAppCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("failed to read application with ID %q: %w", inputs.ID, err)
 			}
 
+			if inputs.Effective {
+				tenant, err := cli.Config.GetTenant(cli.tenant)
+				if err != nil {
+					return err
+				}
+
+				if err := checkScopes(cmd.CommandPath(), effectiveConfigurationScopes, tenant); err != nil {
+					return err
+				}
+
+				var config *display.EffectiveConfiguration
+				if err := ansi.Waiting(func() (err error) {
+					config, err = fetchEffectiveConfiguration(cmd.Context(), cli, a, inputs.Audience)
+					return err
+				}); err != nil {
+					return fmt.Errorf("failed to resolve the effective configuration of the application with ID %q: %w", inputs.ID, err)
+				}
+
+				cli.renderer.ApplicationEffectiveConfiguration(config)
+				return nil
+			}
+
 			cli.renderer.ApplicationShow(a, inputs.RevealSecrets)
 
 			return nil
@@ -313,11 +502,17 @@ func showAppCmd(cli *cli) *cobra.Command {
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 	revealSecrets.RegisterBool(cmd, &inputs.RevealSecrets, false)
+	appEffective.RegisterBool(cmd, &inputs.Effective, false)
+	appEffectiveAudience.RegisterString(cmd, &inputs.Audience, "")
 
 	return cmd
 }
 
 func deleteAppCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Tags map[string]string
+	}
+
 	cmd := &cobra.Command{
 		Use:     "delete",
 		Aliases: []string{"rm"},
@@ -325,16 +520,34 @@ func deleteAppCmd(cli *cli) *cobra.Command {
 		Long: "Delete an application.\n\n" +
 			"To delete interactively, use `auth0 apps delete` with no arguments.\n\n" +
 			"To delete non-interactively, supply the application id and the `--force` " +
-			"flag to skip confirmation.",
+			"flag to skip confirmation.\n\n" +
+			"To delete all the applications with some tags, supply them through the `--tag` flag instead of the ids.",
 		Example: `  auth0 apps delete 
   auth0 apps rm
   auth0 apps delete <app-id>
   auth0 apps delete <app-id> --force
   auth0 apps delete <app-id> <app-id2> <app-idn>
-  auth0 apps delete <app-id> <app-id2> <app-idn> --force`,
+  auth0 apps delete <app-id> <app-id2> <app-idn> --force
+  auth0 apps delete --tag env=staging --tag team=payments`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(inputs.Tags) > 0 && len(args) > 0 {
+				return fmt.Errorf("either the application ids or the --tag flag can be passed, not both")
+			}
+
 			ids := make([]string, len(args))
-			if len(args) == 0 {
+			if len(inputs.Tags) > 0 {
+				var err error
+				if ids, err = taggedAppIDs(cmd.Context(), cli.api, inputs.Tags); err != nil {
+					return err
+				}
+
+				if len(ids) == 0 {
+					cli.renderer.Infof("There are no applications with the given tags.")
+					return nil
+				}
+
+				cli.renderer.Infof("Found %d application(s) with the given tags: %s", len(ids), strings.Join(ids, ", "))
+			} else if len(args) == 0 {
 				if err := appID.PickMany(cmd, &ids, cli.appPickerOptions()); err != nil {
 					return err
 				}
@@ -367,10 +580,31 @@ func deleteAppCmd(cli *cli) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	appTagsFilter.RegisterStringMap(cmd, &inputs.Tags, nil)
 
 	return cmd
 }
 
+// taggedAppIDs returns the ids of the applications with all the given tags.
+func taggedAppIDs(ctx context.Context, api *auth0.API, tags map[string]string) ([]string, error) {
+	var resources []*display.TaggedResource
+	if err := ansi.Waiting(func() (err error) {
+		resources, err = (&appTagStore{api}).List(ctx)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+
+	var ids []string
+	for _, resource := range resources {
+		if tagsMatch(resource.Tags, tags) {
+			ids = append(ids, resource.ID)
+		}
+	}
+
+	return ids, nil
+}
+
 func createAppCmd(cli *cli) *cobra.Command {
 	var inputs struct {
 		Name              string
@@ -383,7 +617,9 @@ func createAppCmd(cli *cli) *cobra.Command {
 		AuthMethod        string
 		Grants            []string
 		RevealSecrets     bool
+		Copy              bool
 		Metadata          map[string]string
+		IdempotentBy      string
 	}
 	var oidcConformant = true
 	var algorithm = "RS256"
@@ -400,15 +636,41 @@ func createAppCmd(cli *cli) *cobra.Command {
   auth0 apps create --name myapp --description <description>
   auth0 apps create --name myapp --description <description> --type [native|spa|regular|m2m]
   auth0 apps create --name myapp --description <description> --type [native|spa|regular|m2m] --reveal-secrets
+  auth0 apps create --name myapp --description <description> --type [native|spa|regular|m2m] --copy
   auth0 apps create -n myapp -d <description> -t [native|spa|regular|m2m] -r --json
   auth0 apps create -n myapp -d <description> -t [native|spa|regular|m2m] -r --json --metadata "foo=bar"
   auth0 apps create -n myapp -d <description> -t [native|spa|regular|m2m] -r --json --metadata "foo=bar" --metadata "bazz=buzz"
-  auth0 apps create -n myapp -d <description> -t [native|spa|regular|m2m] -r --json --metadata "foo=bar,bazz=buzz"`,
+  auth0 apps create -n myapp -d <description> -t [native|spa|regular|m2m] -r --json --metadata "foo=bar,bazz=buzz"
+  auth0 apps create -n myapp -t [native|spa|regular|m2m] --idempotent-by name --json --no-input`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateIdempotencyKey(inputs.IdempotentBy, idempotencyKeyName); err != nil {
+				return err
+			}
+
 			if err := appName.Ask(cmd, &inputs.Name, nil); err != nil {
 				return err
 			}
 
+			if inputs.IdempotentBy != "" {
+				var existing *management.Client
+				if err := ansi.Waiting(func() (err error) {
+					existing, err = findAppByName(cmd.Context(), cli.api, inputs.Name)
+					return err
+				}); err != nil {
+					return err
+				}
+
+				if existing != nil {
+					if err := cli.Config.SetDefaultAppIDForTenant(cli.tenant, existing.GetClientID()); err != nil {
+						return err
+					}
+
+					cli.renderer.Warnf("An application named %q already exists, skipping its creation.", inputs.Name)
+					cli.renderer.ApplicationShow(existing, inputs.RevealSecrets)
+					return nil
+				}
+			}
+
 			if err := appDescription.Ask(cmd, &inputs.Description, nil); err != nil {
 				return err
 			}
@@ -509,15 +771,28 @@ func createAppCmd(cli *cli) *cobra.Command {
 				return err
 			}
 
-			cli.renderer.ApplicationCreate(a, inputs.RevealSecrets)
+			// The client secret goes to the clipboard only when copying it, even when revealing secrets.
+			clientSecret := a.GetClientSecret()
+			revealSecrets := (inputs.RevealSecrets || cli.renderer.RevealSecrets) && !inputs.Copy
+			cli.renderer.ApplicationCreate(a, revealSecrets)
 
-			return nil
+			if !inputs.Copy {
+				return nil
+			}
+
+			if clientSecret == "" {
+				cli.renderer.Warnf("The application has no client secret to copy.")
+				return nil
+			}
+
+			return copySecretToClipboard(cli.renderer, "client secret", clientSecret)
 		},
 	}
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 	appName.RegisterString(cmd, &inputs.Name, "")
 	appType.RegisterString(cmd, &inputs.Type, "")
+	appType.RegisterCompletions(cmd, appTypeCompletions)
 	appDescription.RegisterString(cmd, &inputs.Description, "")
 	appCallbacks.RegisterStringSlice(cmd, &inputs.Callbacks, nil)
 	appOrigins.RegisterStringSlice(cmd, &inputs.AllowedOrigins, nil)
@@ -525,8 +800,12 @@ func createAppCmd(cli *cli) *cobra.Command {
 	appWebOrigins.RegisterStringSlice(cmd, &inputs.AllowedWebOrigins, nil)
 	appLogoutURLs.RegisterStringSlice(cmd, &inputs.AllowedLogoutURLs, nil)
 	appAuthMethod.RegisterString(cmd, &inputs.AuthMethod, "")
+	appAuthMethod.RegisterCompletions(cmd, appAuthMethodCompletions)
 	appGrants.RegisterStringSlice(cmd, &inputs.Grants, nil)
 	revealSecrets.RegisterBool(cmd, &inputs.RevealSecrets, false)
+	copySecret.RegisterBool(cmd, &inputs.Copy, false)
+	idempotentBy.RegisterString(cmd, &inputs.IdempotentBy, "")
+	cmd.MarkFlagsMutuallyExclusive("reveal-secrets", "copy")
 
 	return cmd
 }
@@ -545,6 +824,8 @@ func updateAppCmd(cli *cli) *cobra.Command {
 		Grants            []string
 		RevealSecrets     bool
 		Metadata          map[string]string
+		OrgUsage          string
+		OrgBehavior       string
 	}
 
 	cmd := &cobra.Command{
@@ -563,10 +844,15 @@ func updateAppCmd(cli *cli) *cobra.Command {
   auth0 apps update <app-id> -n myapp -d <description> -t [native|spa|regular|m2m] -r --json
   auth0 apps update <app-id> -n myapp -d <description> -t [native|spa|regular|m2m] -r --json --metadata "foo=bar"
   auth0 apps update <app-id> -n myapp -d <description> -t [native|spa|regular|m2m] -r --json --metadata "foo=bar" --metadata "bazz=buzz"
-  auth0 apps update <app-id> -n myapp -d <description> -t [native|spa|regular|m2m] -r --json --metadata "foo=bar,bazz=buzz"`,
+  auth0 apps update <app-id> -n myapp -d <description> -t [native|spa|regular|m2m] -r --json --metadata "foo=bar,bazz=buzz"
+  auth0 apps update <app-id> --organization-usage require --organization-behavior post_login_prompt`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var current *management.Client
 
+			if err := validateAppOrganizationSettings(inputs.OrgUsage, inputs.OrgBehavior); err != nil {
+				return err
+			}
+
 			if len(args) == 0 {
 				err := appID.Pick(cmd, &inputs.ID, cli.appPickerOptions())
 				if err != nil {
@@ -722,6 +1008,14 @@ func updateAppCmd(cli *cli) *cobra.Command {
 				a.ClientMetadata = &clientMetadata
 			}
 
+			if len(inputs.OrgUsage) > 0 {
+				a.OrganizationUsage = &inputs.OrgUsage
+			}
+
+			if len(inputs.OrgBehavior) > 0 {
+				a.OrganizationRequireBehavior = &inputs.OrgBehavior
+			}
+
 			if err := ansi.Waiting(func() error {
 				return cli.api.Client.Update(cmd.Context(), inputs.ID, a)
 			}); err != nil {
@@ -737,6 +1031,7 @@ func updateAppCmd(cli *cli) *cobra.Command {
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 	appName.RegisterStringU(cmd, &inputs.Name, "")
 	appType.RegisterStringU(cmd, &inputs.Type, "")
+	appType.RegisterCompletions(cmd, appTypeCompletions)
 	appDescription.RegisterStringU(cmd, &inputs.Description, "")
 	appCallbacks.RegisterStringSliceU(cmd, &inputs.Callbacks, nil)
 	appMetadata.RegisterStringMap(cmd, &inputs.Metadata, map[string]string{})
@@ -744,12 +1039,39 @@ func updateAppCmd(cli *cli) *cobra.Command {
 	appWebOrigins.RegisterStringSliceU(cmd, &inputs.AllowedWebOrigins, nil)
 	appLogoutURLs.RegisterStringSliceU(cmd, &inputs.AllowedLogoutURLs, nil)
 	appAuthMethod.RegisterStringU(cmd, &inputs.AuthMethod, "")
+	appAuthMethod.RegisterCompletions(cmd, appAuthMethodCompletions)
 	appGrants.RegisterStringSliceU(cmd, &inputs.Grants, nil)
+	appOrganizationUsage.RegisterStringU(cmd, &inputs.OrgUsage, "")
+	appOrganizationBehavior.RegisterStringU(cmd, &inputs.OrgBehavior, "")
+	appOrganizationUsage.RegisterCompletions(cmd, appOrganizationUsageOptions)
+	appOrganizationBehavior.RegisterCompletions(cmd, appOrganizationBehaviorOptions)
 	revealSecrets.RegisterBool(cmd, &inputs.RevealSecrets, false)
 
 	return cmd
 }
 
+// validateAppOrganizationSettings makes sure the organization
+// usage and behavior are supported by the Management API.
+func validateAppOrganizationSettings(usage, behavior string) error {
+	if usage != "" && !containsStr(appOrganizationUsageOptions, usage) {
+		return fmt.Errorf(
+			"invalid organization usage %q, use one of: %s",
+			usage,
+			strings.Join(appOrganizationUsageOptions, ", "),
+		)
+	}
+
+	if behavior != "" && !containsStr(appOrganizationBehaviorOptions, behavior) {
+		return fmt.Errorf(
+			"invalid organization behavior %q, use one of: %s",
+			behavior,
+			strings.Join(appOrganizationBehaviorOptions, ", "),
+		)
+	}
+
+	return nil
+}
+
 func openAppCmd(cli *cli) *cobra.Command {
 	var inputs struct {
 		ID string
@@ -856,6 +1178,16 @@ func apiGrantsFor(s []string) *[]string {
 	return &res
 }
 
+// apiGrantFor maps a grant type to its identifier,
+// keeping the values that are identifiers already.
+func apiGrantFor(v string) string {
+	if grant := (*apiGrantsFor([]string{v}))[0]; grant != "" {
+		return grant
+	}
+
+	return v
+}
+
 func apiDefaultGrantsFor(t string) *[]string {
 	switch apiTypeFor(strings.ToLower(t)) {
 	case appTypeNative:
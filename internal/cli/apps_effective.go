@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// The values Auth0 uses when neither the tenant, the API nor the application set them.
+const (
+	defaultIDTokenLifetime           = 36000
+	defaultAccessTokenLifetime       = 86400
+	defaultAccessTokenLifetimeForWeb = 7200
+	defaultSigningAlgorithm          = "RS256"
+	defaultRefreshTokenLifetime      = 2592000
+	defaultRefreshTokenIdleLifetime  = 1296000
+	defaultSessionLifetimeHours      = 168
+	defaultIdleSessionLifetimeHours  = 72
+	defaultSessionCookieMode         = "persistent"
+)
+
+const (
+	sourceApp     = "app"
+	sourceAPI     = "API"
+	sourceTenant  = "tenant"
+	sourceDefault = "default"
+)
+
+// effectiveConfigurationScopes are the scopes needed to resolve the effective configuration of an application.
+var effectiveConfigurationScopes = []string{"read:clients", "read:tenant_settings", "read:resource_servers"}
+
+var appEffective = Flag{
+	Name:     "Effective",
+	LongForm: "effective",
+	Help: "Show the effective configuration a login through the application gets, " +
+		"resolving the tenant defaults, the API settings and the application overrides.",
+}
+
+var appEffectiveAudience = Flag{
+	Name:     "Audience",
+	LongForm: "audience",
+	Help:     "Identifier of the API to resolve the effective configuration for. Defaults to the default audience of the tenant.",
+}
+
+// fetchEffectiveConfiguration reads the tenant settings and the API the
+// application logs in to, in order to resolve its effective configuration.
+func fetchEffectiveConfiguration(ctx context.Context, cli *cli, client *management.Client, audience string) (*display.EffectiveConfiguration, error) {
+	tenant, err := cli.api.Tenant.Read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the tenant settings: %w", err)
+	}
+
+	audienceFromFlag := audience != ""
+	if !audienceFromFlag {
+		audience = tenant.GetDefaultAudience()
+	}
+
+	var api *management.ResourceServer
+	if audience != "" {
+		if api, err = cli.api.ResourceServer.Read(ctx, audience); err != nil {
+			return nil, fmt.Errorf("failed to read the API with identifier %q: %w", audience, err)
+		}
+	}
+
+	config := resolveEffectiveConfiguration(client, tenant, api)
+	if audienceFromFlag {
+		config.Audience.Source = "flag"
+	}
+
+	return config, nil
+}
+
+// resolveEffectiveConfiguration resolves the configuration a login through the
+// client gets, where the api is the one the login requests an access token for,
+// if any. The app overrides take precedence over the API and tenant settings,
+// which in turn take precedence over the defaults of Auth0.
+func resolveEffectiveConfiguration(
+	client *management.Client,
+	tenant *management.Tenant,
+	api *management.ResourceServer,
+) *display.EffectiveConfiguration {
+	config := &display.EffectiveConfiguration{
+		ClientID: client.GetClientID(),
+		Name:     client.GetName(),
+	}
+
+	jwtConfiguration := client.GetJWTConfiguration()
+	config.IDTokenLifetime = lifetimeSetting(jwtConfiguration.GetLifetimeInSeconds(), sourceApp, defaultIDTokenLifetime)
+	config.IDTokenAlgorithm = stringSetting(jwtConfiguration.GetAlgorithm(), sourceApp, defaultSigningAlgorithm)
+
+	if api == nil {
+		noAudience := display.EffectiveSetting{Value: "n/a", Source: "no audience"}
+
+		config.Audience = display.EffectiveSetting{Value: "none", Source: "no default audience"}
+		config.AccessTokenFormat = display.EffectiveSetting{Value: "opaque, only usable with /userinfo", Source: "no audience"}
+		config.AccessTokenLifetime = noAudience
+		config.AccessTokenLifetimeForWeb = noAudience
+		config.AccessTokenAlgorithm = noAudience
+	} else {
+		config.Audience = display.EffectiveSetting{Value: api.GetIdentifier(), Source: "default audience"}
+		config.AccessTokenFormat = stringSetting(api.GetTokenDialect(), sourceAPI, "access_token")
+		config.AccessTokenLifetime = lifetimeSetting(api.GetTokenLifetime(), sourceAPI, defaultAccessTokenLifetime)
+		config.AccessTokenLifetimeForWeb = lifetimeSetting(api.GetTokenLifetimeForWeb(), sourceAPI, defaultAccessTokenLifetimeForWeb)
+		config.AccessTokenAlgorithm = stringSetting(api.GetSigningAlgorithm(), sourceAPI, defaultSigningAlgorithm)
+	}
+
+	resolveRefreshTokens(config, client, api)
+
+	config.SessionLifetime = hoursSetting(tenant.GetSessionLifetime(), defaultSessionLifetimeHours)
+	config.IdleSessionLifetime = hoursSetting(tenant.GetIdleSessionLifetime(), defaultIdleSessionLifetimeHours)
+	config.SessionCookie = stringSetting(tenant.GetSessionCookie().GetMode(), sourceTenant, defaultSessionCookieMode)
+
+	return config
+}
+
+func resolveRefreshTokens(config *display.EffectiveConfiguration, client *management.Client, api *management.ResourceServer) {
+	notIssued := display.EffectiveSetting{Value: "n/a", Source: "no refresh tokens"}
+
+	switch {
+	case !containsStr(client.GetGrantTypes(), "refresh_token"):
+		config.RefreshTokens = display.EffectiveSetting{Value: "never", Source: "app lacks the refresh_token grant"}
+	case api != nil && !api.GetAllowOfflineAccess():
+		config.RefreshTokens = display.EffectiveSetting{Value: "never", Source: "API disallows offline access"}
+	default:
+		config.RefreshTokens = display.EffectiveSetting{Value: "when requesting the offline_access scope", Source: sourceApp}
+	}
+
+	if config.RefreshTokens.Value == "never" {
+		config.RefreshTokenRotation = notIssued
+		config.RefreshTokenLifetime = notIssued
+		config.RefreshTokenIdleLifetime = notIssued
+		return
+	}
+
+	refreshToken := client.GetRefreshToken()
+	config.RefreshTokenRotation = stringSetting(refreshToken.GetRotationType(), sourceApp, "non-rotating")
+
+	if stringSetting(refreshToken.GetExpirationType(), sourceApp, "non-expiring").Value != "expiring" {
+		neverExpires := display.EffectiveSetting{Value: "never expires", Source: sourceApp}
+		if refreshToken.GetExpirationType() == "" {
+			neverExpires.Source = sourceDefault
+		}
+
+		config.RefreshTokenLifetime = neverExpires
+		config.RefreshTokenIdleLifetime = neverExpires
+		return
+	}
+
+	config.RefreshTokenLifetime = lifetimeSetting(refreshToken.GetTokenLifetime(), sourceApp, defaultRefreshTokenLifetime)
+	if refreshToken.GetInfiniteTokenLifetime() {
+		config.RefreshTokenLifetime = display.EffectiveSetting{Value: "never expires", Source: sourceApp}
+	}
+
+	config.RefreshTokenIdleLifetime = lifetimeSetting(refreshToken.GetIdleTokenLifetime(), sourceApp, defaultRefreshTokenIdleLifetime)
+	if refreshToken.GetInfiniteIdleTokenLifetime() {
+		config.RefreshTokenIdleLifetime = display.EffectiveSetting{Value: "never expires", Source: sourceApp}
+	}
+}
+
+func stringSetting(value, source, defaultValue string) display.EffectiveSetting {
+	if value == "" {
+		return display.EffectiveSetting{Value: defaultValue, Source: sourceDefault}
+	}
+
+	return display.EffectiveSetting{Value: value, Source: source}
+}
+
+func lifetimeSetting(seconds int, source string, defaultSeconds int) display.EffectiveSetting {
+	if seconds == 0 {
+		return display.EffectiveSetting{Value: formatLifetime(defaultSeconds), Source: sourceDefault}
+	}
+
+	return display.EffectiveSetting{Value: formatLifetime(seconds), Source: source}
+}
+
+func hoursSetting(hours float64, defaultHours float64) display.EffectiveSetting {
+	if hours == 0 {
+		return display.EffectiveSetting{Value: formatLifetime(int(defaultHours * 3600)), Source: sourceDefault}
+	}
+
+	return display.EffectiveSetting{Value: formatLifetime(int(hours * 3600)), Source: sourceTenant}
+}
+
+// formatLifetime formats a lifetime in seconds, such as "10h" or "1d 12h".
+func formatLifetime(seconds int) string {
+	units := []struct {
+		suffix  string
+		seconds int
+	}{
+		{"d", 86400},
+		{"h", 3600},
+		{"m", 60},
+		{"s", 1},
+	}
+
+	var parts []string
+	for _, unit := range units {
+		if seconds >= unit.seconds {
+			parts = append(parts, fmt.Sprintf("%d%s", seconds/unit.seconds, unit.suffix))
+			seconds %= unit.seconds
+		}
+	}
+
+	if len(parts) == 0 {
+		return "0s"
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestResolveEffectiveConfiguration(t *testing.T) {
+	t.Run("it falls back to the defaults when nothing is set", func(t *testing.T) {
+		client := &management.Client{
+			ClientID:   auth0.String("client-id"),
+			Name:       auth0.String("My App"),
+			GrantTypes: &[]string{"authorization_code"},
+		}
+
+		config := resolveEffectiveConfiguration(client, &management.Tenant{}, nil)
+
+		assert.Equal(t, "client-id", config.ClientID)
+		assert.Equal(t, display.EffectiveSetting{Value: "10h", Source: "default"}, config.IDTokenLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "RS256", Source: "default"}, config.IDTokenAlgorithm)
+		assert.Equal(t, "none", config.Audience.Value)
+		assert.Equal(t, "opaque, only usable with /userinfo", config.AccessTokenFormat.Value)
+		assert.Equal(t, "never", config.RefreshTokens.Value)
+		assert.Equal(t, "n/a", config.RefreshTokenLifetime.Value)
+		assert.Equal(t, display.EffectiveSetting{Value: "7d", Source: "default"}, config.SessionLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "3d", Source: "default"}, config.IdleSessionLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "persistent", Source: "default"}, config.SessionCookie)
+	})
+
+	t.Run("it resolves the app overrides, the API and the tenant settings", func(t *testing.T) {
+		client := &management.Client{
+			GrantTypes: &[]string{"authorization_code", "refresh_token"},
+			JWTConfiguration: &management.ClientJWTConfiguration{
+				LifetimeInSeconds: auth0.Int(3600),
+				Algorithm:         auth0.String("HS256"),
+			},
+			RefreshToken: &management.ClientRefreshToken{
+				RotationType:          auth0.String("rotating"),
+				ExpirationType:        auth0.String("expiring"),
+				InfiniteTokenLifetime: auth0.Bool(true),
+			},
+		}
+		tenant := &management.Tenant{
+			SessionLifetime:     auth0.Float64(36),
+			IdleSessionLifetime: auth0.Float64(0.5),
+			SessionCookie:       &management.TenantSessionCookie{Mode: auth0.String("non-persistent")},
+		}
+		api := &management.ResourceServer{
+			Identifier:         auth0.String("https://api.example.com"),
+			TokenLifetime:      auth0.Int(600),
+			SigningAlgorithm:   auth0.String("PS256"),
+			AllowOfflineAccess: auth0.Bool(true),
+		}
+
+		config := resolveEffectiveConfiguration(client, tenant, api)
+
+		assert.Equal(t, display.EffectiveSetting{Value: "1h", Source: "app"}, config.IDTokenLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "HS256", Source: "app"}, config.IDTokenAlgorithm)
+		assert.Equal(t, "https://api.example.com", config.Audience.Value)
+		assert.Equal(t, display.EffectiveSetting{Value: "10m", Source: "API"}, config.AccessTokenLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "2h", Source: "default"}, config.AccessTokenLifetimeForWeb)
+		assert.Equal(t, display.EffectiveSetting{Value: "PS256", Source: "API"}, config.AccessTokenAlgorithm)
+		assert.Equal(t, "when requesting the offline_access scope", config.RefreshTokens.Value)
+		assert.Equal(t, display.EffectiveSetting{Value: "rotating", Source: "app"}, config.RefreshTokenRotation)
+		assert.Equal(t, display.EffectiveSetting{Value: "never expires", Source: "app"}, config.RefreshTokenLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "15d", Source: "default"}, config.RefreshTokenIdleLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "1d 12h", Source: "tenant"}, config.SessionLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "30m", Source: "tenant"}, config.IdleSessionLifetime)
+		assert.Equal(t, display.EffectiveSetting{Value: "non-persistent", Source: "tenant"}, config.SessionCookie)
+	})
+
+	t.Run("it doesn't issue refresh tokens when the API disallows offline access", func(t *testing.T) {
+		client := &management.Client{GrantTypes: &[]string{"refresh_token"}}
+		api := &management.ResourceServer{Identifier: auth0.String("https://api.example.com")}
+
+		config := resolveEffectiveConfiguration(client, &management.Tenant{}, api)
+
+		assert.Equal(t, display.EffectiveSetting{Value: "never", Source: "API disallows offline access"}, config.RefreshTokens)
+		assert.Equal(t, "n/a", config.RefreshTokenRotation.Value)
+	})
+}
+
+func TestFormatLifetime(t *testing.T) {
+	assert.Equal(t, "0s", formatLifetime(0))
+	assert.Equal(t, "45s", formatLifetime(45))
+	assert.Equal(t, "10h", formatLifetime(36000))
+	assert.Equal(t, "1d 1h 1m 1s", formatLifetime(90061))
+	assert.Equal(t, "30d", formatLifetime(2592000))
+}
@@ -0,0 +1,273 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const fapiDocsURL = "https://auth0.com/docs/secure/highly-regulated-identity"
+
+// fapiClientSettings holds the client settings relevant to FAPI. Some of them
+// aren't supported by the Management SDK yet, so the client is read as raw JSON.
+type fapiClientSettings struct {
+	ClientID                           string   `json:"client_id"`
+	Name                               string   `json:"name"`
+	AppType                            string   `json:"app_type"`
+	GrantTypes                         []string `json:"grant_types"`
+	Callbacks                          []string `json:"callbacks"`
+	TokenEndpointAuthMethod            *string  `json:"token_endpoint_auth_method"`
+	RequirePushedAuthorizationRequests bool     `json:"require_pushed_authorization_requests"`
+	RequireProofOfPossession           bool     `json:"require_proof_of_possession"`
+	ComplianceLevel                    *string  `json:"compliance_level"`
+	JWTConfiguration                   struct {
+		Algorithm string `json:"alg"`
+	} `json:"jwt_configuration"`
+	ClientAuthenticationMethods struct {
+		PrivateKeyJWT           *fapiCredentials `json:"private_key_jwt"`
+		TLSClientAuth           *fapiCredentials `json:"tls_client_auth"`
+		SelfSignedTLSClientAuth *fapiCredentials `json:"self_signed_tls_client_auth"`
+	} `json:"client_authentication_methods"`
+	SignedRequestObject *struct {
+		Required    bool              `json:"required"`
+		Credentials []json.RawMessage `json:"credentials"`
+	} `json:"signed_request_object"`
+}
+
+type fapiCredentials struct {
+	Credentials []json.RawMessage `json:"credentials"`
+}
+
+func (c *fapiCredentials) isConfigured() bool {
+	return c != nil && len(c.Credentials) > 0
+}
+
+func fapiCheckAppCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "fapi-check",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Check an application against the FAPI requirements",
+		Long: fmt.Sprintf(
+			"Check the configuration of an application against the requirements of the "+
+				"[Financial-grade API (FAPI)](%s) security profile and report any gaps.\n\n"+
+				"The checks cover the client authentication method, Pushed Authorization Requests (PAR), "+
+				"JWT-Secured Authorization Requests (JAR), the token signing algorithm, sender-constrained tokens, "+
+				"the grant types and the callback URLs. The command fails if any of the requirements isn't met, "+
+				"so it can be used as a gate in CI.",
+			fapiDocsURL,
+		),
+		Example: `  auth0 apps fapi-check
+  auth0 apps fapi-check <app-id>
+  auth0 apps fapi-check <app-id> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := appID.Pick(cmd, &inputs.ID, cli.appPickerOptions()); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var settings *fapiClientSettings
+			if err := ansi.Waiting(func() (err error) {
+				settings, err = fetchFAPIClientSettings(cmd.Context(), cli.api, cli.tenant, inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read application with ID %q: %w", inputs.ID, err)
+			}
+
+			results := checkFAPIRequirements(settings)
+
+			cli.renderer.FAPICheck(settings.Name, results)
+
+			failedChecks := 0
+			for _, result := range results {
+				if result.Status == display.FAPICheckFail {
+					failedChecks++
+				}
+			}
+
+			if failedChecks > 0 {
+				return fmt.Errorf("the application doesn't meet %d of the FAPI requirements", failedChecks)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func fetchFAPIClientSettings(
+	ctx context.Context,
+	api *auth0.API,
+	domain string,
+	clientID string,
+) (*fapiClientSettings, error) {
+	uri := fmt.Sprintf("https://%s/api/v2/clients/%s", domain, url.PathEscape(clientID))
+
+	request, err := api.HTTPClient.NewRequest(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := api.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if err := isInsufficientScopeError(response); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("%d %s: %s", response.StatusCode, http.StatusText(response.StatusCode), body)
+	}
+
+	var settings fapiClientSettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, fmt.Errorf("failed to parse the application: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// checkFAPIRequirements checks the client settings against the requirements
+// of the FAPI security profiles, as supported by Auth0.
+func checkFAPIRequirements(settings *fapiClientSettings) []display.FAPICheckResult {
+	var results []display.FAPICheckResult
+
+	check := func(requirement string, status display.FAPICheckStatus, details string) {
+		results = append(results, display.FAPICheckResult{
+			Requirement: requirement,
+			Status:      status,
+			Details:     details,
+		})
+	}
+
+	if settings.AppType == appTypeRegularWeb {
+		check("Confidential client", display.FAPICheckPass, "")
+	} else {
+		check("Confidential client", display.FAPICheckFail, fmt.Sprintf(
+			"FAPI requires a confidential client using the authorization code flow, "+
+				"but the application type is %q. Use a Regular Web Application instead.",
+			settings.AppType,
+		))
+	}
+
+	authMethods := settings.ClientAuthenticationMethods
+	switch {
+	case authMethods.TLSClientAuth.isConfigured() || authMethods.SelfSignedTLSClientAuth.isConfigured():
+		check("Client authentication", display.FAPICheckPass, "mTLS client authentication is configured.")
+	case authMethods.PrivateKeyJWT.isConfigured():
+		check("Client authentication", display.FAPICheckPass, "Private Key JWT client authentication is configured.")
+	default:
+		method := "none"
+		if settings.TokenEndpointAuthMethod != nil {
+			method = *settings.TokenEndpointAuthMethod
+		}
+		check("Client authentication", display.FAPICheckFail, fmt.Sprintf(
+			"The application authenticates with %q. Configure Private Key JWT or mTLS client authentication "+
+				"through the client_authentication_methods setting.",
+			method,
+		))
+	}
+
+	if settings.RequirePushedAuthorizationRequests {
+		check("Pushed Authorization Requests (PAR)", display.FAPICheckPass, "")
+	} else {
+		check("Pushed Authorization Requests (PAR)", display.FAPICheckFail,
+			"Require PAR by setting require_pushed_authorization_requests to true.")
+	}
+
+	switch {
+	case settings.SignedRequestObject == nil || !settings.SignedRequestObject.Required:
+		check("JWT-Secured Authorization Requests (JAR)", display.FAPICheckFail,
+			"Require signed request objects by setting signed_request_object.required to true.")
+	case len(settings.SignedRequestObject.Credentials) == 0:
+		check("JWT-Secured Authorization Requests (JAR)", display.FAPICheckFail,
+			"Signed request objects are required, but no credentials are configured to verify them.")
+	default:
+		check("JWT-Secured Authorization Requests (JAR)", display.FAPICheckPass, "")
+	}
+
+	if settings.JWTConfiguration.Algorithm == "PS256" {
+		check("Token signing algorithm", display.FAPICheckPass, "")
+	} else {
+		check("Token signing algorithm", display.FAPICheckFail, fmt.Sprintf(
+			"FAPI requires PS256 to sign the tokens, but the application uses %q.",
+			settings.JWTConfiguration.Algorithm,
+		))
+	}
+
+	if settings.RequireProofOfPossession {
+		check("Sender-constrained tokens", display.FAPICheckPass, "")
+	} else {
+		check("Sender-constrained tokens", display.FAPICheckFail,
+			"Bind the access tokens to the client certificate by setting require_proof_of_possession to true.")
+	}
+
+	var disallowedGrants []string
+	for _, grantType := range settings.GrantTypes {
+		if grantType == "implicit" || grantType == "password" ||
+			grantType == "http://auth0.com/oauth/grant-type/password-realm" {
+			disallowedGrants = append(disallowedGrants, grantType)
+		}
+	}
+	if len(disallowedGrants) > 0 {
+		check("Grant types", display.FAPICheckFail, fmt.Sprintf(
+			"Remove the grant types not allowed by FAPI: %s.",
+			strings.Join(disallowedGrants, ", "),
+		))
+	} else {
+		check("Grant types", display.FAPICheckPass, "")
+	}
+
+	var insecureCallbacks []string
+	for _, callback := range settings.Callbacks {
+		if !strings.HasPrefix(callback, "https://") || strings.Contains(callback, "*") {
+			insecureCallbacks = append(insecureCallbacks, callback)
+		}
+	}
+	if len(insecureCallbacks) > 0 {
+		check("Callback URLs", display.FAPICheckFail, fmt.Sprintf(
+			"FAPI requires exact HTTPS callback URLs, without wildcards: %s.",
+			strings.Join(insecureCallbacks, ", "),
+		))
+	} else {
+		check("Callback URLs", display.FAPICheckPass, "")
+	}
+
+	if settings.ComplianceLevel != nil && strings.HasPrefix(*settings.ComplianceLevel, "fapi") {
+		check("Compliance level", display.FAPICheckPass, fmt.Sprintf("Set to %q.", *settings.ComplianceLevel))
+	} else {
+		check("Compliance level", display.FAPICheckWarn,
+			"Set the compliance_level of the application so Auth0 enforces the FAPI profile at runtime.")
+	}
+
+	return results
+}
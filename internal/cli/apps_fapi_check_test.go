@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestCheckFAPIRequirements(t *testing.T) {
+	t.Run("it passes for an application meeting all the requirements", func(t *testing.T) {
+		var settings fapiClientSettings
+		err := json.Unmarshal([]byte(`{
+			"client_id": "client-id",
+			"name": "Bank",
+			"app_type": "regular_web",
+			"grant_types": ["authorization_code", "refresh_token"],
+			"callbacks": ["https://bank.example.com/callback"],
+			"token_endpoint_auth_method": null,
+			"client_authentication_methods": {"private_key_jwt": {"credentials": [{"id": "cred_1"}]}},
+			"require_pushed_authorization_requests": true,
+			"require_proof_of_possession": true,
+			"signed_request_object": {"required": true, "credentials": [{"id": "cred_2"}]},
+			"jwt_configuration": {"alg": "PS256"},
+			"compliance_level": "fapi1_adv_pkj_par"
+		}`), &settings)
+		require.NoError(t, err)
+
+		for _, result := range checkFAPIRequirements(&settings) {
+			assert.Equal(t, display.FAPICheckPass, result.Status, result.Requirement)
+		}
+	})
+
+	t.Run("it reports the gaps of an application", func(t *testing.T) {
+		var settings fapiClientSettings
+		err := json.Unmarshal([]byte(`{
+			"client_id": "client-id",
+			"name": "SPA",
+			"app_type": "spa",
+			"grant_types": ["authorization_code", "implicit"],
+			"callbacks": ["http://localhost:3000", "https://*.example.com"],
+			"token_endpoint_auth_method": "client_secret_post",
+			"signed_request_object": {"required": true},
+			"jwt_configuration": {"alg": "RS256"}
+		}`), &settings)
+		require.NoError(t, err)
+
+		statuses := make(map[string]display.FAPICheckStatus)
+		details := make(map[string]string)
+		for _, result := range checkFAPIRequirements(&settings) {
+			statuses[result.Requirement] = result.Status
+			details[result.Requirement] = result.Details
+		}
+
+		assert.Equal(t, map[string]display.FAPICheckStatus{
+			"Confidential client":                      display.FAPICheckFail,
+			"Client authentication":                    display.FAPICheckFail,
+			"Pushed Authorization Requests (PAR)":      display.FAPICheckFail,
+			"JWT-Secured Authorization Requests (JAR)": display.FAPICheckFail,
+			"Token signing algorithm":                  display.FAPICheckFail,
+			"Sender-constrained tokens":                display.FAPICheckFail,
+			"Grant types":                              display.FAPICheckFail,
+			"Callback URLs":                            display.FAPICheckFail,
+			"Compliance level":                         display.FAPICheckWarn,
+		}, statuses)
+
+		assert.Contains(t, details["Client authentication"], `"client_secret_post"`)
+		assert.Contains(t, details["JWT-Secured Authorization Requests (JAR)"], "no credentials are configured")
+		assert.Contains(t, details["Grant types"], "implicit")
+		assert.Contains(t, details["Callback URLs"], "http://localhost:3000, https://*.example.com")
+	})
+}
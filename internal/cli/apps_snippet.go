@@ -0,0 +1,311 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const (
+	snippetSecretPlaceholder = "<client-secret>"
+	snippetDefaultBaseURL    = "http://localhost:3000"
+)
+
+var (
+	snippetStack = Flag{
+		Name:       "Stack",
+		LongForm:   "stack",
+		ShortForm:  "s",
+		Help:       "Stack to generate the configuration for. Options are \"nextjs\", \"spring\", \"express\" or \"dotnet\".",
+		IsRequired: true,
+	}
+
+	snippetAudience = Flag{
+		Name:      "Audience",
+		LongForm:  "audience",
+		ShortForm: "a",
+		Help:      "Identifier of the API the application requests access tokens for.",
+	}
+)
+
+// appSnippetFile is a file of the configuration to paste.
+type appSnippetFile struct {
+	Name     string
+	Template string
+}
+
+// appSnippets are the configuration files of each stack, using the SDK Auth0 recommends for it.
+var appSnippets = map[string][]appSnippetFile{
+	"nextjs": {
+		{
+			Name: ".env.local",
+			Template: `# Run 'openssl rand -hex 32' to generate a secret.
+AUTH0_SECRET='<secret>'
+AUTH0_BASE_URL='{{ .BaseURL }}'
+AUTH0_ISSUER_BASE_URL='https://{{ .Domain }}'
+AUTH0_CLIENT_ID='{{ .ClientID }}'
+{{- if .ClientSecret }}
+AUTH0_CLIENT_SECRET='{{ .ClientSecret }}'
+{{- end }}
+{{- if .Audience }}
+AUTH0_AUDIENCE='{{ .Audience }}'
+{{- end }}
+`,
+		},
+	},
+	"express": {
+		{
+			Name: ".env",
+			Template: `# Run 'openssl rand -hex 32' to generate a secret.
+SECRET=<secret>
+BASE_URL={{ .BaseURL }}
+ISSUER_BASE_URL=https://{{ .Domain }}
+CLIENT_ID={{ .ClientID }}
+{{- if .ClientSecret }}
+CLIENT_SECRET={{ .ClientSecret }}
+{{- end }}
+{{- if .Audience }}
+AUDIENCE={{ .Audience }}
+{{- end }}
+`,
+		},
+		{
+			Name: "app.js",
+			Template: `const { auth } = require('express-openid-connect');
+
+app.use(
+  auth({
+    authRequired: false,
+    auth0Logout: true,
+    secret: process.env.SECRET,
+    baseURL: process.env.BASE_URL,
+    issuerBaseURL: process.env.ISSUER_BASE_URL,
+    clientID: process.env.CLIENT_ID,
+{{- if .ClientSecret }}
+    clientSecret: process.env.CLIENT_SECRET,
+{{- end }}
+{{- if .Audience }}
+    authorizationParams: {
+      response_type: 'code',
+      audience: process.env.AUDIENCE,
+    },
+{{- end }}
+  })
+);
+`,
+		},
+	},
+	"spring": {
+		{
+			Name: "src/main/resources/application.yml",
+			Template: `okta:
+  oauth2:
+    issuer: https://{{ .Domain }}/
+    client-id: {{ .ClientID }}
+{{- if .ClientSecret }}
+    client-secret: {{ .ClientSecret }}
+{{- end }}
+{{- if .Audience }}
+    audience: {{ .Audience }}
+{{- end }}
+`,
+		},
+	},
+	"dotnet": {
+		{
+			Name: "appsettings.json",
+			Template: `{
+  "Auth0": {
+    "Domain": "{{ .Domain }}",
+    "ClientId": "{{ .ClientID }}"
+{{- if .ClientSecret }},
+    "ClientSecret": "{{ .ClientSecret }}"
+{{- end }}
+{{- if .Audience }},
+    "Audience": "{{ .Audience }}"
+{{- end }}
+  }
+}
+`,
+		},
+		{
+			Name: "Program.cs",
+			Template: `builder.Services
+    .AddAuth0WebAppAuthentication(options =>
+    {
+        options.Domain = builder.Configuration["Auth0:Domain"];
+        options.ClientId = builder.Configuration["Auth0:ClientId"];
+{{- if .ClientSecret }}
+        options.ClientSecret = builder.Configuration["Auth0:ClientSecret"];
+{{- end }}
+    })
+{{- if .Audience }}
+    .WithAccessToken(options =>
+    {
+        options.Audience = builder.Configuration["Auth0:Audience"];
+    })
+{{- end }};
+`,
+		},
+	},
+}
+
+// appSnippetStacks are the stacks to pick from, in the order they're offered.
+var appSnippetStacks = []string{"nextjs", "express", "spring", "dotnet"}
+
+// appSnippetValues are the values the snippets get populated with.
+type appSnippetValues struct {
+	Domain       string
+	ClientID     string
+	ClientSecret string
+	Audience     string
+	BaseURL      string
+}
+
+func snippetAppCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID            string
+		Stack         string
+		Audience      string
+		RevealSecrets bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "snippet",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Print the SDK configuration of an application",
+		Long: "Print ready-to-paste SDK configuration for an application, such as environment variables " +
+			"and configuration files, populated with the domain, client ID and audience of the application.\n\n" +
+			"The client secret is left as a placeholder, unless revealed through `--reveal-secrets`.",
+		Example: `  auth0 apps snippet
+  auth0 apps snippet <app-id> --stack nextjs
+  auth0 apps snippet <app-id> --stack express --audience https://my-api
+  auth0 apps snippet <app-id> --stack spring --reveal-secrets
+  auth0 apps snippet <app-id> -s dotnet -a https://my-api -r`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := appID.Pick(cmd, &inputs.ID, cli.appPickerOptions()); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			if err := snippetStack.Select(cmd, &inputs.Stack, appSnippetStacks, nil); err != nil {
+				return err
+			}
+
+			files, ok := appSnippets[inputs.Stack]
+			if !ok {
+				return fmt.Errorf(
+					"unsupported stack %q, possible values: %s",
+					inputs.Stack,
+					strings.Join(appSnippetStacks, ", "),
+				)
+			}
+
+			var client *management.Client
+			if err := ansi.Waiting(func() (err error) {
+				client, err = cli.api.Client.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read application with ID %q: %w", inputs.ID, err)
+			}
+
+			revealSecrets := inputs.RevealSecrets || cli.renderer.RevealSecrets
+			values := appSnippetValuesFor(cli.tenant, client, inputs.Audience, revealSecrets)
+
+			if client.GetAppType() != appTypeRegularWeb {
+				cli.renderer.Warnf(
+					"The %s SDK is meant for regular web applications, while this application is of type %s.",
+					inputs.Stack,
+					display.FriendlyAppType(client.GetAppType()),
+				)
+			}
+
+			for _, file := range files {
+				snippet, err := renderAppSnippet(file, values)
+				if err != nil {
+					return err
+				}
+
+				cli.renderer.Newline()
+				cli.renderer.Infof("%s", ansi.Bold(file.Name))
+				cli.renderer.Output(snippet)
+			}
+
+			if values.ClientSecret == snippetSecretPlaceholder {
+				cli.renderer.Newline()
+				cli.renderer.Infof(
+					"%s Run `auth0 apps snippet %s --stack %s --reveal-secrets` to include the client secret.",
+					ansi.Faint("Hint:"),
+					inputs.ID,
+					inputs.Stack,
+				)
+			}
+
+			return nil
+		},
+	}
+
+	snippetStack.RegisterString(cmd, &inputs.Stack, "")
+	snippetAudience.RegisterString(cmd, &inputs.Audience, "")
+	revealSecrets.RegisterBool(cmd, &inputs.RevealSecrets, false)
+
+	return cmd
+}
+
+// appSnippetValuesFor returns the values to populate the snippets with. The base URL is
+// the origin of the first web callback of the application, and the client secret is left
+// out for public applications.
+func appSnippetValuesFor(domain string, client *management.Client, audience string, revealSecrets bool) appSnippetValues {
+	values := appSnippetValues{
+		Domain:   domain,
+		ClientID: client.GetClientID(),
+		Audience: audience,
+		BaseURL:  snippetDefaultBaseURL,
+	}
+
+	if client.GetTokenEndpointAuthMethod() != "none" {
+		values.ClientSecret = snippetSecretPlaceholder
+		if revealSecrets && client.GetClientSecret() != "" {
+			values.ClientSecret = client.GetClientSecret()
+		}
+	}
+
+	for _, callback := range client.GetCallbacks() {
+		callbackURL, err := url.Parse(callback)
+		if err != nil || callbackURL.Host == "" {
+			continue
+		}
+
+		if callbackURL.Scheme == "http" || callbackURL.Scheme == "https" {
+			values.BaseURL = fmt.Sprintf("%s://%s", callbackURL.Scheme, callbackURL.Host)
+			break
+		}
+	}
+
+	return values
+}
+
+func renderAppSnippet(file appSnippetFile, values appSnippetValues) (string, error) {
+	tmpl, err := template.New(file.Name).Parse(file.Template)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the snippet %q: %w", file.Name, err)
+	}
+
+	var snippet bytes.Buffer
+	if err := tmpl.Execute(&snippet, values); err != nil {
+		return "", fmt.Errorf("failed to render the snippet %q: %w", file.Name, err)
+	}
+
+	return snippet.String(), nil
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+func TestAppSnippetValuesFor(t *testing.T) {
+	client := &management.Client{
+		ClientID:                auth0.String("client-id"),
+		ClientSecret:            auth0.String("client-secret"),
+		TokenEndpointAuthMethod: auth0.String("client_secret_post"),
+		Callbacks:               &[]string{"myapp://callback", "https://app.example.com/api/auth/callback"},
+	}
+
+	t.Run("it masks the client secret unless revealed", func(t *testing.T) {
+		values := appSnippetValuesFor("example.us.auth0.com", client, "https://my-api", false)
+		assert.Equal(t, appSnippetValues{
+			Domain:       "example.us.auth0.com",
+			ClientID:     "client-id",
+			ClientSecret: snippetSecretPlaceholder,
+			Audience:     "https://my-api",
+			BaseURL:      "https://app.example.com",
+		}, values)
+
+		values = appSnippetValuesFor("example.us.auth0.com", client, "", true)
+		assert.Equal(t, "client-secret", values.ClientSecret)
+	})
+
+	t.Run("it leaves out the client secret of public applications", func(t *testing.T) {
+		values := appSnippetValuesFor("example.us.auth0.com", &management.Client{
+			ClientID:                auth0.String("client-id"),
+			TokenEndpointAuthMethod: auth0.String("none"),
+		}, "", true)
+
+		assert.Empty(t, values.ClientSecret)
+		assert.Equal(t, snippetDefaultBaseURL, values.BaseURL)
+	})
+}
+
+func TestRenderAppSnippet(t *testing.T) {
+	values := appSnippetValues{
+		Domain:       "example.us.auth0.com",
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		BaseURL:      "http://localhost:3000",
+	}
+
+	t.Run("it renders the nextjs environment variables", func(t *testing.T) {
+		snippet, err := renderAppSnippet(appSnippets["nextjs"][0], appSnippetValues{
+			Domain:   "example.us.auth0.com",
+			ClientID: "client-id",
+			Audience: "https://my-api",
+			BaseURL:  "http://localhost:3000",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `# Run 'openssl rand -hex 32' to generate a secret.
+AUTH0_SECRET='<secret>'
+AUTH0_BASE_URL='http://localhost:3000'
+AUTH0_ISSUER_BASE_URL='https://example.us.auth0.com'
+AUTH0_CLIENT_ID='client-id'
+AUTH0_AUDIENCE='https://my-api'
+`, snippet)
+	})
+
+	t.Run("it renders the spring configuration", func(t *testing.T) {
+		snippet, err := renderAppSnippet(appSnippets["spring"][0], values)
+		require.NoError(t, err)
+		assert.Equal(t, `okta:
+  oauth2:
+    issuer: https://example.us.auth0.com/
+    client-id: client-id
+    client-secret: client-secret
+`, snippet)
+	})
+
+	t.Run("it renders valid dotnet settings", func(t *testing.T) {
+		snippet, err := renderAppSnippet(appSnippets["dotnet"][0], values)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"Auth0": {"Domain": "example.us.auth0.com", "ClientId": "client-id", "ClientSecret": "client-secret"}
+		}`, snippet)
+
+		snippet, err = renderAppSnippet(appSnippets["dotnet"][1], values)
+		require.NoError(t, err)
+		assert.Contains(t, snippet, "options.ClientSecret = builder.Configuration[\"Auth0:ClientSecret\"];\n    });\n")
+		assert.NotContains(t, snippet, "WithAccessToken")
+	})
+
+	t.Run("it renders all the snippets", func(t *testing.T) {
+		for _, stack := range appSnippetStacks {
+			for _, file := range appSnippets[stack] {
+				_, err := renderAppSnippet(file, values)
+				assert.NoError(t, err, file.Name)
+			}
+		}
+	})
+}
@@ -90,6 +90,54 @@ func TestAppsListCmd(t *testing.T) {
 	}
 }
 
+func TestAppListFilters(t *testing.T) {
+	t.Run("it maps the app types for the Management API", func(t *testing.T) {
+		filters := appListFilters{Types: []string{"m2m", "spa", "regular_web"}}
+
+		appTypes, err := filters.apiAppTypes()
+		assert.NoError(t, err)
+		assert.Equal(t, "non_interactive,spa,regular_web", appTypes)
+
+		filters = appListFilters{Types: []string{"m2m", "desktop"}}
+
+		_, err = filters.apiAppTypes()
+		assert.EqualError(t, err, `invalid application type "desktop", possible values: native, spa, regular, m2m`)
+	})
+
+	t.Run("it matches the applications with all the grants and metadata", func(t *testing.T) {
+		client := &management.Client{
+			GrantTypes: &[]string{"authorization_code", "refresh_token"},
+			ClientMetadata: &map[string]interface{}{
+				"team":    "payments",
+				"tier":    "gold",
+				"tag_env": "prod",
+			},
+		}
+
+		for _, test := range []struct {
+			filters  appListFilters
+			expected bool
+		}{
+			{appListFilters{}, true},
+			{appListFilters{Grants: []string{"refresh_token"}}, true},
+			{appListFilters{Grants: []string{"code", "refresh-token"}}, true},
+			{appListFilters{Grants: []string{"refresh_token", "client_credentials"}}, false},
+			{appListFilters{Metadata: map[string]string{"team": "payments"}}, true},
+			{appListFilters{Metadata: map[string]string{"team": "payments", "tier": "silver"}}, false},
+			{appListFilters{Metadata: map[string]string{"region": "eu"}}, false},
+			{appListFilters{Grants: []string{"code"}, Metadata: map[string]string{"tier": "gold"}}, true},
+			{appListFilters{Tags: map[string]string{"env": "prod"}}, true},
+			{appListFilters{Tags: map[string]string{"env": "staging"}}, false},
+			{appListFilters{Tags: map[string]string{"tag_env": "prod"}}, false},
+		} {
+			assert.Equal(t, test.expected, test.filters.matches(client), "%+v", test.filters)
+		}
+
+		assert.True(t, (&appListFilters{}).matches(&management.Client{}))
+		assert.False(t, (&appListFilters{Grants: []string{"implicit"}}).matches(&management.Client{}))
+	})
+}
+
 func TestFormatAppSettingsPath(t *testing.T) {
 	assert.Empty(t, formatAppSettingsPath(""))
 	assert.Equal(t, "applications/app-id-1/settings", formatAppSettingsPath("app-id-1"))
@@ -120,3 +168,18 @@ func TestCommaSeparatedStringToSlice(t *testing.T) {
 	assert.Equal(t, []string{}, commaSeparatedStringToSlice(""))
 	assert.Equal(t, []string{"foo", "bar", "baz"}, commaSeparatedStringToSlice(" foo  , bar , baz "))
 }
+
+func TestValidateAppOrganizationSettings(t *testing.T) {
+	assert.NoError(t, validateAppOrganizationSettings("", ""))
+	assert.NoError(t, validateAppOrganizationSettings("require", "post_login_prompt"))
+	assert.EqualError(
+		t,
+		validateAppOrganizationSettings("always", ""),
+		`invalid organization usage "always", use one of: deny, allow, require`,
+	)
+	assert.EqualError(
+		t,
+		validateAppOrganizationSettings("require", "prompt"),
+		`invalid organization behavior "prompt", use one of: no_prompt, pre_login_prompt, post_login_prompt`,
+	)
+}
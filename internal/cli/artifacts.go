@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+// artifactTimeFormat is the format of the time the default names of the artifacts end with,
+// so that they sort chronologically and don't overwrite the artifacts of previous runs.
+const artifactTimeFormat = "20060102T150405Z"
+
+var (
+	artifactOut = Flag{
+		Name:      "Out",
+		LongForm:  "out",
+		ShortForm: "o",
+	}
+
+	artifactForceOverwrite = Flag{
+		Name:     "Force Overwrite",
+		LongForm: "force-overwrite",
+		Help:     "Overwrite the existing output files without asking for confirmation.",
+	}
+)
+
+// artifactOutput is where an export command writes its files to. The export commands share
+// it so that they name their files, and protect the existing ones, in the same way.
+type artifactOutput struct {
+	Out            string
+	ForceOverwrite bool
+}
+
+// registerArtifactOutput registers the --out and --force-overwrite flags of an export command.
+func registerArtifactOutput(cmd *cobra.Command, output *artifactOutput, help, defaultOut string) {
+	out := artifactOut
+	out.Help = help
+	out.RegisterString(cmd, &output.Out, defaultOut)
+	artifactForceOverwrite.RegisterBool(cmd, &output.ForceOverwrite, false)
+}
+
+// artifactName returns the default name of the artifacts of a kind exported from the tenant
+// at the given time, such as "travel0.us.auth0.com-users-20240501T140000Z.csv".
+func artifactName(tenant, kind string, at time.Time, extension string) string {
+	name := fmt.Sprintf("%s-%s%s", kind, at.UTC().Format(artifactTimeFormat), extension)
+	if tenant == "" {
+		return name
+	}
+
+	return tenant + "-" + name
+}
+
+// dir returns the directory to write the artifacts to. Without --out, it's
+// a new directory named after the tenant, the kind and the current time.
+func (o *artifactOutput) dir(cli *cli, kind string) string {
+	if o.Out != "" {
+		return o.Out
+	}
+
+	return artifactName(cli.tenant, kind, cli.now(), "")
+}
+
+// file returns the file to write the artifact to. Without --out, or when --out is
+// a directory, the file gets named after the tenant, the kind and the current time.
+func (o *artifactOutput) file(cli *cli, kind, extension string) string {
+	name := artifactName(cli.tenant, kind, cli.now(), extension)
+	if o.Out == "" {
+		return name
+	}
+
+	if strings.HasSuffix(o.Out, "/") || strings.HasSuffix(o.Out, string(os.PathSeparator)) {
+		return filepath.Join(o.Out, name)
+	}
+
+	if info, err := os.Stat(o.Out); err == nil && info.IsDir() {
+		return filepath.Join(o.Out, name)
+	}
+
+	return o.Out
+}
+
+// checkOverwrite fails if any of the files already exists, unless --force-overwrite
+// is set or overwriting them gets confirmed when prompting is possible.
+func (o *artifactOutput) checkOverwrite(cmd *cobra.Command, paths ...string) error {
+	if o.ForceOverwrite {
+		return nil
+	}
+
+	var existing []string
+	for _, path := range paths {
+		if _, err := os.Stat(path); err == nil {
+			existing = append(existing, path)
+		}
+	}
+
+	if len(existing) == 0 {
+		return nil
+	}
+
+	if canPrompt(cmd) {
+		if prompt.Confirm(fmt.Sprintf("%s already exists. Do you want to overwrite it?", strings.Join(existing, ", "))) {
+			return nil
+		}
+
+		return fmt.Errorf("%s already exists, aborting", strings.Join(existing, ", "))
+	}
+
+	return artifactExistsError(existing...)
+}
+
+func artifactExistsError(paths ...string) error {
+	return fmt.Errorf(
+		"%s already exists, run the command with the --%s flag to overwrite it",
+		strings.Join(paths, ", "),
+		artifactForceOverwrite.LongForm,
+	)
+}
+
+// createArtifactFile creates the file of an artifact, along with its parent directories.
+func createArtifactFile(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	return os.Create(path)
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactOutput(t *testing.T) {
+	exportedAt := time.Date(2024, time.May, 1, 14, 0, 0, 0, time.UTC)
+	cli := &cli{
+		tenant: "travel0.us.auth0.com",
+		clock: func() time.Time {
+			return exportedAt
+		},
+	}
+
+	t.Run("it names the artifacts after the tenant, the kind and the time without --out", func(t *testing.T) {
+		output := &artifactOutput{}
+
+		assert.Equal(t, "travel0.us.auth0.com-users-20240501T140000Z.csv", output.file(cli, "users", ".csv"))
+		assert.Equal(t, "travel0.us.auth0.com-logs-20240501T140000Z", output.dir(cli, "logs"))
+	})
+
+	t.Run("it leaves the tenant out of the names of the artifacts without a tenant", func(t *testing.T) {
+		assert.Equal(t, "support-bundle-20240501T140000Z.zip", artifactName("", "support-bundle", exportedAt, ".zip"))
+	})
+
+	t.Run("it names the artifacts within the directory given by --out", func(t *testing.T) {
+		dir := t.TempDir()
+
+		output := &artifactOutput{Out: dir}
+		assert.Equal(t, filepath.Join(dir, "travel0.us.auth0.com-users-20240501T140000Z.json"), output.file(cli, "users", ".json"))
+
+		output = &artifactOutput{Out: "exports/"}
+		assert.Equal(t, filepath.Join("exports", "travel0.us.auth0.com-users-20240501T140000Z.json"), output.file(cli, "users", ".json"))
+	})
+
+	t.Run("it uses the file given by --out", func(t *testing.T) {
+		output := &artifactOutput{Out: "users.csv"}
+
+		assert.Equal(t, "users.csv", output.file(cli, "users", ".csv"))
+		assert.Equal(t, "users.csv", output.dir(cli, "users"))
+	})
+}
+
+func TestArtifactOutput_CheckOverwrite(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("no-input", true, "")
+
+	existing := filepath.Join(t.TempDir(), "users.csv")
+	require.NoError(t, os.WriteFile(existing, []byte("user_id\n"), 0600))
+	missing := filepath.Join(t.TempDir(), "users.csv")
+
+	output := &artifactOutput{}
+	assert.NoError(t, output.checkOverwrite(cmd, missing))
+	assert.EqualError(
+		t,
+		output.checkOverwrite(cmd, missing, existing),
+		existing+" already exists, run the command with the --force-overwrite flag to overwrite it",
+	)
+
+	output.ForceOverwrite = true
+	assert.NoError(t, output.checkOverwrite(cmd, existing))
+}
+
+func TestCreateArtifactFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "exports", "2024", "users.csv")
+
+	file, err := createArtifactFile(path)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	assert.FileExists(t, path)
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func auditCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit the configuration of the tenant",
+		Long:  "Audit the configuration of the tenant against security best practices, and the integrity of its references.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(auditRefreshRotationCmd(cli))
+	cmd.AddCommand(auditReferencesCmd(cli))
+
+	return cmd
+}
+
+func auditRefreshRotationCmd(cli *cli) *cobra.Command {
+	var multiTenant multiTenantInputs
+
+	cmd := &cobra.Command{
+		Use:   "refresh-rotation",
+		Args:  cobra.NoArgs,
+		Short: "List the applications using refresh tokens without rotation",
+		Long: "List the applications that are granted refresh tokens without rotating them, together with " +
+			"their last activity, to plan enforcing refresh token rotation.\n\n" +
+			"The last activity is the date of the latest log of the application, so it's only known within " +
+			"the log retention window of the tenant. Enable rotation from the settings of the application " +
+			"in the dashboard, or with `auth0 api patch clients/<app-id> --data " +
+			"'{\"refresh_token\": {\"rotation_type\": \"rotating\", \"expiration_type\": \"expiring\"}}'`.",
+		Example: `  auth0 audit refresh-rotation
+  auth0 audit refresh-rotation --json
+  auth0 audit refresh-rotation --all-tenants --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runForTenants(cmd, cli, multiTenant, func(api *auth0.API, renderer *display.Renderer, waiting waitingFunc) error {
+				var results []display.RefreshRotationAuditResult
+				if err := waiting("Auditing the refresh token rotation of the applications", func() (err error) {
+					results, err = auditRefreshTokenRotation(cmd.Context(), api)
+					return err
+				}); err != nil {
+					return fmt.Errorf("failed to audit the refresh token rotation: %w", err)
+				}
+
+				renderer.RefreshRotationAudit(results)
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	multiTenant.register(cmd)
+
+	return cmd
+}
+
+// auditRefreshTokenRotation finds the applications granted refresh tokens that don't rotate
+// them, sorted by their last activity so that the most active ones come first.
+func auditRefreshTokenRotation(ctx context.Context, api *auth0.API) ([]display.RefreshRotationAuditResult, error) {
+	var results []display.RefreshRotationAuditResult
+
+	for page := 0; ; page++ {
+		list, err := api.Client.List(
+			ctx,
+			management.Page(page),
+			management.PerPage(defaultPageSize),
+			management.Parameter("is_global", "false"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list applications: %w", err)
+		}
+
+		for _, client := range list.Clients {
+			if !usesNonRotatingRefreshTokens(client) {
+				continue
+			}
+
+			result := display.RefreshRotationAuditResult{
+				ClientID:       client.GetClientID(),
+				Name:           client.GetName(),
+				AppType:        client.GetAppType(),
+				ExpirationType: client.GetRefreshToken().GetExpirationType(),
+			}
+
+			if result.LastActivity, err = lastClientActivity(ctx, api.Log, client.GetClientID()); err != nil {
+				return nil, fmt.Errorf("failed to find the last activity of the application %q: %w", client.GetName(), err)
+			}
+
+			results = append(results, result)
+		}
+
+		if !list.HasNext() {
+			break
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i].LastActivity, results[j].LastActivity
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.After(*b)
+	})
+
+	return results, nil
+}
+
+// usesNonRotatingRefreshTokens tells whether the client is granted refresh tokens that don't rotate.
+func usesNonRotatingRefreshTokens(client *management.Client) bool {
+	if !containsStr(client.GetGrantTypes(), "refresh_token") {
+		return false
+	}
+
+	return !strings.EqualFold(client.GetRefreshToken().GetRotationType(), "rotating")
+}
+
+// lastClientActivity returns the date of the latest log of the client, if any is retained.
+func lastClientActivity(ctx context.Context, logAPI auth0.LogAPI, clientID string) (*time.Time, error) {
+	logs, err := logAPI.List(
+		ctx,
+		management.Query(fmt.Sprintf("client_id:%q", clientID)),
+		management.Parameter("page", "0"),
+		management.Parameter("per_page", "1"),
+		management.Parameter("sort", "date:-1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(logs) == 0 {
+		return nil, nil
+	}
+
+	return logs[0].Date, nil
+}
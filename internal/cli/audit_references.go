@@ -0,0 +1,339 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func auditReferencesCmd(cli *cli) *cobra.Command {
+	var multiTenant multiTenantInputs
+
+	cmd := &cobra.Command{
+		Use:   "references",
+		Args:  cobra.NoArgs,
+		Short: "List the dangling references between the resources of the tenant",
+		Long: "List the references between the resources of the tenant that point at resources that were " +
+			"deleted or disabled, together with suggestions to clean them up.\n\n" +
+			"This checks the client grants pointing at deleted APIs or applications, the actions supporting " +
+			"triggers that don't exist anymore, the trigger bindings of deleted actions, and the connections " +
+			"of the organizations that were deleted or aren't enabled for any application.",
+		Example: `  auth0 audit references
+  auth0 audit references --json
+  auth0 audit references --tenants "example.us.auth0.com,example.eu.auth0.com"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runForTenants(cmd, cli, multiTenant, func(api *auth0.API, renderer *display.Renderer, waiting waitingFunc) error {
+				var results []display.ReferenceAuditResult
+				if err := waiting("Auditing the references between the resources", func() (err error) {
+					results, err = auditReferences(cmd.Context(), api)
+					return err
+				}); err != nil {
+					return fmt.Errorf("failed to audit the references: %w", err)
+				}
+
+				renderer.ReferenceAudit(results)
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	multiTenant.register(cmd)
+
+	return cmd
+}
+
+// auditReferences finds the dangling references between the resources of the tenant.
+func auditReferences(ctx context.Context, api *auth0.API) ([]display.ReferenceAuditResult, error) {
+	var results []display.ReferenceAuditResult
+
+	for _, audit := range []func(context.Context, *auth0.API) ([]display.ReferenceAuditResult, error){
+		auditClientGrantReferences,
+		auditActionReferences,
+		auditOrganizationConnectionReferences,
+	} {
+		list, err := audit(ctx, api)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, list...)
+	}
+
+	return results, nil
+}
+
+// auditClientGrantReferences finds the client grants pointing at deleted APIs or applications.
+func auditClientGrantReferences(ctx context.Context, api *auth0.API) ([]display.ReferenceAuditResult, error) {
+	audiences := make(map[string]bool)
+	for page := 0; ; page++ {
+		list, err := api.ResourceServer.List(
+			ctx,
+			management.Page(page),
+			management.PerPage(defaultPageSize),
+			management.IncludeFields("identifier"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list APIs: %w", err)
+		}
+
+		for _, resourceServer := range list.ResourceServers {
+			audiences[resourceServer.GetIdentifier()] = true
+		}
+
+		if !list.HasNext() {
+			break
+		}
+	}
+
+	clientIDs := make(map[string]bool)
+	for page := 0; ; page++ {
+		list, err := api.Client.List(
+			ctx,
+			management.Page(page),
+			management.PerPage(defaultPageSize),
+			management.IncludeFields("client_id"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list applications: %w", err)
+		}
+
+		for _, client := range list.Clients {
+			clientIDs[client.GetClientID()] = true
+		}
+
+		if !list.HasNext() {
+			break
+		}
+	}
+
+	var results []display.ReferenceAuditResult
+	for page := 0; ; page++ {
+		list, err := api.ClientGrant.List(ctx, management.Page(page), management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list client grants: %w", err)
+		}
+
+		for _, grant := range list.ClientGrants {
+			suggestion := fmt.Sprintf("Delete the grant with `auth0 api delete client-grants/%s`", grant.GetID())
+
+			if !audiences[grant.GetAudience()] {
+				results = append(results, display.ReferenceAuditResult{
+					ResourceType: "client grant",
+					ResourceID:   grant.GetID(),
+					Reference:    "API " + grant.GetAudience(),
+					Problem:      "deleted",
+					Suggestion:   suggestion,
+				})
+			}
+
+			if !clientIDs[grant.GetClientID()] {
+				results = append(results, display.ReferenceAuditResult{
+					ResourceType: "client grant",
+					ResourceID:   grant.GetID(),
+					Reference:    "application " + grant.GetClientID(),
+					Problem:      "deleted",
+					Suggestion:   suggestion,
+				})
+			}
+		}
+
+		if !list.HasNext() {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// auditActionReferences finds the actions supporting triggers that don't exist anymore,
+// along with the current triggers that have deleted actions bound to them.
+func auditActionReferences(ctx context.Context, api *auth0.API) ([]display.ReferenceAuditResult, error) {
+	actions, err := listAllActions(ctx, api)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list actions: %w", err)
+	}
+
+	triggers, err := api.Action.Triggers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list triggers: %w", err)
+	}
+
+	triggerVersions := make(map[string]bool)
+	for _, trigger := range triggers.Triggers {
+		triggerVersions[trigger.GetID()+"@"+trigger.GetVersion()] = true
+	}
+
+	actionIDs := make(map[string]bool)
+	var results []display.ReferenceAuditResult
+	for _, action := range actions {
+		actionIDs[action.GetID()] = true
+
+		for _, trigger := range action.SupportedTriggers {
+			if triggerVersions[trigger.GetID()+"@"+trigger.GetVersion()] {
+				continue
+			}
+
+			results = append(results, display.ReferenceAuditResult{
+				ResourceType: "action",
+				ResourceID:   action.GetID(),
+				Reference:    fmt.Sprintf("trigger %s (%s)", trigger.GetID(), trigger.GetVersion()),
+				Problem:      "missing",
+				Suggestion: fmt.Sprintf(
+					"Recreate the action %q for a current trigger with `auth0 actions create`, then delete it with `auth0 actions delete %s`",
+					action.GetName(),
+					action.GetID(),
+				),
+			})
+		}
+	}
+
+	for _, trigger := range filterOutDeprecatedActionTriggers(triggers.Triggers) {
+		bindings, err := api.Action.Bindings(ctx, trigger.GetID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the bindings of the trigger %q: %w", trigger.GetID(), err)
+		}
+
+		for _, binding := range bindings.Bindings {
+			if binding.GetAction() != nil && actionIDs[binding.GetAction().GetID()] {
+				continue
+			}
+
+			results = append(results, display.ReferenceAuditResult{
+				ResourceType: "trigger",
+				ResourceID:   trigger.GetID(),
+				Reference:    fmt.Sprintf("action %s", binding.GetDisplayName()),
+				Problem:      "deleted",
+				Suggestion: fmt.Sprintf(
+					"Remove the binding with `auth0 api patch actions/triggers/%s/bindings`, listing the other bindings only",
+					trigger.GetID(),
+				),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// auditOrganizationConnectionReferences finds the connections of the organizations that were
+// deleted, or that aren't enabled for any application, so that members can't log in with them.
+func auditOrganizationConnectionReferences(ctx context.Context, api *auth0.API) ([]display.ReferenceAuditResult, error) {
+	connections := make(map[string]*management.Connection)
+	for page := 0; ; page++ {
+		list, err := api.Connection.List(
+			ctx,
+			management.Page(page),
+			management.PerPage(defaultPageSize),
+			management.IncludeFields("id", "name", "enabled_clients"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list connections: %w", err)
+		}
+
+		for _, connection := range list.Connections {
+			connections[connection.GetID()] = connection
+		}
+
+		if !list.HasNext() {
+			break
+		}
+	}
+
+	orgs, err := listAllOrganizations(ctx, api)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	var results []display.ReferenceAuditResult
+	for _, org := range orgs {
+		orgConnections, err := listAllOrganizationConnections(ctx, api, org.GetID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the connections of the organization %q: %w", org.GetName(), err)
+		}
+
+		for _, orgConnection := range orgConnections {
+			connectionID := orgConnection.GetConnectionID()
+			removal := fmt.Sprintf(
+				"`auth0 api delete organizations/%s/enabled_connections/%s`",
+				org.GetID(),
+				connectionID,
+			)
+
+			connection, ok := connections[connectionID]
+			switch {
+			case !ok:
+				results = append(results, display.ReferenceAuditResult{
+					ResourceType: "organization",
+					ResourceID:   org.GetID(),
+					Reference:    "connection " + connectionID,
+					Problem:      "deleted",
+					Suggestion:   "Remove the connection from the organization with " + removal,
+				})
+			case len(connection.GetEnabledClients()) == 0:
+				results = append(results, display.ReferenceAuditResult{
+					ResourceType: "organization",
+					ResourceID:   org.GetID(),
+					Reference:    "connection " + connection.GetName(),
+					Problem:      "disabled",
+					Suggestion:   "Enable the connection for the applications of the organization, or remove it with " + removal,
+				})
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// listAllOrganizations follows the checkpoint pagination to fetch all the organizations,
+// as the page based pagination is limited to the first 1000 of them.
+func listAllOrganizations(ctx context.Context, api *auth0.API) ([]*management.Organization, error) {
+	var (
+		orgs []*management.Organization
+		from string
+	)
+
+	for {
+		opts := []management.RequestOption{management.Take(defaultPageSize)}
+		if from != "" {
+			opts = append(opts, management.From(from))
+		}
+
+		list, err := api.Organization.List(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		orgs = append(orgs, list.Organizations...)
+
+		if list.Next == "" || len(list.Organizations) == 0 {
+			return orgs, nil
+		}
+
+		from = list.Next
+	}
+}
+
+func listAllOrganizationConnections(ctx context.Context, api *auth0.API, orgID string) ([]*management.OrganizationConnection, error) {
+	var connections []*management.OrganizationConnection
+
+	for page := 0; ; page++ {
+		list, err := api.Organization.Connections(ctx, orgID, management.Page(page), management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		connections = append(connections, list.OrganizationConnections...)
+
+		if !list.HasNext() {
+			break
+		}
+	}
+
+	return connections, nil
+}
@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestAuditClientGrantReferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceServerAPI := mock.NewMockResourceServerAPI(ctrl)
+	resourceServerAPI.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(&management.ResourceServerList{
+			ResourceServers: []*management.ResourceServer{{Identifier: auth0.String("https://api.example.com")}},
+		}, nil)
+
+	clientAPI := mock.NewMockClientAPI(ctrl)
+	clientAPI.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(&management.ClientList{Clients: []*management.Client{{ClientID: auth0.String("client-id")}}}, nil)
+
+	clientGrantAPI := mock.NewMockClientGrantAPI(ctrl)
+	clientGrantAPI.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(&management.ClientGrantList{
+			ClientGrants: []*management.ClientGrant{
+				{ID: auth0.String("cgr_valid"), ClientID: auth0.String("client-id"), Audience: auth0.String("https://api.example.com")},
+				{ID: auth0.String("cgr_api"), ClientID: auth0.String("client-id"), Audience: auth0.String("https://deleted.example.com")},
+				{ID: auth0.String("cgr_client"), ClientID: auth0.String("deleted-client-id"), Audience: auth0.String("https://api.example.com")},
+			},
+		}, nil)
+
+	results, err := auditClientGrantReferences(context.Background(), &auth0.API{
+		ResourceServer: resourceServerAPI,
+		Client:         clientAPI,
+		ClientGrant:    clientGrantAPI,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []display.ReferenceAuditResult{
+		{
+			ResourceType: "client grant",
+			ResourceID:   "cgr_api",
+			Reference:    "API https://deleted.example.com",
+			Problem:      "deleted",
+			Suggestion:   "Delete the grant with `auth0 api delete client-grants/cgr_api`",
+		},
+		{
+			ResourceType: "client grant",
+			ResourceID:   "cgr_client",
+			Reference:    "application deleted-client-id",
+			Problem:      "deleted",
+			Suggestion:   "Delete the grant with `auth0 api delete client-grants/cgr_client`",
+		},
+	}, results)
+}
+
+func TestAuditActionReferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	actionAPI := mock.NewMockActionAPI(ctrl)
+	actionAPI.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(&management.ActionList{
+			Actions: []*management.Action{
+				{
+					ID:                auth0.String("act_current"),
+					Name:              auth0.String("Add roles"),
+					SupportedTriggers: []management.ActionTrigger{{ID: auth0.String("post-login"), Version: auth0.String("v3")}},
+				},
+				{
+					ID:                auth0.String("act_legacy"),
+					Name:              auth0.String("Legacy"),
+					SupportedTriggers: []management.ActionTrigger{{ID: auth0.String("post-login"), Version: auth0.String("v1")}},
+				},
+			},
+		}, nil)
+	actionAPI.EXPECT().
+		Triggers(gomock.Any()).
+		Return(&management.ActionTriggerList{
+			Triggers: []*management.ActionTrigger{
+				{ID: auth0.String("post-login"), Version: auth0.String("v3"), Status: auth0.String("CURRENT")},
+				{ID: auth0.String("post-login"), Version: auth0.String("v2"), Status: auth0.String("DEPRECATED")},
+			},
+		}, nil)
+	actionAPI.EXPECT().
+		Bindings(gomock.Any(), "post-login").
+		Return(&management.ActionBindingList{
+			Bindings: []*management.ActionBinding{
+				{DisplayName: auth0.String("Add roles"), Action: &management.Action{ID: auth0.String("act_current")}},
+				{DisplayName: auth0.String("Deleted"), Action: &management.Action{ID: auth0.String("act_deleted")}},
+			},
+		}, nil)
+
+	results, err := auditActionReferences(context.Background(), &auth0.API{Action: actionAPI})
+	require.NoError(t, err)
+
+	assert.Equal(t, []display.ReferenceAuditResult{
+		{
+			ResourceType: "action",
+			ResourceID:   "act_legacy",
+			Reference:    "trigger post-login (v1)",
+			Problem:      "missing",
+			Suggestion: "Recreate the action \"Legacy\" for a current trigger with `auth0 actions create`, " +
+				"then delete it with `auth0 actions delete act_legacy`",
+		},
+		{
+			ResourceType: "trigger",
+			ResourceID:   "post-login",
+			Reference:    "action Deleted",
+			Problem:      "deleted",
+			Suggestion:   "Remove the binding with `auth0 api patch actions/triggers/post-login/bindings`, listing the other bindings only",
+		},
+	}, results)
+}
+
+func TestAuditOrganizationConnectionReferences(t *testing.T) {
+	t.Run("it finds the deleted and disabled connections of the organizations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		connectionAPI := mock.NewMockConnectionAPI(ctrl)
+		connectionAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(&management.ConnectionList{
+				Connections: []*management.Connection{
+					{ID: auth0.String("con_enabled"), Name: auth0.String("enabled"), EnabledClients: &[]string{"client-id"}},
+					{ID: auth0.String("con_disabled"), Name: auth0.String("disabled"), EnabledClients: &[]string{}},
+				},
+			}, nil)
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(&management.OrganizationList{
+				Organizations: []*management.Organization{{ID: auth0.String("org_1"), Name: auth0.String("acme")}},
+			}, nil)
+		organizationAPI.EXPECT().
+			Connections(gomock.Any(), "org_1", gomock.Any()).
+			Return(&management.OrganizationConnectionList{
+				OrganizationConnections: []*management.OrganizationConnection{
+					{ConnectionID: auth0.String("con_enabled")},
+					{ConnectionID: auth0.String("con_disabled")},
+					{ConnectionID: auth0.String("con_deleted")},
+				},
+			}, nil)
+
+		results, err := auditOrganizationConnectionReferences(context.Background(), &auth0.API{
+			Connection:   connectionAPI,
+			Organization: organizationAPI,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []display.ReferenceAuditResult{
+			{
+				ResourceType: "organization",
+				ResourceID:   "org_1",
+				Reference:    "connection disabled",
+				Problem:      "disabled",
+				Suggestion: "Enable the connection for the applications of the organization, or remove it with " +
+					"`auth0 api delete organizations/org_1/enabled_connections/con_disabled`",
+			},
+			{
+				ResourceType: "organization",
+				ResourceID:   "org_1",
+				Reference:    "connection con_deleted",
+				Problem:      "deleted",
+				Suggestion: "Remove the connection from the organization with " +
+					"`auth0 api delete organizations/org_1/enabled_connections/con_deleted`",
+			},
+		}, results)
+	})
+
+	t.Run("it fails when the connections fail to be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		connectionAPI := mock.NewMockConnectionAPI(ctrl)
+		connectionAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("api error"))
+
+		_, err := auditOrganizationConnectionReferences(context.Background(), &auth0.API{Connection: connectionAPI})
+		assert.EqualError(t, err, "failed to list connections: api error")
+	})
+}
+
+func TestListAllOrganizations(t *testing.T) {
+	t.Run("it follows the checkpoints to list all the organizations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		gomock.InOrder(
+			organizationAPI.EXPECT().
+				List(gomock.Any(), gomock.Len(1)).
+				Return(&management.OrganizationList{
+					List:          management.List{Next: "checkpoint_1"},
+					Organizations: []*management.Organization{{ID: auth0.String("org_1")}},
+				}, nil),
+			organizationAPI.EXPECT().
+				List(gomock.Any(), gomock.Len(2)).
+				Return(&management.OrganizationList{
+					Organizations: []*management.Organization{{ID: auth0.String("org_2")}},
+				}, nil),
+		)
+
+		orgs, err := listAllOrganizations(context.Background(), &auth0.API{Organization: organizationAPI})
+		require.NoError(t, err)
+		assert.Equal(t, []*management.Organization{{ID: auth0.String("org_1")}, {ID: auth0.String("org_2")}}, orgs)
+	})
+
+	t.Run("it fails when the organizations fail to be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("api error"))
+
+		_, err := listAllOrganizations(context.Background(), &auth0.API{Organization: organizationAPI})
+		assert.EqualError(t, err, "api error")
+	})
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestUsesNonRotatingRefreshTokens(t *testing.T) {
+	var tests = []struct {
+		name     string
+		client   *management.Client
+		expected bool
+	}{
+		{
+			name:     "it ignores applications not granted refresh tokens",
+			client:   &management.Client{GrantTypes: &[]string{"authorization_code"}},
+			expected: false,
+		},
+		{
+			name: "it ignores applications rotating refresh tokens",
+			client: &management.Client{
+				GrantTypes:   &[]string{"authorization_code", "refresh_token"},
+				RefreshToken: &management.ClientRefreshToken{RotationType: auth0.String("rotating")},
+			},
+			expected: false,
+		},
+		{
+			name: "it reports applications not rotating refresh tokens",
+			client: &management.Client{
+				GrantTypes:   &[]string{"authorization_code", "refresh_token"},
+				RefreshToken: &management.ClientRefreshToken{RotationType: auth0.String("non-rotating")},
+			},
+			expected: true,
+		},
+		{
+			name:     "it reports applications without refresh token settings",
+			client:   &management.Client{GrantTypes: &[]string{"refresh_token"}},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, usesNonRotatingRefreshTokens(test.client))
+		})
+	}
+}
+
+func TestAuditRefreshTokenRotation(t *testing.T) {
+	t.Run("it lists the applications without rotation by their last activity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		nonRotating := &management.ClientRefreshToken{RotationType: auth0.String("non-rotating")}
+
+		clientAPI := mock.NewMockClientAPI(ctrl)
+		clientAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(&management.ClientList{
+				Clients: []*management.Client{
+					{
+						ClientID:     auth0.String("client-idle"),
+						Name:         auth0.String("Idle App"),
+						AppType:      auth0.String("spa"),
+						GrantTypes:   &[]string{"refresh_token"},
+						RefreshToken: nonRotating,
+					},
+					{
+						ClientID:     auth0.String("client-rotating"),
+						Name:         auth0.String("Rotating App"),
+						GrantTypes:   &[]string{"refresh_token"},
+						RefreshToken: &management.ClientRefreshToken{RotationType: auth0.String("rotating")},
+					},
+					{
+						ClientID:     auth0.String("client-active"),
+						Name:         auth0.String("Active App"),
+						AppType:      auth0.String("native"),
+						GrantTypes:   &[]string{"refresh_token"},
+						RefreshToken: nonRotating,
+					},
+				},
+			}, nil)
+
+		lastActivity := time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC)
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return([]*management.Log{{Date: &lastActivity}}, nil)
+
+		results, err := auditRefreshTokenRotation(context.Background(), &auth0.API{Client: clientAPI, Log: logAPI})
+		require.NoError(t, err)
+
+		assert.Equal(t, []display.RefreshRotationAuditResult{
+			{
+				ClientID:     "client-active",
+				Name:         "Active App",
+				AppType:      "native",
+				LastActivity: &lastActivity,
+			},
+			{
+				ClientID: "client-idle",
+				Name:     "Idle App",
+				AppType:  "spa",
+			},
+		}, results)
+	})
+
+	t.Run("it fails when the applications fail to be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientAPI := mock.NewMockClientAPI(ctrl)
+		clientAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("api error"))
+
+		_, err := auditRefreshTokenRotation(context.Background(), &auth0.API{Client: clientAPI})
+		assert.EqualError(t, err, "failed to list applications: api error")
+	})
+}
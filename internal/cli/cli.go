@@ -3,7 +3,11 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"time"
 
+	"github.com/auth0/go-auth0/management"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -36,13 +40,40 @@ type cli struct {
 	tracker  *analytics.Tracker
 
 	// Set of flags which are user specified.
-	debug   bool
-	tenant  string
-	json    bool
-	csv     bool
-	force   bool
-	noInput bool
-	noColor bool
+	debug    bool
+	tenant   string
+	json     bool
+	csv      bool
+	force    bool
+	noInput  bool
+	noColor  bool
+	readOnly bool
+	reveal   bool
+	record   string
+	timeout  time.Duration
+	deadline time.Duration
+	strict   bool
+
+	// iKnowThisIsProd acknowledges running destructive commands against production tenants.
+	iKnowThisIsProd bool
+
+	// clock and randomSource override the current time and the source of the random
+	// identifiers, for deterministic output. See now and random.
+	clock        func() time.Time
+	randomSource io.Reader
+
+	// recordFixtures and replayFixtures are the files of the fixtures used by the tests of the commands.
+	recordFixtures string
+	replayFixtures string
+
+	// recorder records the Management API requests when --record or --record-fixtures is passed.
+	recorder *sessionRecorder
+
+	// deadlineCancel releases the context of the --deadline flag once the command is over.
+	deadlineCancel context.CancelFunc
+
+	// apiErrors keeps track of the Management API errors, for the json output.
+	apiErrors apiErrorObserver
 
 	Config config.Config
 }
@@ -73,7 +104,7 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 	switch err {
 	case config.ErrTokenMissingRequiredScopes:
 		c.renderer.Warnf("Required scopes have changed. Please log in to re-authorize the CLI.\n")
-		tenant, err = RunLoginAsUser(ctx, c, tenant.GetExtraRequestedScopes(), "")
+		tenant, err = RunLoginAsUser(ctx, c, tenant.GetScopesToRequest(), "", false)
 		if err != nil {
 			return err
 		}
@@ -95,7 +126,7 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 			c.renderer.Warnf("Failed to renew access token: %s", err)
 			c.renderer.Warnf("Please log in to re-authorize the CLI.\n")
 
-			tenant, err = RunLoginAsUser(ctx, c, tenant.GetExtraRequestedScopes(), "")
+			tenant, err = RunLoginAsUser(ctx, c, tenant.GetScopesToRequest(), "", false)
 			if err != nil {
 				return err
 			}
@@ -106,7 +137,7 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 		}
 	}
 
-	api, err := initializeManagementClient(tenant.Domain, tenant.GetAccessToken())
+	api, err := c.newManagementClient(tenant)
 	if err != nil {
 		return err
 	}
@@ -115,8 +146,25 @@ func (c *cli) setupWithAuthentication(ctx context.Context) error {
 	return nil
 }
 
+// newManagementClient sets up a Management API client of the tenant with the standard
+// transports of the CLI, recording the requests, applying the --timeout and keeping track
+// of the errors. The given transports come first, e.g. to observe the rate limits.
+func (c *cli) newManagementClient(
+	tenant config.Tenant,
+	transports ...func(http.RoundTripper) http.RoundTripper,
+) (*management.Management, error) {
+	return initializeManagementClient(
+		tenant.Domain,
+		newTenantAccessTokenSource(tenant, &c.Config),
+		c.readOnly || tenant.ReadOnly,
+		c.recorder,
+		append(transports, c.requestTimeoutTransport, c.apiErrors.transport)...,
+	)
+}
+
 func (c *cli) configureRenderer() {
 	c.renderer.Tenant = c.tenant
+	c.renderer.RevealSecrets = c.reveal
 
 	if c.json {
 		c.renderer.Format = display.OutputFormatJSON
@@ -127,6 +175,14 @@ func (c *cli) configureRenderer() {
 	}
 }
 
+// cancelDeadline cancels the context of the --deadline flag of the last command, if any.
+func (c *cli) cancelDeadline() {
+	if c.deadlineCancel != nil {
+		c.deadlineCancel()
+		c.deadlineCancel = nil
+	}
+}
+
 func canPrompt(cmd *cobra.Command) bool {
 	noInput, err := cmd.Root().Flags().GetBool("no-input")
 	if err != nil {
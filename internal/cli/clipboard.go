@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth/authutil"
+	"github.com/auth0/auth0-cli/internal/clipboard"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// clipboardClearTimeout is how long a copied secret stays on the clipboard.
+const clipboardClearTimeout = 30 * time.Second
+
+var copySecret = Flag{
+	Name:     "Copy",
+	LongForm: "copy",
+	Help: "Copy the secret to the clipboard instead of printing it, to keep it out of the terminal. " +
+		"The clipboard gets cleared after 30 seconds.",
+}
+
+// copySecretToClipboard places the secret on the clipboard, then
+// clears it after a while so that it doesn't linger there.
+func copySecretToClipboard(renderer *display.Renderer, name, secret string) error {
+	if err := clipboard.Write(secret); err != nil {
+		return fmt.Errorf("failed to copy the %s to the clipboard: %w", name, err)
+	}
+
+	renderer.Infof("The %s got copied to the clipboard.", name)
+
+	return ansi.Spinner(
+		fmt.Sprintf("Clearing the clipboard in %s, press Ctrl+C to keep the %s on it", clipboardClearTimeout, name),
+		func() error {
+			time.Sleep(clipboardClearTimeout)
+
+			if err := clipboard.ClearIfUnchanged(secret); err != nil {
+				return fmt.Errorf("failed to clear the clipboard: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+// withoutTokens returns a copy of the token response without
+// the tokens, for when the access token is copied instead.
+func withoutTokens(tokenResponse *authutil.TokenResponse) *authutil.TokenResponse {
+	redacted := *tokenResponse
+
+	redacted.AccessToken = "(copied to the clipboard)"
+	if redacted.IDToken != "" {
+		redacted.IDToken = "(hidden)"
+	}
+	if redacted.RefreshToken != "" {
+		redacted.RefreshToken = "(hidden)"
+	}
+
+	return &redacted
+}
+
+// renderTestToken renders the token response, or copies the access
+// token to the clipboard instead of printing the tokens when asked to.
+func renderTestToken(
+	renderer *display.Renderer,
+	client *management.Client,
+	tokenResponse *authutil.TokenResponse,
+	copyToClipboard bool,
+) error {
+	if !copyToClipboard {
+		renderer.TestToken(client, tokenResponse)
+		return nil
+	}
+
+	renderer.TestToken(client, withoutTokens(tokenResponse))
+
+	return copySecretToClipboard(renderer, "access token", tokenResponse.AccessToken)
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth/authutil"
+)
+
+func TestWithoutTokens(t *testing.T) {
+	tokenResponse := &authutil.TokenResponse{
+		AccessToken:  "access-token",
+		IDToken:      "id-token",
+		RefreshToken: "refresh-token",
+		TokenType:    "Bearer",
+		ExpiresIn:    86400,
+	}
+
+	redacted := withoutTokens(tokenResponse)
+
+	assert.Equal(t, &authutil.TokenResponse{
+		AccessToken:  "(copied to the clipboard)",
+		IDToken:      "(hidden)",
+		RefreshToken: "(hidden)",
+		TokenType:    "Bearer",
+		ExpiresIn:    86400,
+	}, redacted)
+	assert.Equal(t, "access-token", tokenResponse.AccessToken, "the token response is left untouched")
+
+	redacted = withoutTokens(&authutil.TokenResponse{AccessToken: "access-token"})
+	assert.Empty(t, redacted.IDToken)
+	assert.Empty(t, redacted.RefreshToken)
+}
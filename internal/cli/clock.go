@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"crypto/rand"
+	"io"
+	"time"
+)
+
+// now returns the current time, unless the clock of the cli is overridden.
+// Commands computing times, such as expirations or time ranges, rely on it
+// so that their output can be made deterministic, e.g. when replaying fixtures.
+func (c *cli) now() time.Time {
+	if c.clock != nil {
+		return c.clock()
+	}
+
+	return time.Now()
+}
+
+// random returns the source of the random identifiers generated
+// by the commands, unless it's overridden it's crypto/rand.
+func (c *cli) random() io.Reader {
+	if c.randomSource != nil {
+		return c.randomSource
+	}
+
+	return rand.Reader
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCLINow(t *testing.T) {
+	cli := &cli{}
+	assert.WithinDuration(t, time.Now(), cli.now(), time.Second)
+
+	frozen := time.Date(2024, time.May, 1, 14, 0, 0, 0, time.UTC)
+	cli.clock = func() time.Time {
+		return frozen
+	}
+	assert.Equal(t, frozen, cli.now())
+}
+
+func TestCLIRandom(t *testing.T) {
+	cli := &cli{randomSource: bytes.NewReader([]byte{0xff, 0xff, 0xff})}
+
+	state, err := generateState(cli.random(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, "____", state)
+
+	_, err = generateState(cli.random(), 3)
+	assert.Error(t, err, "the source is exhausted")
+}
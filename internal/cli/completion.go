@@ -3,6 +3,7 @@ package cli
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/auth0/auth0-cli/internal/auth0"
 	"github.com/auth0/auth0-cli/internal/iostream"
 )
 
@@ -89,3 +90,80 @@ and source this file from your powershell profile.
 
 	return cmd
 }
+
+// completionFunc completes the arguments or the value of a flag in the shell.
+type completionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// pickerOptionsCompletions completes the first argument with the values of the
+// picker options, described by their labels in the shells supporting it.
+func pickerOptionsCompletions(options pickerOptions) completionFunc {
+	return func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		completions := make([]string, 0, len(options))
+		for _, option := range options {
+			completions = append(completions, option.value+"\t"+option.label)
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// enabledLocalesCompletions completes the locales enabled for the tenant. Nothing gets completed
+// unless already logged in, as the completions are computed in the background of the shell.
+func (c *cli) enabledLocalesCompletions(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	api, err := c.completionAPI()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	tenant, err := api.Tenant.Read(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return tenant.GetEnabledLocales(), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionAPI returns the Management API client of the tenant for the completions.
+// Unlike setupWithAuthentication, it never prompts to log in again nor renews the token.
+func (c *cli) completionAPI() (*auth0.API, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+
+	if err := c.Config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if c.tenant == "" {
+		c.tenant = c.Config.DefaultTenant
+	}
+
+	tenant, err := c.Config.GetTenant(c.tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tenant.CheckAuthenticationStatus(); err != nil {
+		return nil, err
+	}
+
+	api, err := initializeManagementClient(
+		tenant.Domain,
+		newTenantAccessTokenSource(tenant, &c.Config),
+		true,
+		nil,
+		c.requestTimeoutTransport,
+		c.apiErrors.transport,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.api = auth0.NewAPI(api)
+
+	return c.api, nil
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestFlag_RegisterCompletions(t *testing.T) {
+	var format string
+
+	cmd := &cobra.Command{Use: "create", Run: func(*cobra.Command, []string) {}}
+	httpContentFormat.RegisterString(cmd, &format, "")
+	httpContentFormat.RegisterCompletions(cmd, httpContentFormatOptions)
+
+	assert.Equal(t, []string{"JSONLINES", "JSONARRAY", "JSONOBJECT"}, complete(t, cmd, "create", "--format", ""))
+}
+
+func TestPickerOptionsCompletions(t *testing.T) {
+	cmd := &cobra.Command{
+		Use:               "show",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: pickerOptionsCompletions(emailTemplateOptions[:2]),
+		Run:               func(*cobra.Command, []string) {},
+	}
+
+	assert.Equal(t, []string{
+		"verify-link\tVerification Email (using Link)",
+		"verify-code\tVerification Email (using Code)",
+	}, complete(t, cmd, "show", ""))
+	assert.Empty(t, complete(t, cmd, "show", "verify-link", ""))
+}
+
+func TestEnabledLocalesCompletions(t *testing.T) {
+	t.Run("it completes the enabled locales of the tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		tenantAPI := mock.NewMockTenantAPI(ctrl)
+		tenantAPI.EXPECT().
+			Read(gomock.Any()).
+			Return(&management.Tenant{EnabledLocales: &[]string{"en", "fr"}}, nil)
+
+		cli := &cli{api: &auth0.API{Tenant: tenantAPI}}
+
+		completions, directive := cli.enabledLocalesCompletions(&cobra.Command{}, nil, "")
+		assert.Equal(t, []string{"en", "fr"}, completions)
+		assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+
+	t.Run("it completes nothing when the tenant can't be read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		tenantAPI := mock.NewMockTenantAPI(ctrl)
+		tenantAPI.EXPECT().
+			Read(gomock.Any()).
+			Return(nil, errors.New("unauthorized"))
+
+		cli := &cli{api: &auth0.API{Tenant: tenantAPI}}
+
+		completions, directive := cli.enabledLocalesCompletions(&cobra.Command{}, nil, "")
+		assert.Empty(t, completions)
+		assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+}
+
+// complete returns the shell completions of the command invoked with the args.
+func complete(t *testing.T, cmd *cobra.Command, args ...string) []string {
+	t.Helper()
+
+	root := &cobra.Command{Use: "auth0"}
+	root.AddCommand(cmd)
+
+	out := &bytes.Buffer{}
+	root.SetOut(out)
+	root.SetErr(io.Discard)
+	root.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+	require.NoError(t, root.Execute())
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+
+	var completions []string
+	for _, line := range lines[:len(lines)-1] { // The last line is the directive.
+		completions = append(completions, string(line))
+	}
+
+	return completions
+}
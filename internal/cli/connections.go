@@ -0,0 +1,333 @@
+package cli
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+
+	jobStatusCompleted = "completed"
+	jobStatusFailed    = "failed"
+)
+
+// jobPollInterval is how often the status of the jobs gets checked.
+var jobPollInterval = 2 * time.Second
+
+var (
+	connectionNameArg = Argument{
+		Name: "Connection",
+		Help: "Name or ID of the connection.",
+	}
+
+	exportFormat = Flag{
+		Name:      "Format",
+		LongForm:  "format",
+		ShortForm: "f",
+		Help:      "Format of the exported users. Options are \"csv\" or \"json\", the latter exporting a JSON object per line.",
+	}
+
+	exportFields = Flag{
+		Name:     "Fields",
+		LongForm: "fields",
+		Help: "Comma-separated list of the user fields to export, such as \"user_id,email,app_metadata.plan\". " +
+			"A set of predefined fields gets exported if omitted.",
+	}
+
+	exportLimit = Flag{
+		Name:      "Limit",
+		LongForm:  "limit",
+		ShortForm: "l",
+		Help:      "Maximum number of users to export. All the users of the connection get exported if omitted.",
+	}
+
+	exportOutputFile = Flag{
+		Name:     "Output File",
+		LongForm: "output-file",
+		Help:     "File to write the exported users to. The users are printed if omitted.",
+	}
+)
+
+func connectionsCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connections",
+		Short: "Manage resources for connections",
+		Long: "Connections are sources of users. They are categorized into Database, Social, Enterprise " +
+			"and Passwordless connections.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(connectionAttributesCmd(cli))
+	cmd.AddCommand(createConnectionCmd(cli))
+	cmd.AddCommand(exportConnectionUsersCmd(cli))
+
+	return cmd
+}
+
+func exportConnectionUsersCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Connection string
+		Format     string
+		Fields     []string
+		Limit      int
+		Output     artifactOutput
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export-users",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Export the users of a connection",
+		Long: "Export the users of a single connection, to migrate them to another connection " +
+			"or to off-board an identity provider.\n\n" +
+			"This issues an export users job scoped to the connection, waits for it to complete, " +
+			"then downloads the exported users.\n\n" +
+			"The users can then be imported to a database connection with `auth0 users import`.\n\n" +
+			"When `--out` is a directory, the exported users are written to a file named after the tenant, " +
+			"the connection and the time of the export within it.",
+		Example: `  auth0 connections export-users
+  auth0 connections export-users <connection>
+  auth0 connections export-users <connection> --format csv
+  auth0 connections export-users <connection> --format csv --fields "user_id,email,name"
+  auth0 connections export-users <connection> --format json --out users.json
+  auth0 connections export-users <connection> --format json --out users.json --force-overwrite
+  auth0 connections export-users <connection> -f csv -l 100 -o users.csv
+  auth0 connections export-users <connection> -f csv -o ./exports/`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := connectionNameArg.Pick(cmd, &inputs.Connection, cli.connectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Connection = args[0]
+			}
+
+			if inputs.Format != exportFormatCSV && inputs.Format != exportFormatJSON {
+				return fmt.Errorf("invalid format %q, possible values: %s, %s", inputs.Format, exportFormatCSV, exportFormatJSON)
+			}
+
+			connection, err := readConnection(cmd.Context(), cli.api, inputs.Connection)
+			if err != nil {
+				return err
+			}
+
+			var outputFile string
+			if inputs.Output.Out != "" {
+				outputFile = inputs.Output.file(cli, connection.GetName()+"-users", "."+inputs.Format)
+				if err := inputs.Output.checkOverwrite(cmd, outputFile); err != nil {
+					return err
+				}
+			}
+
+			job := &management.Job{
+				ConnectionID: connection.ID,
+				Format:       &inputs.Format,
+				Fields:       exportJobFields(inputs.Fields),
+			}
+			if inputs.Limit > 0 {
+				job.Limit = &inputs.Limit
+			}
+
+			if err := ansi.Spinner("Exporting users", func() error {
+				if err := cli.api.Jobs.ExportUsers(cmd.Context(), job); err != nil {
+					return fmt.Errorf("failed to start the export users job: %w", err)
+				}
+
+				if job, err = waitForJob(cmd.Context(), cli.api, job.GetID()); err != nil {
+					return err
+				}
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			output := cli.renderer.ResultWriter
+			if outputFile != "" {
+				file, err := createArtifactFile(outputFile)
+				if err != nil {
+					return fmt.Errorf("failed to create the output file %q: %w", outputFile, err)
+				}
+				defer func() {
+					_ = file.Close()
+				}()
+
+				output = file
+			}
+
+			if err := downloadJobExport(cmd.Context(), job.GetLocation(), output); err != nil {
+				return err
+			}
+
+			if outputFile != "" {
+				cli.renderer.Infof(
+					"Users of the connection %s successfully exported to %s",
+					ansi.Bold(connection.GetName()),
+					ansi.Bold(outputFile),
+				)
+			}
+
+			return nil
+		},
+	}
+
+	exportFormat.RegisterString(cmd, &inputs.Format, exportFormatCSV)
+	exportFields.RegisterStringSlice(cmd, &inputs.Fields, nil)
+	exportLimit.RegisterInt(cmd, &inputs.Limit, 0)
+	registerArtifactOutput(
+		cmd,
+		&inputs.Output,
+		"File or directory to write the exported users to. The users are printed if omitted.",
+		"",
+	)
+	exportOutputFile.RegisterString(cmd, &inputs.Output.Out, "")
+	deprecateFlag(cmd, exportOutputFile.LongForm, artifactOut.LongForm)
+	cmd.MarkFlagsMutuallyExclusive(artifactOut.LongForm, exportOutputFile.LongForm)
+
+	return cmd
+}
+
+// readConnection reads the connection by its ID, or by its name otherwise.
+func readConnection(ctx context.Context, api *auth0.API, connection string) (*management.Connection, error) {
+	if strings.HasPrefix(connection, "con_") {
+		c, err := api.Connection.Read(ctx, connection)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read connection with ID %q: %w", connection, err)
+		}
+		return c, nil
+	}
+
+	c, err := api.Connection.ReadByName(ctx, connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read connection with name %q: %w", connection, err)
+	}
+
+	return c, nil
+}
+
+func exportJobFields(fields []string) []map[string]interface{} {
+	var jobFields []map[string]interface{}
+	for _, field := range fields {
+		if field = strings.TrimSpace(field); field != "" {
+			jobFields = append(jobFields, map[string]interface{}{"name": field})
+		}
+	}
+
+	return jobFields
+}
+
+// waitForJob polls the job until it's done, and returns it once completed.
+func waitForJob(ctx context.Context, api *auth0.API, id string) (*management.Job, error) {
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := api.Jobs.Read(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read job with ID %q: %w", id, err)
+		}
+
+		switch job.GetStatus() {
+		case jobStatusCompleted:
+			return job, nil
+		case jobStatusFailed:
+			return nil, fmt.Errorf("job with ID %q failed, run `auth0 api jobs/%s/errors` to see why", id, id)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// downloadJobExport downloads the gzipped file the export job produced, and writes it uncompressed.
+func downloadJobExport(ctx context.Context, location string, output io.Writer) error {
+	if location == "" {
+		return errors.New("the export users job completed without a file to download")
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to download the exported users: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download the exported users: %s", response.Status)
+	}
+
+	reader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to decompress the exported users: %w", err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	if _, err := io.Copy(output, reader); err != nil {
+		return fmt.Errorf("failed to write the exported users: %w", err)
+	}
+
+	return nil
+}
+
+func (c *cli) connectionPickerOptions(ctx context.Context) (pickerOptions, error) {
+	list, err := c.api.Connection.List(ctx, management.PerPage(100))
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, connection := range list.Connections {
+		label := fmt.Sprintf("%s %s", connection.GetName(), ansi.Faint("("+connection.GetStrategy()+")"))
+
+		opts = append(opts, pickerOption{value: connection.GetName(), label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New("there are currently no connections to choose from")
+	}
+
+	return opts, nil
+}
+
+// formatConnectionSettingsPath returns the path of the settings of the connection
+// within the dashboard, which groups the connections by the kind of their strategy.
+func formatConnectionSettingsPath(strategy, id string) string {
+	if len(id) == 0 {
+		return ""
+	}
+
+	switch strategy {
+	case "auth0":
+		return fmt.Sprintf("connections/database/%s/settings", id)
+	case "email", "sms":
+		return "connections/passwordless"
+	case "ad", "adfs", "google-apps", "ip", "office365", "oidc", "okta", "pingfederate", "samlp", "sharepoint", "waad":
+		return fmt.Sprintf("connections/enterprise/%s/%s/settings", strategy, id)
+	default:
+		return fmt.Sprintf("connections/social/%s/settings", id)
+	}
+}
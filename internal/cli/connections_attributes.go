@@ -0,0 +1,363 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+var (
+	// connectionSyncModes are the values of set_user_root_attributes, telling when the root
+	// attributes of the user profile get updated from the identity provider.
+	connectionSyncModes = []string{"on_each_login", "on_first_login", "never_on_login"}
+
+	// connectionMappingModes are the ways the OIDC connections map the claims of the identity provider.
+	connectionMappingModes = []string{"basic_profile", "use_map", "bind_all"}
+)
+
+var (
+	connectionSync = Flag{
+		Name:     "Sync User Profile",
+		LongForm: "sync",
+		Help: "When to update the root attributes of the user profile, such as the name or the picture, from " +
+			"the identity provider: on_each_login, on_first_login or never_on_login.",
+	}
+
+	connectionNonPersistent = Flag{
+		Name:     "Non-persistent Attributes",
+		LongForm: "non-persistent",
+		Help: "Comma-separated list of the user attributes not to store in the user profile, such as " +
+			"\"ethnicity,gender\". It replaces the current list, which gets cleared with an empty value.",
+	}
+
+	connectionMap = Flag{
+		Name:     "Map",
+		LongForm: "map",
+		Help: "Attribute mappings to add or replace, as <user attribute>=<identity provider attribute>, e.g. " +
+			"email=http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress for SAML connections, or " +
+			"email=${context.tokenset.id_token.email} for OIDC and Okta connections.",
+	}
+
+	connectionUnmap = Flag{
+		Name:     "Unmap",
+		LongForm: "unmap",
+		Help:     "Comma-separated list of the user attributes to remove the mapping of.",
+	}
+
+	connectionMappingMode = Flag{
+		Name:     "Mapping Mode",
+		LongForm: "mapping-mode",
+		Help: "How the claims of the identity provider are mapped, for OIDC and Okta connections: basic_profile, " +
+			"use_map or bind_all. New attribute maps use basic_profile unless set.",
+	}
+)
+
+// connectionAttributesChanges are the changes to apply to the options of a connection.
+type connectionAttributesChanges struct {
+	Sync          string
+	NonPersistent []string
+
+	// SetNonPersistent tells whether to replace the non-persistent attributes,
+	// as an empty list clears them.
+	SetNonPersistent bool
+
+	Map         map[string]string
+	Unmap       []string
+	MappingMode string
+}
+
+func connectionAttributesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attributes",
+		Short: "Manage the user attributes of connections",
+		Long: "Manage how the attributes of the identity provider of a connection end up in the profile of its " +
+			"users: when the profile gets synced, which attributes aren't stored and how they're mapped.\n\n" +
+			"The attributes can be mapped for SAML, OIDC and Okta connections.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(showConnectionAttributesCmd(cli))
+	cmd.AddCommand(updateConnectionAttributesCmd(cli))
+
+	return cmd
+}
+
+func showConnectionAttributesCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Connection string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show the user attributes settings of a connection",
+		Long: "Show when the profile of the users of a connection gets synced from the identity provider, " +
+			"which attributes aren't stored in it and how they're mapped.",
+		Example: `  auth0 connections attributes show
+  auth0 connections attributes show <connection>
+  auth0 connections attributes show <connection> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := connectionNameArg.Pick(cmd, &inputs.Connection, cli.connectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Connection = args[0]
+			}
+
+			var (
+				connection *management.Connection
+				options    map[string]interface{}
+			)
+			if err := ansi.Waiting(func() (err error) {
+				connection, options, err = readConnectionOptions(cmd.Context(), cli.api, inputs.Connection)
+				return err
+			}); err != nil {
+				return err
+			}
+
+			cli.renderer.ConnectionAttributesShow(connectionAttributes(connection, options))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func updateConnectionAttributesCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Connection    string
+		Sync          string
+		NonPersistent []string
+		Map           map[string]string
+		Unmap         []string
+		MappingMode   string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update the user attributes settings of a connection",
+		Long: "Update when the profile of the users of a connection gets synced from the identity provider, " +
+			"which attributes aren't stored in it and how they're mapped.\n\n" +
+			"Only the given settings change. The other options of the connection are kept as they are.",
+		Example: `  auth0 connections attributes update <connection> --sync on_first_login
+  auth0 connections attributes update <connection> --non-persistent "ethnicity,gender"
+  auth0 connections attributes update <connection> --non-persistent ""
+  auth0 connections attributes update <connection> --map email=http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress
+  auth0 connections attributes update <connection> --map 'email=${context.tokenset.id_token.email}' --mapping-mode use_map
+  auth0 connections attributes update <connection> --unmap given_name,family_name --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := connectionNameArg.Pick(cmd, &inputs.Connection, cli.connectionPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Connection = args[0]
+			}
+
+			changes := connectionAttributesChanges{
+				Sync:             inputs.Sync,
+				NonPersistent:    inputs.NonPersistent,
+				SetNonPersistent: cmd.Flags().Changed(connectionNonPersistent.LongForm),
+				Map:              inputs.Map,
+				Unmap:            inputs.Unmap,
+				MappingMode:      inputs.MappingMode,
+			}
+			if changes.isEmpty() {
+				return fmt.Errorf(
+					"nothing to update, set at least one of --%s, --%s, --%s, --%s or --%s",
+					connectionSync.LongForm,
+					connectionNonPersistent.LongForm,
+					connectionMap.LongForm,
+					connectionUnmap.LongForm,
+					connectionMappingMode.LongForm,
+				)
+			}
+
+			var (
+				connection *management.Connection
+				options    map[string]interface{}
+			)
+			if err := ansi.Waiting(func() (err error) {
+				connection, options, err = readConnectionOptions(cmd.Context(), cli.api, inputs.Connection)
+				return err
+			}); err != nil {
+				return err
+			}
+
+			if err := applyConnectionAttributesChanges(connection.GetStrategy(), options, changes); err != nil {
+				return err
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.ConnectionOptions.Update(cmd.Context(), connection.GetID(), options)
+			}); err != nil {
+				return fmt.Errorf("failed to update the options of connection %q: %w", connection.GetName(), err)
+			}
+
+			cli.renderer.ConnectionAttributesUpdate(connectionAttributes(connection, options))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	connectionSync.RegisterString(cmd, &inputs.Sync, "")
+	connectionNonPersistent.RegisterStringSlice(cmd, &inputs.NonPersistent, nil)
+	connectionMap.RegisterStringMap(cmd, &inputs.Map, nil)
+	connectionUnmap.RegisterStringSlice(cmd, &inputs.Unmap, nil)
+	connectionMappingMode.RegisterString(cmd, &inputs.MappingMode, "")
+
+	return cmd
+}
+
+// readConnectionOptions reads the connection by its ID or name, along with its raw options.
+func readConnectionOptions(
+	ctx context.Context,
+	api *auth0.API,
+	connection string,
+) (*management.Connection, map[string]interface{}, error) {
+	c, err := readConnection(ctx, api, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options, err := api.ConnectionOptions.Read(ctx, c.GetID())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the options of connection %q: %w", c.GetName(), err)
+	}
+
+	return c, options, nil
+}
+
+func (c connectionAttributesChanges) isEmpty() bool {
+	return c.Sync == "" && !c.SetNonPersistent && len(c.Map) == 0 && len(c.Unmap) == 0 && c.MappingMode == ""
+}
+
+// applyConnectionAttributesChanges applies the changes to the raw options of a connection of the strategy.
+func applyConnectionAttributesChanges(strategy string, options map[string]interface{}, changes connectionAttributesChanges) error {
+	if changes.Sync != "" {
+		if !containsStr(connectionSyncModes, changes.Sync) {
+			return fmt.Errorf(
+				"invalid sync %q, possible values: %s",
+				changes.Sync,
+				strings.Join(connectionSyncModes, ", "),
+			)
+		}
+
+		options["set_user_root_attributes"] = changes.Sync
+	}
+
+	if changes.SetNonPersistent {
+		nonPersistent := make([]interface{}, 0, len(changes.NonPersistent))
+		for _, attribute := range changes.NonPersistent {
+			if attribute = strings.TrimSpace(attribute); attribute != "" {
+				nonPersistent = append(nonPersistent, attribute)
+			}
+		}
+
+		options["non_persistent_attrs"] = nonPersistent
+	}
+
+	if len(changes.Map) == 0 && len(changes.Unmap) == 0 && changes.MappingMode == "" {
+		return nil
+	}
+
+	switch strategy {
+	case management.ConnectionStrategySAML:
+		if changes.MappingMode != "" {
+			return fmt.Errorf("the mapping mode is only supported by the OIDC and Okta connections, not %s ones", strategy)
+		}
+
+		options["fieldsMap"] = applyAttributeMapChanges(mapOption(options, "fieldsMap"), changes)
+	case management.ConnectionStrategyOIDC, management.ConnectionStrategyOkta:
+		attributeMap := mapOption(options, "attribute_map")
+		if changes.MappingMode != "" {
+			if !containsStr(connectionMappingModes, changes.MappingMode) {
+				return fmt.Errorf(
+					"invalid mapping mode %q, possible values: %s",
+					changes.MappingMode,
+					strings.Join(connectionMappingModes, ", "),
+				)
+			}
+
+			attributeMap["mapping_mode"] = changes.MappingMode
+		}
+		if _, ok := attributeMap["mapping_mode"]; !ok {
+			attributeMap["mapping_mode"] = "basic_profile"
+		}
+
+		attributeMap["attributes"] = applyAttributeMapChanges(mapOption(attributeMap, "attributes"), changes)
+		options["attribute_map"] = attributeMap
+	default:
+		return fmt.Errorf("the attributes can only be mapped for SAML, OIDC and Okta connections, not %s ones", strategy)
+	}
+
+	return nil
+}
+
+// applyAttributeMapChanges maps and unmaps the attributes, the unmapped ones being removed last.
+func applyAttributeMapChanges(attributeMap map[string]interface{}, changes connectionAttributesChanges) map[string]interface{} {
+	for attribute, value := range changes.Map {
+		attributeMap[attribute] = value
+	}
+
+	for _, attribute := range changes.Unmap {
+		delete(attributeMap, strings.TrimSpace(attribute))
+	}
+
+	return attributeMap
+}
+
+// mapOption returns the object option of the options, or an empty one when missing.
+func mapOption(options map[string]interface{}, name string) map[string]interface{} {
+	if option, ok := options[name].(map[string]interface{}); ok {
+		return option
+	}
+
+	return make(map[string]interface{})
+}
+
+// connectionAttributes gathers the user attributes settings of the connection from its raw options.
+func connectionAttributes(connection *management.Connection, options map[string]interface{}) *display.ConnectionAttributes {
+	attributes := &display.ConnectionAttributes{
+		ID:       connection.GetID(),
+		Name:     connection.GetName(),
+		Strategy: connection.GetStrategy(),
+	}
+
+	attributes.SyncUserProfile, _ = options["set_user_root_attributes"].(string)
+
+	if nonPersistent, ok := options["non_persistent_attrs"].([]interface{}); ok {
+		for _, attribute := range nonPersistent {
+			if attribute, ok := attribute.(string); ok {
+				attributes.NonPersistentAttributes = append(attributes.NonPersistentAttributes, attribute)
+			}
+		}
+		sort.Strings(attributes.NonPersistentAttributes)
+	}
+
+	switch connection.GetStrategy() {
+	case management.ConnectionStrategySAML:
+		attributes.AttributeMap, _ = options["fieldsMap"].(map[string]interface{})
+	case management.ConnectionStrategyOIDC, management.ConnectionStrategyOkta:
+		if attributeMap, ok := options["attribute_map"].(map[string]interface{}); ok {
+			attributes.MappingMode, _ = attributeMap["mapping_mode"].(string)
+			attributes.AttributeMap, _ = attributeMap["attributes"].(map[string]interface{})
+		}
+	}
+
+	return attributes
+}
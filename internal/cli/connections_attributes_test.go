@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestApplyConnectionAttributesChanges(t *testing.T) {
+	t.Run("it sets the sync and the non-persistent attributes", func(t *testing.T) {
+		options := map[string]interface{}{"domain": "example.com"}
+
+		err := applyConnectionAttributesChanges("waad", options, connectionAttributesChanges{
+			Sync:             "on_first_login",
+			NonPersistent:    []string{"ethnicity", " gender ", ""},
+			SetNonPersistent: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"domain":                   "example.com",
+			"set_user_root_attributes": "on_first_login",
+			"non_persistent_attrs":     []interface{}{"ethnicity", "gender"},
+		}, options)
+	})
+
+	t.Run("it clears the non-persistent attributes", func(t *testing.T) {
+		options := map[string]interface{}{"non_persistent_attrs": []interface{}{"gender"}}
+
+		err := applyConnectionAttributesChanges("samlp", options, connectionAttributesChanges{SetNonPersistent: true})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{}, options["non_persistent_attrs"])
+	})
+
+	t.Run("it maps and unmaps the attributes of SAML connections", func(t *testing.T) {
+		options := map[string]interface{}{
+			"fieldsMap": map[string]interface{}{
+				"given_name":  "firstName",
+				"family_name": []interface{}{"lastName", "surname"},
+			},
+		}
+
+		err := applyConnectionAttributesChanges("samlp", options, connectionAttributesChanges{
+			Map:   map[string]string{"email": "mail", "given_name": "givenName"},
+			Unmap: []string{"family_name"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"email": "mail", "given_name": "givenName"}, options["fieldsMap"])
+	})
+
+	t.Run("it maps the attributes of OIDC connections with the basic profile by default", func(t *testing.T) {
+		options := map[string]interface{}{}
+
+		err := applyConnectionAttributesChanges("oidc", options, connectionAttributesChanges{
+			Map: map[string]string{"email": "${context.tokenset.id_token.email}"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"mapping_mode": "basic_profile",
+			"attributes":   map[string]interface{}{"email": "${context.tokenset.id_token.email}"},
+		}, options["attribute_map"])
+	})
+
+	t.Run("it keeps the settings of the attribute map of OIDC connections", func(t *testing.T) {
+		options := map[string]interface{}{
+			"attribute_map": map[string]interface{}{
+				"mapping_mode":   "bind_all",
+				"userinfo_scope": "openid email",
+			},
+		}
+
+		err := applyConnectionAttributesChanges("okta", options, connectionAttributesChanges{MappingMode: "use_map"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"mapping_mode":   "use_map",
+			"userinfo_scope": "openid email",
+			"attributes":     map[string]interface{}{},
+		}, options["attribute_map"])
+	})
+
+	var testCases = []struct {
+		name          string
+		strategy      string
+		changes       connectionAttributesChanges
+		expectedError string
+	}{
+		{
+			name:          "it fails with an invalid sync",
+			strategy:      "samlp",
+			changes:       connectionAttributesChanges{Sync: "always"},
+			expectedError: `invalid sync "always", possible values: on_each_login, on_first_login, never_on_login`,
+		},
+		{
+			name:          "it fails with an invalid mapping mode",
+			strategy:      "oidc",
+			changes:       connectionAttributesChanges{MappingMode: "all"},
+			expectedError: `invalid mapping mode "all", possible values: basic_profile, use_map, bind_all`,
+		},
+		{
+			name:          "it fails to set the mapping mode of SAML connections",
+			strategy:      "samlp",
+			changes:       connectionAttributesChanges{MappingMode: "use_map"},
+			expectedError: "the mapping mode is only supported by the OIDC and Okta connections, not samlp ones",
+		},
+		{
+			name:          "it fails to map the attributes of other connections",
+			strategy:      "google-oauth2",
+			changes:       connectionAttributesChanges{Map: map[string]string{"email": "mail"}},
+			expectedError: "the attributes can only be mapped for SAML, OIDC and Okta connections, not google-oauth2 ones",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := applyConnectionAttributesChanges(testCase.strategy, map[string]interface{}{}, testCase.changes)
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestConnectionAttributes(t *testing.T) {
+	connection := &management.Connection{
+		ID:       auth0.String("con_123"),
+		Name:     auth0.String("okta-sso"),
+		Strategy: auth0.String("okta"),
+	}
+	options := map[string]interface{}{
+		"set_user_root_attributes": "never_on_login",
+		"non_persistent_attrs":     []interface{}{"gender", "ethnicity"},
+		"attribute_map": map[string]interface{}{
+			"mapping_mode": "use_map",
+			"attributes":   map[string]interface{}{"email": "${context.tokenset.id_token.email}"},
+		},
+	}
+
+	assert.Equal(t, &display.ConnectionAttributes{
+		ID:                      "con_123",
+		Name:                    "okta-sso",
+		Strategy:                "okta",
+		SyncUserProfile:         "never_on_login",
+		NonPersistentAttributes: []string{"ethnicity", "gender"},
+		MappingMode:             "use_map",
+		AttributeMap:            map[string]interface{}{"email": "${context.tokenset.id_token.email}"},
+	}, connectionAttributes(connection, options))
+}
+
+func TestUpdateConnectionAttributesCmd(t *testing.T) {
+	t.Run("it updates the options of the connection keeping the other ones", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		connectionAPI := mock.NewMockConnectionAPI(ctrl)
+		connectionAPI.EXPECT().
+			ReadByName(gomock.Any(), "saml-sso").
+			Return(&management.Connection{
+				ID:       auth0.String("con_123"),
+				Name:     auth0.String("saml-sso"),
+				Strategy: auth0.String("samlp"),
+			}, nil)
+
+		connectionOptionsAPI := mock.NewMockConnectionOptionsAPI(ctrl)
+		connectionOptionsAPI.EXPECT().
+			Read(gomock.Any(), "con_123").
+			Return(map[string]interface{}{"signInEndpoint": "https://idp.example.com/sso"}, nil)
+		connectionOptionsAPI.EXPECT().
+			Update(gomock.Any(), "con_123", map[string]interface{}{
+				"signInEndpoint":           "https://idp.example.com/sso",
+				"set_user_root_attributes": "on_first_login",
+				"fieldsMap":                map[string]interface{}{"email": "mail"},
+			}).
+			Return(nil)
+
+		result := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: result},
+			api:      &auth0.API{Connection: connectionAPI, ConnectionOptions: connectionOptionsAPI},
+			noInput:  true,
+		}
+
+		cmd := updateConnectionAttributesCmd(cli)
+		cmd.SetArgs([]string{"saml-sso", "--sync", "on_first_login", "--map", "email=mail"})
+		cmd.SetOut(io.Discard)
+
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, result.String(), "on_first_login")
+		assert.Contains(t, result.String(), "email=mail")
+	})
+
+	t.Run("it fails without any change", func(t *testing.T) {
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			noInput:  true,
+		}
+
+		cmd := updateConnectionAttributesCmd(cli)
+		cmd.SetArgs([]string{"saml-sso"})
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+
+		err := cmd.Execute()
+		assert.EqualError(t, err, "nothing to update, set at least one of --sync, --non-persistent, --map, --unmap or --mapping-mode")
+	})
+}
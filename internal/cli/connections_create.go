@@ -0,0 +1,298 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+var (
+	connectionName = Flag{
+		Name:      "Name",
+		LongForm:  "name",
+		ShortForm: "n",
+		Help:      "Name of the connection. Defaults to the strategy of the connection.",
+	}
+
+	connectionClientID = Flag{
+		Name:       "Client ID",
+		LongForm:   "client-id",
+		Help:       "Client ID of the application registered with the provider.",
+		IsRequired: true,
+	}
+
+	connectionClientSecret = Flag{
+		Name:       "Client Secret",
+		LongForm:   "client-secret",
+		Help:       "Client secret of the application registered with the provider.",
+		IsRequired: true,
+	}
+
+	connectionScopes = Flag{
+		Name:         "Scopes",
+		LongForm:     "scopes",
+		ShortForm:    "s",
+		Help:         "Comma-separated list of the scopes to request from the provider.",
+		AlwaysPrompt: true,
+	}
+
+	connectionAppleTeamID = Flag{
+		Name:       "Team ID",
+		LongForm:   "team-id",
+		Help:       "ID of the Apple developer team the Services ID belongs to.",
+		IsRequired: true,
+	}
+
+	connectionAppleKeyID = Flag{
+		Name:       "Key ID",
+		LongForm:   "key-id",
+		Help:       "ID of the Sign in with Apple key.",
+		IsRequired: true,
+	}
+)
+
+// socialConnectionOptions are the options shared by the social connections, whose
+// scopes are toggled through the fields of the options rather than set as a list.
+type socialConnectionOptions interface {
+	Scopes() []string
+	SetScopes(enable bool, scopes ...string)
+}
+
+type socialConnectionInputs struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	TeamID       string
+	KeyID        string
+}
+
+// socialConnectionPreset describes how to create the connection to a social provider.
+type socialConnectionPreset struct {
+	use           string
+	provider      string
+	strategy      string
+	clientIDHelp  string
+	secretHelp    string
+	defaultScopes []string
+	newOptions    func(inputs *socialConnectionInputs) socialConnectionOptions
+
+	// signingKey tells whether the provider also needs the IDs of the team and key signing the secret.
+	signingKey bool
+}
+
+var socialConnectionPresets = []socialConnectionPreset{
+	{
+		use:           "google",
+		provider:      "Google",
+		strategy:      management.ConnectionStrategyGoogleOAuth2,
+		defaultScopes: []string{"email", "profile"},
+		newOptions: func(inputs *socialConnectionInputs) socialConnectionOptions {
+			return &management.ConnectionOptionsGoogleOAuth2{
+				ClientID:     &inputs.ClientID,
+				ClientSecret: &inputs.ClientSecret,
+			}
+		},
+	},
+	{
+		use:           "github",
+		provider:      "GitHub",
+		strategy:      management.ConnectionStrategyGitHub,
+		defaultScopes: []string{"email", "read_user"},
+		newOptions: func(inputs *socialConnectionInputs) socialConnectionOptions {
+			return &management.ConnectionOptionsGitHub{
+				ClientID:     &inputs.ClientID,
+				ClientSecret: &inputs.ClientSecret,
+			}
+		},
+	},
+	{
+		use:           "apple",
+		provider:      "Apple",
+		strategy:      management.ConnectionStrategyApple,
+		clientIDHelp:  "Services ID registered with Apple.",
+		secretHelp:    "Contents of the private key of the Sign in with Apple key, as downloaded from Apple.",
+		defaultScopes: []string{"email", "name"},
+		newOptions: func(inputs *socialConnectionInputs) socialConnectionOptions {
+			return &management.ConnectionOptionsApple{
+				ClientID:     &inputs.ClientID,
+				ClientSecret: &inputs.ClientSecret,
+				TeamID:       &inputs.TeamID,
+				KeyID:        &inputs.KeyID,
+			}
+		},
+		signingKey: true,
+	},
+	{
+		use:           "facebook",
+		provider:      "Facebook",
+		strategy:      management.ConnectionStrategyFacebook,
+		clientIDHelp:  "App ID of the application registered with Facebook.",
+		secretHelp:    "App secret of the application registered with Facebook.",
+		defaultScopes: []string{"email", "public_profile"},
+		newOptions: func(inputs *socialConnectionInputs) socialConnectionOptions {
+			return &management.ConnectionOptionsFacebook{
+				ClientID:     &inputs.ClientID,
+				ClientSecret: &inputs.ClientSecret,
+			}
+		},
+	},
+}
+
+func createConnectionCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new connection",
+		Long: "Create a new connection from a preset, which only asks for the credentials " +
+			"and scopes of the provider instead of the whole options of the connection.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+
+	for _, preset := range socialConnectionPresets {
+		cmd.AddCommand(createSocialConnectionCmd(cli, preset))
+	}
+
+	return cmd
+}
+
+func createSocialConnectionCmd(cli *cli, preset socialConnectionPreset) *cobra.Command {
+	var inputs socialConnectionInputs
+
+	name := connectionName
+	name.Help = fmt.Sprintf("Name of the connection. Defaults to '%s'.", preset.strategy)
+
+	clientID := connectionClientID
+	if preset.clientIDHelp != "" {
+		clientID.Help = preset.clientIDHelp
+	}
+
+	clientSecret := connectionClientSecret
+	if preset.secretHelp != "" {
+		clientSecret.Help = preset.secretHelp
+	}
+
+	example := fmt.Sprintf(`  auth0 connections create %[1]s
+  auth0 connections create %[1]s --client-id <client-id> --client-secret <client-secret>
+  auth0 connections create %[1]s --name <name> --client-id <client-id> --client-secret <client-secret> --scopes %[2]s --json`,
+		preset.use,
+		strings.Join(preset.defaultScopes, ","),
+	)
+	if preset.signingKey {
+		example = fmt.Sprintf(`  auth0 connections create %[1]s
+  auth0 connections create %[1]s --client-id <services-id> --team-id <team-id> --key-id <key-id> --client-secret "$(cat key.p8)"
+  auth0 connections create %[1]s -n <name> --client-id <services-id> --team-id <team-id> --key-id <key-id> --client-secret "$(cat key.p8)" --json`,
+			preset.use,
+		)
+	}
+
+	cmd := &cobra.Command{
+		Use:   preset.use,
+		Args:  cobra.NoArgs,
+		Short: fmt.Sprintf("Create a connection to %s", preset.provider),
+		Long: fmt.Sprintf("Create a connection letting users log in with their %s account.\n\n", preset.provider) +
+			fmt.Sprintf("The scopes default to %s. ", strings.Join(preset.defaultScopes, ", ")) +
+			"To enable the connection for applications, use the dashboard or the Management API once created.",
+		Example: example,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := name.Ask(cmd, &inputs.Name, &preset.strategy); err != nil {
+				return err
+			}
+			if inputs.Name == "" {
+				inputs.Name = preset.strategy
+			}
+
+			if err := clientID.Ask(cmd, &inputs.ClientID, nil); err != nil {
+				return err
+			}
+
+			if err := clientSecret.AskPassword(cmd, &inputs.ClientSecret); err != nil {
+				return err
+			}
+
+			if preset.signingKey {
+				if err := connectionAppleTeamID.Ask(cmd, &inputs.TeamID, nil); err != nil {
+					return err
+				}
+
+				if err := connectionAppleKeyID.Ask(cmd, &inputs.KeyID, nil); err != nil {
+					return err
+				}
+			}
+
+			defaultScopes := strings.Join(preset.defaultScopes, ",")
+			if err := connectionScopes.AskMany(cmd, &inputs.Scopes, &defaultScopes); err != nil {
+				return err
+			}
+			if len(inputs.Scopes) == 0 {
+				inputs.Scopes = preset.defaultScopes
+			}
+
+			options, err := socialConnectionPresetOptions(preset, &inputs)
+			if err != nil {
+				return err
+			}
+
+			connection := &management.Connection{
+				Name:     &inputs.Name,
+				Strategy: &preset.strategy,
+				Options:  options,
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Connection.Create(cmd.Context(), connection)
+			}); err != nil {
+				return fmt.Errorf("failed to create %s connection %q: %w", preset.provider, inputs.Name, err)
+			}
+
+			cli.renderer.ConnectionCreate(connection)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	name.RegisterString(cmd, &inputs.Name, "")
+	clientID.RegisterString(cmd, &inputs.ClientID, "")
+	clientSecret.RegisterString(cmd, &inputs.ClientSecret, "")
+	connectionScopes.RegisterStringSlice(cmd, &inputs.Scopes, nil)
+	if preset.signingKey {
+		connectionAppleTeamID.RegisterString(cmd, &inputs.TeamID, "")
+		connectionAppleKeyID.RegisterString(cmd, &inputs.KeyID, "")
+	}
+
+	return cmd
+}
+
+// socialConnectionPresetOptions returns the options of the connection with the requested scopes
+// enabled. The scopes the provider doesn't support are rejected, as they'd be silently dropped.
+func socialConnectionPresetOptions(preset socialConnectionPreset, inputs *socialConnectionInputs) (socialConnectionOptions, error) {
+	options := preset.newOptions(inputs)
+	options.SetScopes(true, inputs.Scopes...)
+
+	enabled := make(map[string]bool)
+	for _, scope := range options.Scopes() {
+		enabled[scope] = true
+	}
+
+	var unsupported []string
+	for _, scope := range inputs.Scopes {
+		if !enabled[scope] {
+			unsupported = append(unsupported, scope)
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return nil, fmt.Errorf(
+			"the %s connection doesn't support the scopes: %s",
+			preset.provider,
+			strings.Join(unsupported, ", "),
+		)
+	}
+
+	return options, nil
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestCreateSocialConnectionCmd(t *testing.T) {
+	t.Run("it creates the connection with the requested scopes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var created *management.Connection
+		connectionAPI := mock.NewMockConnectionAPI(ctrl)
+		connectionAPI.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, connection *management.Connection, _ ...management.RequestOption) error {
+				created = connection
+				connection.ID = auth0.String("con_123")
+				return nil
+			})
+
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: &bytes.Buffer{}},
+			api:      &auth0.API{Connection: connectionAPI},
+			noInput:  true,
+		}
+
+		cmd := createConnectionCmd(cli)
+		cmd.SetArgs([]string{"github", "--client-id", "abc", "--client-secret", "xyz", "--scopes", "email,read_org"})
+		cmd.SetOut(io.Discard)
+
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "github", created.GetName())
+		assert.Equal(t, "github", created.GetStrategy())
+		assert.Equal(t, &management.ConnectionOptionsGitHub{
+			ClientID:     auth0.String("abc"),
+			ClientSecret: auth0.String("xyz"),
+			Email:        auth0.Bool(true),
+			ReadOrg:      auth0.Bool(true),
+		}, created.Options)
+	})
+
+	for _, test := range []struct {
+		name           string
+		reveal         bool
+		expectedSecret string
+	}{
+		{name: "it masks the client secret in the json output", reveal: false, expectedSecret: "********"},
+		{name: "it reveals the client secret in the json output with --reveal", reveal: true, expectedSecret: "xyz"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			connectionAPI := mock.NewMockConnectionAPI(ctrl)
+			connectionAPI.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ interface{}, connection *management.Connection, _ ...management.RequestOption) error {
+					connection.ID = auth0.String("con_123")
+					return nil
+				})
+
+			result := &bytes.Buffer{}
+			cli := &cli{
+				renderer: &display.Renderer{
+					MessageWriter: io.Discard,
+					ResultWriter:  result,
+					Format:        display.OutputFormatJSON,
+					RevealSecrets: test.reveal,
+				},
+				api:     &auth0.API{Connection: connectionAPI},
+				noInput: true,
+			}
+
+			cmd := createConnectionCmd(cli)
+			cmd.SetArgs([]string{"github", "--client-id", "abc", "--client-secret", "xyz", "--json"})
+			cmd.SetOut(io.Discard)
+
+			require.NoError(t, cmd.Execute())
+			assert.Contains(t, result.String(), `"client_secret": "`+test.expectedSecret+`"`)
+			assert.Contains(t, result.String(), `"client_id": "abc"`)
+		})
+	}
+}
+
+func TestSocialConnectionPresetOptions(t *testing.T) {
+	preset := socialConnectionPresets[0]
+
+	t.Run("it enables the scopes of the provider", func(t *testing.T) {
+		options, err := socialConnectionPresetOptions(preset, &socialConnectionInputs{Scopes: []string{"email", "profile"}})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"email", "profile"}, options.Scopes())
+	})
+
+	t.Run("it rejects the scopes the provider doesn't support", func(t *testing.T) {
+		_, err := socialConnectionPresetOptions(preset, &socialConnectionInputs{Scopes: []string{"email", "repo"}})
+		assert.EqualError(t, err, "the Google connection doesn't support the scopes: repo")
+	})
+}
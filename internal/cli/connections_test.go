@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestExportJobFields(t *testing.T) {
+	assert.Nil(t, exportJobFields(nil))
+	assert.Equal(
+		t,
+		[]map[string]interface{}{{"name": "user_id"}, {"name": "app_metadata.plan"}},
+		exportJobFields([]string{"user_id", " app_metadata.plan ", ""}),
+	)
+}
+
+func TestReadConnection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	connectionAPI := mock.NewMockConnectionAPI(ctrl)
+	connectionAPI.EXPECT().
+		Read(gomock.Any(), "con_123").
+		Return(&management.Connection{ID: auth0.String("con_123")}, nil)
+	connectionAPI.EXPECT().
+		ReadByName(gomock.Any(), "Username-Password-Authentication").
+		Return(&management.Connection{ID: auth0.String("con_456")}, nil)
+
+	api := &auth0.API{Connection: connectionAPI}
+
+	connection, err := readConnection(context.Background(), api, "con_123")
+	require.NoError(t, err)
+	assert.Equal(t, "con_123", connection.GetID())
+
+	connection, err = readConnection(context.Background(), api, "Username-Password-Authentication")
+	require.NoError(t, err)
+	assert.Equal(t, "con_456", connection.GetID())
+}
+
+func TestWaitForJob(t *testing.T) {
+	defaultInterval := jobPollInterval
+	jobPollInterval = time.Millisecond
+	t.Cleanup(func() {
+		jobPollInterval = defaultInterval
+	})
+
+	t.Run("it returns the job once completed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		jobsAPI := mock.NewMockJobsAPI(ctrl)
+		gomock.InOrder(
+			jobsAPI.EXPECT().
+				Read(gomock.Any(), "job_123").
+				Return(&management.Job{ID: auth0.String("job_123"), Status: auth0.String("processing")}, nil),
+			jobsAPI.EXPECT().
+				Read(gomock.Any(), "job_123").
+				Return(&management.Job{
+					ID:       auth0.String("job_123"),
+					Status:   auth0.String(jobStatusCompleted),
+					Location: auth0.String("https://example.com/users.csv.gz"),
+				}, nil),
+		)
+
+		job, err := waitForJob(context.Background(), &auth0.API{Jobs: jobsAPI}, "job_123")
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/users.csv.gz", job.GetLocation())
+	})
+
+	t.Run("it fails when the job failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		jobsAPI := mock.NewMockJobsAPI(ctrl)
+		jobsAPI.EXPECT().
+			Read(gomock.Any(), "job_123").
+			Return(&management.Job{ID: auth0.String("job_123"), Status: auth0.String(jobStatusFailed)}, nil)
+
+		_, err := waitForJob(context.Background(), &auth0.API{Jobs: jobsAPI}, "job_123")
+		assert.EqualError(t, err, "job with ID \"job_123\" failed, run `auth0 api jobs/job_123/errors` to see why")
+	})
+}
+
+func TestDownloadJobExport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users.csv.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		writer := gzip.NewWriter(w)
+		_, _ = writer.Write([]byte("user_id,email\nauth0|123,jane@example.com\n"))
+		_ = writer.Close()
+	}))
+	t.Cleanup(server.Close)
+
+	var output bytes.Buffer
+	err := downloadJobExport(context.Background(), server.URL+"/users.csv.gz", &output)
+	require.NoError(t, err)
+	assert.Equal(t, "user_id,email\nauth0|123,jane@example.com\n", output.String())
+
+	err = downloadJobExport(context.Background(), server.URL+"/missing.csv.gz", &output)
+	assert.EqualError(t, err, "failed to download the exported users: 404 Not Found")
+
+	err = downloadJobExport(context.Background(), "", &output)
+	assert.EqualError(t, err, "the export users job completed without a file to download")
+}
+
+func TestFormatConnectionSettingsPath(t *testing.T) {
+	assert.Equal(t, "connections/database/con_1/settings", formatConnectionSettingsPath("auth0", "con_1"))
+	assert.Equal(t, "connections/enterprise/samlp/con_1/settings", formatConnectionSettingsPath("samlp", "con_1"))
+	assert.Equal(t, "connections/social/con_1/settings", formatConnectionSettingsPath("google-oauth2", "con_1"))
+	assert.Equal(t, "connections/passwordless", formatConnectionSettingsPath("sms", "con_1"))
+	assert.Empty(t, formatConnectionSettingsPath("auth0", ""))
+}
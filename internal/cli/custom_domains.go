@@ -236,6 +236,9 @@ func createCustomDomainCmd(cli *cli) *cobra.Command {
 	customDomainType.RegisterString(cmd, &inputs.Type, "")
 	customDomainVerification.RegisterString(cmd, &inputs.VerificationMethod, "")
 	customDomainPolicy.RegisterString(cmd, &inputs.TLSPolicy, "")
+	customDomainType.RegisterCompletions(cmd, []string{"auth0", "self"})
+	customDomainVerification.RegisterCompletions(cmd, []string{customDomainVerificationMethodTxt})
+	customDomainPolicy.RegisterCompletions(cmd, customDomainPolicyOptions)
 	customDomainIPHeader.RegisterString(cmd, &inputs.CustomClientIPHeader, "")
 
 	return cmd
@@ -312,6 +315,7 @@ func updateCustomDomainCmd(cli *cli) *cobra.Command {
 	}
 
 	customDomainPolicy.RegisterStringU(cmd, &inputs.TLSPolicy, "")
+	customDomainPolicy.RegisterCompletions(cmd, customDomainPolicyOptions)
 	customDomainIPHeader.RegisterStringU(cmd, &inputs.CustomClientIPHeader, "")
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
@@ -0,0 +1,418 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const (
+	// dashboardPollInterval is how often the dashboard polls the latest logs.
+	dashboardPollInterval = 2 * time.Second
+
+	// dashboardFailedLoginsInterval is how often the dashboard refreshes the failed logins.
+	dashboardFailedLoginsInterval = 30 * time.Second
+
+	// dashboardMaxLogs is how many logs the dashboard keeps around.
+	dashboardMaxLogs = 500
+
+	// failedLoginsQuery matches the failed logins, whether because of a wrong password or username.
+	failedLoginsQuery = "(type:f OR type:fp OR type:fu)"
+)
+
+// Keys handled by the dashboard.
+const (
+	dashboardKeyQuit    = "quit"
+	dashboardKeyUp      = "up"
+	dashboardKeyDown    = "down"
+	dashboardKeyRefresh = "refresh"
+	dashboardKeyLogs    = display.DashboardLogs
+	dashboardKeyApps    = display.DashboardApps
+	dashboardKeyUsers   = display.DashboardUsers
+)
+
+func dashboardCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dashboard",
+		Args:  cobra.NoArgs,
+		Short: "Monitor your tenant from the terminal",
+		Long: "Open a terminal dashboard to keep an eye on your tenant.\n\n" +
+			"The dashboard tails the tenant logs live, lists the failed logins of the last hour and shows " +
+			"the headroom left within the Management API rate limit. Press `a` and `u` to browse the applications " +
+			"and the most recently active users, `l` to get back to the logs, `r` to refresh and `q` to quit.",
+		Example: `  auth0 dashboard
+  auth0 dashboard --tenant "example.us.auth0.com"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !canPrompt(cmd) {
+				return fmt.Errorf("the dashboard command is interactive, please run it within a terminal and without --no-input")
+			}
+
+			tenant, err := cli.Config.GetTenant(cli.tenant)
+			if err != nil {
+				return err
+			}
+
+			// A dedicated client keeps track of the rate limit of the Management API.
+			rateLimits := &rateLimitObserver{}
+			client, err := cli.newManagementClient(tenant, rateLimits.transport)
+			if err != nil {
+				return err
+			}
+
+			dashboard := &terminalDashboard{
+				api:        auth0.NewAPI(client),
+				rateLimits: rateLimits,
+				state: &display.Dashboard{
+					Tenant: tenant.Domain,
+					View:   display.DashboardLogs,
+				},
+				seenLogs: make(map[string]struct{}),
+			}
+
+			return dashboard.run(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+// rateLimitObserver keeps track of the rate limit reported by the Management API responses.
+type rateLimitObserver struct {
+	mu     sync.Mutex
+	latest *display.RateLimit
+}
+
+func (o *rateLimitObserver) transport(tripper http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		response, err := tripper.RoundTrip(request)
+		if err != nil {
+			return response, err
+		}
+
+		limit, limitErr := strconv.Atoi(response.Header.Get("X-RateLimit-Limit"))
+		remaining, remainingErr := strconv.Atoi(response.Header.Get("X-RateLimit-Remaining"))
+		resetAt, resetErr := strconv.ParseInt(response.Header.Get("X-RateLimit-Reset"), 10, 64)
+		if limitErr != nil || remainingErr != nil || resetErr != nil {
+			return response, nil
+		}
+
+		o.mu.Lock()
+		o.latest = &display.RateLimit{
+			Limit:     limit,
+			Remaining: remaining,
+			ResetAt:   time.Unix(resetAt, 0),
+		}
+		o.mu.Unlock()
+
+		return response, nil
+	})
+}
+
+// Latest returns the rate limit reported by the latest response, if any.
+func (o *rateLimitObserver) Latest() *display.RateLimit {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.latest
+}
+
+type terminalDashboard struct {
+	api        *auth0.API
+	rateLimits *rateLimitObserver
+
+	mu       sync.Mutex
+	state    *display.Dashboard
+	seenLogs map[string]struct{}
+	lastLog  string
+}
+
+func (d *terminalDashboard) run(ctx context.Context) error {
+	fd := int(os.Stdin.Fd())
+
+	terminalState, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("failed to set up the terminal: %w", err)
+	}
+	defer func() {
+		_ = term.Restore(fd, terminalState)
+	}()
+
+	// Switch to the alternate screen, and hide the cursor.
+	fmt.Fprint(os.Stdout, "\x1b[?1049h\x1b[?25l")
+	defer fmt.Fprint(os.Stdout, "\x1b[?25h\x1b[?1049l")
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	keys := make(chan string)
+	go readDashboardKeys(ctx, keys)
+	go d.pollLogs(ctx)
+	go d.pollFailedLogins(ctx)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		d.draw(fd)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case key := <-keys:
+			if key == dashboardKeyQuit {
+				return nil
+			}
+			d.handleKey(ctx, key)
+		}
+	}
+}
+
+func (d *terminalDashboard) draw(fd int) {
+	width, height, err := term.GetSize(fd)
+	if err != nil {
+		width, height = 80, 24
+	}
+
+	d.mu.Lock()
+	d.state.RateLimit = d.rateLimits.Latest()
+	frame := d.state.Frame(width, height)
+	d.mu.Unlock()
+
+	// Clear each line as it gets overwritten, rather than the whole screen, to avoid flickering.
+	fmt.Fprint(os.Stdout, "\x1b[H"+strings.ReplaceAll(frame, "\r\n", "\x1b[K\r\n")+"\x1b[K\x1b[J")
+}
+
+func (d *terminalDashboard) handleKey(ctx context.Context, key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch key {
+	case dashboardKeyLogs, dashboardKeyApps, dashboardKeyUsers:
+		if d.state.View != key {
+			d.state.View = key
+			d.state.Selected = 0
+			go d.loadView(ctx, key)
+		}
+	case dashboardKeyRefresh:
+		go d.loadView(ctx, d.state.View)
+		go d.refreshFailedLogins(ctx)
+	case dashboardKeyUp:
+		if d.state.Selected > 0 {
+			d.state.Selected--
+		}
+	case dashboardKeyDown:
+		items := len(d.state.Apps)
+		if d.state.View == display.DashboardUsers {
+			items = len(d.state.Users)
+		}
+		if d.state.Selected < items-1 {
+			d.state.Selected++
+		}
+	}
+}
+
+// loadView loads the applications or the users to browse.
+func (d *terminalDashboard) loadView(ctx context.Context, view string) {
+	switch view {
+	case display.DashboardApps:
+		d.setStatus("Loading the applications...")
+
+		list, err := d.api.Client.List(
+			ctx,
+			management.PerPage(100),
+			management.Parameter("is_global", "false"),
+			management.IncludeFields("client_id", "name", "app_type", "callbacks", "grant_types"),
+		)
+		if err != nil {
+			d.setStatus(fmt.Sprintf("Failed to list the applications: %s", err))
+			return
+		}
+
+		d.update(func(state *display.Dashboard) {
+			state.Apps = list.Clients
+		})
+	case display.DashboardUsers:
+		d.setStatus("Loading the users...")
+
+		list, err := d.api.User.List(
+			ctx,
+			management.PerPage(50),
+			management.Parameter("sort", "last_login:-1"),
+		)
+		if err != nil {
+			d.setStatus(fmt.Sprintf("Failed to list the users: %s", err))
+			return
+		}
+
+		d.update(func(state *display.Dashboard) {
+			state.Users = list.Users
+		})
+	}
+}
+
+func (d *terminalDashboard) pollLogs(ctx context.Context) {
+	for {
+		d.fetchLatestLogs(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(dashboardPollInterval):
+		}
+	}
+}
+
+func (d *terminalDashboard) fetchLatestLogs(ctx context.Context) {
+	queryParams := []management.RequestOption{
+		management.Parameter("page", "0"),
+		management.Parameter("per_page", strconv.Itoa(logsPerPageLimit)),
+		management.Parameter("sort", "date:-1"),
+	}
+
+	d.mu.Lock()
+	lastLog := d.lastLog
+	d.mu.Unlock()
+
+	if lastLog != "" {
+		queryParams = append(queryParams, management.Query(fmt.Sprintf("log_id:[%s TO *]", lastLog)))
+	}
+
+	list, err := d.api.Log.List(ctx, queryParams...)
+	if err != nil {
+		if ctx.Err() == nil {
+			d.setStatus(fmt.Sprintf("Failed to get the latest logs: %s", err))
+		}
+		return
+	}
+
+	d.update(func(state *display.Dashboard) {
+		logs := dedupeLogs(list, d.seenLogs)
+		if len(logs) > 0 {
+			d.lastLog = logs[len(logs)-1].GetLogID()
+		}
+
+		state.Logs = append(state.Logs, logs...)
+		if len(state.Logs) > dashboardMaxLogs {
+			state.Logs = state.Logs[len(state.Logs)-dashboardMaxLogs:]
+		}
+	})
+}
+
+func (d *terminalDashboard) pollFailedLogins(ctx context.Context) {
+	for {
+		d.refreshFailedLogins(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(dashboardFailedLoginsInterval):
+		}
+	}
+}
+
+func (d *terminalDashboard) refreshFailedLogins(ctx context.Context) {
+	since := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	list, err := d.api.Log.List(
+		ctx,
+		management.Parameter("page", "0"),
+		management.Parameter("per_page", strconv.Itoa(logsPerPageLimit)),
+		management.Parameter("sort", "date:-1"),
+		management.Query(fmt.Sprintf("%s AND date:[%s TO *]", failedLoginsQuery, since)),
+	)
+	if err != nil {
+		if ctx.Err() == nil {
+			d.setStatus(fmt.Sprintf("Failed to get the failed logins: %s", err))
+		}
+		return
+	}
+
+	d.update(func(state *display.Dashboard) {
+		state.FailedLogins = list
+	})
+}
+
+// update applies the changes to the dashboard state, and clears the status.
+func (d *terminalDashboard) update(fn func(state *display.Dashboard)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	fn(d.state)
+	d.state.Status = ""
+	d.state.UpdatedAt = time.Now()
+}
+
+func (d *terminalDashboard) setStatus(status string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.state.Status = status
+}
+
+// readDashboardKeys reads the keys pressed until the context is done.
+func readDashboardKeys(ctx context.Context, keys chan<- string) {
+	buf := make([]byte, 16)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return
+		}
+
+		for _, key := range parseDashboardKeys(buf[:n]) {
+			select {
+			case keys <- key:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// parseDashboardKeys turns the input read from the terminal in raw mode into the keys handled by the dashboard.
+func parseDashboardKeys(input []byte) []string {
+	var keys []string
+
+	for i := 0; i < len(input); i++ {
+		switch input[i] {
+		case 'q', 'Q', 3: // Ctrl+C.
+			keys = append(keys, dashboardKeyQuit)
+		case 'l':
+			keys = append(keys, dashboardKeyLogs)
+		case 'a':
+			keys = append(keys, dashboardKeyApps)
+		case 'u':
+			keys = append(keys, dashboardKeyUsers)
+		case 'r':
+			keys = append(keys, dashboardKeyRefresh)
+		case 'k':
+			keys = append(keys, dashboardKeyUp)
+		case 'j':
+			keys = append(keys, dashboardKeyDown)
+		case 0x1b: // Arrow keys are sent as escape sequences.
+			if i+2 < len(input) && input[i+1] == '[' {
+				switch input[i+2] {
+				case 'A':
+					keys = append(keys, dashboardKeyUp)
+				case 'B':
+					keys = append(keys, dashboardKeyDown)
+				}
+				i += 2
+			}
+		}
+	}
+
+	return keys
+}
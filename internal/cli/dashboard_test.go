@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitObserver(t *testing.T) {
+	resetAt := time.Now().Add(time.Second).Unix()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/with-headers" {
+			w.Header().Set("X-RateLimit-Limit", "50")
+			w.Header().Set("X-RateLimit-Remaining", "42")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(resetAt, 10))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	observer := &rateLimitObserver{}
+	client := &http.Client{Transport: observer.transport(http.DefaultTransport)}
+
+	response, err := client.Get(server.URL + "/without-headers")
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Nil(t, observer.Latest())
+
+	response, err = client.Get(server.URL + "/with-headers")
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	latest := observer.Latest()
+	require.NotNil(t, latest)
+	assert.Equal(t, 50, latest.Limit)
+	assert.Equal(t, 42, latest.Remaining)
+	assert.Equal(t, resetAt, latest.ResetAt.Unix())
+}
+
+func TestParseDashboardKeys(t *testing.T) {
+	assert.Equal(t, []string{"apps", "down", "down", "up"}, parseDashboardKeys([]byte("aj\x1b[B\x1b[A")))
+	assert.Equal(t, []string{"users", "refresh", "logs"}, parseDashboardKeys([]byte("url")))
+	assert.Equal(t, []string{"quit"}, parseDashboardKeys([]byte{3}))
+	assert.Equal(t, []string{"quit"}, parseDashboardKeys([]byte("xq")))
+	assert.Empty(t, parseDashboardKeys([]byte("\x1b")))
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const (
+	deprecatedCommandAnnotation     = "deprecated"
+	deprecatedAliasAnnotationPrefix = "deprecated-alias:"
+	deprecatedFlagAnnotationPrefix  = "deprecated-flag:"
+)
+
+// deprecationError is returned instead of warning about
+// a deprecated command, alias or flag when running with --strict.
+type deprecationError struct {
+	deprecation display.Deprecation
+}
+
+func (e *deprecationError) Error() string {
+	return strings.TrimSuffix(e.deprecation.Message, ".") + ". Run it without --strict to allow it"
+}
+
+// deprecateCommand marks the command, and all of its subcommands, as deprecated.
+// The message defaults to pointing at the replacement, if any.
+func deprecateCommand(cmd *cobra.Command, replacement, message string) {
+	annotate(cmd, deprecatedCommandAnnotation, display.Deprecation{
+		Kind:        "command",
+		Replacement: replacement,
+		Message:     message,
+	})
+}
+
+// deprecateAlias marks one of the aliases of the command as deprecated. Only the aliases
+// of the invoked command can be detected, as cobra doesn't track those of its parents.
+func deprecateAlias(cmd *cobra.Command, alias, replacement string) {
+	annotate(cmd, deprecatedAliasAnnotationPrefix+alias, display.Deprecation{
+		Kind:        "alias",
+		Name:        alias,
+		Replacement: replacement,
+	})
+}
+
+// deprecateFlag marks a flag of the command as deprecated, once it got registered.
+// Persistent flags are deprecated for all the subcommands as well.
+func deprecateFlag(cmd *cobra.Command, name, replacement string) {
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil {
+		flag = cmd.PersistentFlags().Lookup(name)
+	}
+	if flag == nil {
+		return
+	}
+
+	flag.Usage = strings.TrimSpace(flag.Usage + " Deprecated, use --" + replacement + " instead.")
+
+	annotate(cmd, deprecatedFlagAnnotationPrefix+name, display.Deprecation{
+		Kind:        "flag",
+		Name:        "--" + name,
+		Replacement: "--" + replacement,
+	})
+}
+
+func annotate(cmd *cobra.Command, key string, deprecation display.Deprecation) {
+	value, err := json.Marshal(deprecation)
+	if err != nil {
+		panic(err) // Can't happen, the deprecation only holds strings.
+	}
+
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[key] = string(value)
+}
+
+// usedDeprecations lists the deprecated commands, aliases and flags used
+// to invoke the command, starting from the root command.
+func usedDeprecations(cmd *cobra.Command) []display.Deprecation {
+	var lineage []*cobra.Command
+	for c := cmd; c != nil; c = c.Parent() {
+		lineage = append([]*cobra.Command{c}, lineage...)
+	}
+
+	var deprecations []display.Deprecation
+	for _, c := range lineage {
+		keys := make([]string, 0, len(c.Annotations))
+		for key := range c.Annotations {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			var deprecation display.Deprecation
+			if json.Unmarshal([]byte(c.Annotations[key]), &deprecation) != nil {
+				continue
+			}
+
+			switch {
+			case key == deprecatedCommandAnnotation:
+				deprecation.Name = c.CommandPath()
+			case strings.HasPrefix(key, deprecatedAliasAnnotationPrefix):
+				if c != cmd || cmd.CalledAs() != deprecation.Name {
+					continue
+				}
+			case strings.HasPrefix(key, deprecatedFlagAnnotationPrefix):
+				flag := cmd.Flags().Lookup(strings.TrimPrefix(key, deprecatedFlagAnnotationPrefix))
+				if flag == nil || !flag.Changed {
+					continue
+				}
+			default:
+				continue
+			}
+
+			if deprecation.Message == "" {
+				deprecation.Message = deprecationMessage(deprecation)
+			}
+
+			deprecations = append(deprecations, deprecation)
+		}
+	}
+
+	return deprecations
+}
+
+func deprecationMessage(deprecation display.Deprecation) string {
+	message := fmt.Sprintf("The %s '%s' is deprecated and will be removed in a future release", deprecation.Kind, deprecation.Name)
+	if deprecation.Replacement == "" {
+		return message + "."
+	}
+
+	return fmt.Sprintf("%s, use '%s' instead.", message, deprecation.Replacement)
+}
+
+// checkDeprecations warns about the deprecated commands, aliases and flags used
+// to invoke the command, or fails the command when running with --strict.
+func (c *cli) checkDeprecations(cmd *cobra.Command) error {
+	for _, deprecation := range usedDeprecations(cmd) {
+		if c.strict {
+			return &deprecationError{deprecation: deprecation}
+		}
+
+		c.renderer.DeprecationWarning(deprecation)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestCheckDeprecations(t *testing.T) {
+	var tests = []struct {
+		name     string
+		args     []string
+		expected []display.Deprecation
+	}{
+		{
+			name: "it doesn't warn when nothing deprecated is used",
+			args: []string{"terraform", "generate"},
+		},
+		{
+			name: "it warns about the deprecated aliases of the invoked command",
+			args: []string{"terraform", "gen"},
+			expected: []display.Deprecation{
+				{
+					Kind:        "alias",
+					Name:        "gen",
+					Replacement: "generate",
+					Message:     "The alias 'gen' is deprecated and will be removed in a future release, use 'generate' instead.",
+				},
+			},
+		},
+		{
+			name: "it warns about the deprecated flags that got passed",
+			args: []string{"terraform", "generate", "--out", "tmp"},
+			expected: []display.Deprecation{
+				{
+					Kind:        "flag",
+					Name:        "--out",
+					Replacement: "--output-dir",
+					Message:     "The flag '--out' is deprecated and will be removed in a future release, use '--output-dir' instead.",
+				},
+			},
+		},
+		{
+			name: "it warns about the deprecated parents of the invoked command",
+			args: []string{"legacy", "list"},
+			expected: []display.Deprecation{
+				{
+					Kind:    "command",
+					Name:    "auth0 legacy",
+					Message: "Legacy commands are going away.",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var deprecations []display.Deprecation
+			cmd := newDeprecationsTestCmd(func(cmd *cobra.Command) {
+				deprecations = usedDeprecations(cmd)
+			})
+			cmd.SetArgs(test.args)
+
+			require.NoError(t, cmd.Execute())
+			assert.Equal(t, test.expected, deprecations)
+		})
+	}
+
+	t.Run("it renders the warnings as json in json mode", func(t *testing.T) {
+		message := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: message, Format: display.OutputFormatJSON}}
+
+		cmd := newDeprecationsTestCmd(func(cmd *cobra.Command) {
+			require.NoError(t, cli.checkDeprecations(cmd))
+		})
+		cmd.SetArgs([]string{"terraform", "gen"})
+
+		require.NoError(t, cmd.Execute())
+		assert.JSONEq(
+			t,
+			`{"warning":{"code":"deprecated","kind":"alias","name":"gen","replacement":"generate","message":"The alias 'gen' is deprecated and will be removed in a future release, use 'generate' instead."}}`,
+			message.String(),
+		)
+	})
+
+	t.Run("it fails instead of warning when running with --strict", func(t *testing.T) {
+		message := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: message}, strict: true}
+
+		var err error
+		cmd := newDeprecationsTestCmd(func(cmd *cobra.Command) {
+			err = cli.checkDeprecations(cmd)
+		})
+		cmd.SetArgs([]string{"legacy", "list"})
+
+		require.NoError(t, cmd.Execute())
+		assert.EqualError(t, err, "Legacy commands are going away. Run it without --strict to allow it")
+		assert.Empty(t, message.String())
+
+		var deprecationErr *deprecationError
+		assert.True(t, errors.As(err, &deprecationErr))
+		assert.Equal(t, "deprecated", errorResult(err, nil).Code)
+	})
+}
+
+// newDeprecationsTestCmd returns a command tree with deprecated
+// commands, aliases and flags, running fn for each invoked command.
+func newDeprecationsTestCmd(fn func(cmd *cobra.Command)) *cobra.Command {
+	run := func(cmd *cobra.Command, _ []string) { fn(cmd) }
+
+	generate := &cobra.Command{Use: "generate", Aliases: []string{"gen"}, Run: run}
+	generate.Flags().String("output-dir", "", "Output directory.")
+	generate.Flags().String("out", "", "Output directory.")
+	deprecateAlias(generate, "gen", "generate")
+	deprecateFlag(generate, "out", "output-dir")
+
+	terraform := &cobra.Command{Use: "terraform"}
+	terraform.AddCommand(generate)
+
+	legacy := &cobra.Command{Use: "legacy"}
+	legacy.AddCommand(&cobra.Command{Use: "list", Run: run})
+	deprecateCommand(legacy, "", "Legacy commands are going away.")
+
+	root := &cobra.Command{Use: "auth0", SilenceUsage: true, SilenceErrors: true}
+	root.AddCommand(terraform, legacy)
+
+	return root
+}
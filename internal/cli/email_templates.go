@@ -109,6 +109,7 @@ func emailTemplateCmd(cli *cli) *cobra.Command {
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(showEmailTemplateCmd(cli))
 	cmd.AddCommand(updateEmailTemplateCmd(cli))
+	cmd.AddCommand(diffEmailTemplateCmd(cli))
 	return cmd
 }
 
@@ -118,10 +119,11 @@ func showEmailTemplateCmd(cli *cli) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "show",
-		Args:  cobra.MaximumNArgs(1),
-		Short: "Show an email template",
-		Long:  "Display information about an email template.",
+		Use:               "show",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: pickerOptionsCompletions(emailTemplateOptions),
+		Short:             "Show an email template",
+		Long:              "Display information about an email template.",
 		Example: `  auth0 email templates show
   auth0 email templates show <template>
   auth0 email templates show welcome`,
@@ -165,9 +167,10 @@ func updateEmailTemplateCmd(cli *cli) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "update",
-		Args:  cobra.MaximumNArgs(1),
-		Short: "Update an email template",
+		Use:               "update",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: pickerOptionsCompletions(emailTemplateOptions),
+		Short:             "Update an email template",
 		Long: "Update an email template.\n\n" +
 			"To update interactively, use `auth0 email templates update` with no arguments.\n\n" +
 			"To update non-interactively, supply the template name and other information " +
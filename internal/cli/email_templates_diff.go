@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+var emailTemplateFile = Flag{
+	Name:      "File",
+	LongForm:  "file",
+	ShortForm: "f",
+	Help: "Path to the local template file. An HTML or Liquid file is compared with the body of the template, " +
+		"while a JSON file is compared with the fields of the template it sets, e.g. 'subject' or 'body'.",
+	IsRequired: true,
+}
+
+func diffEmailTemplateCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Template string
+		File     string
+	}
+
+	cmd := &cobra.Command{
+		Use:               "diff",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: pickerOptionsCompletions(emailTemplateOptions),
+		Short:             "Compare a local file with an email template",
+		Long: "Compare a local file with the deployed email template, to review the changes before updating it.\n\n" +
+			"The differences are shown as a unified diff, from the deployed template to the local file.",
+		Example: `  auth0 email templates diff
+  auth0 email templates diff <template> --file <path>
+  auth0 email templates diff welcome --file path/to/welcome.html
+  auth0 email templates diff welcome -f path/to/welcome.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := emailTemplateTemplate.Pick(cmd, &inputs.Template, cli.emailTemplatePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.Template = args[0]
+			}
+
+			if err := emailTemplateFile.Ask(cmd, &inputs.File, nil); err != nil {
+				return err
+			}
+
+			local, err := os.ReadFile(inputs.File)
+			if err != nil {
+				return fmt.Errorf("failed to read the template file: %w", err)
+			}
+
+			deployed := &management.EmailTemplate{}
+			if err := ansi.Waiting(func() error {
+				template, err := cli.api.EmailTemplate.Read(cmd.Context(), apiEmailTemplateFor(inputs.Template))
+				if err != nil {
+					return err
+				}
+
+				deployed = template
+				return nil
+			}); err != nil {
+				if mErr, ok := err.(management.Error); !ok || mErr.Status() != http.StatusNotFound {
+					return fmt.Errorf("failed to read email template %q: %w", inputs.Template, err)
+				}
+			}
+
+			from, to, err := emailTemplateDiffInputs(deployed, local, inputs.File)
+			if err != nil {
+				return err
+			}
+
+			diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(strings.TrimSuffix(from, "\n")),
+				B:        difflib.SplitLines(strings.TrimSuffix(to, "\n")),
+				FromFile: inputs.Template + " (deployed)",
+				ToFile:   inputs.File,
+				Context:  3,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to compare the email template %q: %w", inputs.Template, err)
+			}
+
+			if diff == "" {
+				cli.renderer.Infof("The email template %s is identical to %s", ansi.Bold(inputs.Template), inputs.File)
+				return nil
+			}
+
+			cli.renderer.Output(colorizeDiff(diff))
+
+			return nil
+		},
+	}
+
+	emailTemplateFile.RegisterString(cmd, &inputs.File, "")
+
+	return cmd
+}
+
+// emailTemplateDiffInputs returns the deployed template and the local file to compare. Only the
+// fields set by a JSON file are compared, as they are the only ones it would update.
+func emailTemplateDiffInputs(deployed *management.EmailTemplate, local []byte, path string) (string, string, error) {
+	if !strings.EqualFold(filepath.Ext(path), ".json") {
+		return deployed.GetBody(), string(local), nil
+	}
+
+	var localFields map[string]interface{}
+	if err := json.Unmarshal(local, &localFields); err != nil {
+		return "", "", fmt.Errorf("invalid template file: %w", err)
+	}
+
+	deployedJSON, err := json.Marshal(deployed)
+	if err != nil {
+		return "", "", err
+	}
+
+	var deployedFields map[string]interface{}
+	if err := json.Unmarshal(deployedJSON, &deployedFields); err != nil {
+		return "", "", err
+	}
+
+	for field := range deployedFields {
+		if _, ok := localFields[field]; !ok {
+			delete(deployedFields, field)
+		}
+	}
+
+	from, err := emailTemplateDiffJSON(deployedFields)
+	if err != nil {
+		return "", "", err
+	}
+
+	to, err := emailTemplateDiffJSON(localFields)
+	if err != nil {
+		return "", "", err
+	}
+
+	return from, to, nil
+}
+
+// emailTemplateDiffJSON formats the fields with one field per line and the
+// body split into lines, so that the diff points at the lines that changed.
+func emailTemplateDiffJSON(fields map[string]interface{}) (string, error) {
+	body, hasBody := fields["body"].(string)
+	delete(fields, "body")
+
+	b, err := json.MarshalIndent(fields, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	if !hasBody {
+		return string(b) + "\n", nil
+	}
+
+	return string(b) + "\n\nbody:\n" + body, nil
+}
+
+func colorizeDiff(diff string) string {
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+			lines[i] = ansi.Bold(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = ansi.Cyan(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = ansi.Red(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = ansi.Green(line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestDiffEmailTemplateCmd(t *testing.T) {
+	deployed := &management.EmailTemplate{
+		Template: auth0.String("welcome_email"),
+		Subject:  auth0.String("Welcome"),
+		From:     auth0.String("hello@travel0.com"),
+		Body:     auth0.String("<html>\n<p>Hello</p>\n</html>\n"),
+	}
+
+	var tests = []struct {
+		name     string
+		file     string
+		content  string
+		expected string
+		message  string
+	}{
+		{
+			name:    "it shows the changes of the body",
+			file:    "welcome.html",
+			content: "<html>\n<p>Hello world</p>\n</html>\n",
+			expected: `--- welcome (deployed)
++++ welcome.html
+@@ -1,3 +1,3 @@
+ <html>
+-<p>Hello</p>
++<p>Hello world</p>
+ </html>
+`,
+		},
+		{
+			name:    "it only compares the fields set by a json file",
+			file:    "welcome.json",
+			content: `{"subject": "Welcome aboard", "body": "<html>\n<p>Hello</p>\n</html>\n"}`,
+			expected: "--- welcome (deployed)\n" +
+				"+++ welcome.json\n" +
+				"@@ -1,5 +1,5 @@\n" +
+				" {\n" +
+				"-    \"subject\": \"Welcome\"\n" +
+				"+    \"subject\": \"Welcome aboard\"\n" +
+				" }\n" +
+				" \n" +
+				" body:\n",
+		},
+		{
+			name:    "it tells when the template is identical",
+			file:    "welcome.html",
+			content: "<html>\n<p>Hello</p>\n</html>\n",
+			message: "The email template welcome is identical to",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), test.file)
+			require.NoError(t, os.WriteFile(file, []byte(test.content), 0600))
+
+			ctrl := gomock.NewController(t)
+			emailTemplateAPI := mock.NewMockEmailTemplateAPI(ctrl)
+			emailTemplateAPI.EXPECT().
+				Read(gomock.Any(), "welcome_email").
+				Return(deployed, nil)
+
+			result := &bytes.Buffer{}
+			message := &bytes.Buffer{}
+			cli := &cli{
+				renderer: &display.Renderer{MessageWriter: message, ResultWriter: result},
+				api:      &auth0.API{EmailTemplate: emailTemplateAPI},
+			}
+
+			cmd := diffEmailTemplateCmd(cli)
+			cmd.SetArgs([]string{"welcome", "--file", file})
+			cmd.SetOut(io.Discard)
+
+			require.NoError(t, cmd.Execute())
+
+			expected := strings.Replace(test.expected, "+++ "+test.file, "+++ "+file, 1)
+			assert.Equal(t, expected, result.String())
+			assert.Contains(t, message.String(), test.message)
+		})
+	}
+}
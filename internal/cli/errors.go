@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// apiErrorResponse is an error response of the Management API.
+type apiErrorResponse struct {
+	StatusCode int
+	RequestID  string
+	Payload    []byte
+}
+
+// apiErrorObserver keeps track of the latest error response of the Management API,
+// to report its payload and request ID when the command fails in json mode.
+type apiErrorObserver struct {
+	mu     sync.Mutex
+	latest *apiErrorResponse
+}
+
+func (o *apiErrorObserver) transport(tripper http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		response, err := tripper.RoundTrip(request)
+		if err != nil || response.StatusCode < http.StatusBadRequest {
+			return response, err
+		}
+
+		payload, err := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(payload))
+
+		requestID := response.Header.Get("X-Auth0-RequestId")
+		if requestID == "" {
+			requestID = response.Header.Get("X-Request-Id")
+		}
+
+		o.mu.Lock()
+		o.latest = &apiErrorResponse{
+			StatusCode: response.StatusCode,
+			RequestID:  requestID,
+			Payload:    payload,
+		}
+		o.mu.Unlock()
+
+		return response, nil
+	})
+}
+
+// Latest returns the latest error response, if any.
+func (o *apiErrorObserver) Latest() *apiErrorResponse {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.latest
+}
+
+// errorResult describes the error of a command for the json output. The latest error
+// response of the Management API is only reported when the error is caused by it.
+func errorResult(err error, latest *apiErrorResponse) display.ErrorResult {
+	result := display.ErrorResult{
+		Code:    "error",
+		Message: err.Error(),
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		result.Code = "deadline_exceeded"
+	}
+
+	var deprecationErr *deprecationError
+	if errors.As(err, &deprecationErr) {
+		result.Code = "deprecated"
+	}
+
+	var apiErr management.Error
+	if !errors.As(err, &apiErr) {
+		return result
+	}
+
+	result.StatusCode = apiErr.Status()
+	result.Code = strings.ReplaceAll(strings.ToLower(http.StatusText(apiErr.Status())), " ", "_")
+
+	if latest == nil || latest.StatusCode != apiErr.Status() {
+		return result
+	}
+
+	result.RequestID = latest.RequestID
+
+	var payload struct {
+		ErrorCode string `json:"errorCode"`
+	}
+	if json.Unmarshal(latest.Payload, &payload) != nil {
+		return result
+	}
+
+	result.Payload = latest.Payload
+	if payload.ErrorCode != "" {
+		result.Code = payload.ErrorCode
+	}
+
+	return result
+}
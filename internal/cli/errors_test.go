@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestAPIErrorObserver(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/missing" {
+			writer.Header().Set("X-Auth0-RequestId", "req_123")
+			writer.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = writer.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"The client does not exist","errorCode":"inexistent_client"}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	observer := &apiErrorObserver{}
+	client := &http.Client{Transport: observer.transport(http.DefaultTransport)}
+
+	response, err := client.Get(testServer.URL + "/found")
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.Nil(t, observer.Latest())
+
+	response, err = client.Get(testServer.URL + "/missing")
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	assert.Contains(t, string(body), "inexistent_client", "the body must still be readable")
+	assert.Equal(t, &apiErrorResponse{
+		StatusCode: http.StatusNotFound,
+		RequestID:  "req_123",
+		Payload:    body,
+	}, observer.Latest())
+}
+
+func TestErrorResult(t *testing.T) {
+	notFoundPayload := []byte(`{"statusCode":404,"error":"Not Found","message":"The client does not exist","errorCode":"inexistent_client"}`)
+	notFoundErr := newTestManagementError(t, notFoundPayload)
+
+	var tests = []struct {
+		name     string
+		err      error
+		latest   *apiErrorResponse
+		expected display.ErrorResult
+	}{
+		{
+			name: "it describes the errors of the cli",
+			err:  errors.New("number flag invalid"),
+			expected: display.ErrorResult{
+				Code:    "error",
+				Message: "number flag invalid",
+			},
+		},
+		{
+			name: "it describes the deadline errors",
+			err:  fmt.Errorf("failed to list logs: %w", context.DeadlineExceeded),
+			expected: display.ErrorResult{
+				Code:    "deadline_exceeded",
+				Message: "failed to list logs: context deadline exceeded",
+			},
+		},
+		{
+			name: "it reports the payload of the management api errors",
+			err:  fmt.Errorf("failed to find application: %w", notFoundErr),
+			latest: &apiErrorResponse{
+				StatusCode: http.StatusNotFound,
+				RequestID:  "req_123",
+				Payload:    notFoundPayload,
+			},
+			expected: display.ErrorResult{
+				Code:       "inexistent_client",
+				Message:    "failed to find application: 404 Not Found: The client does not exist",
+				StatusCode: http.StatusNotFound,
+				RequestID:  "req_123",
+				Payload:    notFoundPayload,
+			},
+		},
+		{
+			name: "it ignores the error responses not causing the error",
+			err:  fmt.Errorf("failed to find application: %w", notFoundErr),
+			latest: &apiErrorResponse{
+				StatusCode: http.StatusTooManyRequests,
+				Payload:    []byte(`{"statusCode":429}`),
+			},
+			expected: display.ErrorResult{
+				Code:       "not_found",
+				Message:    "failed to find application: 404 Not Found: The client does not exist",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, errorResult(test.err, test.latest))
+		})
+	}
+}
+
+// newTestManagementError returns the error of the Management API for the payload.
+func newTestManagementError(t *testing.T, payload []byte) error {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusNotFound)
+		_, _ = writer.Write(payload)
+	}))
+	t.Cleanup(server.Close)
+
+	api, err := management.New(strings.TrimPrefix(server.URL, "http://"), management.WithInsecure())
+	require.NoError(t, err)
+
+	_, err = api.Client.Read(context.Background(), "client-id")
+	require.Error(t, err)
+
+	return err
+}
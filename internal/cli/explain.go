@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+//go:embed data/explain-codes.yaml
+var explainCodes []byte
+
+var explainCode = Argument{
+	Name: "Code",
+	Help: "Log event type or OAuth error code to explain, such as \"fp\" or \"invalid_grant\".",
+}
+
+func explainCmd(cli *cli) *cobra.Command {
+	var code string
+
+	cmd := &cobra.Command{
+		Use:   "explain",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Explain a log event type or an OAuth error code",
+		Long: "Explain what a log event type or an OAuth error code means, along with its common causes " +
+			"and hints to remediate it.\n\n" +
+			"This works offline, from a reference table embedded in the CLI.",
+		Example: `  auth0 explain
+  auth0 explain fp
+  auth0 explain limit_wc
+  auth0 explain invalid_grant
+  auth0 explain login_required --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			explanations, err := loadCodeExplanations()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				if err := explainCode.Pick(cmd, &code, codeExplanationPickerOptions(explanations)); err != nil {
+					return err
+				}
+			} else {
+				code = args[0]
+			}
+
+			explanation := findCodeExplanation(explanations, code)
+			if explanation == nil {
+				return fmt.Errorf(
+					"there's no explanation for the code %q. See the full list of log event types at "+
+						"https://auth0.com/docs/deploy-monitor/logs/log-event-type-codes",
+					code,
+				)
+			}
+
+			cli.renderer.Explain(explanation)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func loadCodeExplanations() ([]*display.CodeExplanation, error) {
+	var explanations []*display.CodeExplanation
+	if err := yaml.Unmarshal(explainCodes, &explanations); err != nil {
+		return nil, fmt.Errorf("failed to load the explanations: %w", err)
+	}
+
+	return explanations, nil
+}
+
+// findCodeExplanation returns the explanation of the code, or nil if there's none. Log event
+// types that the reference table misses are still named after the ones the Management API knows.
+func findCodeExplanation(explanations []*display.CodeExplanation, code string) *display.CodeExplanation {
+	code = strings.TrimSpace(code)
+
+	for _, explanation := range explanations {
+		if strings.EqualFold(explanation.Code, code) {
+			return explanation
+		}
+	}
+
+	if name := (&management.Log{Type: &code}).TypeName(); name != "" {
+		return &display.CodeExplanation{
+			Code:    code,
+			Kind:    "log event",
+			Name:    name,
+			Meaning: fmt.Sprintf("A %s event was logged.", strings.ToLower(name)),
+		}
+	}
+
+	return nil
+}
+
+func codeExplanationPickerOptions(explanations []*display.CodeExplanation) pickerOptionsFunc {
+	return func(_ context.Context) (pickerOptions, error) {
+		var opts pickerOptions
+		for _, explanation := range explanations {
+			label := fmt.Sprintf("%s %s", explanation.Code, ansi.Faint("("+explanation.Name+")"))
+			opts = append(opts, pickerOption{value: explanation.Code, label: label})
+		}
+
+		return opts, nil
+	}
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestLoadCodeExplanations(t *testing.T) {
+	explanations, err := loadCodeExplanations()
+	require.NoError(t, err)
+	require.NotEmpty(t, explanations)
+
+	codes := make(map[string]bool)
+	for _, explanation := range explanations {
+		assert.False(t, codes[explanation.Code], "the code %q is explained twice", explanation.Code)
+		codes[explanation.Code] = true
+
+		assert.Contains(t, []string{"log event", "OAuth error"}, explanation.Kind, explanation.Code)
+		assert.NotEmpty(t, explanation.Name, explanation.Code)
+		assert.NotEmpty(t, explanation.Meaning, explanation.Code)
+	}
+}
+
+func TestFindCodeExplanation(t *testing.T) {
+	explanations, err := loadCodeExplanations()
+	require.NoError(t, err)
+
+	t.Run("it finds a log event type", func(t *testing.T) {
+		explanation := findCodeExplanation(explanations, "fp")
+		require.NotNil(t, explanation)
+		assert.Equal(t, "Failed Login (wrong password)", explanation.Name)
+		assert.NotEmpty(t, explanation.Remediation)
+	})
+
+	t.Run("it finds an OAuth error code regardless of its case", func(t *testing.T) {
+		explanation := findCodeExplanation(explanations, "Invalid_Grant")
+		require.NotNil(t, explanation)
+		assert.Equal(t, "invalid_grant", explanation.Code)
+		assert.Equal(t, "OAuth error", explanation.Kind)
+	})
+
+	t.Run("it names the log event types missing from the table", func(t *testing.T) {
+		explanation := findCodeExplanation(explanations, "scpn")
+		require.NotNil(t, explanation)
+		assert.Equal(t, "Success Change Phone Number", explanation.Name)
+		assert.Equal(t, "log event", explanation.Kind)
+	})
+
+	t.Run("it returns nil for unknown codes", func(t *testing.T) {
+		assert.Nil(t, findCodeExplanation(explanations, "not_a_code"))
+	})
+}
+
+func TestExplainCmd(t *testing.T) {
+	t.Run("it explains the code", func(t *testing.T) {
+		stdout := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: &bytes.Buffer{}, ResultWriter: stdout}}
+
+		cmd := explainCmd(cli)
+		cmd.SetArgs([]string{"limit_wc"})
+		require.NoError(t, cmd.Execute())
+
+		assert.Contains(t, stdout.String(), "Blocked Account")
+		assert.Contains(t, stdout.String(), "Common causes")
+		assert.Contains(t, stdout.String(), "auth0 users blocks unblock")
+	})
+
+	t.Run("it fails for unknown codes", func(t *testing.T) {
+		cli := &cli{renderer: &display.Renderer{MessageWriter: &bytes.Buffer{}, ResultWriter: &bytes.Buffer{}}}
+
+		cmd := explainCmd(cli)
+		cmd.SetArgs([]string{"not_a_code"})
+		err := cmd.Execute()
+
+		assert.ErrorContains(t, err, `there's no explanation for the code "not_a_code"`)
+	})
+}
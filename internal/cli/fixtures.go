@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// fixtureTenant replaces the domain of the tenant within the fixtures,
+// so that they don't depend on the tenant they were recorded against.
+const fixtureTenant = "fixtures.auth0.local"
+
+// newFixtureRecorder records the requests like the --record flag does, but masks
+// the secrets and the tenant domain so that the fixtures can be committed.
+func newFixtureRecorder(path, command string, recordedAt time.Time) (*sessionRecorder, error) {
+	recorder, err := newSessionRecorder(path, command, recordedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder.sanitize = true
+
+	return recorder, nil
+}
+
+func sanitizeFixtureRequest(tenant string, request *recordedRequest) *recordedRequest {
+	sanitized := *request
+	sanitized.Body = sanitizeFixturePayload(tenant, request.Body)
+	sanitized.Response = sanitizeFixturePayload(tenant, request.Response)
+
+	return &sanitized
+}
+
+func sanitizeFixturePayload(tenant string, payload json.RawMessage) json.RawMessage {
+	if len(payload) == 0 {
+		return payload
+	}
+
+	if tenant != "" {
+		payload = bytes.ReplaceAll(payload, []byte(tenant), []byte(fixtureTenant))
+	}
+
+	return display.MaskSecrets(payload)
+}
+
+// fixtureReplayTransport answers the requests with the recorded responses instead of sending
+// them to the Management API. The requests must be made in the order they were recorded,
+// so that any change to the requests made by a command shows up as a failure of its tests.
+func fixtureReplayTransport(recording *sessionRecording) http.RoundTripper {
+	var (
+		mu   sync.Mutex
+		next int
+	)
+
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if next >= len(recording.Requests) {
+			return nil, fmt.Errorf(
+				"unexpected request %s %s: all the %d recorded requests were already made",
+				request.Method,
+				request.URL.Path,
+				len(recording.Requests),
+			)
+		}
+
+		recorded := recording.Requests[next]
+		if recorded.Method != request.Method ||
+			recorded.Path != request.URL.Path ||
+			recorded.Query != request.URL.RawQuery {
+			return nil, fmt.Errorf(
+				"unexpected request %s %s, expected request #%d to be %s %s",
+				request.Method,
+				requestURI(request.URL.Path, request.URL.RawQuery),
+				next+1,
+				recorded.Method,
+				requestURI(recorded.Path, recorded.Query),
+			)
+		}
+		next++
+
+		if request.Body != nil {
+			_ = request.Body.Close()
+		}
+
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode: recorded.StatusCode,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(recorded.Response)),
+			Request:    request,
+		}, nil
+	})
+}
+
+func requestURI(path, query string) string {
+	if query == "" {
+		return path
+	}
+
+	return path + "?" + query
+}
+
+// setupWithFixtures sets up the Management API client to replay the requests of a
+// recording instead of reaching the tenant, so no authentication is needed.
+// The clock of the cli is frozen at the time of the recording.
+func (c *cli) setupWithFixtures(path string) error {
+	recording, err := loadSessionRecording(path)
+	if err != nil {
+		return err
+	}
+
+	c.tenant = recording.Tenant
+	if c.tenant == "" {
+		c.tenant = fixtureTenant
+	}
+	c.renderer.Tenant = c.tenant
+
+	if c.clock == nil && !recording.RecordedAt.IsZero() {
+		c.clock = func() time.Time {
+			return recording.RecordedAt
+		}
+	}
+
+	client, err := management.New(
+		c.tenant,
+		management.WithStaticToken("fixtures"),
+		management.WithNoRetries(),
+		management.WithClient(&http.Client{
+			Transport: c.apiErrors.transport(fixtureReplayTransport(recording)),
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	c.api = auth0.NewAPI(client)
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestFixtureRecorder(t *testing.T) {
+	var tenant string
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusCreated)
+		_, _ = writer.Write([]byte(`{"client_id":"1","client_secret":"s3cr3t","callbacks":["https://` + tenant + `/callback"]}`))
+	}))
+	t.Cleanup(testServer.Close)
+	tenant = strings.TrimPrefix(testServer.URL, "http://")
+
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	recorder, err := newFixtureRecorder(path, "auth0 apps create", time.Now())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(
+		http.MethodPost,
+		testServer.URL+"/api/v2/clients",
+		strings.NewReader(`{"name":"app","client_secret":"s3cr3t"}`),
+	)
+	require.NoError(t, err)
+
+	response, err := recordingTransport(http.DefaultTransport, recorder).RoundTrip(request)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.Contains(t, string(body), "s3cr3t", "the response itself isn't sanitized")
+
+	recording, err := loadSessionRecording(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, fixtureTenant, recording.Tenant)
+	require.Len(t, recording.Requests, 1)
+	assert.JSONEq(t, `{"name":"app","client_secret":"********"}`, string(recording.Requests[0].Body))
+	assert.JSONEq(
+		t,
+		`{"client_id":"1","client_secret":"********","callbacks":["https://fixtures.auth0.local/callback"]}`,
+		string(recording.Requests[0].Response),
+	)
+}
+
+func TestFixtureReplayTransport(t *testing.T) {
+	recording := &sessionRecording{
+		Requests: []*recordedRequest{
+			{
+				Method:     http.MethodGet,
+				Path:       "/api/v2/roles/rol_1",
+				StatusCode: http.StatusOK,
+				Response:   json.RawMessage(`{"id":"rol_1","name":"Admin"}`),
+			},
+			{
+				Method:     http.MethodDelete,
+				Path:       "/api/v2/roles/rol_1",
+				StatusCode: http.StatusNoContent,
+			},
+		},
+	}
+
+	transport := fixtureReplayTransport(recording)
+
+	request := httptest.NewRequest(http.MethodGet, "https://fixtures.auth0.local/api/v2/roles/rol_1", nil)
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"rol_1","name":"Admin"}`, string(body))
+
+	request = httptest.NewRequest(http.MethodDelete, "https://fixtures.auth0.local/api/v2/roles/rol_2", nil)
+	_, err = transport.RoundTrip(request)
+	assert.EqualError(
+		t,
+		err,
+		"unexpected request DELETE /api/v2/roles/rol_2, expected request #2 to be DELETE /api/v2/roles/rol_1",
+	)
+
+	request = httptest.NewRequest(http.MethodDelete, "https://fixtures.auth0.local/api/v2/roles/rol_1", nil)
+	response, err = transport.RoundTrip(request)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	_, err = transport.RoundTrip(request)
+	assert.EqualError(t, err, "unexpected request DELETE /api/v2/roles/rol_1: all the 2 recorded requests were already made")
+}
+
+func TestReplayFixturesFlag(t *testing.T) {
+	recordedAt := time.Date(2024, time.May, 1, 14, 0, 0, 0, time.UTC)
+	recording := sessionRecording{
+		Command:    "auth0 roles show",
+		Tenant:     fixtureTenant,
+		RecordedAt: recordedAt,
+		Requests: []*recordedRequest{
+			{
+				Method:     http.MethodGet,
+				Path:       "/api/v2/roles/rol_1",
+				StatusCode: http.StatusOK,
+				Response:   json.RawMessage(`{"id":"rol_1","name":"Admin","description":"Administrators"}`),
+			},
+		},
+	}
+	data, err := json.Marshal(recording)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	result := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: result},
+	}
+
+	cmd := buildCommandTree(cli)
+	cmd.SetArgs([]string{"roles", "show", "rol_1", "--json", "--no-input", "--replay-fixtures", path})
+	cmd.SetOut(io.Discard)
+
+	require.NoError(t, cmd.Execute())
+	assert.JSONEq(t, `{"id":"rol_1","name":"Admin","description":"Administrators"}`, result.String())
+	assert.Equal(t, recordedAt, cli.now(), "the clock is frozen at the time of the recording")
+}
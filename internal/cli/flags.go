@@ -146,6 +146,18 @@ func (f *Flag) RegisterBoolU(cmd *cobra.Command, value *bool, defaultValue bool)
 	registerBool(cmd, f, value, defaultValue, true)
 }
 
+// RegisterCompletions completes the value of the flag with the given options in the shell.
+func (f *Flag) RegisterCompletions(cmd *cobra.Command, options []string) {
+	f.RegisterCompletionFunc(cmd, cobra.FixedCompletions(options, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// RegisterCompletionFunc completes the value of the flag with the given function in the shell.
+func (f *Flag) RegisterCompletionFunc(cmd *cobra.Command, fn completionFunc) {
+	if err := cmd.RegisterFlagCompletionFunc(f.LongForm, fn); err != nil {
+		panic(auth0.Error(err, "failed to register flag completion"))
+	}
+}
+
 func askFlag(cmd *cobra.Command, f *Flag, value interface{}, defaultValue *string, isUpdate bool) error {
 	if shouldAsk(cmd, f, isUpdate) {
 		return ask(f, value, defaultValue, isUpdate)
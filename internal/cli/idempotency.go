@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"golang.org/x/exp/slices"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+const (
+	idempotencyKeyName       = "name"
+	idempotencyKeyIdentifier = "identifier"
+)
+
+var idempotentBy = Flag{
+	Name:     "Idempotent By",
+	LongForm: "idempotent-by",
+	Help: "Look for an existing resource with the same value of this field before creating one, " +
+		"and return it instead of creating a duplicate. Makes re-running the command safe, such as within CI pipelines.",
+}
+
+// validateIdempotencyKey checks that the resource can be looked up by the given key, if any.
+func validateIdempotencyKey(key string, supported ...string) error {
+	if key == "" || slices.Contains(supported, key) {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"unsupported value %q for --%s, possible values: %s",
+		key,
+		idempotentBy.LongForm,
+		strings.Join(supported, ", "),
+	)
+}
+
+// findAppByName returns the application with the given name, or nil if there's none.
+func findAppByName(ctx context.Context, api *auth0.API, name string) (*management.Client, error) {
+	var matches []*management.Client
+
+	for page := 0; ; page++ {
+		list, err := api.Client.List(
+			ctx,
+			management.Page(page),
+			management.PerPage(defaultPageSize),
+			management.Parameter("is_global", "false"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look for an application named %q: %w", name, err)
+		}
+
+		for _, client := range list.Clients {
+			if client.GetName() == name {
+				matches = append(matches, client)
+			}
+		}
+
+		if !list.HasNext() {
+			break
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf(
+			"found %d applications named %q, so the existing one can't be told apart, rename them to be unique",
+			len(matches),
+			name,
+		)
+	}
+}
+
+// findAPIByKey returns the API with the same name or identifier, or nil if there's none.
+func findAPIByKey(ctx context.Context, api *auth0.API, key, name, identifier string) (*management.ResourceServer, error) {
+	if key == idempotencyKeyIdentifier {
+		resourceServer, err := api.ResourceServer.Read(ctx, identifier)
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look for an API with identifier %q: %w", identifier, err)
+		}
+
+		return resourceServer, nil
+	}
+
+	var matches []*management.ResourceServer
+
+	for page := 0; ; page++ {
+		list, err := api.ResourceServer.List(ctx, management.PerPage(defaultPageSize), management.Page(page))
+		if err != nil {
+			return nil, fmt.Errorf("failed to look for an API named %q: %w", name, err)
+		}
+
+		for _, resourceServer := range list.ResourceServers {
+			if resourceServer.GetName() == name {
+				matches = append(matches, resourceServer)
+			}
+		}
+
+		if !list.HasNext() {
+			break
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf(
+			"found %d APIs named %q, so the existing one can't be told apart, use --%s %s instead",
+			len(matches),
+			name,
+			idempotentBy.LongForm,
+			idempotencyKeyIdentifier,
+		)
+	}
+}
+
+// findOrganizationByName returns the organization with the given name, or nil if there's none.
+func findOrganizationByName(ctx context.Context, api *auth0.API, name string) (*management.Organization, error) {
+	organization, err := api.Organization.ReadByName(ctx, name)
+	if isNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look for an organization named %q: %w", name, err)
+	}
+
+	return organization, nil
+}
+
+func isNotFoundError(err error) bool {
+	var mErr management.Error
+	return errors.As(err, &mErr) && mErr.Status() == http.StatusNotFound
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	assert.NoError(t, validateIdempotencyKey("", idempotencyKeyName))
+	assert.NoError(t, validateIdempotencyKey("identifier", idempotencyKeyName, idempotencyKeyIdentifier))
+	assert.EqualError(
+		t,
+		validateIdempotencyKey("identifier", idempotencyKeyName),
+		`unsupported value "identifier" for --idempotent-by, possible values: name`,
+	)
+}
+
+func TestFindAppByName(t *testing.T) {
+	t.Run("it looks through all the pages of applications", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientAPI := mock.NewMockClientAPI(ctrl)
+		gomock.InOrder(
+			clientAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&management.ClientList{
+					List:    management.List{Start: 0, Limit: 1, Total: 2},
+					Clients: []*management.Client{{ClientID: auth0.String("1"), Name: auth0.String("Other App")}},
+				}, nil),
+			clientAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&management.ClientList{
+					List:    management.List{Start: 1, Limit: 1, Total: 2},
+					Clients: []*management.Client{{ClientID: auth0.String("2"), Name: auth0.String("My App")}},
+				}, nil),
+		)
+
+		client, err := findAppByName(context.Background(), &auth0.API{Client: clientAPI}, "My App")
+		require.NoError(t, err)
+		assert.Equal(t, "2", client.GetClientID())
+	})
+
+	t.Run("it returns nothing when there's no application with the name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientAPI := mock.NewMockClientAPI(ctrl)
+		clientAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&management.ClientList{Clients: []*management.Client{{Name: auth0.String("Other App")}}}, nil)
+
+		client, err := findAppByName(context.Background(), &auth0.API{Client: clientAPI}, "My App")
+		require.NoError(t, err)
+		assert.Nil(t, client)
+	})
+
+	t.Run("it fails when several applications have the name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientAPI := mock.NewMockClientAPI(ctrl)
+		clientAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&management.ClientList{
+				Clients: []*management.Client{{Name: auth0.String("My App")}, {Name: auth0.String("My App")}},
+			}, nil)
+
+		_, err := findAppByName(context.Background(), &auth0.API{Client: clientAPI}, "My App")
+		assert.EqualError(
+			t,
+			err,
+			`found 2 applications named "My App", so the existing one can't be told apart, rename them to be unique`,
+		)
+	})
+}
+
+func TestFindAPIByKey(t *testing.T) {
+	t.Run("it reads the api by its identifier", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceServerAPI := mock.NewMockResourceServerAPI(ctrl)
+		resourceServerAPI.EXPECT().
+			Read(gomock.Any(), "https://my-api").
+			Return(&management.ResourceServer{Identifier: auth0.String("https://my-api")}, nil)
+
+		api, err := findAPIByKey(context.Background(), &auth0.API{ResourceServer: resourceServerAPI}, "identifier", "My API", "https://my-api")
+		require.NoError(t, err)
+		assert.Equal(t, "https://my-api", api.GetIdentifier())
+	})
+
+	t.Run("it returns nothing when there's no api with the identifier", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceServerAPI := mock.NewMockResourceServerAPI(ctrl)
+		resourceServerAPI.EXPECT().
+			Read(gomock.Any(), "https://my-api").
+			Return(nil, mockManagamentError{error: errors.New("not found"), status: http.StatusNotFound})
+
+		api, err := findAPIByKey(context.Background(), &auth0.API{ResourceServer: resourceServerAPI}, "identifier", "My API", "https://my-api")
+		require.NoError(t, err)
+		assert.Nil(t, api)
+	})
+
+	t.Run("it looks for the api by its name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceServerAPI := mock.NewMockResourceServerAPI(ctrl)
+		resourceServerAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&management.ResourceServerList{
+				ResourceServers: []*management.ResourceServer{
+					{Name: auth0.String("Other API"), Identifier: auth0.String("https://other-api")},
+					{Name: auth0.String("My API"), Identifier: auth0.String("https://my-api")},
+				},
+			}, nil)
+
+		api, err := findAPIByKey(context.Background(), &auth0.API{ResourceServer: resourceServerAPI}, "name", "My API", "https://new-api")
+		require.NoError(t, err)
+		assert.Equal(t, "https://my-api", api.GetIdentifier())
+	})
+}
+
+func TestFindOrganizationByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		ReadByName(gomock.Any(), "my-org").
+		Return(&management.Organization{ID: auth0.String("org_123")}, nil)
+	organizationAPI.EXPECT().
+		ReadByName(gomock.Any(), "missing-org").
+		Return(nil, mockManagamentError{error: errors.New("not found"), status: http.StatusNotFound})
+	organizationAPI.EXPECT().
+		ReadByName(gomock.Any(), "broken-org").
+		Return(nil, errors.New("unexpected error"))
+
+	api := &auth0.API{Organization: organizationAPI}
+
+	organization, err := findOrganizationByName(context.Background(), api, "my-org")
+	require.NoError(t, err)
+	assert.Equal(t, "org_123", organization.GetID())
+
+	organization, err = findOrganizationByName(context.Background(), api, "missing-org")
+	require.NoError(t, err)
+	assert.Nil(t, organization)
+
+	_, err = findOrganizationByName(context.Background(), api, "broken-org")
+	assert.EqualError(t, err, `failed to look for an organization named "broken-org": unexpected error`)
+}
@@ -0,0 +1,320 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const (
+	initCreateApp   = "Create a new application"
+	initExistingApp = "Use an existing application"
+)
+
+func initCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Args:  cobra.NoArgs,
+		Short: "Set up your tenant step by step",
+		Long: "Walk through setting up a tenant for a new project in a single guided flow.\n\n" +
+			"The wizard authenticates the CLI, then lets you create or choose the application to sign in with, " +
+			"set its callback URLs, create an API for it to call and download a Quickstart sample " +
+			"already configured for it.\n\n" +
+			"Each step can be done separately as well, through `auth0 login`, `auth0 apps create`, " +
+			"`auth0 apps update`, `auth0 apis create` and `auth0 quickstarts download`.",
+		Example: `  auth0 init
+  auth0 init --tenant "example.us.auth0.com"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !canPrompt(cmd) {
+				return fmt.Errorf("the init command is interactive, please run it within a terminal and without --no-input")
+			}
+
+			cli.renderer.Output(
+				fmt.Sprintf(
+					"%s\n\n%s\n%s\n\n",
+					ansi.Bold("✪ Welcome to the Auth0 CLI 🎊"),
+					"Let's set up your tenant: log in, choose an application and an API,",
+					"configure the callback URLs and download a Quickstart sample.",
+				),
+			)
+
+			ctx := cmd.Context()
+
+			if err := initTenant(ctx, cmd, cli); err != nil {
+				return err
+			}
+
+			client, err := initApp(ctx, cmd, cli)
+			if err != nil {
+				return err
+			}
+
+			if err := initAppURLs(ctx, cmd, cli, client); err != nil {
+				return err
+			}
+
+			api, err := initAPI(ctx, cmd, cli, client)
+			if err != nil {
+				return err
+			}
+
+			if prompt.Confirm("Do you want to download a Quickstart sample for your application?") {
+				if err := downloadQuickstart(cli, &qsInputs{})(cmd, []string{client.GetClientID()}); err != nil {
+					return err
+				}
+			}
+
+			cli.renderer.Newline()
+			cli.renderer.Infof("Your tenant is set up: %s", cli.tenant)
+			cli.renderer.Infof("Application: %s %s", client.GetName(), ansi.Faint("("+client.GetClientID()+")"))
+			if api != nil {
+				cli.renderer.Infof("API: %s %s", api.GetName(), ansi.Faint("("+api.GetIdentifier()+")"))
+			}
+			cli.renderer.Infof(
+				"%s Try logging in to your application with `auth0 test login %s`",
+				ansi.Faint("Hint:"),
+				client.GetClientID(),
+			)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// initTenant authenticates the CLI, reusing the tenant it's already logged
+// in to when confirmed, and sets up the Management API client.
+func initTenant(ctx context.Context, cmd *cobra.Command, cli *cli) error {
+	useCurrentTenant := false
+	if err := cli.Config.Validate(); err == nil && cli.tenant != "" {
+		message := fmt.Sprintf("You're logged in to %s. Do you want to set up this tenant?", cli.tenant)
+		useCurrentTenant = prompt.Confirm(message)
+	}
+
+	if !useCurrentTenant {
+		// Creating the client grant of the application requires a scope that isn't requested by default.
+		scopes := (&LoginInputs{AdditionalScopes: requiredScopesByCommand[cmd.CommandPath()]}).scopesToRequest()
+
+		tenant, err := RunLoginAsUser(ctx, cli, scopes, "", false)
+		if err != nil {
+			return fmt.Errorf("failed to start the authentication process: %w", err)
+		}
+
+		// The tenant is left empty when not made the default one.
+		if tenant.Domain != "" {
+			cli.tenant = tenant.Domain
+		}
+	}
+
+	if err := cli.setupWithAuthentication(ctx); err != nil {
+		return err
+	}
+
+	tenant, err := cli.Config.GetTenant(cli.tenant)
+	if err != nil {
+		return err
+	}
+
+	if err := checkReadOnlyMode(cmd.CommandPath(), tenant, cli.readOnly); err != nil {
+		return err
+	}
+
+	return checkRequiredScopes(cmd.CommandPath(), tenant)
+}
+
+// initApp creates the application to set up, or picks an existing one.
+func initApp(ctx context.Context, cmd *cobra.Command, cli *cli) (*management.Client, error) {
+	var choice string
+	input := prompt.SelectInput(
+		"",
+		"Which application do you want to set up?",
+		"",
+		[]string{initCreateApp, initExistingApp},
+		initCreateApp,
+		true,
+	)
+	if err := prompt.AskOne(input, &choice); err != nil {
+		return nil, handleInputError(err)
+	}
+
+	if choice == initExistingApp {
+		var id string
+		if err := appID.Pick(cmd, &id, cli.appPickerOptions()); err != nil {
+			return nil, err
+		}
+
+		var client *management.Client
+		if err := ansi.Waiting(func() (err error) {
+			client, err = cli.api.Client.Read(ctx, id)
+			return err
+		}); err != nil {
+			return nil, fmt.Errorf("failed to read application with ID %q: %w", id, err)
+		}
+
+		return client, nil
+	}
+
+	var name, appTypeName string
+	if err := appName.Ask(cmd, &name, nil); err != nil {
+		return nil, err
+	}
+
+	if err := appType.Select(cmd, &appTypeName, appTypeOptions, nil); err != nil {
+		return nil, err
+	}
+
+	client := &management.Client{
+		Name:                    &name,
+		AppType:                 auth0.String(apiTypeFor(appTypeName)),
+		OIDCConformant:          auth0.Bool(true),
+		JWTConfiguration:        &management.ClientJWTConfiguration{Algorithm: auth0.String("RS256")},
+		TokenEndpointAuthMethod: apiDefaultAuthMethodFor(appTypeName),
+		GrantTypes:              apiDefaultGrantsFor(appTypeName),
+	}
+
+	if err := ansi.Waiting(func() error {
+		return cli.api.Client.Create(ctx, client)
+	}); err != nil {
+		return nil, fmt.Errorf("failed to create application: %w", err)
+	}
+
+	if err := cli.Config.SetDefaultAppIDForTenant(cli.tenant, client.GetClientID()); err != nil {
+		return nil, err
+	}
+
+	cli.renderer.Infof("Application successfully created: %s", client.GetClientID())
+
+	return client, nil
+}
+
+// initAppURLs sets the callback, logout and web origin URLs of the application.
+func initAppURLs(ctx context.Context, cmd *cobra.Command, cli *cli, client *management.Client) error {
+	switch client.GetAppType() {
+	case appTypeNonInteractive:
+		return nil
+	case appTypeNative:
+		// Native apps use custom schemes, so there's no default URL to suggest.
+		if len(client.GetCallbacks()) == 0 && !prompt.Confirm("Do you want to set the callback URLs of your application?") {
+			return nil
+		}
+	}
+
+	update := &management.Client{}
+
+	var callbacks, logoutURLs, webOrigins []string
+	if err := appCallbacks.AskMany(cmd, &callbacks, initURLsDefault(client, client.GetCallbacks())); err != nil {
+		return err
+	}
+	update.Callbacks = &callbacks
+
+	if err := appLogoutURLs.AskMany(cmd, &logoutURLs, initURLsDefault(client, client.GetAllowedLogoutURLs())); err != nil {
+		return err
+	}
+	update.AllowedLogoutURLs = &logoutURLs
+
+	if client.GetAppType() == appTypeSPA {
+		if err := appWebOrigins.AskMany(cmd, &webOrigins, initURLsDefault(client, client.GetWebOrigins())); err != nil {
+			return err
+		}
+		update.WebOrigins = &webOrigins
+		update.AllowedOrigins = &webOrigins
+	}
+
+	if err := ansi.Waiting(func() error {
+		return cli.api.Client.Update(ctx, client.GetClientID(), update)
+	}); err != nil {
+		return fmt.Errorf("failed to update the URLs of the application with ID %q: %w", client.GetClientID(), err)
+	}
+
+	client.Callbacks = update.Callbacks
+	client.AllowedLogoutURLs = update.AllowedLogoutURLs
+	if update.WebOrigins != nil {
+		client.WebOrigins = update.WebOrigins
+		client.AllowedOrigins = update.AllowedOrigins
+	}
+
+	cli.renderer.Infof("Application URLs successfully updated")
+
+	return nil
+}
+
+// initURLsDefault returns the URLs to suggest, which are the
+// current ones if any or the localhost URL for web apps.
+func initURLsDefault(client *management.Client, current []string) *string {
+	if len(current) > 0 {
+		return auth0.String(strings.Join(current, ","))
+	}
+
+	if client.GetAppType() == appTypeNative {
+		return nil
+	}
+
+	return auth0.String(appDefaultURL)
+}
+
+// initAPI optionally creates the API for the application to call, and
+// authorizes the application to call it when it's a machine to machine one.
+func initAPI(
+	ctx context.Context,
+	cmd *cobra.Command,
+	cli *cli,
+	client *management.Client,
+) (*management.ResourceServer, error) {
+	if !prompt.Confirm("Do you want to create an API for your application to call?") {
+		return nil, nil
+	}
+
+	var name, identifier string
+	if err := apiName.Ask(cmd, &name, nil); err != nil {
+		return nil, err
+	}
+
+	if err := apiIdentifier.Ask(cmd, &identifier, nil); err != nil {
+		return nil, err
+	}
+
+	api := &management.ResourceServer{
+		Name:             &name,
+		Identifier:       &identifier,
+		TokenLifetime:    auth0.Int(apiDefaultTokenLifetime),
+		SigningAlgorithm: auth0.String("RS256"),
+	}
+
+	if err := ansi.Waiting(func() error {
+		return cli.api.ResourceServer.Create(ctx, api)
+	}); err != nil {
+		return nil, fmt.Errorf("failed to create API with name %q and identifier %q: %w", name, identifier, err)
+	}
+
+	cli.renderer.Infof("API successfully created: %s", api.GetIdentifier())
+
+	if client.GetAppType() != appTypeNonInteractive {
+		return api, nil
+	}
+
+	grant := &management.ClientGrant{
+		ClientID: client.ClientID,
+		Audience: api.Identifier,
+		Scope:    &[]string{},
+	}
+
+	if err := ansi.Waiting(func() error {
+		return cli.api.ClientGrant.Create(ctx, grant)
+	}); err != nil {
+		return nil, fmt.Errorf("failed to authorize the application to call the API %q: %w", identifier, err)
+	}
+
+	cli.renderer.Infof("Application successfully authorized to call the API")
+
+	return api, nil
+}
@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+func TestInitURLsDefault(t *testing.T) {
+	spa := &management.Client{AppType: auth0.String(appTypeSPA)}
+	native := &management.Client{AppType: auth0.String(appTypeNative)}
+
+	assert.Equal(t, auth0.String(appDefaultURL), initURLsDefault(spa, nil))
+	assert.Equal(
+		t,
+		auth0.String("https://example.com/callback,http://localhost:3000"),
+		initURLsDefault(spa, []string{"https://example.com/callback", "http://localhost:3000"}),
+	)
+	assert.Nil(t, initURLsDefault(native, nil))
+	assert.Equal(t, auth0.String("myapp://callback"), initURLsDefault(native, []string{"myapp://callback"}))
+}
@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/exec"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// listenMaxBodySize is the largest payload accepted, log streams sending batches of up to a few MB.
+const listenMaxBodySize = 10 << 20
+
+var (
+	listenPort = Flag{
+		Name:      "Port",
+		LongForm:  "port",
+		ShortForm: "p",
+		Help:      "Port to listen on for webhook and log stream payloads.",
+	}
+
+	listenAuthorization = Flag{
+		Name:      "Authorization",
+		LongForm:  "authorization",
+		ShortForm: "a",
+		Help: "Only accept requests sending this value within their \"Authorization\" header, " +
+			"such as the one configured on a Custom Webhook log stream.",
+	}
+
+	listenTunnel = Flag{
+		Name:     "Tunnel",
+		LongForm: "tunnel",
+		Help: "Expose the server on a public URL through a Cloudflare quick tunnel, " +
+			"for Auth0 to be able to reach it. Requires cloudflared to be installed.",
+	}
+
+	tunnelURLPattern = regexp.MustCompile(`https://[a-z0-9-]+\.trycloudflare\.com`)
+)
+
+func listenCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Port          int
+		Authorization string
+		Tunnel        bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "listen",
+		Args:  cobra.NoArgs,
+		Short: "Receive webhooks and log stream events locally",
+		Long: "Run a local HTTP server that prints the webhook and log stream payloads it receives, " +
+			"to develop integrations against real events.\n\n" +
+			"The JSON payloads get pretty printed, and the logs sent by log streams get summarized. " +
+			"Use `--json` to print a payload per line instead, to pipe them to other commands.\n\n" +
+			"Auth0 needs a public URL to send the events to, use `--tunnel` to expose the server " +
+			"through a Cloudflare quick tunnel, then point a Custom Webhook log stream to it with " +
+			"`auth0 logs streams create http --endpoint <url>`.",
+		Example: `  auth0 listen
+  auth0 listen --port 8080
+  auth0 listen --port 8080 --tunnel
+  auth0 listen --port 8080 --tunnel --authorization "Bearer my-secret"
+  auth0 listen -p 8080 -a "Bearer my-secret" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", inputs.Port))
+			if err != nil {
+				return fmt.Errorf("failed to listen on port %d: %w", inputs.Port, err)
+			}
+			defer func() {
+				_ = listener.Close()
+			}()
+
+			server := &http.Server{
+				Handler: &webhookListener{
+					renderer:      cli.renderer,
+					authorization: inputs.Authorization,
+				},
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			errChan := make(chan error, 1)
+			go func() {
+				errChan <- server.Serve(listener)
+			}()
+
+			localURL := fmt.Sprintf("http://localhost:%d", inputs.Port)
+			cli.renderer.Infof("Listening for webhooks on %s", ansi.Bold(localURL))
+
+			if inputs.Tunnel {
+				tunnelURL, err := startTunnel(ctx, localURL)
+				if err != nil {
+					_ = server.Close()
+					return err
+				}
+
+				cli.renderer.Infof("Forwarding from %s", ansi.Bold(tunnelURL))
+				cli.renderer.Infof(
+					"%s Send the logs of your tenant here with `auth0 logs streams create http --endpoint %s`",
+					ansi.Faint("Hint:"),
+					tunnelURL,
+				)
+			}
+
+			cli.renderer.Infof("Press Ctrl+C to stop.")
+
+			select {
+			case err := <-errChan:
+				return err
+			case <-ctx.Done():
+				return server.Close()
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	listenPort.RegisterInt(cmd, &inputs.Port, 8080)
+	listenAuthorization.RegisterString(cmd, &inputs.Authorization, "")
+	listenTunnel.RegisterBool(cmd, &inputs.Tunnel, false)
+
+	return cmd
+}
+
+// webhookListener prints the requests it receives.
+type webhookListener struct {
+	renderer      *display.Renderer
+	authorization string
+
+	// mu keeps the output of the requests received concurrently from interleaving.
+	mu sync.Mutex
+}
+
+func (l *webhookListener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if l.authorization != "" && r.Header.Get("Authorization") != l.authorization {
+		l.renderer.Warnf("Rejected %s %s: the Authorization header doesn't match.", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, listenMaxBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	l.mu.Lock()
+	l.renderer.WebhookRequest(time.Now(), r.Method, r.URL.RequestURI(), parseWebhookPayloads(body), body)
+	l.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// parseWebhookPayloads splits the body into its JSON payloads, which log streams
+// send as a JSON array, as JSON lines or as a single JSON object depending on their
+// content format. It returns nil when the body isn't JSON.
+func parseWebhookPayloads(body []byte) []json.RawMessage {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil
+	}
+
+	if body[0] == '[' {
+		var payloads []json.RawMessage
+		if err := json.Unmarshal(body, &payloads); err == nil {
+			return payloads
+		}
+	}
+
+	var payloads []json.RawMessage
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	for {
+		var payload json.RawMessage
+		err := decoder.Decode(&payload)
+		if errors.Is(err, io.EOF) {
+			return payloads
+		}
+		if err != nil {
+			return nil
+		}
+		payloads = append(payloads, payload)
+	}
+}
+
+// startTunnel exposes the local URL through a Cloudflare quick tunnel, which
+// runs until the context is done, and returns the public URL of the tunnel.
+func startTunnel(ctx context.Context, localURL string) (string, error) {
+	cloudflared, err := exec.LookPath("cloudflared")
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to find cloudflared, install it to expose the server through a tunnel: " +
+				"https://developers.cloudflare.com/cloudflare-one/connections/connect-networks/downloads",
+		)
+	}
+
+	cmd := exec.CommandContext(ctx, cloudflared, "tunnel", "--no-autoupdate", "--url", localURL)
+
+	// The URL of the tunnel gets logged once it's ready.
+	logs, err := cmd.StderrPipe()
+	if err != nil {
+		return "", err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return "", fmt.Errorf("failed to start the tunnel: %w", err)
+	}
+
+	var tunnelURL string
+	if err := ansi.Spinner("Starting the tunnel", func() (err error) {
+		tunnelURL, err = tunnelURLFrom(logs, 30*time.Second)
+		return err
+	}); err != nil {
+		_ = cmd.Process.Kill()
+		return "", err
+	}
+
+	// Keep draining the logs for cloudflared not to block on writing them.
+	go func() {
+		_, _ = io.Copy(io.Discard, logs)
+		_ = cmd.Wait()
+	}()
+
+	return tunnelURL, nil
+}
+
+// tunnelURLFrom waits for the public URL of the tunnel to show up within its logs.
+func tunnelURLFrom(logs io.Reader, timeout time.Duration) (string, error) {
+	urlChan := make(chan string, 1)
+
+	go func() {
+		defer close(urlChan)
+
+		scanner := bufio.NewScanner(logs)
+		for scanner.Scan() {
+			if tunnelURL := tunnelURLPattern.FindString(scanner.Text()); tunnelURL != "" {
+				urlChan <- tunnelURL
+				return
+			}
+		}
+	}()
+
+	select {
+	case tunnelURL, ok := <-urlChan:
+		if !ok {
+			return "", fmt.Errorf("the tunnel stopped before providing its URL")
+		}
+		return tunnelURL, nil
+	case <-time.After(timeout):
+		return "", fmt.Errorf("timed out waiting for the tunnel to start")
+	}
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestParseWebhookPayloads(t *testing.T) {
+	var tests = []struct {
+		name     string
+		body     string
+		expected []json.RawMessage
+	}{
+		{
+			name:     "it parses a json array",
+			body:     `[{"log_id":"1"},{"log_id":"2"}]`,
+			expected: []json.RawMessage{json.RawMessage(`{"log_id":"1"}`), json.RawMessage(`{"log_id":"2"}`)},
+		},
+		{
+			name:     "it parses json lines",
+			body:     "{\"log_id\":\"1\"}\n{\"log_id\":\"2\"}\n",
+			expected: []json.RawMessage{json.RawMessage(`{"log_id":"1"}`), json.RawMessage(`{"log_id":"2"}`)},
+		},
+		{
+			name:     "it parses a json object",
+			body:     ` {"event":"user.created"} `,
+			expected: []json.RawMessage{json.RawMessage(`{"event":"user.created"}`)},
+		},
+		{
+			name:     "it returns nothing for an empty body",
+			body:     "  ",
+			expected: nil,
+		},
+		{
+			name:     "it returns nothing when the body isn't json",
+			body:     "hello=world",
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseWebhookPayloads([]byte(test.body)))
+		})
+	}
+}
+
+func TestWebhookListener(t *testing.T) {
+	t.Run("it prints the payloads received", func(t *testing.T) {
+		var output, messages bytes.Buffer
+		listener := &webhookListener{
+			renderer: &display.Renderer{ResultWriter: &output, MessageWriter: &messages},
+		}
+
+		body := `[{"log_id":"1","data":{"type":"s","description":"Successful login","client_name":"My App"}}]`
+		request := httptest.NewRequest(http.MethodPost, "/logs?source=auth0", strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		listener.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, messages.String(), "POST /logs?source=auth0")
+		assert.Contains(t, messages.String(), "Successful login")
+		assert.Contains(t, messages.String(), "My App")
+		assert.Contains(t, output.String(), `"log_id": "1"`)
+	})
+
+	t.Run("it prints a payload per line when outputting json", func(t *testing.T) {
+		var output bytes.Buffer
+		listener := &webhookListener{
+			renderer: &display.Renderer{ResultWriter: &output, MessageWriter: io.Discard, Format: display.OutputFormatJSON},
+		}
+
+		body := "{\"id\": 1}\n{\"id\": 2}"
+		listener.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+		assert.Equal(t, "{\"id\":1}\n{\"id\":2}\n", output.String())
+	})
+
+	t.Run("it rejects requests without the expected authorization", func(t *testing.T) {
+		var output, messages bytes.Buffer
+		listener := &webhookListener{
+			renderer:      &display.Renderer{ResultWriter: &output, MessageWriter: &messages},
+			authorization: "Bearer secret",
+		}
+
+		request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+		request.Header.Set("Authorization", "Bearer wrong")
+		recorder := httptest.NewRecorder()
+
+		listener.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Empty(t, output.String())
+		assert.Contains(t, messages.String(), "the Authorization header doesn't match")
+	})
+}
+
+func TestTunnelURLFrom(t *testing.T) {
+	t.Run("it finds the url of the tunnel within its logs", func(t *testing.T) {
+		logs := strings.NewReader(
+			"INF Requesting new quick Tunnel on trycloudflare.com...\n" +
+				"INF |  https://some-random-words.trycloudflare.com  |\n",
+		)
+
+		tunnelURL, err := tunnelURLFrom(logs, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "https://some-random-words.trycloudflare.com", tunnelURL)
+	})
+
+	t.Run("it fails when the tunnel stops before providing its url", func(t *testing.T) {
+		_, err := tunnelURLFrom(strings.NewReader("ERR failed to request quick Tunnel\n"), time.Second)
+		assert.EqualError(t, err, "the tunnel stopped before providing its URL")
+	})
+}
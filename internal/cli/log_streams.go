@@ -53,6 +53,7 @@ func logStreamsCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(updateLogStreamCmd(cli))
 	cmd.AddCommand(deleteLogStreamCmd(cli))
 	cmd.AddCommand(openLogStreamsCmd(cli))
+	cmd.AddCommand(replayLogStreamCmd(cli))
 
 	return cmd
 }
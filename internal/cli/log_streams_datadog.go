@@ -89,6 +89,7 @@ func createLogStreamsDatadogCmd(cli *cli) *cobra.Command {
 	logStreamName.RegisterString(cmd, &inputs.Name, "")
 	datadogAPIKey.RegisterString(cmd, &inputs.DatadogAPIKey, "")
 	datadogRegion.RegisterString(cmd, &inputs.DatadogRegion, "")
+	datadogRegion.RegisterCompletions(cmd, datadogRegionOptions)
 
 	return cmd
 }
@@ -180,6 +181,7 @@ func updateLogStreamsDatadogCmd(cli *cli) *cobra.Command {
 	logStreamName.RegisterStringU(cmd, &inputs.Name, "")
 	datadogAPIKey.RegisterStringU(cmd, &inputs.DatadogAPIKey, "")
 	datadogRegion.RegisterStringU(cmd, &inputs.DatadogRegion, "")
+	datadogRegion.RegisterCompletions(cmd, datadogRegionOptions)
 
 	return cmd
 }
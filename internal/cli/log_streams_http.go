@@ -125,6 +125,7 @@ func createLogStreamsCustomWebhookCmd(cli *cli) *cobra.Command {
 	httpEndpoint.RegisterString(cmd, &inputs.HTTPEndpoint, "")
 	httpContentType.RegisterString(cmd, &inputs.HTTPContentType, "")
 	httpContentFormat.RegisterString(cmd, &inputs.HTTPContentFormat, "")
+	httpContentFormat.RegisterCompletions(cmd, httpContentFormatOptions)
 	httpAuthorization.RegisterString(cmd, &inputs.HTTPAuthorization, "")
 
 	return cmd
@@ -233,6 +234,7 @@ func updateLogStreamsCustomWebhookCmd(cli *cli) *cobra.Command {
 	httpEndpoint.RegisterStringU(cmd, &inputs.HTTPEndpoint, "")
 	httpContentType.RegisterStringU(cmd, &inputs.HTTPContentType, "")
 	httpContentFormat.RegisterStringU(cmd, &inputs.HTTPContentFormat, "")
+	httpContentFormat.RegisterCompletions(cmd, httpContentFormatOptions)
 	httpAuthorization.RegisterStringU(cmd, &inputs.HTTPAuthorization, "")
 
 	return cmd
@@ -0,0 +1,452 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var (
+	logStreamReplayFrom = Flag{
+		Name:     "From",
+		LongForm: "from",
+		Help: "Replay the logs since this time, either as an RFC 3339 timestamp such as \"2024-05-01T14:00:00Z\" " +
+			"or as how long ago, such as \"12h\" or \"2d\".",
+		IsRequired: true,
+	}
+
+	logStreamReplayTo = Flag{
+		Name:     "To",
+		LongForm: "to",
+		Help: "Replay the logs until this time, either as an RFC 3339 timestamp or as how long ago. " +
+			"Defaults to now.",
+	}
+)
+
+// logStreamReplayTimeout is how long a request to the sink of a log stream may take.
+const logStreamReplayTimeout = 30 * time.Second
+
+func replayLogStreamCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID   string
+		From string
+		To   string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Replay the retained logs to a log stream",
+		Long: "Re-deliver the logs retained by the tenant to the sink of a log stream, such as to fill the gaps " +
+			"left by an outage of the sink.\n\n" +
+			"The logs are read from the Management API and sent by the CLI to the sink in the same format as the " +
+			"log stream sends them. Only the Custom Webhook, Datadog, Splunk and Sumo Logic log streams can be " +
+			"replayed to. The filters of the log stream are not applied, every log within the range is delivered, " +
+			"so the sink might receive logs it already received.",
+		Example: `  auth0 logs streams replay <log-stream-id> --from 2024-05-01T14:00:00Z
+  auth0 logs streams replay <log-stream-id> --from 2024-05-01T14:00:00Z --to 2024-05-01T18:00:00Z
+  auth0 logs streams replay <log-stream-id> --from 12h
+  auth0 logs streams replay <log-stream-id> --from 2d --to 1d --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := logStreamID.Pick(cmd, &inputs.ID, cli.allLogStreamsPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			now := cli.now()
+
+			from, err := parseReplayTime(inputs.From, now)
+			if err != nil {
+				return fmt.Errorf("invalid --from: %w", err)
+			}
+
+			to := now
+			if inputs.To != "" {
+				if to, err = parseReplayTime(inputs.To, now); err != nil {
+					return fmt.Errorf("invalid --to: %w", err)
+				}
+			}
+
+			if !from.Before(to) {
+				return fmt.Errorf("the --from time must be before the --to time")
+			}
+
+			var logStream *management.LogStream
+			if err := ansi.Waiting(func() (err error) {
+				logStream, err = cli.api.LogStream.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read log stream with ID %q: %w", inputs.ID, err)
+			}
+
+			sink, err := newLogStreamSink(logStream, &http.Client{Timeout: logStreamReplayTimeout})
+			if err != nil {
+				return err
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				message := fmt.Sprintf(
+					"Are you sure you want to replay the logs from %s to %s to the log stream %q?",
+					from.UTC().Format(time.RFC3339),
+					to.UTC().Format(time.RFC3339),
+					logStream.GetName(),
+				)
+				if confirmed := prompt.Confirm(message); !confirmed {
+					return nil
+				}
+			}
+
+			tenant, err := cli.Config.GetTenant(cli.tenant)
+			if err != nil {
+				return err
+			}
+
+			// A dedicated client keeps track of the rate limit of the Management API, to pace the requests.
+			rateLimits := &rateLimitObserver{}
+			client, err := cli.newManagementClient(tenant, rateLimits.transport)
+			if err != nil {
+				return err
+			}
+
+			replay := &logStreamReplay{
+				api:       auth0.NewAPI(client),
+				sink:      sink,
+				renderer:  cli.renderer,
+				from:      from,
+				to:        to,
+				rateLimit: rateLimits.Latest,
+			}
+
+			count, err := replay.run(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to replay the logs after replaying %d of them: %w", count, err)
+			}
+
+			cli.renderer.Infof(
+				"Successfully replayed %s logs to the log stream %s",
+				ansi.Bold(strconv.Itoa(count)),
+				ansi.Bold(logStream.GetName()),
+			)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	logStreamReplayFrom.RegisterString(cmd, &inputs.From, "")
+	logStreamReplayTo.RegisterString(cmd, &inputs.To, "")
+
+	return cmd
+}
+
+// parseReplayTime parses either an RFC 3339 timestamp or how long before now, such as "12h" or "2d".
+func parseReplayTime(value string, now time.Time) (time.Time, error) {
+	if timestamp, err := time.Parse(time.RFC3339, value); err == nil {
+		return timestamp, nil
+	}
+
+	if ago, ok := parseDurationWithDays(value); ok {
+		return now.Add(-ago), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%q is neither an RFC 3339 timestamp nor a duration such as \"12h\" or \"2d\"", value)
+}
+
+// logStreamReplay pages forwards through the logs within the time range, from the oldest
+// to the latest, and delivers them to the sink of the log stream a page at a time.
+type logStreamReplay struct {
+	api       *auth0.API
+	sink      *logStreamSink
+	renderer  *display.Renderer
+	from      time.Time
+	to        time.Time
+	rateLimit func() *display.RateLimit
+}
+
+func (r *logStreamReplay) run(ctx context.Context) (int, error) {
+	var count int
+	var latestLogID string
+
+	dateRange := fmt.Sprintf("date:[%s TO %s]", formatLogQueryDate(r.from), formatLogQueryDate(r.to))
+
+	for {
+		query := dateRange
+		if latestLogID != "" {
+			query = fmt.Sprintf("%s AND log_id:[%s TO *]", dateRange, latestLogID)
+		}
+
+		list, err := r.api.Log.List(
+			ctx,
+			management.Query(query),
+			management.Parameter("page", "0"),
+			management.Parameter("per_page", strconv.Itoa(logsPerPageLimit)),
+			management.Parameter("sort", "date:1"),
+		)
+		if err != nil {
+			return count, err
+		}
+
+		var logs []*management.Log
+		for _, log := range list {
+			if log.GetLogID() != latestLogID {
+				logs = append(logs, log)
+			}
+		}
+
+		if len(logs) == 0 {
+			return count, nil
+		}
+
+		if err := r.sink.Deliver(ctx, logs); err != nil {
+			return count, err
+		}
+		count += len(logs)
+
+		r.renderer.Infof("Replayed %d logs up to %s", count, logs[len(logs)-1].GetDate().UTC().Format(time.RFC3339))
+
+		latestLogID = logs[len(logs)-1].GetLogID()
+
+		if err := waitForRateLimit(ctx, r.rateLimit()); err != nil {
+			return count, err
+		}
+	}
+}
+
+func formatLogQueryDate(date time.Time) string {
+	return date.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// logStreamEvent is how log streams wrap each log they deliver.
+type logStreamEvent struct {
+	LogID string          `json:"log_id"`
+	Data  *management.Log `json:"data"`
+}
+
+// logStreamSink delivers the logs to the sink of a log stream over HTTP.
+type logStreamSink struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+
+	// encode turns the events into the bodies of the requests delivering them.
+	encode func(events []logStreamEvent) ([][]byte, error)
+}
+
+// newLogStreamSink returns the sink of the log stream, for the types of
+// log streams whose sink the CLI is able to deliver the logs to.
+func newLogStreamSink(logStream *management.LogStream, client *http.Client) (*logStreamSink, error) {
+	switch sink := logStream.Sink.(type) {
+	case *management.LogStreamSinkHTTP:
+		headers := map[string]string{"Content-Type": "application/json"}
+		if sink.GetContentType() != "" {
+			headers["Content-Type"] = sink.GetContentType()
+		}
+		if sink.GetAuthorization() != "" {
+			headers["Authorization"] = sink.GetAuthorization()
+		}
+		for _, customHeader := range sink.GetCustomHeaders() {
+			headers[customHeader["header"]] = customHeader["value"]
+		}
+
+		encode := encodeJSONLines
+		switch sink.GetContentFormat() {
+		case "JSONARRAY":
+			encode = encodeJSONArray
+		case "JSONOBJECT":
+			encode = encodeJSONObjects
+		}
+
+		return &logStreamSink{client: client, url: sink.GetEndpoint(), headers: headers, encode: encode}, nil
+	case *management.LogStreamSinkDatadog:
+		site, ok := datadogSites[sink.GetRegion()]
+		if !ok {
+			return nil, fmt.Errorf("unknown Datadog region %q", sink.GetRegion())
+		}
+
+		return &logStreamSink{
+			client: client,
+			url:    "https://http-intake.logs." + site + "/api/v2/logs",
+			headers: map[string]string{
+				"Content-Type": "application/json",
+				"DD-API-KEY":   sink.GetAPIKey(),
+			},
+			encode: encodeDatadogLogs,
+		}, nil
+	case *management.LogStreamSinkSplunk:
+		scheme := "http"
+		if sink.GetSecure() {
+			scheme = "https"
+		}
+
+		port := sink.GetPort()
+		if port == "" {
+			port = "8088"
+		}
+
+		return &logStreamSink{
+			client: client,
+			url:    fmt.Sprintf("%s://%s:%s/services/collector/event", scheme, sink.GetDomain(), port),
+			headers: map[string]string{
+				"Content-Type":  "application/json",
+				"Authorization": "Splunk " + sink.GetToken(),
+			},
+			encode: encodeSplunkEvents,
+		}, nil
+	case *management.LogStreamSinkSumo:
+		return &logStreamSink{
+			client:  client,
+			url:     sink.GetSourceAddress(),
+			headers: map[string]string{"Content-Type": "application/json"},
+			encode:  encodeJSONLines,
+		}, nil
+	default:
+		return nil, fmt.Errorf("replaying the logs to %q log streams is not supported", logStream.GetType())
+	}
+}
+
+// datadogSites maps the regions of the Datadog log streams to their Datadog site.
+var datadogSites = map[string]string{
+	"us":  "datadoghq.com",
+	"us3": "us3.datadoghq.com",
+	"us5": "us5.datadoghq.com",
+	"eu":  "datadoghq.eu",
+}
+
+// Deliver sends the logs to the sink, failing on the first request the sink doesn't accept.
+func (s *logStreamSink) Deliver(ctx context.Context, logs []*management.Log) error {
+	events := make([]logStreamEvent, 0, len(logs))
+	for _, log := range logs {
+		events = append(events, logStreamEvent{LogID: log.GetLogID(), Data: log})
+	}
+
+	bodies, err := s.encode(events)
+	if err != nil {
+		return err
+	}
+
+	for _, body := range bodies {
+		if err := s.send(ctx, body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *logStreamSink) send(ctx context.Context, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	for key, value := range s.headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("the sink responded with %s: %s", response.Status, strings.TrimSpace(string(message)))
+	}
+
+	return nil
+}
+
+func encodeJSONLines(events []logStreamEvent) ([][]byte, error) {
+	var body bytes.Buffer
+	for _, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		body.Write(line)
+		body.WriteByte('\n')
+	}
+
+	return [][]byte{body.Bytes()}, nil
+}
+
+func encodeJSONArray(events []logStreamEvent) ([][]byte, error) {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]byte{body}, nil
+}
+
+func encodeJSONObjects(events []logStreamEvent) ([][]byte, error) {
+	bodies := make([][]byte, 0, len(events))
+	for _, event := range events {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		bodies = append(bodies, body)
+	}
+
+	return bodies, nil
+}
+
+func encodeDatadogLogs(events []logStreamEvent) ([][]byte, error) {
+	type datadogLog struct {
+		Source  string `json:"ddsource"`
+		Service string `json:"service"`
+		Message string `json:"message"`
+	}
+
+	logs := make([]datadogLog, 0, len(events))
+	for _, event := range events {
+		message, err := json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, datadogLog{Source: "auth0", Service: "auth0", Message: string(message)})
+	}
+
+	body, err := json.Marshal(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]byte{body}, nil
+}
+
+func encodeSplunkEvents(events []logStreamEvent) ([][]byte, error) {
+	var body bytes.Buffer
+	for _, event := range events {
+		line, err := json.Marshal(map[string]interface{}{
+			"time":  event.Data.GetDate().Unix(),
+			"event": event,
+		})
+		if err != nil {
+			return nil, err
+		}
+		body.Write(line)
+		body.WriteByte('\n')
+	}
+
+	return [][]byte{body.Bytes()}, nil
+}
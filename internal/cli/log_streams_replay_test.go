@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestParseReplayTime(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		value    string
+		expected time.Time
+	}{
+		{value: "2024-05-01T14:00:00Z", expected: time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC)},
+		{value: "12h", expected: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)},
+		{value: "2d", expected: time.Date(2024, 4, 30, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			actual, err := parseReplayTime(test.value, now)
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equal(actual), "expected %s, got %s", test.expected, actual)
+		})
+	}
+
+	for _, value := range []string{"", "yesterday", "2024-05-01", "-2d"} {
+		t.Run("it fails to parse "+value, func(t *testing.T) {
+			_, err := parseReplayTime(value, now)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewLogStreamSink(t *testing.T) {
+	t.Run("it delivers to the endpoint of a datadog log stream", func(t *testing.T) {
+		sink, err := newLogStreamSink(&management.LogStream{
+			Type: auth0.String(management.LogStreamTypeDatadog),
+			Sink: &management.LogStreamSinkDatadog{Region: auth0.String("eu"), APIKey: auth0.String("api-key")},
+		}, http.DefaultClient)
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://http-intake.logs.datadoghq.eu/api/v2/logs", sink.url)
+		assert.Equal(t, "api-key", sink.headers["DD-API-KEY"])
+	})
+
+	t.Run("it delivers to the HTTP event collector of a splunk log stream", func(t *testing.T) {
+		sink, err := newLogStreamSink(&management.LogStream{
+			Type: auth0.String(management.LogStreamTypeSplunk),
+			Sink: &management.LogStreamSinkSplunk{
+				Domain: auth0.String("splunk.example.com"),
+				Token:  auth0.String("token"),
+				Port:   auth0.String("8443"),
+				Secure: auth0.Bool(true),
+			},
+		}, http.DefaultClient)
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://splunk.example.com:8443/services/collector/event", sink.url)
+		assert.Equal(t, "Splunk token", sink.headers["Authorization"])
+	})
+
+	t.Run("it fails for log streams the CLI can't deliver to", func(t *testing.T) {
+		_, err := newLogStreamSink(&management.LogStream{
+			Type: auth0.String(management.LogStreamTypeAmazonEventBridge),
+			Sink: &management.LogStreamSinkAmazonEventBridge{},
+		}, http.DefaultClient)
+
+		assert.EqualError(t, err, `replaying the logs to "eventbridge" log streams is not supported`)
+	})
+}
+
+var logStreamEventDataPattern = regexp.MustCompile(`,"data":\{[^}]*\}`)
+
+func TestLogStreamSink_Deliver(t *testing.T) {
+	logs := []*management.Log{
+		{LogID: auth0.String("log-1"), Type: auth0.String("s")},
+		{LogID: auth0.String("log-2"), Type: auth0.String("f")},
+	}
+
+	var tests = []struct {
+		name           string
+		contentFormat  string
+		expectedBodies []string
+	}{
+		{
+			name:           "it delivers the logs as JSON lines",
+			contentFormat:  "JSONLINES",
+			expectedBodies: []string{`{"log_id":"log-1"}` + "\n" + `{"log_id":"log-2"}` + "\n"},
+		},
+		{
+			name:           "it delivers the logs as a JSON array",
+			contentFormat:  "JSONARRAY",
+			expectedBodies: []string{`[{"log_id":"log-1"},{"log_id":"log-2"}]`},
+		},
+		{
+			name:           "it delivers each log as a JSON object",
+			contentFormat:  "JSONOBJECT",
+			expectedBodies: []string{`{"log_id":"log-1"}`, `{"log_id":"log-2"}`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var bodies []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				assert.Equal(t, "value", r.Header.Get("X-Custom"))
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				// Only keep the log IDs of the events, as the logs get delivered with all their fields.
+				bodies = append(bodies, logStreamEventDataPattern.ReplaceAllString(string(body), ""))
+			}))
+			defer server.Close()
+
+			sink, err := newLogStreamSink(&management.LogStream{
+				Type: auth0.String(management.LogStreamTypeHTTP),
+				Sink: &management.LogStreamSinkHTTP{
+					Endpoint:      auth0.String(server.URL),
+					ContentFormat: auth0.String(test.contentFormat),
+					Authorization: auth0.String("Bearer secret"),
+					CustomHeaders: &[]map[string]string{{"header": "X-Custom", "value": "value"}},
+				},
+			}, server.Client())
+			require.NoError(t, err)
+
+			require.NoError(t, sink.Deliver(context.Background(), logs))
+			assert.Equal(t, test.expectedBodies, bodies)
+		})
+	}
+
+	t.Run("it fails when the sink rejects the logs", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("invalid token"))
+		}))
+		defer server.Close()
+
+		sink, err := newLogStreamSink(&management.LogStream{
+			Type: auth0.String(management.LogStreamTypeSumo),
+			Sink: &management.LogStreamSinkSumo{SourceAddress: auth0.String(server.URL)},
+		}, server.Client())
+		require.NoError(t, err)
+
+		err = sink.Deliver(context.Background(), logs)
+		assert.EqualError(t, err, "the sink responded with 401 Unauthorized: invalid token")
+	})
+}
+
+func TestLogStreamReplay(t *testing.T) {
+	t.Run("it pages forwards through the logs and delivers them", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		gomock.InOrder(
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{{LogID: auth0.String("log-1")}, {LogID: auth0.String("log-2")}}, nil),
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{{LogID: auth0.String("log-2")}, {LogID: auth0.String("log-3")}}, nil),
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{{LogID: auth0.String("log-3")}}, nil),
+		)
+
+		var delivered []string
+		replay := &logStreamReplay{
+			api: &auth0.API{Log: logAPI},
+			sink: &logStreamSink{
+				client: http.DefaultClient,
+				encode: func(events []logStreamEvent) ([][]byte, error) {
+					for _, event := range events {
+						delivered = append(delivered, event.LogID)
+					}
+					return nil, nil
+				},
+			},
+			renderer:  &display.Renderer{MessageWriter: &bytes.Buffer{}},
+			from:      time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			to:        time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+			rateLimit: func() *display.RateLimit { return nil },
+		}
+
+		count, err := replay.run(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, count)
+		assert.Equal(t, []string{"log-1", "log-2", "log-3"}, delivered)
+	})
+
+	t.Run("it fails when the logs fail to be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("api error"))
+
+		replay := &logStreamReplay{
+			api:       &auth0.API{Log: logAPI},
+			renderer:  &display.Renderer{MessageWriter: &bytes.Buffer{}},
+			rateLimit: func() *display.RateLimit { return nil },
+		}
+
+		_, err := replay.run(context.Background())
+		assert.EqualError(t, err, "api error")
+	})
+}
@@ -8,12 +8,14 @@ import (
 
 	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 
 	"github.com/auth0/auth0-cli/internal/ansi"
 	"github.com/auth0/auth0-cli/internal/auth"
 	"github.com/auth0/auth0-cli/internal/config"
 	"github.com/auth0/auth0-cli/internal/keyring"
 	"github.com/auth0/auth0-cli/internal/prompt"
+	"github.com/auth0/auth0-cli/internal/qrcode"
 )
 
 var (
@@ -44,7 +46,23 @@ var (
 	loginAdditionalScopes = Flag{
 		Name:         "Additional Scopes",
 		LongForm:     "scopes",
-		Help:         "Additional scopes to request when authenticating via device code flow. By default, only scopes for first-class functions are requested. Primarily useful when using the api command to execute arbitrary Management API requests.",
+		Help:         "Additional scopes to request when authenticating via device code flow. By default, only scopes for first-class functions are requested. Primarily useful when using the api command to execute arbitrary Management API requests. Wildcards such as read:* are expanded to all the matching scopes.",
+		IsRequired:   false,
+		AlwaysPrompt: false,
+	}
+
+	loginLimitedScopes = Flag{
+		Name:         "Limited Scopes",
+		LongForm:     "limited-scopes",
+		Help:         "Request only the scopes passed through --scopes when authenticating via device code flow, instead of the scopes for first-class functions. Useful to authenticate with least privilege, e.g. read-only access with --scopes \"read:*\".",
+		IsRequired:   false,
+		AlwaysPrompt: false,
+	}
+
+	loginQRCode = Flag{
+		Name:         "QR Code",
+		LongForm:     "qr-code",
+		Help:         "Display the verification URL as a QR code when authenticating via device code flow. Useful when logging in from a headless server, as authentication can be completed from a phone.",
 		IsRequired:   false,
 		AlwaysPrompt: false,
 	}
@@ -55,12 +73,25 @@ type LoginInputs struct {
 	ClientID         string
 	ClientSecret     string
 	AdditionalScopes []string
+	LimitedScopes    bool
+	QRCode           bool
 }
 
 func (i *LoginInputs) isLoggingInWithAdditionalScopes() bool {
 	return len(i.AdditionalScopes) > 0
 }
 
+// scopesToRequest returns the scopes to request through the device code flow.
+// With limited scopes, only the base scopes and the given scopes get requested.
+func (i *LoginInputs) scopesToRequest() []string {
+	scopes := auth.RequiredScopes
+	if i.LimitedScopes {
+		scopes = auth.BaseScopes
+	}
+
+	return auth.ExpandScopes(append(slices.Clone(scopes), i.AdditionalScopes...))
+}
+
 func loginCmd(cli *cli) *cobra.Command {
 	var inputs LoginInputs
 
@@ -74,8 +105,14 @@ func loginCmd(cli *cli) *cobra.Command {
 			"recommended when running on a server or non-interactive environments (ex: CI).",
 		Example: `  auth0 login
   auth0 login --domain <tenant-domain> --client-id <client-id> --client-secret <client-secret>
-  auth0 login --scopes "read:client_grants,create:client_grants"`,
+  auth0 login --scopes "read:client_grants,create:client_grants"
+  auth0 login --scopes "read:*" --limited-scopes
+  auth0 login --qr-code --no-input`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.LimitedScopes && !inputs.isLoggingInWithAdditionalScopes() {
+				return fmt.Errorf("at least one scope needs to be passed through --scopes when using --limited-scopes")
+			}
+
 			var selectedLoginType string
 			const loginAsUser, loginAsMachine = "As a user", "As a machine"
 			shouldLoginAsUser, shouldLoginAsMachine := false, false
@@ -126,8 +163,8 @@ func loginCmd(cli *cli) *cobra.Command {
 				}
 			}
 
-			// If additional scopes are passed we mark shouldLoginAsUser flag to be true.
-			if inputs.isLoggingInWithAdditionalScopes() {
+			// If additional scopes are passed or a QR code is requested we mark shouldLoginAsUser flag to be true.
+			if inputs.isLoggingInWithAdditionalScopes() || inputs.QRCode {
 				shouldLoginAsUser = true
 			}
 
@@ -165,7 +202,7 @@ func loginCmd(cli *cli) *cobra.Command {
 			ctx := cmd.Context()
 
 			if shouldLoginAsUser || selectedLoginType == loginAsUser {
-				if _, err := RunLoginAsUser(ctx, cli, inputs.AdditionalScopes, inputs.Domain); err != nil {
+				if _, err := RunLoginAsUser(ctx, cli, inputs.scopesToRequest(), inputs.Domain, inputs.QRCode); err != nil {
 					return fmt.Errorf("failed to start the authentication process: %w", err)
 				}
 			} else {
@@ -190,8 +227,14 @@ func loginCmd(cli *cli) *cobra.Command {
 	loginClientID.RegisterString(cmd, &inputs.ClientID, "")
 	loginClientSecret.RegisterString(cmd, &inputs.ClientSecret, "")
 	loginAdditionalScopes.RegisterStringSlice(cmd, &inputs.AdditionalScopes, []string{})
+	loginLimitedScopes.RegisterBool(cmd, &inputs.LimitedScopes, false)
+	loginQRCode.RegisterBool(cmd, &inputs.QRCode, false)
 	cmd.MarkFlagsMutuallyExclusive("client-id", "scopes")
 	cmd.MarkFlagsMutuallyExclusive("client-secret", "scopes")
+	cmd.MarkFlagsMutuallyExclusive("client-id", "limited-scopes")
+	cmd.MarkFlagsMutuallyExclusive("client-secret", "limited-scopes")
+	cmd.MarkFlagsMutuallyExclusive("client-id", "qr-code")
+	cmd.MarkFlagsMutuallyExclusive("client-secret", "qr-code")
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		_ = cmd.Flags().MarkHidden("tenant")
@@ -224,13 +267,19 @@ func ensureAuth0URL(input string) (string, error) {
 
 // RunLoginAsUser runs the login flow guiding the user through the process
 // by showing the login instructions, opening the browser.
-func RunLoginAsUser(ctx context.Context, cli *cli, additionalScopes []string, domain string) (config.Tenant, error) {
+func RunLoginAsUser(
+	ctx context.Context,
+	cli *cli,
+	scopes []string,
+	domain string,
+	showQRCode bool,
+) (config.Tenant, error) {
 	domain, err := ensureAuth0URL(domain)
 	if err != nil {
 		return config.Tenant{}, err
 	}
 
-	state, err := auth.GetDeviceCode(ctx, http.DefaultClient, additionalScopes, domain)
+	state, err := auth.GetDeviceCode(ctx, http.DefaultClient, scopes, domain)
 	if err != nil {
 		return config.Tenant{}, fmt.Errorf("failed to get the device code: %w", err)
 	}
@@ -240,6 +289,10 @@ func RunLoginAsUser(ctx context.Context, cli *cli, additionalScopes []string, do
 	)
 	cli.renderer.Output(message)
 
+	if showQRCode {
+		renderVerificationQRCode(cli, state)
+	}
+
 	if cli.noInput {
 		message = "Open the following URL in a browser: %s\n"
 		cli.renderer.Infof(message, ansi.Green(state.VerificationURI))
@@ -254,6 +307,10 @@ func RunLoginAsUser(ctx context.Context, cli *cli, additionalScopes []string, do
 		if err = browser.OpenURL(state.VerificationURI); err != nil {
 			message = "Couldn't open the URL, please do it manually: %s."
 			cli.renderer.Warnf(message, state.VerificationURI)
+
+			if !showQRCode {
+				renderVerificationQRCode(cli, state)
+			}
 		}
 	}
 
@@ -275,8 +332,9 @@ func RunLoginAsUser(ctx context.Context, cli *cli, additionalScopes []string, do
 		Name:      result.Tenant,
 		Domain:    result.Domain,
 		ExpiresAt: result.ExpiresAt,
-		Scopes:    append(auth.RequiredScopes, additionalScopes...),
+		Scopes:    scopes,
 	}
+	tenant.LimitedScopes = !tenant.HasAllRequiredScopes()
 
 	if err := keyring.StoreRefreshToken(result.Domain, result.RefreshToken); err != nil {
 		cli.renderer.Warnf("Could not store the access token and the refresh token to the keyring: %s", err)
@@ -315,6 +373,21 @@ func RunLoginAsUser(ctx context.Context, cli *cli, additionalScopes []string, do
 	return tenant, nil
 }
 
+// renderVerificationQRCode shows the verification URL as a QR code, so the
+// login can be completed from another device without retyping the URL.
+func renderVerificationQRCode(cli *cli, state auth.State) {
+	code, err := qrcode.Encode(state.VerificationURI)
+	if err != nil {
+		cli.renderer.Warnf("Couldn't render the verification URL as a QR code: %s", err)
+		return
+	}
+
+	cli.renderer.Infof("Scan the following QR code to log in from another device and verify the %s code:", ansi.Bold(state.UserCode))
+	cli.renderer.Newline()
+	cli.renderer.Output(code.Render())
+	cli.renderer.Newline()
+}
+
 // RunLoginAsMachine facilitates the authentication process using client credentials (client ID, client secret).
 func RunLoginAsMachine(ctx context.Context, inputs LoginInputs, cli *cli, cmd *cobra.Command) error {
 	if err := loginTenantDomain.Ask(cmd, &inputs.Domain, nil); err != nil {
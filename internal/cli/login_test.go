@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+
+	"github.com/auth0/auth0-cli/internal/auth"
 )
 
 func TestLoginCommand(t *testing.T) {
@@ -52,4 +55,32 @@ func TestLoginCommand(t *testing.T) {
 		err := cmd.Execute()
 		assert.EqualError(t, err, "flags client-id, client-secret and domain are required together")
 	})
+
+	t.Run("Negative Test: it returns an error since limited-scopes requires scopes to be passed", func(t *testing.T) {
+		cli := &cli{}
+		cli.noInput = true
+		cmd := loginCmd(cli)
+		cmd.SetArgs([]string{"--limited-scopes"})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "at least one scope needs to be passed through --scopes when using --limited-scopes")
+	})
+}
+
+func TestLoginInputs_ScopesToRequest(t *testing.T) {
+	t.Run("it requests the required scopes along with the additional scopes", func(t *testing.T) {
+		inputs := LoginInputs{AdditionalScopes: []string{"read:clients", "create:client_grants"}}
+
+		expected := append(slices.Clone(auth.RequiredScopes), "create:client_grants")
+		assert.Equal(t, expected, inputs.scopesToRequest())
+	})
+
+	t.Run("it requests only the base scopes and the expanded scopes when limited", func(t *testing.T) {
+		inputs := LoginInputs{
+			AdditionalScopes: []string{"read:*clients"},
+			LimitedScopes:    true,
+		}
+
+		expected := []string{"openid", "offline_access", "read:clients"}
+		assert.Equal(t, expected, inputs.scopesToRequest())
+	})
 }
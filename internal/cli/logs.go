@@ -2,12 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"time"
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
 )
 
 // Besides the limitation of 100 log events per request to retrieve logs,
@@ -23,6 +27,21 @@ var (
 		Help:      "Filter in Lucene query syntax. See https://auth0.com/docs/logs/log-search-query-syntax for more details.",
 	}
 
+	logsGrep = Flag{
+		Name:      "Grep",
+		LongForm:  "grep",
+		ShortForm: "g",
+		Help: "Only show the logs matching this regular expression, with the matches highlighted. " +
+			"It's matched client-side against the whole log event, in JSON. Prefix it with (?i) to ignore case.",
+	}
+
+	logsInvert = Flag{
+		Name:      "Invert",
+		LongForm:  "invert",
+		ShortForm: "v",
+		Help:      "Only show the logs not matching the regular expression of --grep.",
+	}
+
 	logsNum = Flag{
 		Name:      "Number of Entries",
 		LongForm:  "number",
@@ -41,6 +60,7 @@ func logsCmd(cli *cli) *cobra.Command {
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(listLogsCmd(cli))
 	cmd.AddCommand(tailLogsCmd(cli))
+	cmd.AddCommand(backfillLogsCmd(cli))
 	cmd.AddCommand(logStreamsCmd(cli))
 
 	return cmd
@@ -52,6 +72,8 @@ func listLogsCmd(cli *cli) *cobra.Command {
 		Num    int
 	}
 
+	var multiTenant multiTenantInputs
+
 	cmd := &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
@@ -67,19 +89,23 @@ func listLogsCmd(cli *cli) *cobra.Command {
   auth0 logs list --filter "type:f" # See the full list of type codes at https://auth0.com/docs/logs/log-event-type-codes
   auth0 logs ls -n 250
   auth0 logs ls --json
-  auth0 logs ls --csv`,
+  auth0 logs ls --csv
+  auth0 logs list --filter "type:f" --all-tenants`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if inputs.Num < 1 || inputs.Num > 1000 {
 				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
 			}
-			list, err := getLatestLogs(cmd.Context(), cli, inputs.Num, inputs.Filter)
-			if err != nil {
-				return fmt.Errorf("failed to list logs: %w", err)
-			}
 
-			hasFilter := inputs.Filter != ""
-			cli.renderer.LogList(list, !cli.debug, hasFilter)
-			return nil
+			return runForTenants(cmd, cli, multiTenant, func(api *auth0.API, renderer *display.Renderer, _ waitingFunc) error {
+				list, err := getLatestLogs(cmd.Context(), api, inputs.Num, inputs.Filter)
+				if err != nil {
+					return fmt.Errorf("failed to list logs: %w", err)
+				}
+
+				hasFilter := inputs.Filter != ""
+				renderer.LogList(list, !cli.debug, hasFilter)
+				return nil
+			})
 		},
 	}
 
@@ -89,6 +115,7 @@ func listLogsCmd(cli *cli) *cobra.Command {
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
 	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+	multiTenant.register(cmd)
 
 	return cmd
 }
@@ -96,6 +123,8 @@ func listLogsCmd(cli *cli) *cobra.Command {
 func tailLogsCmd(cli *cli) *cobra.Command {
 	var inputs struct {
 		Filter string
+		Grep   string
+		Invert bool
 		Num    int
 	}
 
@@ -103,7 +132,9 @@ func tailLogsCmd(cli *cli) *cobra.Command {
 		Use:   "tail",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Tail the tenant logs",
-		Long:  "Tail the tenant logs allowing to filter using Lucene query syntax.",
+		Long: "Tail the tenant logs allowing to filter using Lucene query syntax.\n\n" +
+			"Use `--grep` to narrow the logs down client-side with a regular expression instead, " +
+			"and `--invert` to hide the logs matching it.",
 		Example: `  auth0 logs tail
   auth0 logs tail --filter "client_id:<client-id>"
   auth0 logs tail --filter "client_name:<client-name>"
@@ -111,12 +142,21 @@ func tailLogsCmd(cli *cli) *cobra.Command {
   auth0 logs tail --filter "user_name:<user-name>"
   auth0 logs tail --filter "ip:<ip>"
   auth0 logs tail --filter "type:f" # See the full list of type codes at https://auth0.com/docs/logs/log-event-type-codes
-  auth0 logs tail -n 10`,
+  auth0 logs tail -n 10
+  auth0 logs tail --grep "(?i)password"
+  auth0 logs tail --grep "Success (Login|Logout)"
+  auth0 logs tail --grep "Success Exchange" --invert
+  auth0 logs tail -g "<ip>" -v`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if inputs.Num < 1 || inputs.Num > 1000 {
 				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
 			}
-			list, err := getLatestLogs(cmd.Context(), cli, inputs.Num, inputs.Filter)
+
+			filter, err := logTailFilter(inputs.Grep, inputs.Invert)
+			if err != nil {
+				return err
+			}
+			list, err := getLatestLogs(cmd.Context(), cli.api, inputs.Num, inputs.Filter)
 			if err != nil {
 				return fmt.Errorf("failed to list logs: %w", err)
 			}
@@ -168,18 +208,38 @@ func tailLogsCmd(cli *cli) *cobra.Command {
 				}
 			}(lastLogID)
 
-			cli.renderer.LogTail(list, logsCh, !cli.debug)
+			cli.renderer.LogTail(list, logsCh, !cli.debug, filter)
 			return nil
 		},
 	}
 
 	logsFilter.RegisterString(cmd, &inputs.Filter, "")
+	logsGrep.RegisterString(cmd, &inputs.Grep, "")
+	logsInvert.RegisterBool(cmd, &inputs.Invert, false)
 	logsNum.RegisterInt(cmd, &inputs.Num, defaultPageSize)
 
 	return cmd
 }
 
-func getLatestLogs(ctx context.Context, cli *cli, numRequested int, filter string) ([]*management.Log, error) {
+// logTailFilter compiles the regular expression to filter the tailed logs with, if any.
+func logTailFilter(grep string, invert bool) (*display.LogTailFilter, error) {
+	if grep == "" {
+		if invert {
+			return nil, fmt.Errorf("--%s requires a regular expression to be passed with --%s", logsInvert.LongForm, logsGrep.LongForm)
+		}
+
+		return nil, nil
+	}
+
+	pattern, err := regexp.Compile(grep)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression for --%s: %w", logsGrep.LongForm, err)
+	}
+
+	return &display.LogTailFilter{Pattern: pattern, Invert: invert}, nil
+}
+
+func getLatestLogs(ctx context.Context, api *auth0.API, numRequested int, filter string) ([]*management.Log, error) {
 	page := 0
 	logs := []*management.Log{}
 
@@ -198,7 +258,7 @@ func getLatestLogs(ctx context.Context, cli *cli, numRequested int, filter strin
 			queryParams = append(queryParams, management.Query(filter))
 		}
 
-		res, err := cli.api.Log.List(ctx, queryParams...)
+		res, err := api.Log.List(ctx, queryParams...)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,331 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const logWindowMax = "max"
+
+var (
+	logsBackfillWindow = Flag{
+		Name:     "Window",
+		LongForm: "window",
+		Help: "How far back to backfill the logs, such as \"7d\" or \"12h\". " +
+			"Defaults to \"max\", which is the entire log retention window of the tenant.",
+	}
+)
+
+func backfillLogsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Output artifactOutput
+		Window string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Args:  cobra.NoArgs,
+		Short: "Archive the retained tenant logs",
+		Long: "Archive the tenant logs before they expire, by paging backwards through the retained logs.\n\n" +
+			"The logs are written as newline-delimited JSON files, partitioned by the hour they were " +
+			"logged at, such as `date=2024-05-01/hour=14/logs.ndjson`. The requests are paced to stay " +
+			"within the rate limit of the Management API.\n\n" +
+			"Without `--out`, the logs are written to a new local directory named after the tenant and " +
+			"the time of the backfill. Existing local files are only overwritten with `--force-overwrite`.\n\n" +
+			"Writing to S3 requires the AWS CLI to be installed and configured.",
+		Example: `  auth0 logs backfill
+  auth0 logs backfill --out s3://bucket/prefix
+  auth0 logs backfill --out s3://bucket/prefix --window max
+  auth0 logs backfill --out s3://bucket/prefix --window 7d
+  auth0 logs backfill --out ./logs --window 12h
+  auth0 logs backfill -o ./logs --window 12h --force-overwrite`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			window, err := parseLogWindow(inputs.Window)
+			if err != nil {
+				return err
+			}
+
+			to := inputs.Output.dir(cli, "logs")
+			archive, err := newLogArchive(to, inputs.Output.ForceOverwrite)
+			if err != nil {
+				return err
+			}
+
+			tenant, err := cli.Config.GetTenant(cli.tenant)
+			if err != nil {
+				return err
+			}
+
+			// A dedicated client keeps track of the rate limit of the Management API, to pace the requests.
+			rateLimits := &rateLimitObserver{}
+			client, err := cli.newManagementClient(tenant, rateLimits.transport)
+			if err != nil {
+				return err
+			}
+
+			backfill := &logBackfill{
+				api:       auth0.NewAPI(client),
+				archive:   archive,
+				renderer:  cli.renderer,
+				rateLimit: rateLimits.Latest,
+			}
+			if window > 0 {
+				backfill.since = cli.now().Add(-window)
+			}
+
+			count, err := backfill.run(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to backfill the logs: %w", err)
+			}
+
+			cli.renderer.Infof("Successfully backfilled %s logs to %s", ansi.Bold(strconv.Itoa(count)), ansi.Bold(to))
+
+			return nil
+		},
+	}
+
+	registerArtifactOutput(
+		cmd,
+		&inputs.Output,
+		"Where to write the logs to, either an S3 URI such as \"s3://bucket/prefix\" or a local directory.",
+		"",
+	)
+	logsBackfillWindow.RegisterString(cmd, &inputs.Window, logWindowMax)
+
+	return cmd
+}
+
+// parseLogWindow parses how far back to backfill the logs, which is 0 for the entire retention window.
+func parseLogWindow(window string) (time.Duration, error) {
+	if window == "" || window == logWindowMax {
+		return 0, nil
+	}
+
+	duration, ok := parseDurationWithDays(window)
+	if !ok {
+		return 0, fmt.Errorf("invalid window %q, use %q or a duration such as \"7d\" or \"12h\"", window, logWindowMax)
+	}
+
+	return duration, nil
+}
+
+// logArchive is where the backfilled logs get written to.
+type logArchive interface {
+	Write(ctx context.Context, name string, content []byte) error
+}
+
+func newLogArchive(to string, forceOverwrite bool) (logArchive, error) {
+	if strings.HasPrefix(to, "s3://") {
+		aws, err := exec.LookPath("aws")
+		if err != nil {
+			return nil, fmt.Errorf("writing the logs to S3 requires the AWS CLI, install it from https://aws.amazon.com/cli")
+		}
+
+		return &s3LogArchive{aws: aws, uri: strings.TrimSuffix(to, "/")}, nil
+	}
+
+	return &dirLogArchive{dir: to, overwrite: forceOverwrite}, nil
+}
+
+// dirLogArchive writes the logs to a local directory. The existing files are
+// only overwritten when forced to, as the logs they hold may have expired since.
+type dirLogArchive struct {
+	dir       string
+	overwrite bool
+}
+
+func (a *dirLogArchive) Write(_ context.Context, name string, content []byte) error {
+	path := filepath.Join(a.dir, filepath.FromSlash(name))
+	if _, err := os.Stat(path); err == nil && !a.overwrite {
+		return artifactExistsError(path)
+	}
+
+	file, err := createArtifactFile(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// s3LogArchive writes the logs to S3 through the AWS CLI, so that its credentials get used.
+type s3LogArchive struct {
+	aws string
+	uri string
+}
+
+func (a *s3LogArchive) Write(ctx context.Context, name string, content []byte) error {
+	var stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, a.aws, "s3", "cp", "-", a.uri+"/"+name)
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to upload %s: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+// logBackfill pages backwards through the logs, from the latest to the oldest
+// retained one, and writes them to the archive a partition at a time.
+type logBackfill struct {
+	api       *auth0.API
+	archive   logArchive
+	renderer  *display.Renderer
+	since     time.Time
+	rateLimit func() *display.RateLimit
+
+	partition string
+	logs      []*management.Log
+}
+
+func (b *logBackfill) run(ctx context.Context) (int, error) {
+	var count int
+	var oldestLogID string
+
+	for {
+		queryParams := []management.RequestOption{
+			management.Parameter("page", "0"),
+			management.Parameter("per_page", strconv.Itoa(logsPerPageLimit)),
+			management.Parameter("sort", "date:-1"),
+		}
+
+		if oldestLogID != "" {
+			queryParams = append(queryParams, management.Query(fmt.Sprintf("log_id:[* TO %s]", oldestLogID)))
+		}
+
+		list, err := b.api.Log.List(ctx, queryParams...)
+		if err != nil {
+			return count, err
+		}
+
+		var logs []*management.Log
+		for _, log := range list {
+			if log.GetLogID() != oldestLogID {
+				logs = append(logs, log)
+			}
+		}
+
+		if len(logs) == 0 {
+			break
+		}
+
+		done := false
+		for _, log := range logs {
+			if !b.since.IsZero() && log.GetDate().Before(b.since) {
+				done = true
+				break
+			}
+
+			if err := b.add(ctx, log); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if done {
+			break
+		}
+
+		oldestLogID = logs[len(logs)-1].GetLogID()
+
+		if err := b.pace(ctx); err != nil {
+			return count, err
+		}
+	}
+
+	return count, b.flush(ctx)
+}
+
+// add adds the log to its partition, writing the previous partition once the logs move past it.
+func (b *logBackfill) add(ctx context.Context, log *management.Log) error {
+	partition := logPartition(log.GetDate())
+	if partition != b.partition {
+		if err := b.flush(ctx); err != nil {
+			return err
+		}
+		b.partition = partition
+	}
+
+	b.logs = append(b.logs, log)
+
+	return nil
+}
+
+func (b *logBackfill) flush(ctx context.Context) error {
+	if len(b.logs) == 0 {
+		return nil
+	}
+
+	var content bytes.Buffer
+
+	// The logs are paged through from the latest, so they're reversed to be written chronologically.
+	for i := len(b.logs) - 1; i >= 0; i-- {
+		line, err := json.Marshal(b.logs[i])
+		if err != nil {
+			return err
+		}
+		content.Write(line)
+		content.WriteByte('\n')
+	}
+
+	if err := b.archive.Write(ctx, b.partition, content.Bytes()); err != nil {
+		return err
+	}
+
+	b.renderer.Infof("Wrote %d logs to %s", len(b.logs), b.partition)
+	b.logs = nil
+
+	return nil
+}
+
+func (b *logBackfill) pace(ctx context.Context) error {
+	return waitForRateLimit(ctx, b.rateLimit())
+}
+
+// waitForRateLimit waits for the rate limit to reset when the requests are about to exceed it.
+func waitForRateLimit(ctx context.Context, rateLimit *display.RateLimit) error {
+	if rateLimit == nil || rateLimit.Remaining > 1 {
+		return nil
+	}
+
+	wait := time.Until(rateLimit.ResetAt)
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+// logPartition returns the name of the file the logs of the hour of the date get written to.
+func logPartition(date time.Time) string {
+	date = date.UTC()
+	return fmt.Sprintf("date=%s/hour=%02d/logs.ndjson", date.Format("2006-01-02"), date.Hour())
+}
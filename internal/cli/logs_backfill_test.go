@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestParseLogWindow(t *testing.T) {
+	var tests = []struct {
+		window   string
+		expected time.Duration
+	}{
+		{window: "max", expected: 0},
+		{window: "", expected: 0},
+		{window: "7d", expected: 7 * 24 * time.Hour},
+		{window: "12h", expected: 12 * time.Hour},
+	}
+
+	for _, test := range tests {
+		t.Run(test.window, func(t *testing.T) {
+			window, err := parseLogWindow(test.window)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, window)
+		})
+	}
+
+	for _, window := range []string{"forever", "-1d", "0d", "d"} {
+		t.Run(window, func(t *testing.T) {
+			_, err := parseLogWindow(window)
+			assert.EqualError(t, err, `invalid window "`+window+`", use "max" or a duration such as "7d" or "12h"`)
+		})
+	}
+}
+
+func TestLogBackfill(t *testing.T) {
+	newLog := func(id string, date time.Time) *management.Log {
+		return &management.Log{
+			ID:    auth0.String(id),
+			LogID: auth0.String(id),
+			Date:  &date,
+		}
+	}
+
+	hour := time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC)
+
+	t.Run("it pages backwards through the logs and writes them partitioned by hour", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		gomock.InOrder(
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{
+					newLog("4", hour.Add(time.Hour+time.Minute)),
+					newLog("3", hour.Add(2*time.Minute)),
+				}, nil),
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{
+					newLog("3", hour.Add(2*time.Minute)),
+					newLog("2", hour.Add(time.Minute)),
+					newLog("1", hour.Add(-time.Minute)),
+				}, nil),
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{
+					newLog("1", hour.Add(-time.Minute)),
+				}, nil),
+		)
+
+		dir := t.TempDir()
+		backfill := &logBackfill{
+			api:       &auth0.API{Log: logAPI},
+			archive:   &dirLogArchive{dir: dir},
+			renderer:  &display.Renderer{MessageWriter: &bytes.Buffer{}},
+			rateLimit: func() *display.RateLimit { return nil },
+		}
+
+		count, err := backfill.run(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 4, count)
+
+		assertPartition := func(name string, ids ...string) {
+			content, err := os.ReadFile(filepath.Join(dir, name))
+			require.NoError(t, err)
+
+			lines := bytes.Split(bytes.TrimSpace(content), []byte("\n"))
+			require.Len(t, lines, len(ids))
+			for i, id := range ids {
+				assert.Contains(t, string(lines[i]), `"log_id":"`+id+`"`)
+			}
+		}
+
+		assertPartition("date=2024-05-01/hour=15/logs.ndjson", "4")
+		assertPartition("date=2024-05-01/hour=14/logs.ndjson", "2", "3")
+		assertPartition("date=2024-05-01/hour=13/logs.ndjson", "1")
+	})
+
+	t.Run("it stops at the start of the window", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*management.Log{
+				newLog("2", hour.Add(time.Minute)),
+				newLog("1", hour.Add(-time.Minute)),
+			}, nil)
+
+		dir := t.TempDir()
+		backfill := &logBackfill{
+			api:       &auth0.API{Log: logAPI},
+			archive:   &dirLogArchive{dir: dir},
+			renderer:  &display.Renderer{MessageWriter: &bytes.Buffer{}},
+			since:     hour,
+			rateLimit: func() *display.RateLimit { return nil },
+		}
+
+		count, err := backfill.run(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.FileExists(t, filepath.Join(dir, "date=2024-05-01/hour=14/logs.ndjson"))
+		assert.NoFileExists(t, filepath.Join(dir, "date=2024-05-01/hour=13/logs.ndjson"))
+	})
+}
+
+func TestLogBackfill_Pace(t *testing.T) {
+	t.Run("it doesn't wait while requests remain", func(t *testing.T) {
+		backfill := &logBackfill{
+			rateLimit: func() *display.RateLimit {
+				return &display.RateLimit{Limit: 10, Remaining: 5, ResetAt: time.Now().Add(time.Hour)}
+			},
+		}
+
+		assert.NoError(t, backfill.pace(context.Background()))
+	})
+
+	t.Run("it waits for the rate limit to reset", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		backfill := &logBackfill{
+			rateLimit: func() *display.RateLimit {
+				return &display.RateLimit{Limit: 10, Remaining: 0, ResetAt: time.Now().Add(time.Hour)}
+			},
+		}
+
+		assert.ErrorIs(t, backfill.pace(ctx), context.DeadlineExceeded)
+	})
+}
+
+func TestDirLogArchive(t *testing.T) {
+	dir := t.TempDir()
+	name := "date=2024-05-01/hour=14/logs.ndjson"
+
+	archive := &dirLogArchive{dir: dir}
+	require.NoError(t, archive.Write(context.Background(), name, []byte("{}\n")))
+
+	err := archive.Write(context.Background(), name, []byte("{}\n{}\n"))
+	assert.EqualError(
+		t,
+		err,
+		filepath.Join(dir, name)+" already exists, run the command with the --force-overwrite flag to overwrite it",
+	)
+
+	archive.overwrite = true
+	require.NoError(t, archive.Write(context.Background(), name, []byte("{}\n{}\n")))
+
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	require.NoError(t, err)
+	assert.Equal(t, "{}\n{}\n", string(content))
+}
@@ -104,6 +104,91 @@ API Operation              Update branding settings
 	})
 }
 
+func TestTailLogsCommandWithGrep(t *testing.T) {
+	logs := []*management.Log{
+		{
+			ID:          auth0.String("354234"),
+			LogID:       auth0.String("354234"),
+			Type:        auth0.String("sapi"),
+			Description: auth0.String("Update branding settings"),
+		},
+		{
+			ID:          auth0.String("354236"),
+			LogID:       auth0.String("354236"),
+			Type:        auth0.String("sapi"),
+			Description: auth0.String("Update tenant settings"),
+		},
+	}
+
+	var tests = []struct {
+		name           string
+		args           []string
+		expectedResult string
+	}{
+		{
+			name: "it only shows the logs matching the regular expression",
+			args: []string{"--grep", "(?i)TENANT"},
+			expectedResult: `TYPE                       DESCRIPTION                                               DATE                    CONNECTION              CLIENT                  
+API Operation              Update tenant settings                                    Jan 01 00:00:00.000     N/A                     N/A    
+`,
+		},
+		{
+			name: "it only shows the logs not matching the regular expression when inverted",
+			args: []string{"--grep", "tenant", "--invert"},
+			expectedResult: `TYPE                       DESCRIPTION                                               DATE                    CONNECTION              CLIENT                  
+API Operation              Update branding settings                                  Jan 01 00:00:00.000     N/A                     N/A    
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			logsAPI := mock.NewMockLogAPI(ctrl)
+			logsAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(logs, nil)
+			logsAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, fmt.Errorf("generic error"))
+
+			result := &bytes.Buffer{}
+			cli := &cli{
+				renderer: &display.Renderer{
+					MessageWriter: &bytes.Buffer{},
+					ResultWriter:  result,
+				},
+				api: &auth0.API{Log: logsAPI},
+			}
+
+			cmd := tailLogsCmd(cli)
+			cmd.SetArgs(append([]string{"--number", "90"}, test.args...))
+			err := cmd.Execute()
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResult, result.String())
+		})
+	}
+
+	t.Run("it returns an error when the regular expression is invalid", func(t *testing.T) {
+		cmd := tailLogsCmd(&cli{})
+		cmd.SetArgs([]string{"--grep", "(unclosed"})
+		err := cmd.Execute()
+
+		assert.EqualError(t, err, "invalid regular expression for --grep: error parsing regexp: missing closing ): `(unclosed`")
+	})
+
+	t.Run("it returns an error when inverting without a regular expression", func(t *testing.T) {
+		cmd := tailLogsCmd(&cli{})
+		cmd.SetArgs([]string{"--invert"})
+		err := cmd.Execute()
+
+		assert.EqualError(t, err, "--invert requires a regular expression to be passed with --grep")
+	})
+}
+
 func TestDedupeLogs(t *testing.T) {
 	t.Run("removes duplicate logs and sorts by date asc", func(t *testing.T) {
 		logs := []*management.Log{
@@ -1,35 +1,134 @@
 package cli
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/rehttp"
 	"github.com/auth0/go-auth0/management"
 
 	"github.com/auth0/auth0-cli/internal/buildinfo"
+	"github.com/auth0/auth0-cli/internal/config"
 )
 
-func initializeManagementClient(tenantDomain string, accessToken string) (*management.Management, error) {
+// configWriteMu guards writing the config when renewing access tokens,
+// as commands can run against several tenants at the same time.
+var configWriteMu sync.Mutex
+
+// accessTokenSource provides the access token
+// used to authenticate Management API requests.
+type accessTokenSource interface {
+	AccessToken(ctx context.Context) (string, error)
+}
+
+// tenantAccessTokenSource provides the access token of a tenant, regenerating it
+// shortly before it expires so that long-running commands don't fail mid-way.
+type tenantAccessTokenSource struct {
+	mu          sync.Mutex
+	tenant      config.Tenant
+	config      *config.Config
+	accessToken string
+}
+
+func newTenantAccessTokenSource(tenant config.Tenant, cfg *config.Config) *tenantAccessTokenSource {
+	return &tenantAccessTokenSource{
+		tenant:      tenant,
+		config:      cfg,
+		accessToken: tenant.GetAccessToken(),
+	}
+}
+
+// AccessToken returns the cached access token of the tenant,
+// or a freshly regenerated one if the cached token is about to expire.
+func (s *tenantAccessTokenSource) AccessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.tenant.HasExpiredToken() {
+		return s.accessToken, nil
+	}
+
+	if err := s.tenant.RegenerateAccessToken(ctx); err != nil {
+		// The token might still be valid for a few more
+		// minutes, so keep using it until it really expires.
+		if time.Now().Before(s.tenant.ExpiresAt) {
+			return s.accessToken, nil
+		}
+
+		return "", fmt.Errorf("failed to renew the access token: %w", err)
+	}
+
+	configWriteMu.Lock()
+	err := s.config.AddTenant(s.tenant)
+	configWriteMu.Unlock()
+	if err != nil {
+		return "", fmt.Errorf("failed to save the renewed access token: %w", err)
+	}
+
+	s.accessToken = s.tenant.GetAccessToken()
+
+	return s.accessToken, nil
+}
+
+// initializeManagementClient sets up the Management API client of a tenant.
+// The given transports wrap the HTTP transport of the client, e.g. to observe the responses.
+func initializeManagementClient(
+	tenantDomain string,
+	tokenSource accessTokenSource,
+	readOnly bool,
+	recorder *sessionRecorder,
+	transports ...func(http.RoundTripper) http.RoundTripper,
+) (*management.Management, error) {
+	accessToken, err := tokenSource.AccessToken(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := customClientWithRetriesAndTokenRenewal(tokenSource)
+	if readOnly {
+		httpClient.Transport = readOnlyTransport(httpClient.Transport)
+	}
+	if recorder != nil {
+		httpClient.Transport = recordingTransport(httpClient.Transport, recorder)
+	}
+	for _, transport := range transports {
+		httpClient.Transport = transport(httpClient.Transport)
+	}
+
 	client, err := management.New(
 		tenantDomain,
 		management.WithStaticToken(accessToken),
 		management.WithUserAgent(fmt.Sprintf("%v/%v", userAgent, strings.TrimPrefix(buildinfo.Version, "v"))),
 		management.WithAuth0ClientEnvEntry("Auth0-CLI", strings.TrimPrefix(buildinfo.Version, "v")),
 		management.WithNoRetries(),
-		management.WithClient(customClientWithRetries()),
+		management.WithClient(httpClient),
 	)
 
 	return client, err
 }
 
+func customClientWithRetriesAndTokenRenewal(tokenSource accessTokenSource) *http.Client {
+	client := &http.Client{
+		Transport: rateLimitTransport(
+			retryableErrorTransport(
+				tokenRenewalTransport(http.DefaultTransport, tokenSource),
+			),
+		),
+	}
+
+	return client
+}
+
 func customClientWithRetries() *http.Client {
 	client := &http.Client{
 		Transport: rateLimitTransport(
@@ -42,6 +141,61 @@ func customClientWithRetries() *http.Client {
 	return client
 }
 
+// tokenRenewalTransport sets the Authorization header of each request, including
+// retries, to the latest access token given by the token source.
+func tokenRenewalTransport(tripper http.RoundTripper, tokenSource accessTokenSource) http.RoundTripper {
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		accessToken, err := tokenSource.AccessToken(request.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		request = request.Clone(request.Context())
+		request.Header.Set("Authorization", "Bearer "+accessToken)
+
+		return tripper.RoundTrip(request)
+	})
+}
+
+// requestTimeoutTransport bounds each request, including its retries, to the --timeout
+// of the command. The timeout runs until the body of the response is closed.
+func (c *cli) requestTimeoutTransport(tripper http.RoundTripper) http.RoundTripper {
+	if c.timeout <= 0 {
+		return tripper
+	}
+
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		ctx, cancel := context.WithTimeout(request.Context(), c.timeout)
+
+		response, err := tripper.RoundTrip(request.WithContext(ctx))
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		response.Body = &cancelOnCloseBody{ReadCloser: response.Body, cancel: cancel}
+
+		return response, nil
+	})
+}
+
+// cancelOnCloseBody releases the context of the request once its response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
 func rateLimitTransport(tripper http.RoundTripper) http.RoundTripper {
 	return rehttp.NewTransport(tripper, rateLimitRetry, rateLimitDelay)
 }
@@ -1,9 +1,11 @@
 package cli
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -13,6 +15,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+
+	"github.com/auth0/auth0-cli/internal/config"
 )
 
 func TestCustomClientWithRetries(t *testing.T) {
@@ -173,3 +178,152 @@ func TestRetryableErrorRetryFunc(t *testing.T) {
 		})
 	}
 }
+
+type staticTokenSource struct {
+	accessToken string
+	err         error
+}
+
+func (s *staticTokenSource) AccessToken(context.Context) (string, error) {
+	return s.accessToken, s.err
+}
+
+func TestTokenRenewalTransport(t *testing.T) {
+	t.Run("it sets the latest access token on each request", func(t *testing.T) {
+		var authorizationHeader string
+		testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			authorizationHeader = request.Header.Get("Authorization")
+			writer.WriteHeader(200)
+		}))
+
+		client := customClientWithRetriesAndTokenRenewal(&staticTokenSource{accessToken: "renewed-token"})
+
+		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+		request.Header.Set("Authorization", "Bearer stale-token")
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, 200, response.StatusCode)
+		assert.Equal(t, "Bearer renewed-token", authorizationHeader)
+
+		t.Cleanup(func() {
+			testServer.Close()
+			err := response.Body.Close()
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("it fails the request if the access token can't be renewed", func(t *testing.T) {
+		apiCalls := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			apiCalls++
+			writer.WriteHeader(200)
+		}))
+		t.Cleanup(testServer.Close)
+
+		transport := tokenRenewalTransport(
+			http.DefaultTransport,
+			&staticTokenSource{err: errors.New("failed to renew the access token")},
+		)
+
+		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		_, err = transport.RoundTrip(request)
+		assert.EqualError(t, err, "failed to renew the access token")
+		assert.Equal(t, 0, apiCalls)
+	})
+}
+
+func TestRequestTimeoutTransport(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/slow" {
+			select {
+			case <-request.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+		_, _ = writer.Write([]byte("ok"))
+	}))
+	t.Cleanup(testServer.Close)
+
+	timeoutCLI := &cli{timeout: 100 * time.Millisecond}
+	client := &http.Client{Transport: timeoutCLI.requestTimeoutTransport(http.DefaultTransport)}
+
+	t.Run("it reads the response of the requests completing in time", func(t *testing.T) {
+		response, err := client.Get(testServer.URL + "/fast")
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+
+		assert.Equal(t, "ok", string(body))
+	})
+
+	t.Run("it fails the requests taking longer than the timeout", func(t *testing.T) {
+		_, err := client.Get(testServer.URL + "/slow")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("it doesn't bound the requests without a timeout", func(t *testing.T) {
+		transport := (&cli{}).requestTimeoutTransport(http.DefaultTransport)
+		assert.Equal(t, http.DefaultTransport, transport)
+	})
+}
+
+func TestTenantAccessTokenSource(t *testing.T) {
+	t.Run("it returns the cached access token while it is not about to expire", func(t *testing.T) {
+		keyring.MockInit()
+
+		tokenSource := newTenantAccessTokenSource(
+			config.Tenant{
+				Domain:      "example.us.auth0.com",
+				AccessToken: "access-token",
+				ExpiresAt:   time.Now().Add(time.Hour),
+			},
+			&config.Config{},
+		)
+
+		accessToken, err := tokenSource.AccessToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", accessToken)
+	})
+
+	t.Run("it keeps using the access token if it can't be renewed but hasn't expired yet", func(t *testing.T) {
+		keyring.MockInit()
+
+		tokenSource := newTenantAccessTokenSource(
+			config.Tenant{
+				Domain:      "example.us.auth0.com",
+				AccessToken: "access-token",
+				ExpiresAt:   time.Now().Add(time.Minute),
+				ClientID:    "client-id",
+			},
+			&config.Config{},
+		)
+
+		accessToken, err := tokenSource.AccessToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", accessToken)
+	})
+
+	t.Run("it returns an error if the access token expired and can't be renewed", func(t *testing.T) {
+		keyring.MockInit()
+
+		tokenSource := newTenantAccessTokenSource(
+			config.Tenant{
+				Domain:      "example.us.auth0.com",
+				AccessToken: "access-token",
+				ExpiresAt:   time.Now().Add(-time.Minute),
+				ClientID:    "client-id",
+			},
+			&config.Config{},
+		)
+
+		_, err := tokenSource.AccessToken(context.Background())
+		assert.ErrorContains(t, err, "failed to renew the access token")
+	})
+}
@@ -0,0 +1,277 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+var (
+	multiTenantTenants = Flag{
+		Name:     "Tenants",
+		LongForm: "tenants",
+		Help: "Comma-separated list of tenants to run the command across in parallel. " +
+			"The tenants need to be logged in beforehand.",
+	}
+
+	multiTenantAllTenants = Flag{
+		Name:     "All Tenants",
+		LongForm: "all-tenants",
+		Help:     "Run the command across all the logged in tenants in parallel.",
+	}
+)
+
+// multiTenantInputs holds the tenants to run a command across.
+type multiTenantInputs struct {
+	Tenants    []string
+	AllTenants bool
+}
+
+func (i *multiTenantInputs) register(cmd *cobra.Command) {
+	multiTenantTenants.RegisterStringSlice(cmd, &i.Tenants, nil)
+	multiTenantAllTenants.RegisterBool(cmd, &i.AllTenants, false)
+	cmd.MarkFlagsMutuallyExclusive("tenants", "all-tenants")
+}
+
+// tenantDomains resolves the domains of the tenants to run the command across.
+func (i *multiTenantInputs) tenantDomains(cli *cli) ([]string, error) {
+	var domains []string
+
+	if i.AllTenants {
+		tenants, err := cli.Config.ListAllTenants()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tenants: %w", err)
+		}
+
+		for _, tenant := range tenants {
+			domains = append(domains, tenant.Domain)
+		}
+		sort.Strings(domains)
+
+		return domains, nil
+	}
+
+	for _, tenantName := range i.Tenants {
+		tenant, err := cli.Config.GetTenant(tenantName)
+		if err != nil {
+			return nil, err
+		}
+
+		domains = append(domains, tenant.Domain)
+	}
+
+	return domains, nil
+}
+
+// crossTenantCommands are the commands always running across
+// multiple tenants, which authenticate each of them on their own.
+var crossTenantCommands = []string{
+	"auth0 universal-login themes copy",
+}
+
+// isRunningAcrossTenants checks whether the command always runs across multiple
+// tenants or got invoked with any of the flags to run it across multiple tenants.
+func isRunningAcrossTenants(cmd *cobra.Command) bool {
+	if slices.Contains(crossTenantCommands, cmd.CommandPath()) {
+		return true
+	}
+
+	for _, flagName := range []string{multiTenantTenants.LongForm, multiTenantAllTenants.LongForm} {
+		if flag := cmd.Flags().Lookup(flagName); flag != nil && flag.Changed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authenticateTenant sets up the Management API client of the tenant, checking that
+// it was granted the scopes required by the command. The current tenant is switched
+// to the given one, so callers have to restore it.
+func (c *cli) authenticateTenant(cmd *cobra.Command, tenant string) (*auth0.API, error) {
+	c.tenant = tenant
+	if err := c.setupWithAuthentication(cmd.Context()); err != nil {
+		return nil, err
+	}
+
+	tenantConfig, err := c.Config.GetTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRequiredScopes(cmd.CommandPath(), tenantConfig); err != nil {
+		return nil, err
+	}
+
+	return c.api, nil
+}
+
+// waitingFunc shows that fn is running, along with the text if any.
+type waitingFunc func(text string, fn func() error) error
+
+// waitWithSpinner shows a spinner while fn runs, along with the text if any.
+func waitWithSpinner(text string, fn func() error) error {
+	if text == "" {
+		return ansi.Waiting(fn)
+	}
+
+	return ansi.Spinner(text, fn)
+}
+
+// waitWithoutSpinner runs fn without showing a spinner, as a single
+// one is shown for all the tenants when running across them.
+func waitWithoutSpinner(_ string, fn func() error) error {
+	return fn()
+}
+
+// tenantRun holds the buffered output of running a command against a tenant.
+type tenantRun struct {
+	tenant   string
+	api      *auth0.API
+	renderer *display.Renderer
+	messages bytes.Buffer
+	results  bytes.Buffer
+	err      error
+}
+
+// runForTenants runs the command against the current tenant or, if requested
+// through the multi-tenant flags, against each of the given tenants in parallel.
+// When running across tenants, the output of each tenant gets buffered and
+// rendered grouped by tenant once all the runs have completed. The command
+// shows that it's running through the given waiting function, as a single
+// spinner gets shown for all the tenants when running across them.
+func runForTenants(
+	cmd *cobra.Command,
+	cli *cli,
+	inputs multiTenantInputs,
+	runCommand func(api *auth0.API, renderer *display.Renderer, waiting waitingFunc) error,
+) error {
+	if !isRunningAcrossTenants(cmd) {
+		return runCommand(cli.api, cli.renderer, waitWithSpinner)
+	}
+
+	if err := cli.Config.Validate(); err != nil {
+		return err
+	}
+
+	tenants, err := inputs.tenantDomains(cli)
+	if err != nil {
+		return err
+	}
+
+	if len(tenants) == 0 {
+		return fmt.Errorf("no tenants to run the command across")
+	}
+
+	currentTenant := cli.tenant
+	defer func() {
+		cli.tenant = currentTenant
+	}()
+
+	// Authenticating is done one tenant at a time, as
+	// it might require logging in again or saving the config.
+	runs := make([]*tenantRun, 0, len(tenants))
+	for _, tenant := range tenants {
+		run := &tenantRun{tenant: tenant}
+		runs = append(runs, run)
+
+		if run.api, run.err = cli.authenticateTenant(cmd, tenant); run.err != nil {
+			continue
+		}
+
+		run.renderer = &display.Renderer{
+			Tenant:        tenant,
+			MessageWriter: &run.messages,
+			ResultWriter:  &run.results,
+			Format:        cli.renderer.Format,
+			RevealSecrets: cli.renderer.RevealSecrets,
+			// The JSON results of each tenant get combined, so they can't be colorized.
+			PlainJSON: true,
+		}
+	}
+
+	_ = ansi.Spinner(fmt.Sprintf("Running the command across %d tenants", len(runs)), func() error {
+		var wg sync.WaitGroup
+		for _, run := range runs {
+			if run.err != nil {
+				continue
+			}
+
+			wg.Add(1)
+			go func(run *tenantRun) {
+				defer wg.Done()
+				run.err = runCommand(run.api, run.renderer, waitWithoutSpinner)
+			}(run)
+		}
+		wg.Wait()
+
+		return nil
+	})
+
+	if cli.json {
+		return renderTenantRunsAsJSON(cli, runs)
+	}
+
+	return renderTenantRuns(cli, runs)
+}
+
+func renderTenantRuns(cli *cli, runs []*tenantRun) error {
+	failedRuns := 0
+	for _, run := range runs {
+		_, _ = io.Copy(cli.renderer.MessageWriter, &run.messages)
+		_, _ = io.Copy(cli.renderer.ResultWriter, &run.results)
+
+		if run.err != nil {
+			failedRuns++
+			cli.renderer.Errorf("%s: %s", ansi.Bold(run.tenant), run.err)
+		}
+	}
+
+	if failedRuns > 0 {
+		return fmt.Errorf("the command failed for %d out of %d tenants", failedRuns, len(runs))
+	}
+
+	return nil
+}
+
+func renderTenantRunsAsJSON(cli *cli, runs []*tenantRun) error {
+	type tenantResult struct {
+		Tenant string          `json:"tenant"`
+		Result json.RawMessage `json:"result,omitempty"`
+		Error  string          `json:"error,omitempty"`
+	}
+
+	failedRuns := 0
+	results := make([]tenantResult, 0, len(runs))
+	for _, run := range runs {
+		result := tenantResult{Tenant: run.tenant}
+
+		switch {
+		case run.err != nil:
+			failedRuns++
+			result.Error = run.err.Error()
+		case json.Valid(run.results.Bytes()):
+			result.Result = run.results.Bytes()
+		}
+
+		results = append(results, result)
+	}
+
+	cli.renderer.JSONResult(results)
+
+	if failedRuns > 0 {
+		return fmt.Errorf("the command failed for %d out of %d tenants", failedRuns, len(runs))
+	}
+
+	return nil
+}
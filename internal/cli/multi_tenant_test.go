@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestIsRunningAcrossTenants(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		args     []string
+		expected bool
+	}{
+		{
+			name:     "it runs against the current tenant by default",
+			args:     []string{},
+			expected: false,
+		},
+		{
+			name:     "it runs across the given tenants",
+			args:     []string{"--tenants", "example.us.auth0.com,example.eu.auth0.com"},
+			expected: true,
+		},
+		{
+			name:     "it runs across all the tenants",
+			args:     []string{"--all-tenants"},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var inputs multiTenantInputs
+			cmd := &cobra.Command{}
+			inputs.register(cmd)
+
+			require.NoError(t, cmd.ParseFlags(testCase.args))
+			assert.Equal(t, testCase.expected, isRunningAcrossTenants(cmd))
+		})
+	}
+
+	t.Run("it always runs the cross-tenant commands across tenants", func(t *testing.T) {
+		root := &cobra.Command{Use: "auth0"}
+		root.AddCommand(universalLoginCmd(&cli{}))
+
+		copyCmd, _, err := root.Find([]string{"universal-login", "themes", "copy"})
+		require.NoError(t, err)
+
+		assert.True(t, isRunningAcrossTenants(copyCmd))
+	})
+}
+
+func TestRunForTenants(t *testing.T) {
+	t.Run("it runs against the current tenant when not running across tenants", func(t *testing.T) {
+		cli := &cli{
+			api:      &auth0.API{},
+			renderer: &display.Renderer{},
+		}
+
+		var inputs multiTenantInputs
+		cmd := &cobra.Command{}
+		inputs.register(cmd)
+
+		err := runForTenants(cmd, cli, inputs, func(api *auth0.API, renderer *display.Renderer, _ waitingFunc) error {
+			assert.Same(t, cli.api, api)
+			assert.Same(t, cli.renderer, renderer)
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestRenderTenantRuns(t *testing.T) {
+	newRuns := func() []*tenantRun {
+		succeededRun := &tenantRun{tenant: "example.eu.auth0.com"}
+		succeededRun.messages.WriteString("=== example.eu.auth0.com applications\n")
+		succeededRun.results.WriteString(`[{"name":"Default App"}]`)
+
+		return []*tenantRun{
+			succeededRun,
+			{tenant: "example.us.auth0.com", err: errors.New("failed to list applications")},
+		}
+	}
+
+	t.Run("it groups the output by tenant", func(t *testing.T) {
+		var messages, results bytes.Buffer
+		cli := &cli{renderer: &display.Renderer{MessageWriter: &messages, ResultWriter: &results}}
+
+		err := renderTenantRuns(cli, newRuns())
+		assert.EqualError(t, err, "the command failed for 1 out of 2 tenants")
+		assert.Contains(t, messages.String(), "=== example.eu.auth0.com applications")
+		assert.Contains(t, messages.String(), "example.us.auth0.com: failed to list applications")
+		assert.Equal(t, `[{"name":"Default App"}]`, results.String())
+	})
+
+	t.Run("it combines the json output of each tenant", func(t *testing.T) {
+		var messages, results bytes.Buffer
+		cli := &cli{renderer: &display.Renderer{MessageWriter: &messages, ResultWriter: &results}}
+
+		err := renderTenantRunsAsJSON(cli, newRuns())
+		assert.EqualError(t, err, "the command failed for 1 out of 2 tenants")
+		assert.JSONEq(
+			t,
+			`[
+				{"tenant": "example.eu.auth0.com", "result": [{"name": "Default App"}]},
+				{"tenant": "example.us.auth0.com", "error": "failed to list applications"}
+			]`,
+			results.String(),
+		)
+	})
+}
@@ -0,0 +1,837 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var (
+	networkACLID = Argument{
+		Name: "Id",
+		Help: "Id of the network ACL.",
+	}
+
+	networkACLDescription = Flag{
+		Name:       "Description",
+		LongForm:   "description",
+		ShortForm:  "d",
+		Help:       "Description of the network ACL.",
+		IsRequired: true,
+	}
+
+	networkACLPriority = Flag{
+		Name:       "Priority",
+		LongForm:   "priority",
+		ShortForm:  "p",
+		Help:       "Priority of the network ACL. Rules with a lower priority value are evaluated first.",
+		IsRequired: true,
+	}
+
+	networkACLActive = Flag{
+		Name:     "Active",
+		LongForm: "active",
+		Help:     "Whether the network ACL is active.",
+	}
+
+	networkACLAction = Flag{
+		Name:       "Action",
+		LongForm:   "action",
+		ShortForm:  "a",
+		Help:       "Action to take on the matching requests. Possible values: block, allow, log, redirect.",
+		IsRequired: true,
+	}
+
+	networkACLRedirectURI = Flag{
+		Name:     "Redirect URI",
+		LongForm: "redirect-uri",
+		Help:     "URI to redirect the matching requests to, when the action is redirect.",
+	}
+
+	networkACLScope = Flag{
+		Name:     "Scope",
+		LongForm: "scope",
+		Help:     "Scope of the requests the network ACL applies to. Possible values: tenant, authentication, management.",
+	}
+
+	networkACLIPCIDRs = Flag{
+		Name:     "IP CIDRs",
+		LongForm: "ip-cidrs",
+		Help:     "Comma-separated list of IPv4 or IPv6 addresses or CIDR ranges to match.",
+	}
+
+	networkACLCountryCodes = Flag{
+		Name:     "Country Codes",
+		LongForm: "country-codes",
+		Help:     "Comma-separated list of ISO 3166-1 alpha-2 country codes to match.",
+	}
+
+	networkACLSubdivisionCodes = Flag{
+		Name:     "Subdivision Codes",
+		LongForm: "subdivision-codes",
+		Help:     "Comma-separated list of ISO 3166-2 subdivision codes to match.",
+	}
+
+	networkACLASNs = Flag{
+		Name:     "ASNs",
+		LongForm: "asns",
+		Help:     "Comma-separated list of autonomous system numbers to match.",
+	}
+
+	networkACLUserAgents = Flag{
+		Name:     "User Agents",
+		LongForm: "user-agents",
+		Help:     "Comma-separated list of user agents to match.",
+	}
+
+	networkACLNotMatch = Flag{
+		Name:     "Not Match",
+		LongForm: "not-match",
+		Help:     "Apply the network ACL to the requests that don't match the given criteria instead.",
+	}
+
+	networkACLCheckIP = Flag{
+		Name:     "Check IP",
+		LongForm: "check-ip",
+		Help: "Check which of the active network ACLs a request coming from the given IP address would hit, " +
+			"without making any changes.",
+	}
+
+	networkACLActions = []string{"block", "allow", "log", "redirect"}
+	networkACLScopes  = []string{"tenant", "authentication", "management"}
+
+	errNoNetworkACLs = errors.New("there are currently no network ACLs to choose from. " +
+		"Create one by running: `auth0 network-acls create`")
+)
+
+type networkACLInputs struct {
+	Description      string
+	Priority         int
+	Active           bool
+	Action           string
+	RedirectURI      string
+	Scope            string
+	IPCIDRs          []string
+	CountryCodes     []string
+	SubdivisionCodes []string
+	ASNs             []string
+	UserAgents       []string
+	NotMatch         bool
+}
+
+func (i *networkACLInputs) register(cmd *cobra.Command, isUpdate bool) {
+	if isUpdate {
+		networkACLDescription.RegisterStringU(cmd, &i.Description, "")
+		networkACLPriority.RegisterIntU(cmd, &i.Priority, 0)
+		networkACLAction.RegisterStringU(cmd, &i.Action, "")
+	} else {
+		networkACLDescription.RegisterString(cmd, &i.Description, "")
+		networkACLPriority.RegisterInt(cmd, &i.Priority, 0)
+		networkACLAction.RegisterString(cmd, &i.Action, "")
+	}
+
+	networkACLActive.RegisterBool(cmd, &i.Active, true)
+	networkACLRedirectURI.RegisterString(cmd, &i.RedirectURI, "")
+	networkACLScope.RegisterString(cmd, &i.Scope, "tenant")
+	networkACLAction.RegisterCompletions(cmd, networkACLActions)
+	networkACLScope.RegisterCompletions(cmd, networkACLScopes)
+	networkACLIPCIDRs.RegisterStringSlice(cmd, &i.IPCIDRs, nil)
+	networkACLCountryCodes.RegisterStringSlice(cmd, &i.CountryCodes, nil)
+	networkACLSubdivisionCodes.RegisterStringSlice(cmd, &i.SubdivisionCodes, nil)
+	networkACLASNs.RegisterStringSlice(cmd, &i.ASNs, nil)
+	networkACLUserAgents.RegisterStringSlice(cmd, &i.UserAgents, nil)
+	networkACLNotMatch.RegisterBool(cmd, &i.NotMatch, false)
+}
+
+// hasMatchCriteria checks whether any of the match criteria flags got passed.
+func (i *networkACLInputs) hasMatchCriteria(cmd *cobra.Command) bool {
+	for _, flag := range []Flag{
+		networkACLIPCIDRs,
+		networkACLCountryCodes,
+		networkACLSubdivisionCodes,
+		networkACLASNs,
+		networkACLUserAgents,
+		networkACLNotMatch,
+	} {
+		if flag.IsSet(cmd) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// match builds the match criteria of the rule, splitting the IP addresses
+// and CIDR ranges into the IPv4 and IPv6 ones as expected by the API.
+func (i *networkACLInputs) match() (*auth0.NetworkACLRuleMatch, error) {
+	match := &auth0.NetworkACLRuleMatch{
+		GeoCountryCodes:     i.CountryCodes,
+		GeoSubdivisionCodes: i.SubdivisionCodes,
+		UserAgents:          i.UserAgents,
+	}
+
+	for _, cidr := range i.IPCIDRs {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			if ip = net.ParseIP(cidr); ip == nil {
+				return nil, fmt.Errorf("invalid IP address or CIDR range %q", cidr)
+			}
+		}
+
+		if ip.To4() != nil {
+			match.IPv4CIDRs = append(match.IPv4CIDRs, cidr)
+		} else {
+			match.IPv6CIDRs = append(match.IPv6CIDRs, cidr)
+		}
+	}
+
+	for _, asn := range i.ASNs {
+		number, err := strconv.Atoi(asn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid autonomous system number %q", asn)
+		}
+		match.ASNs = append(match.ASNs, number)
+	}
+
+	if len(match.IPv4CIDRs)+len(match.IPv6CIDRs)+len(match.ASNs)+len(match.GeoCountryCodes)+
+		len(match.GeoSubdivisionCodes)+len(match.UserAgents) == 0 {
+		return nil, errors.New("at least one of the match criteria needs to be passed, e.g. --ip-cidrs")
+	}
+
+	return match, nil
+}
+
+func (i *networkACLInputs) action() (*auth0.NetworkACLRuleAction, error) {
+	action := &auth0.NetworkACLRuleAction{}
+
+	switch i.Action {
+	case "block":
+		action.Block = auth0.Bool(true)
+	case "allow":
+		action.Allow = auth0.Bool(true)
+	case "log":
+		action.Log = auth0.Bool(true)
+	case "redirect":
+		if i.RedirectURI == "" {
+			return nil, errors.New("a redirect URI needs to be passed through --redirect-uri when the action is redirect")
+		}
+		action.Redirect = auth0.Bool(true)
+		action.RedirectURI = &i.RedirectURI
+	default:
+		return nil, fmt.Errorf("invalid action %q, possible values: block, allow, log, redirect", i.Action)
+	}
+
+	return action, nil
+}
+
+func networkACLsCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "network-acls",
+		Aliases: []string{"network-acl"},
+		Short:   "Manage network ACLs",
+		Long: "Manage the tenant network access control lists (ACLs), which block, allow, log or redirect " +
+			"the requests made to the tenant based on their IP address, location, network or user agent.\n\n" +
+			"The network ACLs are evaluated in order of priority, lowest value first, and the first one " +
+			"matching a request decides what happens to it.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listNetworkACLsCmd(cli))
+	cmd.AddCommand(showNetworkACLCmd(cli))
+	cmd.AddCommand(createNetworkACLCmd(cli))
+	cmd.AddCommand(updateNetworkACLCmd(cli))
+	cmd.AddCommand(deleteNetworkACLCmd(cli))
+
+	return cmd
+}
+
+func listNetworkACLsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		CheckIP string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List your network ACLs",
+		Long: "List your existing network ACLs in order of priority. To create one, run: `auth0 network-acls create`.\n\n" +
+			"Use the `--check-ip` flag to see which network ACL a request coming from an IP address would hit. " +
+			"Only the IP criteria can be checked, so the network ACLs that also depend on other criteria, " +
+			"such as the location or the user agent, are reported as a possible match.",
+		Example: `  auth0 network-acls list
+  auth0 network-acls ls
+  auth0 network-acls ls --check-ip 203.0.113.10
+  auth0 network-acls ls --json
+  auth0 network-acls ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var checkIP net.IP
+			if inputs.CheckIP != "" {
+				if checkIP = net.ParseIP(inputs.CheckIP); checkIP == nil {
+					return fmt.Errorf("invalid IP address %q", inputs.CheckIP)
+				}
+			}
+
+			var list []*auth0.NetworkACL
+			if err := ansi.Waiting(func() (err error) {
+				list, err = listAllNetworkACLs(cmd.Context(), cli.api)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to list network ACLs: %w", err)
+			}
+
+			sortNetworkACLsByPriority(list)
+
+			if checkIP != nil {
+				cli.renderer.NetworkACLCheck(inputs.CheckIP, checkNetworkACLs(list, checkIP))
+				return nil
+			}
+
+			cli.renderer.NetworkACLList(list)
+
+			return nil
+		},
+	}
+
+	networkACLCheckIP.RegisterString(cmd, &inputs.CheckIP, "")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+
+	return cmd
+}
+
+func showNetworkACLCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show a network ACL",
+		Long:  "Display information about a network ACL.",
+		Example: `  auth0 network-acls show
+  auth0 network-acls show <id>
+  auth0 network-acls show <id> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := networkACLID.Pick(cmd, &inputs.ID, cli.networkACLPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var networkACL *auth0.NetworkACL
+			if err := ansi.Waiting(func() (err error) {
+				networkACL, err = cli.api.NetworkACL.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read network ACL with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.NetworkACLShow(networkACL)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func createNetworkACLCmd(cli *cli) *cobra.Command {
+	var inputs networkACLInputs
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Args:  cobra.NoArgs,
+		Short: "Create a new network ACL",
+		Long: "Create a new network ACL.\n\n" +
+			"To create interactively, use `auth0 network-acls create` with no flags.\n\n" +
+			"To create non-interactively, supply the description, priority, action and match criteria through the flags.",
+		Example: `  auth0 network-acls create
+  auth0 network-acls create --description "Block office network" --priority 1 --action block --ip-cidrs 203.0.113.0/24
+  auth0 network-acls create -d "Only allow EU" -p 2 -a block --country-codes DE,FR,NL --not-match
+  auth0 network-acls create -d "Log bots" -p 3 -a log --user-agents "BadBot/1.0" --scope authentication
+  auth0 network-acls create -d "Redirect ASN" -p 4 -a redirect --redirect-uri https://example.com/blocked --asns 64496 --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := networkACLDescription.Ask(cmd, &inputs.Description, nil); err != nil {
+				return err
+			}
+
+			if err := networkACLPriority.AskInt(cmd, &inputs.Priority, nil); err != nil {
+				return err
+			}
+
+			if err := networkACLAction.Select(cmd, &inputs.Action, networkACLActions, nil); err != nil {
+				return err
+			}
+
+			if !inputs.hasMatchCriteria(cmd) {
+				if err := networkACLIPCIDRs.AskMany(cmd, &inputs.IPCIDRs, nil); err != nil {
+					return err
+				}
+			}
+
+			if err := validateNetworkACLScope(inputs.Scope); err != nil {
+				return err
+			}
+
+			action, err := inputs.action()
+			if err != nil {
+				return err
+			}
+
+			match, err := inputs.match()
+			if err != nil {
+				return err
+			}
+
+			networkACL := &auth0.NetworkACL{
+				Description: &inputs.Description,
+				Active:      &inputs.Active,
+				Priority:    &inputs.Priority,
+				Rule: &auth0.NetworkACLRule{
+					Action: action,
+					Scope:  &inputs.Scope,
+				},
+			}
+
+			if inputs.NotMatch {
+				networkACL.Rule.NotMatch = match
+			} else {
+				networkACL.Rule.Match = match
+			}
+
+			if err := ansi.Waiting(func() error {
+				if err := checkNetworkACLPriorityIsFree(cmd.Context(), cli.api, inputs.Priority, ""); err != nil {
+					return err
+				}
+
+				return cli.api.NetworkACL.Create(cmd.Context(), networkACL)
+			}); err != nil {
+				return fmt.Errorf("failed to create network ACL: %w", err)
+			}
+
+			cli.renderer.NetworkACLCreate(networkACL)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	inputs.register(cmd, false)
+
+	return cmd
+}
+
+func updateNetworkACLCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+		networkACLInputs
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update a network ACL",
+		Long: "Update a network ACL.\n\n" +
+			"To update interactively, use `auth0 network-acls update` with no arguments.\n\n" +
+			"To update non-interactively, supply the network ACL id and the settings to change through the flags. " +
+			"Passing any of the match criteria replaces all the existing criteria of the network ACL.",
+		Example: `  auth0 network-acls update
+  auth0 network-acls update <id> --priority 5
+  auth0 network-acls update <id> --active=false
+  auth0 network-acls update <id> --action allow --ip-cidrs 198.51.100.0/24,2001:db8::/32
+  auth0 network-acls update <id> -d "Block office network" -p 1 --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := networkACLID.Pick(cmd, &inputs.ID, cli.networkACLPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var current *auth0.NetworkACL
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.NetworkACL.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read network ACL with ID %q: %w", inputs.ID, err)
+			}
+
+			// Start with an empty network ACL object. We'll conditionally
+			// hydrate it based on the provided parameters since
+			// we'll do PATCH semantics.
+			networkACL := &auth0.NetworkACL{}
+
+			if networkACLDescription.IsSet(cmd) {
+				networkACL.Description = &inputs.Description
+			}
+			if networkACLPriority.IsSet(cmd) {
+				networkACL.Priority = &inputs.Priority
+			}
+			if networkACLActive.IsSet(cmd) {
+				networkACL.Active = &inputs.Active
+			}
+
+			// The rule gets replaced as a whole, so the current one is used for any settings not passed.
+			if networkACLAction.IsSet(cmd) || networkACLRedirectURI.IsSet(cmd) ||
+				networkACLScope.IsSet(cmd) || inputs.hasMatchCriteria(cmd) {
+				rule, err := updatedNetworkACLRule(cmd, current.GetRule(), &inputs.networkACLInputs)
+				if err != nil {
+					return err
+				}
+				networkACL.Rule = rule
+			}
+
+			if err := ansi.Waiting(func() error {
+				if networkACL.Priority != nil && networkACL.GetPriority() != current.GetPriority() {
+					err := checkNetworkACLPriorityIsFree(cmd.Context(), cli.api, networkACL.GetPriority(), inputs.ID)
+					if err != nil {
+						return err
+					}
+				}
+
+				return cli.api.NetworkACL.Update(cmd.Context(), inputs.ID, networkACL)
+			}); err != nil {
+				return fmt.Errorf("failed to update network ACL with ID %q: %w", inputs.ID, err)
+			}
+
+			networkACL.ID = &inputs.ID
+			cli.renderer.NetworkACLUpdate(networkACL)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	inputs.register(cmd, true)
+
+	return cmd
+}
+
+func deleteNetworkACLCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a network ACL",
+		Long: "Delete a network ACL.\n\n" +
+			"To delete interactively, use `auth0 network-acls delete` with no arguments.\n\n" +
+			"To delete non-interactively, supply the network ACL id and the `--force` flag to skip confirmation.",
+		Example: `  auth0 network-acls delete
+  auth0 network-acls rm
+  auth0 network-acls delete <id>
+  auth0 network-acls delete <id> --force
+  auth0 network-acls delete <id> <id2> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := make([]string, len(args))
+			if len(args) == 0 {
+				if err := networkACLID.PickMany(cmd, &ids, cli.networkACLPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				ids = append(ids, args...)
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting network ACL", ids, func(_ int, id string) error {
+				if id != "" {
+					if _, err := cli.api.NetworkACL.Read(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete network ACL with ID %q: %w", id, err)
+					}
+
+					if err := cli.api.NetworkACL.Delete(cmd.Context(), id); err != nil {
+						return fmt.Errorf("failed to delete network ACL with ID %q: %w", id, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func (c *cli) networkACLPickerOptions(ctx context.Context) (pickerOptions, error) {
+	list, err := listAllNetworkACLs(ctx, c.api)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list network ACLs: %w", err)
+	}
+
+	sortNetworkACLsByPriority(list)
+
+	var opts pickerOptions
+	for _, networkACL := range list {
+		value := networkACL.GetID()
+		label := fmt.Sprintf(
+			"%d. %s %s",
+			networkACL.GetPriority(),
+			networkACL.GetDescription(),
+			ansi.Faint("("+value+")"),
+		)
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errNoNetworkACLs
+	}
+
+	return opts, nil
+}
+
+func updatedNetworkACLRule(
+	cmd *cobra.Command,
+	current *auth0.NetworkACLRule,
+	inputs *networkACLInputs,
+) (*auth0.NetworkACLRule, error) {
+	rule := &auth0.NetworkACLRule{}
+	if current != nil {
+		*rule = *current
+	}
+
+	if networkACLAction.IsSet(cmd) || networkACLRedirectURI.IsSet(cmd) {
+		if !networkACLAction.IsSet(cmd) {
+			inputs.Action = rule.GetAction().Name()
+		}
+
+		action, err := inputs.action()
+		if err != nil {
+			return nil, err
+		}
+		rule.Action = action
+	}
+
+	if networkACLScope.IsSet(cmd) {
+		if err := validateNetworkACLScope(inputs.Scope); err != nil {
+			return nil, err
+		}
+		rule.Scope = &inputs.Scope
+	}
+
+	if inputs.hasMatchCriteria(cmd) {
+		match, err := inputs.match()
+		if err != nil {
+			return nil, err
+		}
+
+		rule.Match, rule.NotMatch = nil, nil
+		if inputs.NotMatch {
+			rule.NotMatch = match
+		} else {
+			rule.Match = match
+		}
+	}
+
+	return rule, nil
+}
+
+func validateNetworkACLScope(scope string) error {
+	for _, validScope := range networkACLScopes {
+		if scope == validScope {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid scope %q, possible values: tenant, authentication, management", scope)
+}
+
+// checkNetworkACLPriorityIsFree makes sure no other network ACL has the same
+// priority, as the order of evaluation between them would be undefined.
+func checkNetworkACLPriorityIsFree(ctx context.Context, api *auth0.API, priority int, id string) error {
+	list, err := listAllNetworkACLs(ctx, api)
+	if err != nil {
+		return fmt.Errorf("failed to list network ACLs: %w", err)
+	}
+
+	for _, networkACL := range list {
+		if networkACL.GetPriority() == priority && networkACL.GetID() != id {
+			return fmt.Errorf(
+				"priority %d is already used by the network ACL %q (%s), "+
+					"choose another priority or update that network ACL first",
+				priority,
+				networkACL.GetDescription(),
+				networkACL.GetID(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// listAllNetworkACLs lists the network ACLs of all the pages. The response doesn't tell whether
+// there's a next page, so the pages are listed until one isn't full.
+func listAllNetworkACLs(ctx context.Context, api *auth0.API) ([]*auth0.NetworkACL, error) {
+	var networkACLs []*auth0.NetworkACL
+
+	var page int
+	for {
+		list, err := api.NetworkACL.List(ctx, management.Page(page), management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		networkACLs = append(networkACLs, list...)
+
+		if len(list) < defaultPageSize {
+			break
+		}
+
+		page++
+	}
+
+	return networkACLs, nil
+}
+
+func sortNetworkACLsByPriority(list []*auth0.NetworkACL) {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].GetPriority() < list[j].GetPriority()
+	})
+}
+
+// checkNetworkACLs evaluates the active network ACLs, sorted by priority, against
+// a request coming from the given IP address. The evaluation stops at the first
+// network ACL that's certain to match, as that's the one deciding what happens
+// to the request. Network ACLs which also depend on criteria other than the IP
+// address are reported as a possible match, and the evaluation carries on.
+func checkNetworkACLs(list []*auth0.NetworkACL, ip net.IP) []display.NetworkACLCheckResult {
+	var results []display.NetworkACLCheckResult
+
+	for _, networkACL := range list {
+		if !networkACL.GetActive() {
+			continue
+		}
+
+		rule := networkACL.GetRule()
+		if rule == nil {
+			continue
+		}
+
+		outcome, otherCriteria := evaluateNetworkACLRule(rule, ip)
+		results = append(results, display.NetworkACLCheckResult{
+			NetworkACL:    networkACL,
+			Outcome:       outcome,
+			OtherCriteria: otherCriteria,
+		})
+
+		if outcome == display.NetworkACLMatch {
+			break
+		}
+	}
+
+	return results
+}
+
+func evaluateNetworkACLRule(rule *auth0.NetworkACLRule, ip net.IP) (display.NetworkACLOutcome, []string) {
+	matchOutcome, matchCriteria := evaluateNetworkACLMatch(rule.Match, ip)
+	notMatchOutcome, notMatchCriteria := evaluateNetworkACLNotMatch(rule.NotMatch, ip)
+
+	otherCriteria := append(matchCriteria, notMatchCriteria...)
+
+	switch {
+	case matchOutcome == display.NetworkACLNoMatch || notMatchOutcome == display.NetworkACLNoMatch:
+		return display.NetworkACLNoMatch, nil
+	case matchOutcome == display.NetworkACLPossibleMatch || notMatchOutcome == display.NetworkACLPossibleMatch:
+		return display.NetworkACLPossibleMatch, otherCriteria
+	default:
+		return display.NetworkACLMatch, nil
+	}
+}
+
+// evaluateNetworkACLMatch checks the IP address against all the given criteria,
+// returning the criteria which can't be evaluated from the IP address alone.
+func evaluateNetworkACLMatch(match *auth0.NetworkACLRuleMatch, ip net.IP) (display.NetworkACLOutcome, []string) {
+	if match == nil {
+		return display.NetworkACLMatch, nil
+	}
+
+	cidrs := append(append([]string{}, match.IPv4CIDRs...), match.IPv6CIDRs...)
+	if len(cidrs) > 0 && !ipMatchesAny(ip, cidrs) {
+		return display.NetworkACLNoMatch, nil
+	}
+
+	if otherCriteria := networkACLOtherCriteria(match); len(otherCriteria) > 0 {
+		return display.NetworkACLPossibleMatch, otherCriteria
+	}
+
+	return display.NetworkACLMatch, nil
+}
+
+// evaluateNetworkACLNotMatch checks the IP address against the criteria which must not
+// match, that is the request only matches when none of them matches. It returns the
+// criteria which can't be evaluated from the IP address alone.
+func evaluateNetworkACLNotMatch(notMatch *auth0.NetworkACLRuleMatch, ip net.IP) (display.NetworkACLOutcome, []string) {
+	if notMatch == nil {
+		return display.NetworkACLMatch, nil
+	}
+
+	cidrs := append(append([]string{}, notMatch.IPv4CIDRs...), notMatch.IPv6CIDRs...)
+	if ipMatchesAny(ip, cidrs) {
+		return display.NetworkACLNoMatch, nil
+	}
+
+	if otherCriteria := networkACLOtherCriteria(notMatch); len(otherCriteria) > 0 {
+		return display.NetworkACLPossibleMatch, otherCriteria
+	}
+
+	return display.NetworkACLMatch, nil
+}
+
+// networkACLOtherCriteria lists the criteria which can't be evaluated from the IP address alone.
+func networkACLOtherCriteria(match *auth0.NetworkACLRuleMatch) []string {
+	var otherCriteria []string
+	for criteria, isSet := range map[string]bool{
+		"asns":                  len(match.ASNs) > 0,
+		"geo_country_codes":     len(match.GeoCountryCodes) > 0,
+		"geo_subdivision_codes": len(match.GeoSubdivisionCodes) > 0,
+		"ja3_fingerprints":      len(match.JA3Fingerprints) > 0,
+		"ja4_fingerprints":      len(match.JA4Fingerprints) > 0,
+		"user_agents":           len(match.UserAgents) > 0,
+	} {
+		if isSet {
+			otherCriteria = append(otherCriteria, criteria)
+		}
+	}
+	sort.Strings(otherCriteria)
+
+	return otherCriteria
+}
+
+func ipMatchesAny(ip net.IP, cidrs []string) bool {
+	for _, cidr := range cidrs {
+		if _, network, err := net.ParseCIDR(cidr); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		if address := net.ParseIP(cidr); address != nil && address.Equal(ip) {
+			return true
+		}
+	}
+
+	return false
+}
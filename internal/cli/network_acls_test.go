@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestNetworkACLInputs_Match(t *testing.T) {
+	t.Run("it splits the IPv4 and IPv6 criteria", func(t *testing.T) {
+		inputs := networkACLInputs{
+			IPCIDRs:      []string{"203.0.113.0/24", "2001:db8::/32", "198.51.100.7", "::1"},
+			ASNs:         []string{"64496"},
+			CountryCodes: []string{"DE"},
+		}
+
+		match, err := inputs.match()
+		require.NoError(t, err)
+
+		assert.Equal(t, &auth0.NetworkACLRuleMatch{
+			ASNs:            []int{64496},
+			GeoCountryCodes: []string{"DE"},
+			IPv4CIDRs:       []string{"203.0.113.0/24", "198.51.100.7"},
+			IPv6CIDRs:       []string{"2001:db8::/32", "::1"},
+		}, match)
+	})
+
+	t.Run("it fails with an invalid IP address", func(t *testing.T) {
+		inputs := networkACLInputs{IPCIDRs: []string{"203.0.113.0/33"}}
+
+		_, err := inputs.match()
+		assert.EqualError(t, err, `invalid IP address or CIDR range "203.0.113.0/33"`)
+	})
+
+	t.Run("it fails with an invalid ASN", func(t *testing.T) {
+		inputs := networkACLInputs{ASNs: []string{"AS64496"}}
+
+		_, err := inputs.match()
+		assert.EqualError(t, err, `invalid autonomous system number "AS64496"`)
+	})
+
+	t.Run("it fails without any criteria", func(t *testing.T) {
+		inputs := networkACLInputs{}
+
+		_, err := inputs.match()
+		assert.EqualError(t, err, "at least one of the match criteria needs to be passed, e.g. --ip-cidrs")
+	})
+}
+
+func TestNetworkACLInputs_Action(t *testing.T) {
+	action, err := (&networkACLInputs{Action: "block"}).action()
+	require.NoError(t, err)
+	assert.Equal(t, "block", action.Name())
+
+	action, err = (&networkACLInputs{Action: "redirect", RedirectURI: "https://example.com"}).action()
+	require.NoError(t, err)
+	assert.Equal(t, "redirect", action.Name())
+	assert.Equal(t, "https://example.com", auth0.StringValue(action.RedirectURI))
+
+	_, err = (&networkACLInputs{Action: "redirect"}).action()
+	assert.EqualError(t, err, "a redirect URI needs to be passed through --redirect-uri when the action is redirect")
+
+	_, err = (&networkACLInputs{Action: "deny"}).action()
+	assert.EqualError(t, err, `invalid action "deny", possible values: block, allow, log, redirect`)
+}
+
+func TestCheckNetworkACLs(t *testing.T) {
+	newNetworkACL := func(id string, priority int, active bool, rule *auth0.NetworkACLRule) *auth0.NetworkACL {
+		return &auth0.NetworkACL{
+			ID:       auth0.String(id),
+			Priority: auth0.Int(priority),
+			Active:   auth0.Bool(active),
+			Rule:     rule,
+		}
+	}
+
+	block := &auth0.NetworkACLRuleAction{Block: auth0.Bool(true)}
+
+	list := []*auth0.NetworkACL{
+		newNetworkACL("acl_inactive", 1, false, &auth0.NetworkACLRule{
+			Action: block,
+			Match:  &auth0.NetworkACLRuleMatch{IPv4CIDRs: []string{"203.0.113.0/24"}},
+		}),
+		newNetworkACL("acl_other_network", 2, true, &auth0.NetworkACLRule{
+			Action: block,
+			Match:  &auth0.NetworkACLRuleMatch{IPv4CIDRs: []string{"198.51.100.0/24"}},
+		}),
+		newNetworkACL("acl_geo", 3, true, &auth0.NetworkACLRule{
+			Action: block,
+			Match: &auth0.NetworkACLRuleMatch{
+				IPv4CIDRs:       []string{"203.0.113.0/24"},
+				GeoCountryCodes: []string{"DE"},
+			},
+		}),
+		newNetworkACL("acl_not_match", 4, true, &auth0.NetworkACLRule{
+			Action:   block,
+			NotMatch: &auth0.NetworkACLRuleMatch{IPv4CIDRs: []string{"198.51.100.0/24"}},
+		}),
+		newNetworkACL("acl_never_reached", 5, true, &auth0.NetworkACLRule{
+			Action: block,
+			Match:  &auth0.NetworkACLRuleMatch{IPv4CIDRs: []string{"0.0.0.0/0"}},
+		}),
+	}
+
+	results := checkNetworkACLs(list, net.ParseIP("203.0.113.10"))
+
+	require.Len(t, results, 3)
+	assert.Equal(t, "acl_other_network", results[0].NetworkACL.GetID())
+	assert.Equal(t, display.NetworkACLNoMatch, results[0].Outcome)
+	assert.Equal(t, "acl_geo", results[1].NetworkACL.GetID())
+	assert.Equal(t, display.NetworkACLPossibleMatch, results[1].Outcome)
+	assert.Equal(t, []string{"geo_country_codes"}, results[1].OtherCriteria)
+	assert.Equal(t, "acl_not_match", results[2].NetworkACL.GetID())
+	assert.Equal(t, display.NetworkACLMatch, results[2].Outcome)
+
+	results = checkNetworkACLs(list, net.ParseIP("198.51.100.1"))
+
+	require.Len(t, results, 1)
+	assert.Equal(t, display.NetworkACLMatch, results[0].Outcome)
+	assert.Equal(t, "acl_other_network", results[0].NetworkACL.GetID())
+
+	results = checkNetworkACLs(list, net.ParseIP("2001:db8::1"))
+
+	require.Len(t, results, 3)
+	assert.Equal(t, display.NetworkACLNoMatch, results[1].Outcome)
+	assert.Equal(t, "acl_not_match", results[2].NetworkACL.GetID())
+	assert.Equal(t, display.NetworkACLMatch, results[2].Outcome)
+}
+
+func TestEvaluateNetworkACLRule_NotMatch(t *testing.T) {
+	rule := &auth0.NetworkACLRule{
+		Action: &auth0.NetworkACLRuleAction{Block: auth0.Bool(true)},
+		NotMatch: &auth0.NetworkACLRuleMatch{
+			IPv4CIDRs:  []string{"198.51.100.0/24"},
+			UserAgents: []string{"curl/8.0"},
+		},
+	}
+
+	t.Run("it doesn't match when any of the conditions matches", func(t *testing.T) {
+		outcome, otherCriteria := evaluateNetworkACLRule(rule, net.ParseIP("198.51.100.1"))
+		assert.Equal(t, display.NetworkACLNoMatch, outcome)
+		assert.Empty(t, otherCriteria)
+	})
+
+	t.Run("it possibly matches when the other conditions can't be evaluated", func(t *testing.T) {
+		outcome, otherCriteria := evaluateNetworkACLRule(rule, net.ParseIP("203.0.113.10"))
+		assert.Equal(t, display.NetworkACLPossibleMatch, outcome)
+		assert.Equal(t, []string{"user_agents"}, otherCriteria)
+	})
+
+	t.Run("it matches when none of the conditions matches", func(t *testing.T) {
+		rule := &auth0.NetworkACLRule{
+			Action: &auth0.NetworkACLRuleAction{Block: auth0.Bool(true)},
+			NotMatch: &auth0.NetworkACLRuleMatch{
+				IPv4CIDRs: []string{"198.51.100.0/24"},
+				IPv6CIDRs: []string{"2001:db8::/32"},
+			},
+		}
+
+		outcome, _ := evaluateNetworkACLRule(rule, net.ParseIP("203.0.113.10"))
+		assert.Equal(t, display.NetworkACLMatch, outcome)
+	})
+}
+
+func TestListAllNetworkACLs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	firstPage := make([]*auth0.NetworkACL, defaultPageSize)
+	for i := range firstPage {
+		firstPage[i] = &auth0.NetworkACL{Priority: auth0.Int(i + 1)}
+	}
+	secondPage := []*auth0.NetworkACL{{Priority: auth0.Int(defaultPageSize + 1)}}
+
+	networkACLAPI := mock.NewMockNetworkACLAPI(ctrl)
+	gomock.InOrder(
+		networkACLAPI.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(firstPage, nil),
+		networkACLAPI.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(secondPage, nil),
+	)
+
+	list, err := listAllNetworkACLs(context.Background(), &auth0.API{NetworkACL: networkACLAPI})
+	require.NoError(t, err)
+	assert.Len(t, list, defaultPageSize+1)
+}
@@ -84,6 +84,14 @@ var (
 		LongForm:  "number",
 		ShortForm: "n",
 	}
+
+	organizationSort = Flag{
+		Name:      "Sort",
+		LongForm:  "sort",
+		ShortForm: "s",
+		Help: "Field to sort by, among name, display_name and created_at. Use 'field:order' where 'order' " +
+			"is '1' for ascending and '-1' for descending. e.g. 'name:1'.",
+	}
 )
 
 func organizationsCmd(cli *cli) *cobra.Command {
@@ -102,8 +110,10 @@ func organizationsCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(updateOrganizationCmd(cli))
 	cmd.AddCommand(deleteOrganizationCmd(cli))
 	cmd.AddCommand(openOrganizationCmd(cli))
+	cmd.AddCommand(brandingOrganizationCmd(cli))
 	cmd.AddCommand(membersOrganizationCmd(cli))
 	cmd.AddCommand(rolesOrganizationCmd(cli))
+	cmd.AddCommand(clientGrantsOrganizationCmd(cli))
 
 	return cmd
 }
@@ -111,6 +121,8 @@ func organizationsCmd(cli *cli) *cobra.Command {
 func listOrganizationsCmd(cli *cli) *cobra.Command {
 	var inputs struct {
 		Number int
+		Sort   string
+		Tags   map[string]string
 	}
 
 	cmd := &cobra.Command{
@@ -118,20 +130,36 @@ func listOrganizationsCmd(cli *cli) *cobra.Command {
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		Short:   "List your organizations",
-		Long:    "List your existing organizations. To create one, run: `auth0 orgs create`.",
+		Long: "List your existing organizations. To create one, run: `auth0 orgs create`.\n\n" +
+			"Filtering by tags requires fetching all the organizations, before keeping the requested number of them.",
 		Example: `  auth0 orgs list
   auth0 orgs ls
   auth0 orgs ls --json
   auth0 orgs ls --csv
-  auth0 orgs ls -n 100`,
+  auth0 orgs ls -n 100
+  auth0 orgs ls --sort name:1
+  auth0 orgs ls -s created_at:-1 -n 10
+  auth0 orgs ls --tag env=prod`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if inputs.Number < 1 || inputs.Number > 1000 {
 				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
 			}
 
+			// The tags can't be filtered on by the Management API, so all
+			// the organizations need to be fetched to find enough matching ones.
+			limit := inputs.Number
+			if len(inputs.Tags) > 0 {
+				limit = 0
+			}
+
+			var total int
 			list, err := getWithPagination(
-				inputs.Number,
+				limit,
 				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+					if inputs.Sort != "" {
+						opts = append(opts, management.Parameter("sort", inputs.Sort))
+					}
+
 					res, err := cli.api.Organization.List(cmd.Context(), opts...)
 					if err != nil {
 						return nil, false, err
@@ -140,6 +168,7 @@ func listOrganizationsCmd(cli *cli) *cobra.Command {
 					for _, item := range res.Organizations {
 						result = append(result, item)
 					}
+					total = res.Total
 
 					return result, res.HasNext(), nil
 				},
@@ -149,11 +178,27 @@ func listOrganizationsCmd(cli *cli) *cobra.Command {
 			}
 
 			var orgs []*management.Organization
+			var matching int
 			for _, item := range list {
-				orgs = append(orgs, item.(*management.Organization))
+				org := item.(*management.Organization)
+				if !tagsMatch(tagsFromMetadata(org.GetMetadata()), inputs.Tags) {
+					continue
+				}
+
+				matching++
+				if len(orgs) < inputs.Number {
+					orgs = append(orgs, org)
+				}
+			}
+
+			// All the organizations were fetched when filtering by tags,
+			// so the total is the number of the matching ones.
+			if len(inputs.Tags) > 0 {
+				total = matching
 			}
 
 			cli.renderer.OrganizationList(orgs)
+			cli.renderer.Truncated("organizations", len(orgs), total)
 
 			return nil
 		},
@@ -165,6 +210,8 @@ func listOrganizationsCmd(cli *cli) *cobra.Command {
 
 	organizationNumber.Help = "Number of organizations to retrieve. Minimum 1, maximum 1000."
 	organizationNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+	organizationSort.RegisterString(cmd, &inputs.Sort, "")
+	tagFilter.RegisterStringMap(cmd, &inputs.Tags, nil)
 
 	return cmd
 }
@@ -220,6 +267,7 @@ func createOrganizationCmd(cli *cli) *cobra.Command {
 		AccentColor     string
 		BackgroundColor string
 		Metadata        map[string]string
+		IdempotentBy    string
 	}
 
 	cmd := &cobra.Command{
@@ -233,12 +281,33 @@ func createOrganizationCmd(cli *cli) *cobra.Command {
   auth0 orgs create --name myorganization
   auth0 orgs create -n myorganization --display "My Organization"
   auth0 orgs create -n myorganization -d "My Organization" -l "https://example.com/logo.png" -a "#635DFF" -b "#2A2E35"
-  auth0 orgs create -n myorganization -d "My Organization" -m "KEY=value" -m "OTHER_KEY=other_value"`,
+  auth0 orgs create -n myorganization -d "My Organization" -m "KEY=value" -m "OTHER_KEY=other_value"
+  auth0 orgs create -n myorganization -d "My Organization" --idempotent-by name --json --no-input`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateIdempotencyKey(inputs.IdempotentBy, idempotencyKeyName); err != nil {
+				return err
+			}
+
 			if err := organizationName.Ask(cmd, &inputs.Name, nil); err != nil {
 				return err
 			}
 
+			if inputs.IdempotentBy != "" {
+				var existing *management.Organization
+				if err := ansi.Waiting(func() (err error) {
+					existing, err = findOrganizationByName(cmd.Context(), cli.api, inputs.Name)
+					return err
+				}); err != nil {
+					return err
+				}
+
+				if existing != nil {
+					cli.renderer.Warnf("An organization named %q already exists, skipping its creation.", inputs.Name)
+					cli.renderer.OrganizationShow(existing)
+					return nil
+				}
+			}
+
 			if err := organizationDisplay.Ask(cmd, &inputs.DisplayName, nil); err != nil {
 				return err
 			}
@@ -289,6 +358,7 @@ func createOrganizationCmd(cli *cli) *cobra.Command {
 	organizationAccent.RegisterString(cmd, &inputs.AccentColor, "")
 	organizationBackground.RegisterString(cmd, &inputs.BackgroundColor, "")
 	organizationMetadata.RegisterStringMap(cmd, &inputs.Metadata, nil)
+	idempotentBy.RegisterString(cmd, &inputs.IdempotentBy, "")
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 
@@ -500,6 +570,7 @@ func membersOrganizationCmd(cli *cli) *cobra.Command {
 
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(listMembersOrganizationCmd(cli))
+	cmd.AddCommand(searchMembersOrganizationCmd(cli))
 
 	return cmd
 }
@@ -743,42 +814,60 @@ func formatOrganizationDetailsPath(id string) string {
 func getWithPagination(
 	limit int,
 	api func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error),
+) ([]interface{}, error) {
+	return getWithPaginationWaiting(waitWithSpinner, limit, api)
+}
+
+// getWithPaginationWaiting is getWithPagination showing that it's running through the given waiting function.
+func getWithPaginationWaiting(
+	waiting waitingFunc,
+	limit int,
+	api func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error),
 ) ([]interface{}, error) {
 	var list []interface{}
-	if err := ansi.Waiting(func() error {
-		pageSize := defaultPageSize
-		page := 0
-		for {
-			if limit > 0 {
-				// Determine page size to avoid getting unwanted elements.
-				want := limit - len(list)
-				if want == 0 {
-					return nil
-				}
-				if want < defaultPageSize {
-					pageSize = want
-				} else {
-					pageSize = defaultPageSize
-				}
-			}
-			res, hasNext, err := api(
-				management.PerPage(pageSize),
-				management.Page(page))
-			if err != nil {
-				return err
-			}
-			page++
-			list = append(list, res...)
-			if len(list) == limit || !hasNext {
-				return nil
-			}
-		}
+	if err := waiting("", func() (err error) {
+		list, err = fetchWithPagination(limit, api)
+		return err
 	}); err != nil {
 		return nil, err
 	}
 	return list, nil
 }
 
+func fetchWithPagination(
+	limit int,
+	api func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error),
+) ([]interface{}, error) {
+	var list []interface{}
+	pageSize := defaultPageSize
+	page := 0
+	for {
+		if limit > 0 {
+			// Determine page size to avoid getting unwanted elements.
+			want := limit - len(list)
+			if want == 0 {
+				return list, nil
+			}
+			if want < defaultPageSize {
+				pageSize = want
+			} else {
+				pageSize = defaultPageSize
+			}
+		}
+		res, hasNext, err := api(
+			management.PerPage(pageSize),
+			management.Page(page))
+		if err != nil {
+			return nil, err
+		}
+		page++
+		list = append(list, res...)
+		if len(list) == limit || !hasNext {
+			return list, nil
+		}
+	}
+}
+
 func (cli *cli) getOrgMembers(
 	context context.Context,
 	orgID string,
@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+var (
+	organizationBrandingLogoURL = Flag{
+		Name:         "Logo URL",
+		LongForm:     "logo-url",
+		ShortForm:    "l",
+		Help:         "URL of the logo to be displayed on the login pages of the organization.",
+		AlwaysPrompt: true,
+	}
+
+	organizationBrandingColors = Flag{
+		Name:      "Colors",
+		LongForm:  "colors",
+		ShortForm: "c",
+		Help: "Colors used to customize the login pages of the organization, as hex colors. " +
+			"Possible keys: primary, page_background. E.g. primary=#635DFF,page_background=#2A2E35.",
+	}
+
+	organizationBrandingColorKeys = []string{apiOrganizationColorPrimary, apiOrganizationColorPageBackground}
+
+	hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+)
+
+func brandingOrganizationCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branding",
+		Short: "Manage the branding of an organization",
+		Long: "Manage the logo and colors shown on the login pages when users log in to an organization, " +
+			"which take precedence over the branding of the tenant.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(showBrandingOrganizationCmd(cli))
+	cmd.AddCommand(updateBrandingOrganizationCmd(cli))
+
+	return cmd
+}
+
+func showBrandingOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show the branding of an organization",
+		Long:  "Display the logo and colors of the login pages of an organization.",
+		Example: `  auth0 orgs branding show
+  auth0 orgs branding show <org-id>
+  auth0 orgs branding show <org-id> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				inputs.ID = args[0]
+			} else {
+				if err := organizationID.Pick(cmd, &inputs.ID, cli.organizationPickerOptions); err != nil {
+					return err
+				}
+			}
+
+			var organization *management.Organization
+			if err := ansi.Waiting(func() (err error) {
+				organization, err = cli.api.Organization.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read organization with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.OrganizationBrandingShow(organization)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func updateBrandingOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID      string
+		LogoURL string
+		Colors  map[string]string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update the branding of an organization",
+		Long: "Update the logo and colors of the login pages of an organization.\n\n" +
+			"The colors that aren't given are left unchanged.",
+		Example: `  auth0 orgs branding update
+  auth0 orgs branding update <org-id> --logo-url "https://example.com/logo.png"
+  auth0 orgs branding update <org-id> --colors primary=#635DFF
+  auth0 orgs branding update <org-id> --colors primary=#635DFF,page_background=#2A2E35
+  auth0 orgs branding update <org-id> -l "https://example.com/logo.png" -c primary=#635DFF --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				inputs.ID = args[0]
+			} else {
+				if err := organizationID.Pick(cmd, &inputs.ID, cli.organizationPickerOptions); err != nil {
+					return err
+				}
+			}
+
+			if err := validateOrganizationColors(inputs.Colors); err != nil {
+				return err
+			}
+
+			var oldOrg *management.Organization
+			if err := ansi.Waiting(func() (err error) {
+				oldOrg, err = cli.api.Organization.Read(cmd.Context(), inputs.ID)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read organization with ID %q: %w", inputs.ID, err)
+			}
+
+			if err := organizationBrandingLogoURL.AskU(cmd, &inputs.LogoURL, oldOrg.GetBranding().LogoURL); err != nil {
+				return err
+			}
+
+			branding := mergeOrganizationBranding(oldOrg.GetBranding(), inputs.LogoURL, inputs.Colors)
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.Update(cmd.Context(), inputs.ID, &management.Organization{Branding: branding})
+			}); err != nil {
+				return fmt.Errorf("failed to update the branding of organization with ID %q: %w", inputs.ID, err)
+			}
+
+			oldOrg.Branding = branding
+			cli.renderer.OrganizationBrandingUpdate(oldOrg)
+
+			return nil
+		},
+	}
+
+	organizationBrandingLogoURL.RegisterStringU(cmd, &inputs.LogoURL, "")
+	organizationBrandingColors.RegisterStringMapU(cmd, &inputs.Colors, nil)
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+// validateOrganizationColors makes sure the colors are hex colors of the
+// parts of the login pages that can be customized for an organization.
+func validateOrganizationColors(colors map[string]string) error {
+	keys := make([]string, 0, len(colors))
+	for key := range colors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var err error
+	for _, key := range keys {
+		if !containsStr(organizationBrandingColorKeys, key) {
+			err = errors.Join(err, fmt.Errorf(
+				"unsupported color %q, use one of: %s",
+				key,
+				strings.Join(organizationBrandingColorKeys, ", "),
+			))
+			continue
+		}
+
+		if !hexColorPattern.MatchString(colors[key]) {
+			err = errors.Join(err, fmt.Errorf("invalid %s color %q, use a hex color such as #635DFF", key, colors[key]))
+		}
+	}
+
+	return err
+}
+
+// mergeOrganizationBranding applies the logo URL and colors to the current
+// branding of the organization, keeping the settings that aren't given.
+func mergeOrganizationBranding(
+	current *management.OrganizationBranding,
+	logoURL string,
+	colors map[string]string,
+) *management.OrganizationBranding {
+	branding := &management.OrganizationBranding{}
+
+	if logoURL != "" {
+		branding.LogoURL = &logoURL
+	} else if current.GetLogoURL() != "" {
+		branding.LogoURL = current.LogoURL
+	}
+
+	mergedColors := make(map[string]string)
+	for key, color := range current.GetColors() {
+		mergedColors[key] = color
+	}
+	for key, color := range colors {
+		mergedColors[key] = color
+	}
+
+	if len(mergedColors) > 0 {
+		branding.Colors = &mergedColors
+	}
+
+	return branding
+}
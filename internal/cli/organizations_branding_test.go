@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestValidateOrganizationColors(t *testing.T) {
+	var tests = []struct {
+		name          string
+		colors        map[string]string
+		expectedError string
+	}{
+		{
+			name:   "it accepts hex colors",
+			colors: map[string]string{"primary": "#635DFF", "page_background": "#fff"},
+		},
+		{
+			name:          "it rejects unsupported colors",
+			colors:        map[string]string{"accent": "#635DFF"},
+			expectedError: `unsupported color "accent", use one of: primary, page_background`,
+		},
+		{
+			name:          "it rejects invalid hex colors",
+			colors:        map[string]string{"primary": "blue", "page_background": "#2A2E3"},
+			expectedError: "invalid page_background color \"#2A2E3\", use a hex color such as #635DFF\ninvalid primary color \"blue\", use a hex color such as #635DFF",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateOrganizationColors(test.colors)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestMergeOrganizationBranding(t *testing.T) {
+	current := &management.OrganizationBranding{
+		LogoURL: auth0.String("https://example.com/logo.png"),
+		Colors:  &map[string]string{"primary": "#635DFF", "page_background": "#2A2E35"},
+	}
+
+	t.Run("it keeps the settings that aren't given", func(t *testing.T) {
+		branding := mergeOrganizationBranding(current, "", map[string]string{"primary": "#000000"})
+
+		assert.Equal(t, &management.OrganizationBranding{
+			LogoURL: auth0.String("https://example.com/logo.png"),
+			Colors:  &map[string]string{"primary": "#000000", "page_background": "#2A2E35"},
+		}, branding)
+	})
+
+	t.Run("it sets the branding of an organization without any", func(t *testing.T) {
+		branding := mergeOrganizationBranding(nil, "https://example.com/other-logo.png", nil)
+
+		assert.Equal(t, &management.OrganizationBranding{
+			LogoURL: auth0.String("https://example.com/other-logo.png"),
+		}, branding)
+	})
+}
+
+func TestUpdateBrandingOrganizationCmd(t *testing.T) {
+	t.Run("it updates the branding of the organization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			Read(gomock.Any(), "org_123").
+			Return(&management.Organization{
+				ID: auth0.String("org_123"),
+				Branding: &management.OrganizationBranding{
+					LogoURL: auth0.String("https://example.com/logo.png"),
+				},
+			}, nil)
+		organizationAPI.EXPECT().
+			Update(gomock.Any(), "org_123", &management.Organization{
+				Branding: &management.OrganizationBranding{
+					LogoURL: auth0.String("https://example.com/logo.png"),
+					Colors:  &map[string]string{"primary": "#635DFF"},
+				},
+			}).
+			Return(nil)
+
+		cli := &cli{
+			renderer: &display.Renderer{
+				MessageWriter: io.Discard,
+				ResultWriter:  io.Discard,
+			},
+			api: &auth0.API{Organization: organizationAPI},
+		}
+
+		cmd := updateBrandingOrganizationCmd(cli)
+		cmd.SetArgs([]string{"org_123", "--colors", "primary=#635DFF"})
+
+		require.NoError(t, cmd.Execute())
+	})
+
+	t.Run("it fails to update the branding with invalid colors", func(t *testing.T) {
+		cli := &cli{
+			renderer: &display.Renderer{
+				MessageWriter: io.Discard,
+				ResultWriter:  io.Discard,
+			},
+		}
+
+		cmd := updateBrandingOrganizationCmd(cli)
+		cmd.SetArgs([]string{"org_123", "--colors", "primary=blue"})
+
+		assert.EqualError(t, cmd.Execute(), `invalid primary color "blue", use a hex color such as #635DFF`)
+	})
+}
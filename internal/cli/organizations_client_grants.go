@@ -0,0 +1,289 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var organizationClientGrantID = Flag{
+	Name:       "Client Grant ID",
+	LongForm:   "grant-id",
+	ShortForm:  "g",
+	Help:       "ID of the client grant.",
+	IsRequired: true,
+}
+
+func clientGrantsOrganizationCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-grants",
+		Short: "Manage client grants of an organization",
+		Long: "Manage the client grants associated with an organization, which allow applications to request " +
+			"access tokens for an API on behalf of the organization using the client credentials flow.\n\n" +
+			"Only the client grants whose organization usage is 'allow' or 'require' can be associated " +
+			"with an organization.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listClientGrantsOrganizationCmd(cli))
+	cmd.AddCommand(addClientGrantOrganizationCmd(cli))
+	cmd.AddCommand(removeClientGrantOrganizationCmd(cli))
+
+	return cmd
+}
+
+func listClientGrantsOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID     string
+		Number int
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "List client grants of an organization",
+		Long:    "List the client grants associated with an organization.",
+		Example: `  auth0 orgs client-grants list
+  auth0 orgs client-grants ls <org-id>
+  auth0 orgs client-grants list <org-id> --number 100
+  auth0 orgs client-grants ls <org-id> -n 100 --json
+  auth0 orgs client-grants ls <org-id> --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			if len(args) == 0 {
+				if err := organizationID.Pick(cmd, &inputs.ID, cli.organizationPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			list, err := getWithPagination(
+				inputs.Number,
+				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+					res, err := cli.api.Organization.ClientGrants(cmd.Context(), inputs.ID, opts...)
+					if err != nil {
+						return nil, false, err
+					}
+
+					for _, item := range res.ClientGrants {
+						result = append(result, item)
+					}
+
+					return result, res.HasNext(), nil
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to list client grants of organization with ID %q: %w", inputs.ID, err)
+			}
+
+			var grants []*management.ClientGrant
+			for _, item := range list {
+				grants = append(grants, item.(*management.ClientGrant))
+			}
+
+			cli.renderer.ClientGrantList(grants)
+
+			return nil
+		},
+	}
+
+	organizationNumber.Help = "Number of client grants to retrieve. Minimum 1, maximum 1000."
+	organizationNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+
+	return cmd
+}
+
+func addClientGrantOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID      string
+		GrantID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Associate a client grant with an organization",
+		Long: "Associate a client grant with an organization, so that the application can request access " +
+			"tokens for the API on behalf of the organization.\n\n" +
+			"The organization usage of the client grant must be 'allow' or 'require'.",
+		Example: `  auth0 orgs client-grants add
+  auth0 orgs client-grants add <org-id>
+  auth0 orgs client-grants add <org-id> --grant-id <grant-id>
+  auth0 orgs client-grants add <org-id> -g <grant-id>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := organizationID.Pick(cmd, &inputs.ID, cli.organizationPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			if err := organizationClientGrantID.Pick(cmd, &inputs.GrantID, cli.organizationUsageClientGrantPickerOptions); err != nil {
+				return err
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.AssociateClientGrant(cmd.Context(), inputs.ID, inputs.GrantID)
+			}); err != nil {
+				return fmt.Errorf(
+					"failed to associate client grant with ID %q with organization with ID %q: %w",
+					inputs.GrantID,
+					inputs.ID,
+					err,
+				)
+			}
+
+			cli.renderer.Infof(
+				"Successfully associated the client grant %s with the organization %s",
+				ansi.Faint(inputs.GrantID),
+				ansi.Faint(inputs.ID),
+			)
+
+			return nil
+		},
+	}
+
+	organizationClientGrantID.RegisterString(cmd, &inputs.GrantID, "")
+
+	return cmd
+}
+
+func removeClientGrantOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID      string
+		GrantID string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove",
+		Aliases: []string{"rm"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Remove a client grant from an organization",
+		Long: "Remove a client grant from an organization. The client grant itself is not deleted.\n\n" +
+			"To remove non-interactively, supply the organization id, the client grant id and the `--force` " +
+			"flag to skip confirmation.",
+		Example: `  auth0 orgs client-grants remove
+  auth0 orgs client-grants rm <org-id>
+  auth0 orgs client-grants remove <org-id> --grant-id <grant-id>
+  auth0 orgs client-grants rm <org-id> -g <grant-id> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := organizationID.Pick(cmd, &inputs.ID, cli.organizationPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			if err := organizationClientGrantID.Pick(
+				cmd,
+				&inputs.GrantID,
+				cli.organizationClientGrantPickerOptions(inputs.ID),
+			); err != nil {
+				return err
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.Organization.RemoveClientGrant(cmd.Context(), inputs.ID, inputs.GrantID)
+			}); err != nil {
+				return fmt.Errorf(
+					"failed to remove client grant with ID %q from organization with ID %q: %w",
+					inputs.GrantID,
+					inputs.ID,
+					err,
+				)
+			}
+
+			cli.renderer.Infof(
+				"Successfully removed the client grant %s from the organization %s",
+				ansi.Faint(inputs.GrantID),
+				ansi.Faint(inputs.ID),
+			)
+
+			return nil
+		},
+	}
+
+	organizationClientGrantID.RegisterString(cmd, &inputs.GrantID, "")
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// organizationUsageClientGrantPickerOptions lists the client grants
+// of the tenant that can be associated with an organization.
+func (cli *cli) organizationUsageClientGrantPickerOptions(ctx context.Context) (pickerOptions, error) {
+	list, err := cli.api.ClientGrant.List(ctx, management.PerPage(defaultPageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var opts pickerOptions
+	for _, grant := range list.ClientGrants {
+		switch grant.GetOrganizationUsage() {
+		case "allow", "require":
+			opts = append(opts, clientGrantPickerOption(grant))
+		}
+	}
+
+	if len(opts) == 0 {
+		return nil, errors.New(
+			"there are currently no client grants allowing organizations to choose from. " +
+				"Set the organization usage of a client grant to 'allow' or 'require' first",
+		)
+	}
+
+	return opts, nil
+}
+
+func (cli *cli) organizationClientGrantPickerOptions(orgID string) pickerOptionsFunc {
+	return func(ctx context.Context) (pickerOptions, error) {
+		list, err := cli.api.Organization.ClientGrants(ctx, orgID, management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		var opts pickerOptions
+		for _, grant := range list.ClientGrants {
+			opts = append(opts, clientGrantPickerOption(grant))
+		}
+
+		if len(opts) == 0 {
+			return nil, errors.New("there are currently no client grants associated with the organization")
+		}
+
+		return opts, nil
+	}
+}
+
+func clientGrantPickerOption(grant *management.ClientGrant) pickerOption {
+	label := fmt.Sprintf(
+		"%s %s",
+		grant.GetAudience(),
+		ansi.Faint("("+grant.GetID()+", client "+grant.GetClientID()+")"),
+	)
+
+	return pickerOption{value: grant.GetID(), label: label}
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestListClientGrantsOrganizationCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		ClientGrants(gomock.Any(), "org_123", gomock.Any()).
+		Return(&management.ClientGrantList{
+			ClientGrants: []*management.ClientGrant{
+				{
+					ID:                auth0.String("cgr_123"),
+					ClientID:          auth0.String("client-id"),
+					Audience:          auth0.String("https://api.example.com"),
+					Scope:             &[]string{"read:invoices", "create:invoices"},
+					OrganizationUsage: auth0.String("allow"),
+				},
+			},
+		}, nil)
+
+	stdout := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{
+			MessageWriter: io.Discard,
+			ResultWriter:  stdout,
+		},
+		api: &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := listClientGrantsOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_123"})
+
+	require.NoError(t, cmd.Execute())
+
+	expectTable(t, stdout.String(),
+		[]string{"ID", "CLIENT ID", "AUDIENCE", "SCOPES", "ORGANIZATION USAGE"},
+		[][]string{
+			{"cgr_123", "client-id", "https://api.example.com", "read:invoices create:invoices", "allow"},
+		},
+	)
+}
+
+func TestAddClientGrantOrganizationCmd(t *testing.T) {
+	t.Run("it associates the client grant with the organization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			AssociateClientGrant(gomock.Any(), "org_123", "cgr_123").
+			Return(nil)
+
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:      &auth0.API{Organization: organizationAPI},
+		}
+
+		cmd := addClientGrantOrganizationCmd(cli)
+		cmd.SetArgs([]string{"org_123", "--grant-id", "cgr_123"})
+
+		require.NoError(t, cmd.Execute())
+	})
+
+	t.Run("it fails to associate the client grant with the organization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+		organizationAPI.EXPECT().
+			AssociateClientGrant(gomock.Any(), "org_123", "cgr_123").
+			Return(errors.New("client grant does not allow organizations"))
+
+		cli := &cli{
+			renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+			api:      &auth0.API{Organization: organizationAPI},
+		}
+
+		cmd := addClientGrantOrganizationCmd(cli)
+		cmd.SetArgs([]string{"org_123", "--grant-id", "cgr_123"})
+
+		assert.EqualError(
+			t,
+			cmd.Execute(),
+			`failed to associate client grant with ID "cgr_123" with organization with ID "org_123": `+
+				"client grant does not allow organizations",
+		)
+	})
+}
+
+func TestRemoveClientGrantOrganizationCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		RemoveClientGrant(gomock.Any(), "org_123", "cgr_123").
+		Return(nil)
+
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard},
+		api:      &auth0.API{Organization: organizationAPI},
+	}
+
+	cmd := removeClientGrantOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_123", "--grant-id", "cgr_123", "--force"})
+
+	require.NoError(t, cmd.Execute())
+}
+
+func TestOrganizationUsageClientGrantPickerOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientGrantAPI := mock.NewMockClientGrantAPI(ctrl)
+	clientGrantAPI.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(&management.ClientGrantList{
+			ClientGrants: []*management.ClientGrant{
+				{ID: auth0.String("cgr_deny"), OrganizationUsage: auth0.String("deny")},
+				{ID: auth0.String("cgr_unset")},
+				{ID: auth0.String("cgr_allow"), OrganizationUsage: auth0.String("allow")},
+				{ID: auth0.String("cgr_require"), OrganizationUsage: auth0.String("require")},
+			},
+		}, nil)
+
+	cli := &cli{api: &auth0.API{ClientGrant: clientGrantAPI}}
+
+	options, err := cli.organizationUsageClientGrantPickerOptions(context.Background())
+	require.NoError(t, err)
+
+	var values []string
+	for _, option := range options {
+		values = append(values, option.value)
+	}
+	assert.Equal(t, []string{"cgr_allow", "cgr_require"}, values)
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// organizationMembersSearchBatchSize is the number of members searched for per request,
+// as their IDs get added to the query, whose length is limited.
+const organizationMembersSearchBatchSize = 50
+
+func searchMembersOrganizationCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID     string
+		Query  string
+		Number int
+	}
+
+	query := userQuery
+	query.Help = "Search query in Lucene query syntax, applied to the members of the organization.\n\n" +
+		"For example: `created_at:[2024-01-01 TO *] AND email:*@travel0.com`\n\n" +
+		" For more info: https://auth0.com/docs/users/user-search/user-search-query-syntax."
+
+	number := organizationNumber
+	number.Help = "Number of organization members, that match the search criteria, to retrieve. Minimum 1, maximum 1000."
+
+	cmd := &cobra.Command{
+		Use:   "search",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Search for members of an organization",
+		Long: "Search for the members of an organization matching a user search query, " +
+			"e.g. to find the members created after a date.\n\n" +
+			"The members are sorted by name.",
+		Example: `  auth0 orgs members search
+  auth0 orgs members search <org-id> --query "created_at:[2024-01-01 TO *]"
+  auth0 orgs members search <org-id> -q "email:*@travel0.com" --number 200
+  auth0 orgs members search <org-id> -q "logins_count:0" -n 200 --json
+  auth0 orgs members search <org-id> -q "logins_count:0" --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Number < 1 || inputs.Number > 1000 {
+				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
+			}
+
+			if len(args) == 0 {
+				if err := organizationID.Pick(cmd, &inputs.ID, cli.organizationPickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			if err := query.Ask(cmd, &inputs.Query, nil); err != nil {
+				return err
+			}
+
+			members, err := cli.getOrgMembers(cmd.Context(), inputs.ID, 0)
+			if err != nil {
+				return err
+			}
+
+			var users []*management.User
+			if err := ansi.Waiting(func() (err error) {
+				users, err = searchOrganizationMembers(cmd.Context(), cli, members, inputs.Query, inputs.Number)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to search for members of organization with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.UserSearch(users)
+
+			return nil
+		},
+	}
+
+	query.RegisterString(cmd, &inputs.Query, "")
+	number.RegisterInt(cmd, &inputs.Number, defaultPageSize)
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+
+	return cmd
+}
+
+// searchOrganizationMembers searches for the users matching the query among the members,
+// in batches whose query restricts the search to the IDs of the members of the batch.
+func searchOrganizationMembers(
+	ctx context.Context,
+	cli *cli,
+	members []management.OrganizationMember,
+	query string,
+	number int,
+) ([]*management.User, error) {
+	var users []*management.User
+
+	for start := 0; start < len(members); start += organizationMembersSearchBatchSize {
+		end := start + organizationMembersSearchBatchSize
+		if end > len(members) {
+			end = len(members)
+		}
+
+		userList, err := cli.api.User.Search(
+			ctx,
+			management.Query(organizationMembersQuery(members[start:end], query)),
+			management.PerPage(organizationMembersSearchBatchSize),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, userList.Users...)
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		return strings.ToLower(users[i].GetName()) < strings.ToLower(users[j].GetName())
+	})
+
+	if len(users) > number {
+		users = users[:number]
+	}
+
+	return users, nil
+}
+
+func organizationMembersQuery(members []management.OrganizationMember, query string) string {
+	ids := make([]string, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, fmt.Sprintf("%q", member.GetUserID()))
+	}
+
+	return fmt.Sprintf("(%s) AND user_id:(%s)", query, strings.Join(ids, " OR "))
+}
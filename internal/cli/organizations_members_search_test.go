@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestSearchMembersOrganizationCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var members []management.OrganizationMember
+	for i := 1; i <= organizationMembersSearchBatchSize+1; i++ {
+		members = append(members, management.OrganizationMember{UserID: auth0.String(fmt.Sprintf("auth0|%d", i))})
+	}
+
+	organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+	organizationAPI.EXPECT().
+		Members(gomock.Any(), "org_123", gomock.Any()).
+		Return(&management.OrganizationMemberList{Members: members}, nil)
+
+	userAPI := mock.NewMockUserAPI(ctrl)
+	gomock.InOrder(
+		userAPI.EXPECT().
+			Search(gomock.Any(), gomock.Any()).
+			Return(&management.UserList{Users: []*management.User{
+				{ID: auth0.String("auth0|2"), Name: auth0.String("Zoe"), Email: auth0.String("zoe@travel0.com")},
+				{ID: auth0.String("auth0|1"), Name: auth0.String("Bob"), Email: auth0.String("bob@travel0.com")},
+			}}, nil),
+		userAPI.EXPECT().
+			Search(gomock.Any(), gomock.Any()).
+			Return(&management.UserList{Users: []*management.User{
+				{ID: auth0.String("auth0|51"), Name: auth0.String("Alice"), Email: auth0.String("alice@travel0.com")},
+			}}, nil),
+	)
+
+	stdout := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{
+			MessageWriter: io.Discard,
+			ResultWriter:  stdout,
+		},
+		api: &auth0.API{Organization: organizationAPI, User: userAPI},
+	}
+
+	cmd := searchMembersOrganizationCmd(cli)
+	cmd.SetArgs([]string{"org_123", "--query", "logins_count:0", "--number", "2"})
+
+	require.NoError(t, cmd.Execute())
+
+	expectTable(t, stdout.String(),
+		[]string{"USERID", "EMAIL", "CONNECTION"},
+		[][]string{
+			{"auth0|51", "alice@travel0.com", ""},
+			{"auth0|1", "bob@travel0.com", ""},
+		},
+	)
+}
+
+func TestOrganizationMembersQuery(t *testing.T) {
+	members := []management.OrganizationMember{
+		{UserID: auth0.String("auth0|1")},
+		{UserID: auth0.String("google-oauth2|2")},
+	}
+
+	assert.Equal(
+		t,
+		`(created_at:[2024-01-01 TO *]) AND user_id:("auth0|1" OR "google-oauth2|2")`,
+		organizationMembersQuery(members, "created_at:[2024-01-01 TO *]"),
+	)
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/auth0/go-auth0/management"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/auth0/auth0-cli/internal/auth0"
 	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
 )
 
 func TestOrganizationsPickerOptions(t *testing.T) {
@@ -92,3 +95,71 @@ func TestOrganizationsPickerOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestListOrganizationsCmd(t *testing.T) {
+	organizations := []*management.Organization{
+		{
+			ID:       auth0.String("org_1"),
+			Name:     auth0.String("acme"),
+			Metadata: &map[string]string{"tag:env": "prod"},
+		},
+		{
+			ID:   auth0.String("org_2"),
+			Name: auth0.String("globex"),
+		},
+	}
+
+	var tests = []struct {
+		name            string
+		args            []string
+		total           int
+		expectedMessage string
+	}{
+		{
+			name:            "it tells when only some of the organizations are listed",
+			args:            []string{"--number", "2"},
+			total:           5,
+			expectedMessage: "Showing 2 of 5 organizations. Use --number to list more.",
+		},
+		{
+			name:  "it counts the organizations matching the tags",
+			args:  []string{"--number", "1", "--tag", "env=prod"},
+			total: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			organizationAPI := mock.NewMockOrganizationAPI(ctrl)
+			organizationAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&management.OrganizationList{
+					List:          management.List{Start: 0, Limit: 2, Total: test.total},
+					Organizations: organizations,
+				}, nil)
+
+			stderr := &bytes.Buffer{}
+			cli := &cli{
+				renderer: &display.Renderer{
+					MessageWriter: stderr,
+					ResultWriter:  io.Discard,
+				},
+				api: &auth0.API{Organization: organizationAPI},
+			}
+
+			cmd := listOrganizationsCmd(cli)
+			cmd.SetArgs(test.args)
+
+			assert.NoError(t, cmd.Execute())
+
+			if test.expectedMessage == "" {
+				assert.NotContains(t, stderr.String(), "Showing")
+				return
+			}
+			assert.Contains(t, stderr.String(), test.expectedMessage)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/config"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+// errProductionTenant is returned when a destructive command against
+// a production tenant is neither confirmed nor acknowledged by flag.
+var errProductionTenant = errors.New("the tenant is tagged as production")
+
+var tenantEnvironments = []string{config.EnvironmentProduction, "staging", "development"}
+
+// destructiveCommands lists the commands that remove data from the
+// tenant through update scopes, so without requiring any delete scope.
+var destructiveCommands = map[string]bool{
+	"auth0 roles expire-sweep":       true,
+	"auth0 roles permissions remove": true,
+	"auth0 tags remove":              true,
+	"auth0 users blocks unblock":     true,
+	"auth0 users roles remove":       true,
+}
+
+// destructiveFlags lists the flags that make otherwise harmless commands remove data from the tenant.
+var destructiveFlags = map[string][]string{
+	"auth0 tenants remove": {"delete-client"},
+}
+
+// isDestructiveCommand checks whether the command deletes resources or data from the tenant, that is
+// whether it's listed as such, is used with a destructive flag, requires any delete scope or is a
+// DELETE api request.
+func isDestructiveCommand(cmd *cobra.Command, args []string) bool {
+	commandPath := cmd.CommandPath()
+	if destructiveCommands[commandPath] {
+		return true
+	}
+
+	for _, flag := range destructiveFlags[commandPath] {
+		if enabled, err := cmd.Flags().GetBool(flag); err == nil && enabled {
+			return true
+		}
+	}
+
+	if commandPath == "auth0 api" {
+		return len(args) == 2 && strings.EqualFold(args[0], http.MethodDelete)
+	}
+
+	for _, scope := range requiredScopesByCommand[commandPath] {
+		if strings.HasPrefix(scope, "delete:") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkProductionTenant requires the domain of a production tenant to be typed in before running
+// a destructive command against it, unless the --i-know-this-is-prod flag acknowledges it upfront.
+func checkProductionTenant(cmd *cobra.Command, args []string, tenant config.Tenant, acknowledged bool) error {
+	if acknowledged || !tenant.IsProduction() || !isDestructiveCommand(cmd, args) {
+		return nil
+	}
+
+	if !canPrompt(cmd) {
+		return fmt.Errorf(
+			"%q would delete data from the tenant %s, but %w.\n\n"+
+				"Run the command with the %s flag to confirm it.",
+			cmd.CommandPath(),
+			tenant.Domain,
+			errProductionTenant,
+			ansi.Bold("--i-know-this-is-prod"),
+		)
+	}
+
+	var domain string
+	input := prompt.TextInput(
+		"domain",
+		fmt.Sprintf("%s is a production tenant. Type its domain to confirm:", ansi.Bold(tenant.Domain)),
+		"",
+		"",
+		true,
+	)
+	if err := prompt.AskOne(input, &domain); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(domain) != tenant.Domain {
+		return fmt.Errorf("the domain doesn't match the production tenant %s, aborting", tenant.Domain)
+	}
+
+	return nil
+}
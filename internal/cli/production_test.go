@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/config"
+)
+
+// newProductionTestCmd builds the command of the given path under the root command.
+func newProductionTestCmd(path ...string) *cobra.Command {
+	cmd := &cobra.Command{Use: "auth0"}
+	for _, use := range path {
+		child := &cobra.Command{Use: use}
+		cmd.AddCommand(child)
+		cmd = child
+	}
+	return cmd
+}
+
+func TestIsDestructiveCommand(t *testing.T) {
+	var testCases = []struct {
+		commandPath string
+		args        []string
+		flags       []string
+		expected    bool
+	}{
+		{"auth0 apps list", nil, nil, false},
+		{"auth0 apps update", nil, nil, false},
+		{"auth0 apps delete", nil, nil, true},
+		{"auth0 logs streams delete", nil, nil, true},
+		{"auth0 orgs client-grants remove", nil, nil, true},
+		{"auth0 users roles remove", nil, nil, true},
+		{"auth0 roles permissions remove", nil, nil, true},
+		{"auth0 users blocks unblock", nil, nil, true},
+		{"auth0 protection suspicious-ip-throttling ips unblock", nil, nil, true},
+		{"auth0 tenants remove", nil, []string{"purge"}, false},
+		{"auth0 tenants remove", nil, []string{"delete-client"}, true},
+		{"auth0 api", []string{"delete", "users/auth0|123"}, nil, true},
+		{"auth0 api", []string{"DELETE", "users/auth0|123"}, nil, true},
+		{"auth0 api", []string{"get", "users/auth0|123"}, nil, false},
+		{"auth0 api", []string{"users"}, nil, false},
+		{"auth0 unknown", nil, nil, false},
+	}
+
+	for _, testCase := range testCases {
+		name := strings.Join(append([]string{testCase.commandPath}, testCase.args...), " ")
+		for _, flag := range testCase.flags {
+			name += " --" + flag
+		}
+
+		t.Run(name, func(t *testing.T) {
+			cmd := newProductionTestCmd(strings.Fields(testCase.commandPath)[1:]...)
+			cmd.Flags().Bool("purge", false, "")
+			cmd.Flags().Bool("delete-client", false, "")
+			for _, flag := range testCase.flags {
+				assert.NoError(t, cmd.Flags().Set(flag, "true"))
+			}
+
+			assert.Equal(t, testCase.expected, isDestructiveCommand(cmd, testCase.args))
+		})
+	}
+}
+
+func TestCheckProductionTenant(t *testing.T) {
+	newCmd := newProductionTestCmd
+
+	production := config.Tenant{Domain: "example.us.auth0.com", Environment: config.EnvironmentProduction}
+
+	t.Run("it allows destructive commands against tenants not tagged as production", func(t *testing.T) {
+		err := checkProductionTenant(newCmd("apps", "delete"), nil, config.Tenant{Domain: "example.us.auth0.com", Environment: "staging"}, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it allows commands that aren't destructive against production tenants", func(t *testing.T) {
+		err := checkProductionTenant(newCmd("apps", "update"), nil, production, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it allows destructive commands acknowledged by flag", func(t *testing.T) {
+		err := checkProductionTenant(newCmd("apps", "delete"), nil, production, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it blocks destructive commands against production tenants when it can't prompt", func(t *testing.T) {
+		err := checkProductionTenant(newCmd("apps", "delete"), nil, production, false)
+		assert.ErrorIs(t, err, errProductionTenant)
+		assert.ErrorContains(t, err, "--i-know-this-is-prod")
+	})
+
+	t.Run("it blocks commands removing data against production tenants when it can't prompt", func(t *testing.T) {
+		err := checkProductionTenant(newCmd("users", "roles", "remove"), nil, production, false)
+		assert.ErrorIs(t, err, errProductionTenant)
+	})
+
+	t.Run("it blocks delete api requests against production tenants when it can't prompt", func(t *testing.T) {
+		err := checkProductionTenant(newCmd("api"), []string{"delete", "users/auth0|123"}, production, false)
+		assert.ErrorIs(t, err, errProductionTenant)
+	})
+
+	t.Run("it allows other api requests against production tenants", func(t *testing.T) {
+		err := checkProductionTenant(newCmd("api"), []string{"get", "users/auth0|123"}, production, false)
+		assert.NoError(t, err)
+	})
+}
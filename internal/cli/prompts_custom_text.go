@@ -89,6 +89,7 @@ func showPromptsTextCmd(cli *cli) *cobra.Command {
 	}
 
 	textLanguage.RegisterString(cmd, &inputs.Language, textLanguageDefault)
+	textLanguage.RegisterCompletionFunc(cmd, cli.enabledLocalesCompletions)
 
 	return cmd
 }
@@ -108,6 +109,7 @@ func updatePromptsTextCmd(cli *cli) *cobra.Command {
 	}
 
 	textLanguage.RegisterString(cmd, &inputs.Language, textLanguageDefault)
+	textLanguage.RegisterCompletionFunc(cmd, cli.enabledLocalesCompletions)
 
 	return cmd
 }
@@ -76,7 +76,10 @@ func listQuickstartsCmd(cli *cli) *cobra.Command {
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		Short:   "List the available Quickstarts",
-		Long:    "List the available Quickstarts.",
+		Long: "List the available Quickstarts.\n\n" +
+			"The JSON output includes the metadata of each Quickstart, such as the URL of its sample " +
+			"repository, the type of application it requires and the command to download it, so that " +
+			"the catalog can be embedded in other tools.",
 		Example: `  auth0 quickstarts list
   auth0 quickstarts ls
   auth0 qs list
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/config"
+)
+
+// errReadOnlyMode is returned when a request that would make
+// changes to the tenant is sent while in read-only mode.
+var errReadOnlyMode = errors.New("read-only mode is enabled")
+
+// isMutatingCommand checks whether the command requires any scope
+// other than read scopes, meaning it makes changes to the tenant.
+func isMutatingCommand(commandPath string) bool {
+	for _, scope := range requiredScopesByCommand[commandPath] {
+		if !strings.HasPrefix(scope, "read:") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkReadOnlyMode blocks the commands that would make changes to the tenant when
+// read-only mode is enabled, either through the --read-only flag or the tenant config.
+func checkReadOnlyMode(commandPath string, tenant config.Tenant, readOnlyFlag bool) error {
+	if !readOnlyFlag && !tenant.ReadOnly {
+		return nil
+	}
+
+	if !isMutatingCommand(commandPath) {
+		return nil
+	}
+
+	howToDisable := "Run the command without the " + ansi.Bold("--read-only") + " flag to make changes."
+	if tenant.ReadOnly {
+		howToDisable = "Disable read-only mode for the tenant by running: " +
+			ansi.Bold(fmt.Sprintf("auth0 tenants read-only %s --disable", tenant.Domain))
+	}
+
+	return fmt.Errorf(
+		"%q would make changes to the tenant %s, but %w.\n\n%s",
+		commandPath,
+		tenant.Domain,
+		errReadOnlyMode,
+		howToDisable,
+	)
+}
+
+// readOnlyTransport refuses to send any request that would make changes to the tenant.
+// This catches all the mutating requests that aren't blocked up front by checkReadOnlyMode.
+func readOnlyTransport(tripper http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		switch request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return tripper.RoundTrip(request)
+		default:
+			return nil, fmt.Errorf(
+				"%w, refusing to send a %s request to %s",
+				errReadOnlyMode,
+				request.Method,
+				request.URL.Path,
+			)
+		}
+	})
+}
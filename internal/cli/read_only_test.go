@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/config"
+)
+
+func TestIsMutatingCommand(t *testing.T) {
+	var testCases = []struct {
+		commandPath string
+		expected    bool
+	}{
+		{"auth0 apps list", false},
+		{"auth0 apps show", false},
+		{"auth0 apps create", true},
+		{"auth0 apps update", true},
+		{"auth0 apps delete", true},
+		{"auth0 users blocks unblock", true},
+		{"auth0 unknown", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.commandPath, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, isMutatingCommand(testCase.commandPath))
+		})
+	}
+}
+
+func TestCheckReadOnlyMode(t *testing.T) {
+	t.Run("it allows mutating commands when read-only mode is disabled", func(t *testing.T) {
+		err := checkReadOnlyMode("auth0 apps delete", config.Tenant{Domain: "example.us.auth0.com"}, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it allows read commands when read-only mode is enabled", func(t *testing.T) {
+		err := checkReadOnlyMode("auth0 apps list", config.Tenant{Domain: "example.us.auth0.com", ReadOnly: true}, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it blocks mutating commands when the tenant is in read-only mode", func(t *testing.T) {
+		err := checkReadOnlyMode("auth0 apps delete", config.Tenant{Domain: "example.us.auth0.com", ReadOnly: true}, false)
+		assert.ErrorIs(t, err, errReadOnlyMode)
+		assert.ErrorContains(t, err, "auth0 tenants read-only example.us.auth0.com --disable")
+	})
+
+	t.Run("it blocks mutating commands when the read-only flag is used", func(t *testing.T) {
+		err := checkReadOnlyMode("auth0 apps delete", config.Tenant{Domain: "example.us.auth0.com"}, true)
+		assert.ErrorIs(t, err, errReadOnlyMode)
+		assert.ErrorContains(t, err, "--read-only")
+	})
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	apiCalls := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		apiCalls++
+		writer.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(testServer.Close)
+
+	transport := readOnlyTransport(http.DefaultTransport)
+
+	t.Run("it sends read requests", func(t *testing.T) {
+		request, err := http.NewRequest(http.MethodGet, testServer.URL+"/api/v2/clients", nil)
+		require.NoError(t, err)
+
+		response, err := transport.RoundTrip(request)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, 1, apiCalls)
+	})
+
+	t.Run("it refuses to send requests making changes", func(t *testing.T) {
+		for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+			request, err := http.NewRequest(method, testServer.URL+"/api/v2/clients", nil)
+			require.NoError(t, err)
+
+			_, err = transport.RoundTrip(request)
+			assert.ErrorIs(t, err, errReadOnlyMode)
+			assert.ErrorContains(t, err, "refusing to send a "+method+" request to /api/v2/clients")
+		}
+
+		assert.Equal(t, 1, apiCalls)
+	})
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// sessionRecording holds the Management API requests made by a command,
+// in the order they were sent, so that they can be replayed afterwards.
+type sessionRecording struct {
+	Command    string             `json:"command"`
+	Tenant     string             `json:"tenant,omitempty"`
+	RecordedAt time.Time          `json:"recorded_at"`
+	Requests   []*recordedRequest `json:"requests"`
+}
+
+type recordedRequest struct {
+	Method     string          `json:"method"`
+	Path       string          `json:"path"`
+	Query      string          `json:"query,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	StatusCode int             `json:"status_code"`
+	Response   json.RawMessage `json:"response,omitempty"`
+}
+
+// sessionRecorder records the requests to a file, saving it after each
+// request so that the recording isn't lost when the command fails mid-way.
+type sessionRecorder struct {
+	mu        sync.Mutex
+	path      string
+	recording sessionRecording
+
+	// sanitize masks the secrets and the tenant domain, for the fixtures of the tests.
+	sanitize bool
+}
+
+func newSessionRecorder(path, command string, recordedAt time.Time) (*sessionRecorder, error) {
+	recorder := &sessionRecorder{
+		path: path,
+		recording: sessionRecording{
+			Command:    command,
+			RecordedAt: recordedAt.UTC(),
+			Requests:   []*recordedRequest{},
+		},
+	}
+
+	// Saving the empty recording right away surfaces
+	// any issue with the file before making requests.
+	if err := recorder.save(); err != nil {
+		return nil, err
+	}
+
+	return recorder, nil
+}
+
+func (r *sessionRecorder) record(tenant string, request *recordedRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.sanitize {
+		request = sanitizeFixtureRequest(tenant, request)
+		tenant = fixtureTenant
+	}
+
+	if r.recording.Tenant == "" {
+		r.recording.Tenant = tenant
+	}
+	r.recording.Requests = append(r.recording.Requests, request)
+
+	return r.save()
+}
+
+func (r *sessionRecorder) save() error {
+	data, err := json.MarshalIndent(r.recording, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// The recording can hold secrets, such as client secrets, so only the owner can read it.
+	if err := os.WriteFile(r.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save the recording to %s: %w", r.path, err)
+	}
+
+	return nil
+}
+
+// recordingTransport records each request sent to the Management API, along with its response.
+func recordingTransport(tripper http.RoundTripper, recorder *sessionRecorder) http.RoundTripper {
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		var requestBody []byte
+		if request.Body != nil {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return nil, err
+			}
+			_ = request.Body.Close()
+
+			requestBody = body
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		response, err := tripper.RoundTrip(request)
+		if err != nil {
+			return nil, err
+		}
+
+		responseBody, err := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+		if err := recorder.record(request.URL.Host, &recordedRequest{
+			Method:     request.Method,
+			Path:       request.URL.Path,
+			Query:      request.URL.RawQuery,
+			Body:       asRawJSON(requestBody),
+			StatusCode: response.StatusCode,
+			Response:   asRawJSON(responseBody),
+		}); err != nil {
+			return nil, err
+		}
+
+		return response, nil
+	})
+}
+
+// asRawJSON keeps JSON payloads as they are, and
+// stores any other non-empty payload as a string.
+func asRawJSON(data []byte) json.RawMessage {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil
+	}
+
+	if json.Valid(data) {
+		return data
+	}
+
+	encoded, _ := json.Marshal(string(data))
+	return encoded
+}
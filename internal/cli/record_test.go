@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingTransport(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodDelete {
+			writer.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Admin"}`, string(body))
+
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusCreated)
+		_, _ = writer.Write([]byte(`{"id":"rol_1","name":"Admin"}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	path := filepath.Join(t.TempDir(), "session.json")
+	recorder, err := newSessionRecorder(path, "auth0 roles create", time.Now())
+	require.NoError(t, err)
+
+	transport := recordingTransport(http.DefaultTransport, recorder)
+
+	request, err := http.NewRequest(http.MethodPost, testServer.URL+"/api/v2/roles", strings.NewReader(`{"name":"Admin"}`))
+	require.NoError(t, err)
+
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.JSONEq(t, `{"id":"rol_1","name":"Admin"}`, string(body), "the response is still readable")
+
+	request, err = http.NewRequest(http.MethodDelete, testServer.URL+"/api/v2/roles/rol_1?force=true", nil)
+	require.NoError(t, err)
+
+	response, err = transport.RoundTrip(request)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	recording, err := loadSessionRecording(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "auth0 roles create", recording.Command)
+	assert.Equal(t, strings.TrimPrefix(testServer.URL, "http://"), recording.Tenant)
+	require.Len(t, recording.Requests, 2)
+
+	assert.Equal(t, http.MethodPost, recording.Requests[0].Method)
+	assert.Equal(t, "/api/v2/roles", recording.Requests[0].Path)
+	assert.JSONEq(t, `{"name":"Admin"}`, string(recording.Requests[0].Body))
+	assert.Equal(t, http.StatusCreated, recording.Requests[0].StatusCode)
+	assert.JSONEq(t, `{"id":"rol_1","name":"Admin"}`, string(recording.Requests[0].Response))
+
+	assert.Equal(t, http.MethodDelete, recording.Requests[1].Method)
+	assert.Equal(t, "/api/v2/roles/rol_1", recording.Requests[1].Path)
+	assert.Equal(t, "force=true", recording.Requests[1].Query)
+	assert.Empty(t, recording.Requests[1].Body)
+	assert.Equal(t, http.StatusNoContent, recording.Requests[1].StatusCode)
+	assert.Empty(t, recording.Requests[1].Response)
+}
+
+func TestAsRawJSON(t *testing.T) {
+	assert.Nil(t, asRawJSON(nil))
+	assert.Nil(t, asRawJSON([]byte("  \n")))
+	assert.Equal(t, `{"id":"rol_1"}`, string(asRawJSON([]byte(`{"id":"rol_1"}`))))
+	assert.Equal(t, `"Not Found"`, string(asRawJSON([]byte("Not Found"))))
+}
@@ -0,0 +1,455 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var recordingFile = Argument{
+	Name: "Recording",
+	Help: "Path to a file recorded with the --record flag.",
+}
+
+func replayCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		File string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Replay the Management API requests recorded by a command",
+		Long: "Replay the Management API requests recorded by a command through the " + ansi.Bold("--record") +
+			" flag, e.g. to reproduce a setup on another tenant.\n\n" +
+			"Only the requests making changes to the tenant are replayed. The IDs of the resources created " +
+			"along the way are mapped to the IDs of the resources created by the replay. You'll be asked " +
+			"which ID to use instead of any other ID found in the requests, as it might refer to a resource " +
+			"that doesn't exist on the tenant. The replay stops at the first request that fails.",
+		Example: `  auth0 apps create --name myapp --type spa --record session.json
+  auth0 replay session.json
+  auth0 replay session.json --tenant <other-tenant>
+  auth0 replay session.json --tenant <other-tenant> --force --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := recordingFile.Ask(cmd, &inputs.File); err != nil {
+					return err
+				}
+			} else {
+				inputs.File = args[0]
+			}
+
+			recording, err := loadSessionRecording(inputs.File)
+			if err != nil {
+				return err
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				message := fmt.Sprintf(
+					"Are you sure you want to replay the requests of %q against the tenant %s?",
+					recording.Command,
+					cli.tenant,
+				)
+				if confirmed := prompt.Confirm(message); !confirmed {
+					return nil
+				}
+			}
+
+			askID := func(id, usedBy string) (string, error) {
+				return id, nil
+			}
+			if canPrompt(cmd) {
+				askID = askReplayID
+			}
+
+			replayer := newSessionReplayer(cli.api, cli.tenant, recording, askID)
+
+			// The requests aren't replayed behind a spinner, as replaying
+			// them might require asking which IDs to use along the way.
+			var replayed []display.ReplayedRequest
+			var replayErr error
+			for _, request := range recording.Requests {
+				if !isMutatingMethod(request.Method) {
+					continue
+				}
+
+				result, err := replayer.replay(cmd.Context(), request)
+				if err != nil {
+					replayErr = err
+					break
+				}
+
+				replayed = append(replayed, *result)
+			}
+
+			cli.renderer.ReplayedRequests(replayed)
+
+			for _, id := range replayer.remappedIDs() {
+				cli.renderer.Infof("%s is now %s", ansi.Faint(id), replayer.ids[id])
+			}
+
+			return replayErr
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func loadSessionRecording(path string) (*sessionRecording, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the recording: %w", err)
+	}
+
+	var recording sessionRecording
+	if err := json.Unmarshal(data, &recording); err != nil {
+		return nil, fmt.Errorf("failed to parse the recording %s: %w", path, err)
+	}
+
+	return &recording, nil
+}
+
+func askReplayID(id, usedBy string) (string, error) {
+	input := prompt.TextInput(
+		"",
+		fmt.Sprintf("ID to use instead of %s, for %s:", id, usedBy),
+		"The ID might refer to a resource of the recorded tenant. Keep it as it is if it's the same on this tenant.",
+		id,
+		true,
+	)
+
+	var answer string
+	if err := prompt.AskOne(input, &answer); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(answer), nil
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// sessionReplayer replays recorded requests, mapping the IDs
+// of the recorded tenant to the IDs on the current tenant.
+type sessionReplayer struct {
+	api    *auth0.API
+	domain string
+
+	// ids maps the IDs found in the recording to the IDs to use instead.
+	ids map[string]string
+
+	// recordedIDs holds the IDs found in the recorded responses.
+	recordedIDs map[string]bool
+
+	// askID asks which ID to use instead of an ID that wasn't mapped yet.
+	askID func(id, usedBy string) (string, error)
+}
+
+func newSessionReplayer(
+	api *auth0.API,
+	domain string,
+	recording *sessionRecording,
+	askID func(id, usedBy string) (string, error),
+) *sessionReplayer {
+	replayer := &sessionReplayer{
+		api:         api,
+		domain:      domain,
+		ids:         make(map[string]string),
+		recordedIDs: make(map[string]bool),
+		askID:       askID,
+	}
+
+	for _, request := range recording.Requests {
+		for _, id := range idsIn(request.Response) {
+			replayer.recordedIDs[id] = true
+		}
+	}
+
+	return replayer
+}
+
+func (r *sessionReplayer) replay(ctx context.Context, recorded *recordedRequest) (*display.ReplayedRequest, error) {
+	usedBy := recorded.Method + " " + recorded.Path
+
+	path, err := r.remapPath(recorded.Path, usedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := r.remapQuery(recorded.Query, usedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload interface{}
+	if len(recorded.Body) > 0 {
+		body, err := r.remapBody(recorded.Body, usedBy)
+		if err != nil {
+			return nil, err
+		}
+		payload = body
+	}
+
+	uri := fmt.Sprintf("https://%s%s", r.domain, path)
+	if query != "" {
+		uri += "?" + query
+	}
+
+	request, err := r.api.HTTPClient.NewRequest(ctx, recorded.Method, uri, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.api.HTTPClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay %s %s: %w", recorded.Method, path, err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if err := isInsufficientScopeError(response); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf(
+			"failed to replay %s %s: %d %s: %s",
+			recorded.Method,
+			path,
+			response.StatusCode,
+			http.StatusText(response.StatusCode),
+			body,
+		)
+	}
+
+	r.mapIDs(recorded.Response, asRawJSON(body))
+
+	return &display.ReplayedRequest{
+		Method:     recorded.Method,
+		Path:       path,
+		StatusCode: response.StatusCode,
+	}, nil
+}
+
+func (r *sessionReplayer) remap(id, usedBy string) (string, error) {
+	if mapped, ok := r.ids[id]; ok {
+		return mapped, nil
+	}
+
+	mapped, err := r.askID(id, usedBy)
+	if err != nil {
+		return "", err
+	}
+
+	r.ids[id] = mapped
+	return mapped, nil
+}
+
+// remapPath maps the IDs within the path. Besides the IDs found in the recorded
+// responses, any segment looking like an ID is considered one, as the command
+// might not have read the resource before making changes to it.
+func (r *sessionReplayer) remapPath(path, usedBy string) (string, error) {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		value, err := url.PathUnescape(segment)
+		if err != nil {
+			continue
+		}
+
+		if !r.recordedIDs[value] && !looksLikeID(value) {
+			continue
+		}
+
+		mapped, err := r.remap(value, usedBy)
+		if err != nil {
+			return "", err
+		}
+		segments[i] = url.PathEscape(mapped)
+	}
+
+	return strings.Join(segments, "/"), nil
+}
+
+func (r *sessionReplayer) remapQuery(query, usedBy string) (string, error) {
+	if query == "" {
+		return "", nil
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return query, nil
+	}
+
+	for key := range values {
+		for i, value := range values[key] {
+			if !r.recordedIDs[value] {
+				continue
+			}
+
+			mapped, err := r.remap(value, usedBy)
+			if err != nil {
+				return "", err
+			}
+			values[key][i] = mapped
+		}
+	}
+
+	return values.Encode(), nil
+}
+
+func (r *sessionReplayer) remapBody(body json.RawMessage, usedBy string) (json.RawMessage, error) {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil, err
+	}
+
+	var walk func(value interface{}) (interface{}, error)
+	walk = func(value interface{}) (interface{}, error) {
+		switch v := value.(type) {
+		case string:
+			if !r.recordedIDs[v] {
+				return v, nil
+			}
+			return r.remap(v, usedBy)
+		case []interface{}:
+			for i := range v {
+				mapped, err := walk(v[i])
+				if err != nil {
+					return nil, err
+				}
+				v[i] = mapped
+			}
+		case map[string]interface{}:
+			for key := range v {
+				mapped, err := walk(v[key])
+				if err != nil {
+					return nil, err
+				}
+				v[key] = mapped
+			}
+		}
+		return value, nil
+	}
+
+	value, err := walk(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(value)
+}
+
+// mapIDs maps the IDs of the recorded response to the
+// IDs found at the same place in the replayed response.
+func (r *sessionReplayer) mapIDs(recorded, replayed json.RawMessage) {
+	recordedIDs := idsByPath(recorded)
+	replayedIDs := idsByPath(replayed)
+
+	for path, id := range recordedIDs {
+		if replayedID, ok := replayedIDs[path]; ok {
+			r.ids[id] = replayedID
+		}
+	}
+}
+
+// remappedIDs returns the IDs of the recording that are now different, sorted.
+func (r *sessionReplayer) remappedIDs() []string {
+	var ids []string
+	for id, mapped := range r.ids {
+		if id != mapped {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// idsByPath returns the IDs within a JSON payload, keyed by their
+// path, e.g. "roles[0].id". IDs are held by "id" and "*_id" fields.
+func idsByPath(data json.RawMessage) map[string]string {
+	ids := make(map[string]string)
+	if len(data) == 0 {
+		return ids
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return ids
+	}
+
+	var walk func(path string, value interface{})
+	walk = func(path string, value interface{}) {
+		switch v := value.(type) {
+		case []interface{}:
+			for i, item := range v {
+				walk(path+"["+strconv.Itoa(i)+"]", item)
+			}
+		case map[string]interface{}:
+			for key, item := range v {
+				if id, ok := item.(string); ok && isIDField(key) && id != "" {
+					ids[path+"."+key] = id
+					continue
+				}
+				walk(path+"."+key, item)
+			}
+		}
+	}
+	walk("", value)
+
+	return ids
+}
+
+func idsIn(data json.RawMessage) []string {
+	var ids []string
+	for _, id := range idsByPath(data) {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func isIDField(key string) bool {
+	return key == "id" || strings.HasSuffix(key, "_id")
+}
+
+// looksLikeID tells whether a path segment looks like a generated
+// ID rather than a resource name, e.g. "rol_2Yq3bWTFxR7" or "auth0|123".
+func looksLikeID(segment string) bool {
+	if len(segment) < 8 {
+		return false
+	}
+
+	return strings.ContainsFunc(segment, func(r rune) bool {
+		return unicode.IsDigit(r) || r == '|'
+	})
+}
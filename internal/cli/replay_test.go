@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+func TestSessionReplayer(t *testing.T) {
+	type receivedRequest struct {
+		method string
+		path   string
+		body   map[string]interface{}
+	}
+	var received []receivedRequest
+
+	testServer := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(request.Body).Decode(&body)
+		received = append(received, receivedRequest{request.Method, request.URL.Path, body})
+
+		writer.Header().Set("Content-Type", "application/json")
+		switch request.URL.Path {
+		case "/api/v2/roles":
+			writer.WriteHeader(http.StatusCreated)
+			_, _ = writer.Write([]byte(`{"id":"rol_replayed","name":"Admin"}`))
+		case "/api/v2/clients/client_unknown":
+			writer.WriteHeader(http.StatusNotFound)
+			_, _ = writer.Write([]byte(`{"message":"The client does not exist"}`))
+		default:
+			writer.WriteHeader(http.StatusOK)
+			_, _ = writer.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(testServer.Close)
+
+	domain := strings.TrimPrefix(testServer.URL, "https://")
+	client, err := management.New(
+		domain,
+		management.WithStaticToken("token"),
+		management.WithClient(testServer.Client()),
+	)
+	require.NoError(t, err)
+
+	recording := &sessionRecording{
+		Command: "auth0 roles create",
+		Requests: []*recordedRequest{
+			{
+				Method:   http.MethodGet,
+				Path:     "/api/v2/clients/client_recorded",
+				Response: json.RawMessage(`{"client_id":"client_recorded","name":"My App"}`),
+			},
+			{
+				Method:   http.MethodPost,
+				Path:     "/api/v2/roles",
+				Body:     json.RawMessage(`{"name":"Admin"}`),
+				Response: json.RawMessage(`{"id":"rol_recorded","name":"Admin"}`),
+			},
+			{
+				Method: http.MethodPost,
+				Path:   "/api/v2/roles/rol_recorded/users",
+				Body:   json.RawMessage(`{"users":["auth0|user_1"],"client_id":"client_recorded"}`),
+			},
+			{
+				Method: http.MethodPatch,
+				Path:   "/api/v2/clients/client_recorded",
+				Body:   json.RawMessage(`{"name":"My App"}`),
+			},
+		},
+	}
+
+	var askedIDs []string
+	askID := func(id, usedBy string) (string, error) {
+		askedIDs = append(askedIDs, id+" for "+usedBy)
+		if id == "client_recorded" {
+			return "client_replayed", nil
+		}
+		return id, nil
+	}
+
+	replayer := newSessionReplayer(auth0.NewAPI(client), domain, recording, askID)
+
+	var paths []string
+	for _, request := range recording.Requests[1:] {
+		result, err := replayer.replay(context.Background(), request)
+		require.NoError(t, err)
+		paths = append(paths, result.Path)
+	}
+
+	assert.Equal(t, []string{
+		"/api/v2/roles",
+		"/api/v2/roles/rol_replayed/users",
+		"/api/v2/clients/client_replayed",
+	}, paths)
+
+	require.Len(t, received, 3)
+	assert.Equal(t, map[string]interface{}{"name": "Admin"}, received[0].body)
+	assert.Equal(t, map[string]interface{}{
+		"users":     []interface{}{"auth0|user_1"},
+		"client_id": "client_replayed",
+	}, received[1].body)
+	assert.Equal(t, http.MethodPatch, received[2].method)
+
+	assert.Equal(t, []string{"client_recorded for POST /api/v2/roles/rol_recorded/users"}, askedIDs)
+	assert.Equal(t, []string{"client_recorded", "rol_recorded"}, replayer.remappedIDs())
+
+	t.Run("it fails when a replayed request fails", func(t *testing.T) {
+		_, err := replayer.replay(context.Background(), &recordedRequest{
+			Method: http.MethodDelete,
+			Path:   "/api/v2/clients/client_unknown",
+		})
+		assert.ErrorContains(t, err, "failed to replay DELETE /api/v2/clients/client_unknown: 404 Not Found")
+	})
+}
+
+func TestLooksLikeID(t *testing.T) {
+	for segment, expected := range map[string]bool{
+		"api":                          false,
+		"v2":                           false,
+		"custom-text":                  false,
+		"breached-password-detection":  false,
+		"rol_2Yq3bWTFxR7":              true,
+		"auth0|user_1":                 true,
+		"qE2d5vX4hBt8cMgZ1sJ0kLyP3wRn": true,
+	} {
+		assert.Equal(t, expected, looksLikeID(segment), segment)
+	}
+}
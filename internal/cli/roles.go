@@ -9,6 +9,8 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
 	"github.com/auth0/auth0-cli/internal/prompt"
 )
 
@@ -54,6 +56,7 @@ func rolesCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(updateRoleCmd(cli))
 	cmd.AddCommand(deleteRoleCmd(cli))
 	cmd.AddCommand(rolePermissionsCmd(cli))
+	cmd.AddCommand(expireSweepRolesCmd(cli))
 
 	return cmd
 }
@@ -63,6 +66,8 @@ func listRolesCmd(cli *cli) *cobra.Command {
 		Number int
 	}
 
+	var multiTenant multiTenantInputs
+
 	cmd := &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
@@ -73,45 +78,50 @@ func listRolesCmd(cli *cli) *cobra.Command {
   auth0 roles ls
   auth0 roles ls --number 100
   auth0 roles ls -n 100 --json
-  auth0 roles ls --csv`,
+  auth0 roles ls --csv
+  auth0 roles list --all-tenants`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if inputs.Number < 1 || inputs.Number > 1000 {
 				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
 			}
 
-			list, err := getWithPagination(
-				inputs.Number,
-				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
-					roleList, err := cli.api.Role.List(cmd.Context(), opts...)
-					if err != nil {
-						return nil, false, err
-					}
-
-					for _, role := range roleList.Roles {
-						result = append(result, role)
-					}
-
-					return result, roleList.HasNext(), nil
-				},
-			)
-			if err != nil {
-				return fmt.Errorf("failed to list roles: %w", err)
-			}
+			return runForTenants(cmd, cli, multiTenant, func(api *auth0.API, renderer *display.Renderer, waiting waitingFunc) error {
+				list, err := getWithPaginationWaiting(
+					waiting,
+					inputs.Number,
+					func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+						roleList, err := api.Role.List(cmd.Context(), opts...)
+						if err != nil {
+							return nil, false, err
+						}
+
+						for _, role := range roleList.Roles {
+							result = append(result, role)
+						}
+
+						return result, roleList.HasNext(), nil
+					},
+				)
+				if err != nil {
+					return fmt.Errorf("failed to list roles: %w", err)
+				}
 
-			var roles []*management.Role
-			for _, item := range list {
-				roles = append(roles, item.(*management.Role))
-			}
+				var roles []*management.Role
+				for _, item := range list {
+					roles = append(roles, item.(*management.Role))
+				}
 
-			cli.renderer.RoleList(roles)
+				renderer.RoleList(roles)
 
-			return nil
+				return nil
+			})
 		},
 	}
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
 	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+	multiTenant.register(cmd)
 
 	roleNumber.RegisterInt(cmd, &inputs.Number, defaultPageSize)
 
@@ -120,18 +130,28 @@ func listRolesCmd(cli *cli) *cobra.Command {
 
 func showRoleCmd(cli *cli) *cobra.Command {
 	var inputs struct {
-		ID string
+		ID             string
+		EffectiveUsers bool
 	}
 
 	cmd := &cobra.Command{
 		Use:   "show",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Show a role",
-		Long:  "Display information about a role.",
+		Long: "Display information about a role.\n\n" +
+			"Use the `--effective-users` flag to list the users holding the role instead, " +
+			"such as for an access review. These include the organization members " +
+			"the role is assigned to within their organization.",
 		Example: `  auth0 roles show
   auth0 roles show <role-id>
-  auth0 roles show <role-id> --json`,
+  auth0 roles show <role-id> --json
+  auth0 roles show <role-id> --effective-users
+  auth0 roles show <role-id> --effective-users --csv`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if cli.csv && !inputs.EffectiveUsers {
+				return fmt.Errorf("the --csv flag can only be used along with --effective-users")
+			}
+
 			if len(args) == 0 {
 				if err := roleID.Pick(cmd, &inputs.ID, cli.rolePickerOptions); err != nil {
 					return err
@@ -150,12 +170,37 @@ func showRoleCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("failed to read role with ID %q: %w", inputs.ID, err)
 			}
 
+			if inputs.EffectiveUsers {
+				tenant, err := cli.Config.GetTenant(cli.tenant)
+				if err != nil {
+					return err
+				}
+
+				if err := checkScopes(cmd.CommandPath(), roleEffectiveUsersScopes, tenant); err != nil {
+					return err
+				}
+
+				var users []*display.RoleUser
+				if err := ansi.Waiting(func() (err error) {
+					users, err = fetchRoleEffectiveUsers(cmd.Context(), cli.api, inputs.ID)
+					return err
+				}); err != nil {
+					return fmt.Errorf("failed to list the users holding the role with ID %q: %w", inputs.ID, err)
+				}
+
+				cli.renderer.RoleUserList(r, users)
+				return nil
+			}
+
 			cli.renderer.RoleShow(r)
 			return nil
 		},
 	}
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+	roleEffectiveUsers.RegisterBool(cmd, &inputs.EffectiveUsers, false)
 
 	return cmd
 }
@@ -350,3 +395,10 @@ func (c *cli) rolePickerOptions(ctx context.Context) (pickerOptions, error) {
 
 	return opts, nil
 }
+
+func formatRoleSettingsPath(id string) string {
+	if len(id) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("roles/%s/settings", id)
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// roleEffectiveUsersScopes are the scopes needed to list the users holding a role.
+var roleEffectiveUsersScopes = []string{"read:users", "read:organizations", "read:organization_members"}
+
+var roleEffectiveUsers = Flag{
+	Name:     "Effective Users",
+	LongForm: "effective-users",
+	Help: "List the users holding the role, whether it's assigned to them directly " +
+		"or as members of an organization.",
+}
+
+// fetchRoleEffectiveUsers lists the users the role is assigned to, followed by the
+// organization members holding it. A user is listed once for each of the assignments.
+func fetchRoleEffectiveUsers(ctx context.Context, api *auth0.API, roleID string) ([]*display.RoleUser, error) {
+	var users []*display.RoleUser
+
+	for page := 0; ; page++ {
+		userList, err := api.Role.Users(ctx, roleID, management.Page(page), management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the users assigned to the role: %w", err)
+		}
+
+		for _, user := range userList.Users {
+			users = append(users, &display.RoleUser{
+				UserID: user.GetID(),
+				Name:   user.GetName(),
+				Email:  user.GetEmail(),
+			})
+		}
+
+		if !userList.HasNext() {
+			break
+		}
+	}
+
+	sortRoleUsers(users)
+
+	var organizations []*management.Organization
+	for page := 0; ; page++ {
+		orgList, err := api.Organization.List(ctx, management.Page(page), management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organizations: %w", err)
+		}
+
+		organizations = append(organizations, orgList.Organizations...)
+
+		if !orgList.HasNext() {
+			break
+		}
+	}
+
+	for _, org := range organizations {
+		members, err := organizationMembersWithRole(ctx, api, org, roleID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list members of organization with ID %q: %w", org.GetID(), err)
+		}
+
+		users = append(users, members...)
+	}
+
+	return users, nil
+}
+
+func organizationMembersWithRole(
+	ctx context.Context,
+	api *auth0.API,
+	org *management.Organization,
+	roleID string,
+) ([]*display.RoleUser, error) {
+	var users []*display.RoleUser
+
+	for page := 0; ; page++ {
+		memberList, err := api.Organization.Members(
+			ctx,
+			url.PathEscape(org.GetID()),
+			management.IncludeFields("user_id", "name", "email", "roles"),
+			management.Page(page),
+			management.PerPage(defaultPageSize),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range memberList.Members {
+			for _, role := range member.Roles {
+				if role.GetID() != roleID {
+					continue
+				}
+
+				users = append(users, &display.RoleUser{
+					UserID:           member.GetUserID(),
+					Name:             member.GetName(),
+					Email:            member.GetEmail(),
+					OrganizationID:   org.GetID(),
+					OrganizationName: org.GetName(),
+				})
+			}
+		}
+
+		if !memberList.HasNext() {
+			break
+		}
+	}
+
+	sortRoleUsers(users)
+
+	return users, nil
+}
+
+func sortRoleUsers(users []*display.RoleUser) {
+	sort.SliceStable(users, func(i, j int) bool {
+		return strings.ToLower(users[i].Name) < strings.ToLower(users[j].Name)
+	})
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestFetchRoleEffectiveUsers(t *testing.T) {
+	t.Run("it lists the users assigned the role directly and through organizations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		roleAPI := mock.NewMockRoleAPI(ctrl)
+		gomock.InOrder(
+			roleAPI.EXPECT().
+				Users(gomock.Any(), "rol_1", gomock.Any()).
+				Return(&management.UserList{
+					List: management.List{Start: 0, Limit: 1, Total: 2},
+					Users: []*management.User{
+						{ID: auth0.String("auth0|2"), Name: auth0.String("Zoe"), Email: auth0.String("zoe@travel0.com")},
+					},
+				}, nil),
+			roleAPI.EXPECT().
+				Users(gomock.Any(), "rol_1", gomock.Any()).
+				Return(&management.UserList{
+					List: management.List{Start: 1, Limit: 1, Total: 2},
+					Users: []*management.User{
+						{ID: auth0.String("auth0|1"), Name: auth0.String("Alice")},
+					},
+				}, nil),
+		)
+
+		orgAPI := mock.NewMockOrganizationAPI(ctrl)
+		orgAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(&management.OrganizationList{
+				Organizations: []*management.Organization{
+					{ID: auth0.String("org_1"), Name: auth0.String("travel0")},
+				},
+			}, nil)
+		orgAPI.EXPECT().
+			Members(gomock.Any(), "org_1", gomock.Any()).
+			Return(&management.OrganizationMemberList{
+				Members: []management.OrganizationMember{
+					{
+						UserID: auth0.String("auth0|3"),
+						Name:   auth0.String("Bob"),
+						Roles:  []*management.OrganizationMemberListRole{{ID: auth0.String("rol_1")}},
+					},
+					{
+						UserID: auth0.String("auth0|4"),
+						Name:   auth0.String("Carol"),
+						Roles:  []*management.OrganizationMemberListRole{{ID: auth0.String("rol_2")}},
+					},
+				},
+			}, nil)
+
+		users, err := fetchRoleEffectiveUsers(context.Background(), &auth0.API{Role: roleAPI, Organization: orgAPI}, "rol_1")
+		require.NoError(t, err)
+		assert.Equal(t, []*display.RoleUser{
+			{UserID: "auth0|1", Name: "Alice"},
+			{UserID: "auth0|2", Name: "Zoe", Email: "zoe@travel0.com"},
+			{UserID: "auth0|3", Name: "Bob", OrganizationID: "org_1", OrganizationName: "travel0"},
+		}, users)
+	})
+
+	t.Run("it returns an error if the users of the role can't be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		roleAPI := mock.NewMockRoleAPI(ctrl)
+		roleAPI.EXPECT().
+			Users(gomock.Any(), "rol_1", gomock.Any()).
+			Return(nil, errors.New("rate limited"))
+
+		_, err := fetchRoleEffectiveUsers(context.Background(), &auth0.API{Role: roleAPI}, "rol_1")
+		assert.EqualError(t, err, "failed to list the users assigned to the role: rate limited")
+	})
+}
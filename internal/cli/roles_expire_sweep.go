@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+// roleExpirationsMetadataKey is the key of the app_metadata of a user recording
+// when their temporary role assignments expire, as role IDs mapped to RFC 3339 dates.
+const roleExpirationsMetadataKey = "role_expirations"
+
+func expireSweepRolesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expire-sweep",
+		Args:  cobra.NoArgs,
+		Short: "Remove the expired role assignments",
+		Long: "Remove the role assignments that expired from the users they were assigned to.\n\n" +
+			"Roles are assigned temporarily with `auth0 users roles assign --expires-in`, which records " +
+			"when they expire in the `app_metadata` of the user. Run this command on a schedule, such as " +
+			"from a cron job, to revoke the temporary access once it expires.",
+		Example: `  auth0 roles expire-sweep
+  auth0 roles expire-sweep --no-input`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var users []*management.User
+			if err := ansi.Waiting(func() (err error) {
+				users, err = usersWithRoleExpirations(cmd.Context(), cli.api.User)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to search for users with temporary role assignments: %w", err)
+			}
+
+			var removed int
+			now := cli.now()
+
+			for _, user := range users {
+				expiredRoleIDs := expiredRoleAssignments(user, now)
+				if len(expiredRoleIDs) == 0 {
+					continue
+				}
+
+				if err := removeExpiredRoles(cmd.Context(), cli.api.User, user, expiredRoleIDs); err != nil {
+					return fmt.Errorf("failed to remove the expired roles of user with ID %q: %w", user.GetID(), err)
+				}
+
+				for _, roleID := range expiredRoleIDs {
+					cli.renderer.Infof("Removed the expired role %s from user %s", ansi.Bold(roleID), ansi.Bold(user.GetID()))
+				}
+				removed += len(expiredRoleIDs)
+			}
+
+			if removed == 0 {
+				cli.renderer.Infof("No role assignments have expired")
+				return nil
+			}
+
+			cli.renderer.Infof("Successfully removed %s expired role assignments", ansi.Bold(strconv.Itoa(removed)))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// usersWithRoleExpirations searches for all the users with temporary role assignments.
+func usersWithRoleExpirations(ctx context.Context, userAPI auth0.UserAPI) ([]*management.User, error) {
+	var users []*management.User
+
+	for page := 0; ; page++ {
+		userList, err := userAPI.Search(
+			ctx,
+			management.Query("_exists_:app_metadata."+roleExpirationsMetadataKey),
+			management.Page(page),
+			management.PerPage(defaultPageSize),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, userList.Users...)
+
+		if !userList.HasNext() {
+			return users, nil
+		}
+	}
+}
+
+// roleExpirations returns when the temporary role assignments of the user expire.
+func roleExpirations(user *management.User) map[string]time.Time {
+	expirations := make(map[string]time.Time)
+
+	recorded, ok := user.GetAppMetadata()[roleExpirationsMetadataKey].(map[string]interface{})
+	if !ok {
+		return expirations
+	}
+
+	for roleID, value := range recorded {
+		date, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			continue
+		}
+
+		expirations[roleID] = expiresAt
+	}
+
+	return expirations
+}
+
+// expiredRoleAssignments returns the IDs of the roles of the user that expired by now.
+func expiredRoleAssignments(user *management.User, now time.Time) []string {
+	var expired []string
+	for roleID, expiresAt := range roleExpirations(user) {
+		if !expiresAt.After(now) {
+			expired = append(expired, roleID)
+		}
+	}
+
+	sort.Strings(expired)
+
+	return expired
+}
+
+func removeExpiredRoles(ctx context.Context, userAPI auth0.UserAPI, user *management.User, roleIDs []string) error {
+	var roles []*management.Role
+	for _, roleID := range roleIDs {
+		roles = append(roles, &management.Role{ID: auth0.String(roleID)})
+	}
+
+	if err := userAPI.RemoveRoles(ctx, user.GetID(), roles); err != nil {
+		return err
+	}
+
+	expirations := roleExpirations(user)
+	for _, roleID := range roleIDs {
+		delete(expirations, roleID)
+	}
+
+	return updateRoleExpirations(ctx, userAPI, user.GetID(), expirations)
+}
+
+// recordRoleExpirations records when the roles assigned to the user expire,
+// or clears their expiration when they're assigned permanently with a zero time.
+func recordRoleExpirations(ctx context.Context, userAPI auth0.UserAPI, userID string, roleIDs []string, expiresAt time.Time) error {
+	user, err := userAPI.Read(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	expirations := roleExpirations(user)
+	changed := false
+
+	for _, roleID := range roleIDs {
+		if _, ok := expirations[roleID]; ok && expiresAt.IsZero() {
+			delete(expirations, roleID)
+			changed = true
+		}
+
+		if !expiresAt.IsZero() {
+			expirations[roleID] = expiresAt
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return updateRoleExpirations(ctx, userAPI, userID, expirations)
+}
+
+// updateRoleExpirations replaces the recorded role expirations of the user, as the app_metadata
+// only gets merged at its top level. The key gets removed once no temporary role assignments remain.
+func updateRoleExpirations(ctx context.Context, userAPI auth0.UserAPI, userID string, expirations map[string]time.Time) error {
+	var recorded interface{}
+	if len(expirations) > 0 {
+		dates := make(map[string]interface{}, len(expirations))
+		for roleID, expiresAt := range expirations {
+			dates[roleID] = expiresAt.UTC().Format(time.RFC3339)
+		}
+		recorded = dates
+	}
+
+	return userAPI.Update(ctx, userID, &management.User{
+		AppMetadata: &map[string]interface{}{
+			roleExpirationsMetadataKey: recorded,
+		},
+	})
+}
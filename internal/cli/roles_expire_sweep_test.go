@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func userWithRoleExpirations(expirations map[string]interface{}) *management.User {
+	return &management.User{
+		ID: auth0.String("auth0|123"),
+		AppMetadata: &map[string]interface{}{
+			roleExpirationsMetadataKey: expirations,
+		},
+	}
+}
+
+func TestExpiredRoleAssignments(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	user := userWithRoleExpirations(map[string]interface{}{
+		"rol_expired":   "2024-05-01T11:00:00Z",
+		"rol_now":       "2024-05-01T12:00:00Z",
+		"rol_active":    "2024-05-01T13:00:00Z",
+		"rol_malformed": "tomorrow",
+	})
+
+	assert.Equal(t, []string{"rol_expired", "rol_now"}, expiredRoleAssignments(user, now))
+	assert.Empty(t, expiredRoleAssignments(&management.User{}, now))
+}
+
+func TestRecordRoleExpirations(t *testing.T) {
+	expiresAt := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+
+	t.Run("it records the expiration of the roles", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userAPI := mock.NewMockUserAPI(ctrl)
+		userAPI.EXPECT().
+			Read(gomock.Any(), "auth0|123").
+			Return(userWithRoleExpirations(map[string]interface{}{"rol_1": "2024-05-01T10:00:00Z"}), nil)
+		userAPI.EXPECT().
+			Update(gomock.Any(), "auth0|123", &management.User{
+				AppMetadata: &map[string]interface{}{
+					roleExpirationsMetadataKey: map[string]interface{}{
+						"rol_1": "2024-05-01T10:00:00Z",
+						"rol_2": "2024-05-01T20:00:00Z",
+					},
+				},
+			}).
+			Return(nil)
+
+		err := recordRoleExpirations(context.Background(), userAPI, "auth0|123", []string{"rol_2"}, expiresAt)
+		require.NoError(t, err)
+	})
+
+	t.Run("it clears the expiration of roles assigned permanently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userAPI := mock.NewMockUserAPI(ctrl)
+		userAPI.EXPECT().
+			Read(gomock.Any(), "auth0|123").
+			Return(userWithRoleExpirations(map[string]interface{}{"rol_1": "2024-05-01T10:00:00Z"}), nil)
+		userAPI.EXPECT().
+			Update(gomock.Any(), "auth0|123", &management.User{
+				AppMetadata: &map[string]interface{}{roleExpirationsMetadataKey: nil},
+			}).
+			Return(nil)
+
+		err := recordRoleExpirations(context.Background(), userAPI, "auth0|123", []string{"rol_1"}, time.Time{})
+		require.NoError(t, err)
+	})
+
+	t.Run("it doesn't update the user when no expiration changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userAPI := mock.NewMockUserAPI(ctrl)
+		userAPI.EXPECT().
+			Read(gomock.Any(), "auth0|123").
+			Return(&management.User{ID: auth0.String("auth0|123")}, nil)
+
+		err := recordRoleExpirations(context.Background(), userAPI, "auth0|123", []string{"rol_1"}, time.Time{})
+		require.NoError(t, err)
+	})
+}
+
+func TestRemoveExpiredRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := userWithRoleExpirations(map[string]interface{}{
+		"rol_expired": "2024-05-01T11:00:00Z",
+		"rol_active":  "2024-05-01T13:00:00Z",
+	})
+
+	userAPI := mock.NewMockUserAPI(ctrl)
+	userAPI.EXPECT().
+		RemoveRoles(gomock.Any(), "auth0|123", []*management.Role{{ID: auth0.String("rol_expired")}}).
+		Return(nil)
+	userAPI.EXPECT().
+		Update(gomock.Any(), "auth0|123", &management.User{
+			AppMetadata: &map[string]interface{}{
+				roleExpirationsMetadataKey: map[string]interface{}{"rol_active": "2024-05-01T13:00:00Z"},
+			},
+		}).
+		Return(nil)
+
+	err := removeExpiredRoles(context.Background(), userAPI, user, []string{"rol_expired"})
+	require.NoError(t, err)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
@@ -36,13 +37,7 @@ func Execute() {
 	// Prevent sorting of commands.
 	cobra.EnableCommandSorting = false
 
-	rootCmd := buildRootCmd(cli)
-	rootCmd.SetUsageTemplate(namespaceUsageTemplate())
-
-	addPersistentFlags(rootCmd, cli)
-	addSubCommands(rootCmd, cli)
-
-	overrideHelpAndVersionFlagText(rootCmd)
+	rootCmd := buildCommandTree(cli)
 
 	defer func() {
 		if v := recover(); v != nil {
@@ -62,8 +57,27 @@ func Execute() {
 	ansi.InitConsole()
 
 	cancelCtx := contextWithCancel()
-	if err := rootCmd.ExecuteContext(cancelCtx); err != nil {
-		renderErrorMessage(cli.renderer, err.Error())
+	defer cli.cancelDeadline()
+	if cmd, err := rootCmd.ExecuteContextC(cancelCtx); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && (cli.timeout > 0 || cli.deadline > 0) {
+			err = fmt.Errorf("%w, consider increasing the --timeout or --deadline", err)
+		}
+
+		result := errorResult(err, cli.apiErrors.Latest())
+		if cli.json {
+			cli.renderer.JSONError(result)
+		} else {
+			renderErrorMessage(cli.renderer, err.Error(), result.RequestID)
+		}
+
+		logFailedCommand(debugLogPath(cli.Config.Path()), debugLogEntry{
+			Time:       cli.now(),
+			Command:    cmd.CommandPath(),
+			Version:    buildinfo.Version,
+			Error:      err.Error(),
+			StatusCode: result.StatusCode,
+			RequestID:  result.RequestID,
+		})
 
 		instrumentation.ReportException(err)
 		os.Exit(1) // nolint:gocritic
@@ -75,6 +89,19 @@ func Execute() {
 	defer cli.tracker.Wait(timeoutCtx) // No event should be tracked after this has run, or it will panic e.g. in earlier deferred functions.
 }
 
+// buildCommandTree builds the root command along with all of its flags and subcommands.
+func buildCommandTree(cli *cli) *cobra.Command {
+	rootCmd := buildRootCmd(cli)
+	rootCmd.SetUsageTemplate(namespaceUsageTemplate())
+
+	addPersistentFlags(rootCmd, cli)
+	addSubCommands(rootCmd, cli)
+
+	overrideHelpAndVersionFlagText(rootCmd)
+
+	return rootCmd
+}
+
 func buildRootCmd(cli *cli) *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:           "auth0",
@@ -88,10 +115,46 @@ func buildRootCmd(cli *cli) *cobra.Command {
 			prepareInteractivity(cmd)
 			cli.configureRenderer()
 
+			if err := cli.checkDeprecations(cmd); err != nil {
+				return err
+			}
+
+			if cli.deadline > 0 {
+				ctx, cancel := context.WithTimeout(cmd.Context(), cli.deadline)
+				cmd.SetContext(ctx)
+				cli.deadlineCancel = cancel
+			}
+
 			if !commandRequiresAuthentication(cmd.CommandPath()) {
 				return nil
 			}
 
+			if cli.record != "" {
+				recorder, err := newSessionRecorder(cli.record, cmd.CommandPath(), cli.now())
+				if err != nil {
+					return err
+				}
+				cli.recorder = recorder
+			}
+
+			if cli.recordFixtures != "" {
+				recorder, err := newFixtureRecorder(cli.recordFixtures, cmd.CommandPath(), cli.now())
+				if err != nil {
+					return err
+				}
+				cli.recorder = recorder
+			}
+
+			// Replaying fixtures doesn't reach the tenant, so it needs no authentication.
+			if cli.replayFixtures != "" {
+				return cli.setupWithFixtures(cli.replayFixtures)
+			}
+
+			// Commands running across multiple tenants authenticate each of them.
+			if isRunningAcrossTenants(cmd) {
+				return nil
+			}
+
 			// We're tracking the login command in its Run method, so
 			// we'll only add this defer if the command is not login.
 			defer func() {
@@ -106,7 +169,20 @@ func buildRootCmd(cli *cli) *cobra.Command {
 				return err
 			}
 
-			return nil
+			tenant, err := cli.Config.GetTenant(cli.tenant)
+			if err != nil {
+				return err
+			}
+
+			if err := checkReadOnlyMode(cmd.CommandPath(), tenant, cli.readOnly); err != nil {
+				return err
+			}
+
+			if err := checkProductionTenant(cmd, args, tenant, cli.iKnowThisIsProd); err != nil {
+				return err
+			}
+
+			return checkRequiredScopes(cmd.CommandPath(), tenant)
 		},
 	}
 
@@ -116,11 +192,23 @@ func buildRootCmd(cli *cli) *cobra.Command {
 func commandRequiresAuthentication(invokedCommandName string) bool {
 	commandsWithNoAuthRequired := []string{
 		"auth0 completion",
+		"auth0 " + cobra.ShellCompRequestCmd,
+		"auth0 " + cobra.ShellCompNoDescRequestCmd,
+		"auth0 explain",
 		"auth0 help",
+		"auth0 init",
+		"auth0 listen",
 		"auth0 login",
 		"auth0 logout",
+		"auth0 support-bundle",
 		"auth0 tenants use",
 		"auth0 tenants list",
+		"auth0 tenants remove",
+		"auth0 tenants read-only",
+		"auth0 tenants environment",
+		"auth0 upgrade",
+		"auth0 version",
+		"auth0 whoami",
 	}
 
 	for _, cmd := range commandsWithNoAuthRequired {
@@ -144,31 +232,80 @@ func addPersistentFlags(rootCmd *cobra.Command, cli *cli) {
 
 	rootCmd.PersistentFlags().BoolVar(&cli.noColor,
 		"no-color", false, "Disable colors.")
+
+	rootCmd.PersistentFlags().BoolVar(&cli.readOnly,
+		"read-only", false, "Block all the commands that would make changes to the tenant.")
+
+	rootCmd.PersistentFlags().BoolVar(&cli.iKnowThisIsProd,
+		"i-know-this-is-prod", false, "Skip the extra confirmation of destructive commands against tenants tagged as production.")
+
+	rootCmd.PersistentFlags().BoolVar(&cli.reveal,
+		"reveal", false, "Reveal the secrets, such as client secrets and credentials, instead of masking them in the output.")
+
+	rootCmd.PersistentFlags().StringVar(&cli.record,
+		"record", "", "Record the Management API requests made by the command to a file, to replay them with 'auth0 replay'.")
+
+	rootCmd.PersistentFlags().StringVar(&cli.recordFixtures,
+		"record-fixtures", "", "Record the Management API requests made by the command to a file, masking the secrets and the tenant domain.")
+
+	rootCmd.PersistentFlags().StringVar(&cli.replayFixtures,
+		"replay-fixtures", "", "Answer the Management API requests made by the command with the responses recorded in a file.")
+
+	// The fixtures are only meant for the tests of the commands.
+	_ = rootCmd.PersistentFlags().MarkHidden("record-fixtures")
+	_ = rootCmd.PersistentFlags().MarkHidden("replay-fixtures")
+	rootCmd.MarkFlagsMutuallyExclusive("record", "record-fixtures", "replay-fixtures")
+
+	rootCmd.PersistentFlags().DurationVar(&cli.timeout,
+		"timeout", 0, "Maximum duration of each Management API request, including its retries, e.g. 30s. Unlimited by default.")
+
+	rootCmd.PersistentFlags().DurationVar(&cli.deadline,
+		"deadline", 0, "Maximum duration of the whole command, e.g. 5m. Unlimited by default.")
+
+	rootCmd.PersistentFlags().BoolVar(&cli.strict,
+		"strict", false, "Fail instead of warning when using deprecated commands, aliases or flags.")
 }
 
 func addSubCommands(rootCmd *cobra.Command, cli *cli) {
 	// The order of the commands here matters.
 	// Add new commands in a place that reflect its
 	// relevance or relation with other commands.
+	rootCmd.AddCommand(initCmd(cli))
 	rootCmd.AddCommand(loginCmd(cli))
 	rootCmd.AddCommand(logoutCmd(cli))
 	rootCmd.AddCommand(tenantsCmd(cli))
+	rootCmd.AddCommand(whoAmICmd(cli))
 	rootCmd.AddCommand(appsCmd(cli))
 	rootCmd.AddCommand(usersCmd(cli))
+	rootCmd.AddCommand(connectionsCmd(cli))
 	rootCmd.AddCommand(rulesCmd(cli))
 	rootCmd.AddCommand(actionsCmd(cli))
 	rootCmd.AddCommand(apisCmd(cli))
 	rootCmd.AddCommand(rolesCmd(cli))
 	rootCmd.AddCommand(organizationsCmd(cli))
+	rootCmd.AddCommand(tagsCmd(cli))
 	rootCmd.AddCommand(universalLoginCmd(cli))
 	rootCmd.AddCommand(emailCmd(cli))
 	rootCmd.AddCommand(customDomainsCmd(cli))
 	rootCmd.AddCommand(quickstartsCmd(cli))
 	rootCmd.AddCommand(attackProtectionCmd(cli))
+	rootCmd.AddCommand(securityCmd(cli))
+	rootCmd.AddCommand(auditCmd(cli))
+	rootCmd.AddCommand(networkACLsCmd(cli))
+	rootCmd.AddCommand(tokenExchangeCmd(cli))
 	rootCmd.AddCommand(testCmd(cli))
 	rootCmd.AddCommand(logsCmd(cli))
+	rootCmd.AddCommand(traceCmd(cli))
+	rootCmd.AddCommand(dashboardCmd(cli))
+	rootCmd.AddCommand(listenCmd(cli))
+	rootCmd.AddCommand(explainCmd(cli))
 	rootCmd.AddCommand(apiCmd(cli))
+	rootCmd.AddCommand(replayCmd(cli))
+	rootCmd.AddCommand(shellCmd(cli))
 	rootCmd.AddCommand(terraformCmd(cli))
+	rootCmd.AddCommand(versionCmd(cli))
+	rootCmd.AddCommand(upgradeCmd(cli))
+	rootCmd.AddCommand(supportBundleCmd(cli))
 
 	// Keep completion at the bottom.
 	rootCmd.AddCommand(completionCmd(cli))
@@ -205,7 +342,9 @@ func overrideHelpAndVersionFlagText(cmd *cobra.Command) {
 	}
 }
 
-func renderErrorMessage(display *display.Renderer, errorMessage string) {
+// renderErrorMessage renders the error, along with the request ID of the
+// Management API error causing it, if any, to be given to Auth0 support.
+func renderErrorMessage(display *display.Renderer, errorMessage, requestID string) {
 	display.Heading(ansi.Red("error"))
 
 	rawErrorMessage := []rune(errorMessage)
@@ -217,5 +356,8 @@ func renderErrorMessage(display *display.Renderer, errorMessage string) {
 	) + "."
 
 	display.Errorf(humanReadableErrorMessage)
+	if requestID != "" {
+		display.Errorf("Request ID: %s", requestID)
+	}
 	display.Newline()
 }
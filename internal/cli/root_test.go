@@ -1,10 +1,18 @@
 package cli
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/display"
 )
 
 func TestCommandRequiresAuthentication(t *testing.T) {
@@ -19,11 +27,19 @@ func TestCommandRequiresAuthentication(t *testing.T) {
 		{"auth0 apps create", true},
 		{"auth0 orgs members list", true},
 		{"auth0 completion", false},
+		{"auth0 __complete", false},
+		{"auth0 __completeNoDesc", false},
 		{"auth0 help", false},
 		{"auth0 login", false},
 		{"auth0 logout", false},
 		{"auth0 tenants use", false},
 		{"auth0 tenants list", false},
+		{"auth0 tenants remove", false},
+		{"auth0 tenants read-only", false},
+		{"auth0 support-bundle", false},
+		{"auth0 upgrade", false},
+		{"auth0 version", false},
+		{"auth0 whoami", false},
 	}
 
 	for index, testCase := range testCases {
@@ -33,3 +49,38 @@ func TestCommandRequiresAuthentication(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderErrorMessage(t *testing.T) {
+	messages := &bytes.Buffer{}
+	renderer := &display.Renderer{MessageWriter: messages, ResultWriter: io.Discard}
+
+	renderErrorMessage(renderer, "failed to read role: 404 Not Found", "8b5c9d1a")
+
+	assert.Contains(t, messages.String(), "Failed to read role: 404 Not Found.")
+	assert.Contains(t, messages.String(), "Request ID: 8b5c9d1a")
+}
+
+func TestRootCmdDeadline(t *testing.T) {
+	cli := &cli{renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: io.Discard}, deadline: time.Minute}
+
+	var ctx context.Context
+	rootCmd := buildRootCmd(cli)
+	rootCmd.AddCommand(&cobra.Command{
+		Use: "explain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx = cmd.Context()
+			return nil
+		},
+	})
+	rootCmd.SetArgs([]string{"explain"})
+
+	require.NoError(t, rootCmd.ExecuteContext(context.Background()))
+
+	_, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.NoError(t, ctx.Err())
+
+	cli.cancelDeadline()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.Nil(t, cli.deadlineCancel)
+}
@@ -62,9 +62,8 @@ var (
 	}
 )
 
-var rulesDeprecationText = "Rules are deprecated and will be removed in the near future. Users should migrate all rules to actions. See https://auth0.com/docs/customize/actions/migrate/migrate-from-rules-to-actions for more details.\n\n"
-var rulesDeprecationLogText = ansi.Red(ansi.Bold("DEPRECATED! ")) + ansi.Red(rulesDeprecationText)
-var rulesDeprecationDocumentationText = "*DEPRECATED!* " + rulesDeprecationText
+var rulesDeprecationText = "Rules are deprecated and will be removed in the near future. Users should migrate all rules to actions. See https://auth0.com/docs/customize/actions/migrate/migrate-from-rules-to-actions for more details."
+var rulesDeprecationDocumentationText = "*DEPRECATED!* " + rulesDeprecationText + "\n\n"
 
 func rulesCmd(cli *cli) *cobra.Command {
 	cmd := &cobra.Command{
@@ -83,6 +82,8 @@ func rulesCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(enableRuleCmd(cli))
 	cmd.AddCommand(disableRuleCmd(cli))
 
+	deprecateCommand(cmd, "auth0 actions", rulesDeprecationText)
+
 	return cmd
 }
 
@@ -114,7 +115,6 @@ func listRulesCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("failed to list rules: %w", err)
 			}
 
-			cli.renderer.Warnf(rulesDeprecationLogText)
 			cli.renderer.RulesList(rules)
 
 			return nil
@@ -192,7 +192,6 @@ func createRuleCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("failed to create rule: %w", err)
 			}
 
-			cli.renderer.Warnf(rulesDeprecationLogText)
 			cli.renderer.RuleCreate(rule)
 
 			return nil
@@ -242,7 +241,6 @@ func showRuleCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("failed to read rule with ID %q: %w", inputs.ID, err)
 			}
 
-			cli.renderer.Warnf(rulesDeprecationLogText)
 			cli.renderer.RuleShow(rule)
 
 			return nil
@@ -396,7 +394,6 @@ func updateRuleCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("failed to update rule with ID %q: %w", inputs.ID, err)
 			}
 
-			cli.renderer.Warnf(rulesDeprecationLogText)
 			cli.renderer.RuleUpdate(updatedRule)
 
 			return nil
@@ -455,7 +452,6 @@ func enableRuleCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("failed to update rule with ID %q: %w", inputs.ID, err)
 			}
 
-			cli.renderer.Warnf(rulesDeprecationLogText)
 			cli.renderer.RuleEnable(rule)
 
 			return nil
@@ -510,7 +506,6 @@ func disableRuleCmd(cli *cli) *cobra.Command {
 			}
 
 			cli.renderer.RuleDisable(rule)
-			cli.renderer.Warnf(rulesDeprecationLogText)
 
 			return nil
 		},
@@ -0,0 +1,255 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth"
+	"github.com/auth0/auth0-cli/internal/config"
+)
+
+// requiredScopesByCommand lists the Management API scopes each
+// command needs in order to perform all of its operations.
+//
+// Commands that are not listed here are not checked up front.
+var requiredScopesByCommand = map[string][]string{
+	"auth0 actions create": {"create:actions"},
+	"auth0 actions delete": {"read:actions", "delete:actions"},
+	"auth0 actions deploy": {"read:actions", "update:actions"},
+	"auth0 actions export": {"read:actions"},
+	"auth0 actions import": {"read:actions", "create:actions", "update:actions"},
+	"auth0 actions list":   {"read:actions"},
+	"auth0 actions show":   {"read:actions"},
+	"auth0 actions update": {"read:actions", "update:actions"},
+
+	"auth0 apis create":      {"create:resource_servers"},
+	"auth0 apis delete":      {"read:resource_servers", "delete:resource_servers"},
+	"auth0 apis list":        {"read:resource_servers"},
+	"auth0 apis scopes list": {"read:resource_servers"},
+	"auth0 apis scopes diff": {"read:resource_servers"},
+	"auth0 apis show":        {"read:resource_servers"},
+	"auth0 apis update":      {"read:resource_servers", "update:resource_servers"},
+
+	"auth0 apps create":     {"create:clients"},
+	"auth0 apps delete":     {"read:clients", "delete:clients"},
+	"auth0 apps fapi-check": {"read:clients"},
+	"auth0 apps list":       {"read:clients"},
+	"auth0 apps show":       {"read:clients"},
+	"auth0 apps snippet":    {"read:clients"},
+	"auth0 apps update":     {"read:clients", "update:clients"},
+
+	"auth0 audit references":       {"read:resource_servers", "read:clients", "read:client_grants", "read:actions", "read:connections", "read:organizations", "read:organization_connections"},
+	"auth0 audit refresh-rotation": {"read:clients", "read:logs"},
+
+	"auth0 connections attributes show":   {"read:connections"},
+	"auth0 connections attributes update": {"read:connections", "update:connections"},
+	"auth0 connections create apple":      {"create:connections"},
+	"auth0 connections create facebook":   {"create:connections"},
+	"auth0 connections create github":     {"create:connections"},
+	"auth0 connections create google":     {"create:connections"},
+	"auth0 connections export-users":      {"read:connections", "read:users"},
+
+	"auth0 dashboard": {"read:logs", "read:clients", "read:users"},
+
+	"auth0 domains create": {"create:custom_domains"},
+	"auth0 domains delete": {"delete:custom_domains"},
+	"auth0 domains list":   {"read:custom_domains"},
+	"auth0 domains show":   {"read:custom_domains"},
+	"auth0 domains update": {"read:custom_domains", "update:custom_domains"},
+	"auth0 domains verify": {"read:custom_domains", "create:custom_domains"},
+
+	"auth0 email templates diff":   {"read:email_templates"},
+	"auth0 email templates show":   {"read:email_templates"},
+	"auth0 email templates update": {"read:email_templates", "update:email_templates"},
+
+	"auth0 init": {"read:clients", "create:clients", "update:clients", "create:resource_servers", "create:client_grants"},
+
+	"auth0 logs backfill":       {"read:logs"},
+	"auth0 logs list":           {"read:logs"},
+	"auth0 logs tail":           {"read:logs"},
+	"auth0 logs streams delete": {"read:log_streams", "delete:log_streams"},
+	"auth0 logs streams list":   {"read:log_streams"},
+	"auth0 logs streams replay": {"read:log_streams", "read:logs"},
+	"auth0 logs streams show":   {"read:log_streams"},
+
+	"auth0 network-acls create": {"read:network_acls", "create:network_acls"},
+	"auth0 network-acls delete": {"read:network_acls", "delete:network_acls"},
+	"auth0 network-acls list":   {"read:network_acls"},
+	"auth0 network-acls show":   {"read:network_acls"},
+	"auth0 network-acls update": {"read:network_acls", "update:network_acls"},
+
+	"auth0 orgs branding show":        {"read:organizations"},
+	"auth0 orgs branding update":      {"read:organizations", "update:organizations"},
+	"auth0 orgs client-grants add":    {"read:client_grants", "create:organization_client_grants"},
+	"auth0 orgs client-grants list":   {"read:organization_client_grants"},
+	"auth0 orgs client-grants remove": {"read:organization_client_grants", "delete:organization_client_grants"},
+	"auth0 orgs create":               {"create:organizations"},
+	"auth0 orgs delete":               {"read:organizations", "delete:organizations"},
+	"auth0 orgs list":                 {"read:organizations"},
+	"auth0 orgs show":                 {"read:organizations"},
+	"auth0 orgs update":               {"read:organizations", "update:organizations"},
+	"auth0 orgs members list":         {"read:organization_members"},
+	"auth0 orgs members search":       {"read:organization_members", "read:users"},
+	"auth0 orgs roles list":           {"read:organization_members", "read:organization_member_roles"},
+	"auth0 orgs roles members list":   {"read:organization_members", "read:organization_member_roles"},
+
+	"auth0 protection suspicious-ip-throttling ips check":   {"read:anomaly_blocks"},
+	"auth0 protection suspicious-ip-throttling ips unblock": {"delete:anomaly_blocks"},
+
+	"auth0 quickstarts download": {"read:clients", "update:clients"},
+
+	"auth0 roles create":             {"create:roles"},
+	"auth0 roles delete":             {"read:roles", "delete:roles"},
+	"auth0 roles expire-sweep":       {"read:users", "update:users"},
+	"auth0 roles list":               {"read:roles"},
+	"auth0 roles show":               {"read:roles"},
+	"auth0 roles update":             {"read:roles", "update:roles"},
+	"auth0 roles permissions add":    {"read:roles", "update:roles", "read:resource_servers"},
+	"auth0 roles permissions list":   {"read:roles"},
+	"auth0 roles permissions remove": {"read:roles", "update:roles", "read:resource_servers"},
+
+	"auth0 rules create":  {"create:rules"},
+	"auth0 rules delete":  {"read:rules", "delete:rules"},
+	"auth0 rules disable": {"read:rules", "update:rules"},
+	"auth0 rules enable":  {"read:rules", "update:rules"},
+	"auth0 rules list":    {"read:rules"},
+	"auth0 rules show":    {"read:rules"},
+	"auth0 rules update":  {"read:rules", "update:rules"},
+
+	"auth0 security check-ip": {"read:anomaly_blocks", "read:users"},
+
+	"auth0 tags add":    {"read:clients", "update:clients", "read:organizations", "update:organizations", "read:connections", "update:connections"},
+	"auth0 tags list":   {"read:clients", "read:organizations", "read:connections"},
+	"auth0 tags remove": {"read:clients", "update:clients", "read:organizations", "update:organizations", "read:connections", "update:connections"},
+
+	"auth0 tenants error-page show":   {"read:tenant_settings"},
+	"auth0 tenants error-page update": {"read:tenant_settings", "update:tenant_settings"},
+	"auth0 tenants locales show":      {"read:tenant_settings"},
+	"auth0 tenants locales update":    {"read:tenant_settings", "update:tenant_settings"},
+
+	"auth0 test login":       {"read:clients", "update:clients"},
+	"auth0 test logout":      {"read:clients", "update:clients"},
+	"auth0 test silent-auth": {"read:clients", "update:clients"},
+	"auth0 test token":       {"read:clients", "read:client_grants"},
+
+	"auth0 token-exchange create": {"create:token_exchange_profiles", "read:actions"},
+	"auth0 token-exchange delete": {"read:token_exchange_profiles", "delete:token_exchange_profiles"},
+	"auth0 token-exchange list":   {"read:token_exchange_profiles"},
+	"auth0 token-exchange show":   {"read:token_exchange_profiles"},
+	"auth0 token-exchange update": {"read:token_exchange_profiles", "update:token_exchange_profiles"},
+
+	"auth0 trace login": {"read:clients", "update:clients", "read:logs", "read:actions"},
+
+	"auth0 universal-login show":             {"read:branding"},
+	"auth0 universal-login update":           {"read:branding", "update:branding"},
+	"auth0 universal-login prompts show":     {"read:prompts"},
+	"auth0 universal-login prompts update":   {"read:prompts", "update:prompts"},
+	"auth0 universal-login rendering show":   {"read:prompts"},
+	"auth0 universal-login rendering update": {"update:prompts"},
+	"auth0 universal-login templates show":   {"read:branding"},
+	"auth0 universal-login templates update": {"read:branding", "update:branding"},
+	"auth0 universal-login themes copy":      {"read:branding", "update:branding"},
+
+	"auth0 users blocks list":    {"read:users"},
+	"auth0 users blocks unblock": {"update:users"},
+	"auth0 users create":         {"create:users"},
+	"auth0 users delete":         {"read:users", "delete:users"},
+	"auth0 users import":         {"read:connections", "create:users"},
+	"auth0 users migrate":        {"read:connections", "create:users"},
+	"auth0 users roles assign":   {"read:roles", "read:users", "update:users"},
+	"auth0 users roles remove":   {"read:users", "update:users"},
+	"auth0 users roles show":     {"read:users"},
+	"auth0 users search":         {"read:users"},
+	"auth0 users show":           {"read:users"},
+	"auth0 users update":         {"read:users", "update:users"},
+}
+
+// missingScopesForCommand returns the scopes required by
+// the command that are not part of the granted scopes.
+func missingScopesForCommand(commandPath string, grantedScopes []string) []string {
+	return findMissingScopes(requiredScopesByCommand[commandPath], grantedScopes)
+}
+
+// findMissingScopes returns the required scopes that are not part of the granted scopes.
+func findMissingScopes(requiredScopes, grantedScopes []string) []string {
+	granted := make(map[string]bool, len(grantedScopes))
+	for _, scope := range grantedScopes {
+		granted[scope] = true
+	}
+
+	var missing []string
+	for _, scope := range requiredScopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+
+	return missing
+}
+
+// checkRequiredScopes makes sure the access token of the tenant has all the
+// scopes needed by the invoked command, so we can fail early with an actionable
+// message instead of surfacing a 403 from the Management API mid-way.
+func checkRequiredScopes(commandPath string, tenant config.Tenant) error {
+	return checkScopes(commandPath, requiredScopesByCommand[commandPath], tenant)
+}
+
+// checkScopes makes sure the access token of the tenant has all the required
+// scopes, for commands whose scopes depend on their inputs.
+func checkScopes(commandPath string, requiredScopes []string, tenant config.Tenant) error {
+	grantedScopes := tenant.GetGrantedScopes()
+	if len(grantedScopes) == 0 {
+		return nil // We can't reliably determine the scopes, so let the API decide.
+	}
+
+	missingScopes := findMissingScopes(requiredScopes, grantedScopes)
+	if len(missingScopes) == 0 {
+		return nil
+	}
+
+	if tenant.IsAuthenticatedWithClientCredentials() {
+		return fmt.Errorf(
+			"the access token lacks the scopes required by %q: %s.\n\n"+
+				"Grant these scopes to the application with client ID %s within the Auth0 Management API "+
+				"and log in again by running: %s",
+			commandPath,
+			strings.Join(missingScopes, ", "),
+			tenant.ClientID,
+			ansi.Bold("auth0 login --domain <tenant-domain> --client-id <client-id> --client-secret <client-secret>"),
+		)
+	}
+
+	loginCommand := fmt.Sprintf("auth0 login --domain %s --scopes %s", tenant.Domain, strings.Join(missingScopes, ","))
+	if tenant.LimitedScopes {
+		// Keep the scopes already granted, so the CLI stays within a limited set of scopes.
+		scopes := append(slices.Clone(grantedScopes), missingScopes...)
+		loginCommand = fmt.Sprintf(
+			"auth0 login --domain %s --limited-scopes --scopes %s",
+			tenant.Domain,
+			strings.Join(withoutBaseScopes(scopes), ","),
+		)
+	}
+
+	return fmt.Errorf(
+		"the access token lacks the scopes required by %q: %s.\n\n"+
+			"Re-authorize the CLI with these scopes by running: %s",
+		commandPath,
+		strings.Join(missingScopes, ", "),
+		ansi.Bold(loginCommand),
+	)
+}
+
+// withoutBaseScopes filters out the scopes that are always requested on login.
+func withoutBaseScopes(scopes []string) []string {
+	filteredScopes := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !slices.Contains(auth.BaseScopes, scope) {
+			filteredScopes = append(filteredScopes, scope)
+		}
+	}
+
+	return filteredScopes
+}
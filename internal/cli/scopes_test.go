@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalando/go-keyring"
+
+	"github.com/auth0/auth0-cli/internal/config"
+)
+
+func TestMissingScopesForCommand(t *testing.T) {
+	t.Run("it returns nothing when all the scopes are granted", func(t *testing.T) {
+		missingScopes := missingScopesForCommand("auth0 apps update", []string{"read:clients", "update:clients"})
+		assert.Empty(t, missingScopes)
+	})
+
+	t.Run("it returns the scopes that were not granted", func(t *testing.T) {
+		missingScopes := missingScopesForCommand("auth0 apps update", []string{"read:clients"})
+		assert.Equal(t, []string{"update:clients"}, missingScopes)
+	})
+
+	t.Run("it returns nothing for commands without known scope requirements", func(t *testing.T) {
+		missingScopes := missingScopesForCommand("auth0 api", []string{})
+		assert.Empty(t, missingScopes)
+	})
+}
+
+func TestCheckRequiredScopes(t *testing.T) {
+	keyring.MockInit()
+
+	t.Run("it doesn't fail when the scopes can't be determined", func(t *testing.T) {
+		err := checkRequiredScopes("auth0 apps list", config.Tenant{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("it suggests logging in with the missing scopes when authenticated as a user", func(t *testing.T) {
+		tenant := config.Tenant{
+			Domain: "example.us.auth0.com",
+			Scopes: []string{"read:users"},
+		}
+
+		err := checkRequiredScopes("auth0 apps update", tenant)
+		assert.ErrorContains(t, err, `the access token lacks the scopes required by "auth0 apps update": read:clients, update:clients`)
+		assert.ErrorContains(t, err, "auth0 login --domain example.us.auth0.com --scopes read:clients,update:clients")
+	})
+
+	t.Run("it suggests logging in with the granted and missing scopes when logged in with limited scopes", func(t *testing.T) {
+		tenant := config.Tenant{
+			Domain:        "example.us.auth0.com",
+			Scopes:        []string{"openid", "offline_access", "read:clients"},
+			LimitedScopes: true,
+		}
+
+		err := checkRequiredScopes("auth0 apps update", tenant)
+		assert.ErrorContains(t, err, `the access token lacks the scopes required by "auth0 apps update": update:clients`)
+		assert.ErrorContains(t, err, "auth0 login --domain example.us.auth0.com --limited-scopes --scopes read:clients,update:clients")
+	})
+
+	t.Run("it suggests granting the missing scopes when authenticated via client credentials", func(t *testing.T) {
+		tenant := config.Tenant{
+			Domain:   "example.us.auth0.com",
+			ClientID: "client-id",
+			Scopes:   []string{"read:users"},
+		}
+
+		err := checkRequiredScopes("auth0 apps list", tenant)
+		assert.ErrorContains(t, err, "Grant these scopes to the application with client ID client-id")
+	})
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+var securityCheckIPUser = Flag{
+	Name:      "User",
+	LongForm:  "user",
+	ShortForm: "u",
+	Help: "Username, email or phone number of a user to also check the brute-force protection blocks of, " +
+		"as they block an IP address for a single user.",
+}
+
+func securityCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security",
+		Short: "Investigate the security of your tenant",
+		Long:  "Investigate the security of your tenant, such as why an IP address can't log in.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(securityCheckIPCmd(cli))
+
+	return cmd
+}
+
+func securityCheckIPCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		IP   string
+		User string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "check-ip",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Check whether an IP address is blocked",
+		Long: "Check whether an IP address is blocked by the Suspicious IP Throttling or, for the user " +
+			"given with `--user`, by the Brute-force Protection.\n\n" +
+			"The command only reports the blocks. Unblock the IP address with " +
+			"`auth0 protection suspicious-ip-throttling ips unblock` and the user with `auth0 users blocks unblock`.",
+		Example: `  auth0 security check-ip
+  auth0 security check-ip <ip>
+  auth0 security check-ip "178.178.178.178"
+  auth0 security check-ip "178.178.178.178" --user "frederik@travel0.com"
+  auth0 security check-ip "178.178.178.178" -u "frederik@travel0.com" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := ipAddress.Ask(cmd, &inputs.IP); err != nil {
+					return err
+				}
+			} else {
+				inputs.IP = args[0]
+			}
+
+			check := &display.IPCheck{IP: inputs.IP, User: inputs.User}
+
+			if err := ansi.Waiting(func() (err error) {
+				check.SuspiciousIPThrottlingBlocked, err = cli.api.Anomaly.CheckIP(cmd.Context(), inputs.IP)
+				if err != nil {
+					return fmt.Errorf("failed to check if IP %q is blocked: %w", inputs.IP, err)
+				}
+
+				if inputs.User == "" {
+					return nil
+				}
+
+				userBlocks, err := cli.api.User.BlocksByIdentifier(cmd.Context(), inputs.User)
+				if err != nil {
+					return fmt.Errorf("failed to list user blocks for user %q: %w", inputs.User, err)
+				}
+
+				check.BruteForceBlocks = []*management.UserBlock{}
+				for _, userBlock := range userBlocks {
+					if userBlock.GetIP() == inputs.IP {
+						check.BruteForceBlocks = append(check.BruteForceBlocks, userBlock)
+					}
+				}
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			cli.renderer.IPCheck(check)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	securityCheckIPUser.RegisterString(cmd, &inputs.User, "")
+
+	return cmd
+}
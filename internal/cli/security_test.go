@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestSecurityCheckIPCmd(t *testing.T) {
+	var tests = []struct {
+		name             string
+		args             []string
+		isBlocked        bool
+		userBlocks       []*management.UserBlock
+		expectedMessages []string
+	}{
+		{
+			name:      "it reports that the ip is not blocked",
+			args:      []string{"178.178.178.178"},
+			isBlocked: false,
+			expectedMessages: []string{
+				"The IP 178.178.178.178 is not blocked by the Suspicious IP Throttling.",
+				"check them with --user",
+			},
+		},
+		{
+			name:      "it reports that the ip is blocked by the suspicious ip throttling",
+			args:      []string{"178.178.178.178"},
+			isBlocked: true,
+			expectedMessages: []string{
+				"The IP 178.178.178.178 is blocked by the Suspicious IP Throttling.",
+				"auth0 protection suspicious-ip-throttling ips unblock 178.178.178.178",
+			},
+		},
+		{
+			name:      "it reports that the ip is blocked by the brute-force protection for the user",
+			args:      []string{"178.178.178.178", "--user", "frederik@travel0.com"},
+			isBlocked: false,
+			userBlocks: []*management.UserBlock{
+				{Identifier: auth0.String("frederik@travel0.com"), IP: auth0.String("10.0.0.1")},
+				{Identifier: auth0.String("frederik@travel0.com"), IP: auth0.String("178.178.178.178")},
+			},
+			expectedMessages: []string{
+				"The IP 178.178.178.178 is not blocked by the Suspicious IP Throttling.",
+				"The IP 178.178.178.178 is blocked by the Brute-force Protection for frederik@travel0.com.",
+				"auth0 users blocks unblock frederik@travel0.com",
+			},
+		},
+		{
+			name:      "it reports that the ip is not blocked by the brute-force protection for the user",
+			args:      []string{"178.178.178.178", "--user", "frederik@travel0.com"},
+			isBlocked: false,
+			userBlocks: []*management.UserBlock{
+				{Identifier: auth0.String("frederik@travel0.com"), IP: auth0.String("10.0.0.1")},
+			},
+			expectedMessages: []string{
+				"The IP 178.178.178.178 is not blocked by the Brute-force Protection for frederik@travel0.com.",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			anomalyAPI := mock.NewMockAnomalyAPI(ctrl)
+			anomalyAPI.EXPECT().CheckIP(gomock.Any(), "178.178.178.178").Return(test.isBlocked, nil)
+
+			userAPI := mock.NewMockUserAPI(ctrl)
+			if test.userBlocks != nil {
+				userAPI.EXPECT().BlocksByIdentifier(gomock.Any(), "frederik@travel0.com").Return(test.userBlocks, nil)
+			}
+
+			messages := &bytes.Buffer{}
+			cli := &cli{
+				renderer: &display.Renderer{
+					MessageWriter: messages,
+					ResultWriter:  io.Discard,
+				},
+				api: &auth0.API{Anomaly: anomalyAPI, User: userAPI},
+			}
+
+			cmd := securityCheckIPCmd(cli)
+			cmd.SetArgs(test.args)
+
+			assert.NoError(t, cmd.Execute())
+			for _, expectedMessage := range test.expectedMessages {
+				assert.Contains(t, messages.String(), expectedMessage)
+			}
+		})
+	}
+
+	t.Run("it outputs the blocks in json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		anomalyAPI := mock.NewMockAnomalyAPI(ctrl)
+		anomalyAPI.EXPECT().CheckIP(gomock.Any(), "178.178.178.178").Return(true, nil)
+
+		userAPI := mock.NewMockUserAPI(ctrl)
+		userAPI.EXPECT().
+			BlocksByIdentifier(gomock.Any(), "frederik@travel0.com").
+			Return([]*management.UserBlock{{Identifier: auth0.String("frederik@travel0.com"), IP: auth0.String("178.178.178.178")}}, nil)
+
+		result := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{
+				MessageWriter: io.Discard,
+				ResultWriter:  result,
+				Format:        display.OutputFormatJSON,
+			},
+			api: &auth0.API{Anomaly: anomalyAPI, User: userAPI},
+		}
+
+		cmd := securityCheckIPCmd(cli)
+		cmd.SetArgs([]string{"178.178.178.178", "--user", "frederik@travel0.com"})
+
+		assert.NoError(t, cmd.Execute())
+		assert.JSONEq(t, `{
+			"ip": "178.178.178.178",
+			"user": "frederik@travel0.com",
+			"suspicious_ip_throttling_blocked": true,
+			"brute_force_blocks": [{"identifier": "frederik@travel0.com", "ip": "178.178.178.178"}]
+		}`, result.String())
+	})
+
+	t.Run("it fails to check the ip due to api error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		anomalyAPI := mock.NewMockAnomalyAPI(ctrl)
+		anomalyAPI.EXPECT().CheckIP(gomock.Any(), "178.178.178.178").Return(false, errors.New("api error"))
+
+		cli := &cli{
+			renderer: &display.Renderer{
+				MessageWriter: io.Discard,
+				ResultWriter:  io.Discard,
+			},
+			api: &auth0.API{Anomaly: anomalyAPI},
+		}
+
+		cmd := securityCheckIPCmd(cli)
+		cmd.SetArgs([]string{"178.178.178.178"})
+
+		assert.EqualError(t, cmd.Execute(), `failed to check if IP "178.178.178.178" is blocked: api error`)
+	})
+}
@@ -0,0 +1,359 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/term"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+const keyTab = '\t'
+
+func shellCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shell",
+		Args:  cobra.NoArgs,
+		Short: "Run commands within an interactive shell",
+		Long: "Start an interactive shell to run several commands against the same tenant, " +
+			"without having to type `auth0` and `--tenant` each time.\n\n" +
+			"Press Tab to complete the commands, flags and resource IDs, such as the IDs of the applications, " +
+			"and use the up and down arrows to go through the command history.\n\n" +
+			"Within the shell:\n" +
+			"- `tenant` shows the tenant the commands run against, and `tenant <tenant>` switches to another one.\n" +
+			"- `exit`, `quit` or Ctrl+D leaves the shell.",
+		Example: `  auth0 shell
+  auth0 shell --tenant "example.us.auth0.com"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !canPrompt(cmd) {
+				return fmt.Errorf("the shell command is interactive, please run it within a terminal and without --no-input")
+			}
+
+			shell := &interactiveShell{
+				cli:       cli,
+				tenant:    cli.tenant,
+				idsByPath: make(map[string]pickerOptions),
+			}
+
+			// Building the command tree resets the flags, including the tenant, to their defaults.
+			shell.commands = buildCommandTree(cli)
+			cli.tenant = shell.tenant
+
+			return shell.run(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+// interactiveShell runs commands against a tenant until exited.
+type interactiveShell struct {
+	cli    *cli
+	tenant string
+
+	// commands is the command tree to complete the commands and flags from.
+	commands *cobra.Command
+
+	// idsByPath caches the resource IDs to complete, by command path.
+	idsByPath map[string]pickerOptions
+}
+
+func (s *interactiveShell) run(ctx context.Context) error {
+	fd := int(os.Stdin.Fd())
+
+	terminal := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, "")
+	terminal.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != keyTab {
+			return "", 0, false
+		}
+		return s.complete(ctx, terminal, line, pos)
+	}
+
+	s.cli.renderer.Infof("Running commands against %s. Type `exit` to leave the shell.", ansi.Bold(s.tenant))
+
+	// Interrupting a command shouldn't exit the shell, so the signal only cancels it.
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+
+	for {
+		terminal.SetPrompt(fmt.Sprintf("auth0 (%s)> ", s.tenant))
+		if width, height, err := term.GetSize(fd); err == nil {
+			_ = terminal.SetSize(width, height)
+		}
+
+		line, err := s.readLine(fd, terminal)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		args, err := shellquote.Split(line)
+		if err != nil {
+			renderErrorMessage(s.cli.renderer, err.Error(), "")
+			continue
+		}
+
+		if len(args) > 0 && args[0] == "auth0" {
+			args = args[1:]
+		}
+
+		if len(args) == 0 {
+			continue
+		}
+
+		switch args[0] {
+		case "exit", "quit":
+			return nil
+		case "tenant":
+			s.switchTenant(ctx, args[1:])
+			continue
+		case "shell":
+			s.cli.renderer.Warnf("You're already within the shell.")
+			continue
+		}
+
+		if err := s.execute(ctx, args); err != nil {
+			renderErrorMessage(s.cli.renderer, err.Error(), errorResult(err, s.cli.apiErrors.Latest()).RequestID)
+		}
+	}
+}
+
+// readLine reads the next command with the terminal in raw mode,
+// then restores the terminal so that the command can prompt.
+func (s *interactiveShell) readLine(fd int, terminal *term.Terminal) (string, error) {
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return "", fmt.Errorf("failed to set up the terminal: %w", err)
+	}
+	defer func() {
+		_ = term.Restore(fd, state)
+	}()
+
+	return terminal.ReadLine()
+}
+
+func (s *interactiveShell) switchTenant(ctx context.Context, args []string) {
+	if len(args) == 0 {
+		s.cli.renderer.Infof("Running commands against %s.", ansi.Bold(s.tenant))
+		return
+	}
+
+	// Authenticate right away, for the resource IDs to be completed from the new tenant.
+	s.cli.tenant = args[0]
+	if err := s.cli.setupWithAuthentication(ctx); err != nil {
+		renderErrorMessage(s.cli.renderer, err.Error(), "")
+		return
+	}
+
+	s.tenant = args[0]
+	s.idsByPath = make(map[string]pickerOptions)
+
+	s.cli.renderer.Infof("Running commands against %s.", ansi.Bold(s.tenant))
+}
+
+// execute runs the command against the tenant of the shell, unless given another one.
+func (s *interactiveShell) execute(ctx context.Context, args []string) error {
+	if !containsTenantFlag(args) {
+		args = append(args, "--tenant", s.tenant)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer func() {
+		stop()
+		signal.Ignore(os.Interrupt)
+	}()
+	defer s.cli.cancelDeadline()
+
+	// The command tree gets rebuilt, so that the flags of the previous command don't linger.
+	rootCmd := buildCommandTree(s.cli)
+	rootCmd.SetArgs(args)
+
+	return rootCmd.ExecuteContext(ctx)
+}
+
+func containsTenantFlag(args []string) bool {
+	for _, arg := range args {
+		if arg == "--tenant" || strings.HasPrefix(arg, "--tenant=") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// complete completes the word under the cursor, listing the candidates when there are several of them.
+func (s *interactiveShell) complete(ctx context.Context, terminal *term.Terminal, line string, pos int) (string, int, bool) {
+	candidates, partial := shellCompletions(s.commands, line[:pos], func(cmd *cobra.Command) pickerOptions {
+		return s.resourceIDs(ctx, cmd)
+	})
+	if len(candidates) == 0 {
+		return "", 0, false
+	}
+
+	values := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		values = append(values, candidate.value)
+	}
+
+	completion := commonPrefix(values)
+	if len(candidates) == 1 {
+		completion += " "
+	} else if completion == partial {
+		var labels []string
+		for _, candidate := range candidates {
+			labels = append(labels, candidate.label)
+		}
+		_, _ = fmt.Fprintf(terminal, "%s\n", strings.Join(labels, "\n"))
+		return "", 0, false
+	}
+
+	newLine := line[:pos-len(partial)] + completion + line[pos:]
+	return newLine, pos - len(partial) + len(completion), true
+}
+
+// resourceIDs returns the IDs of the resources the command takes as arguments, if any.
+func (s *interactiveShell) resourceIDs(ctx context.Context, cmd *cobra.Command) pickerOptions {
+	path := strings.TrimPrefix(cmd.Parent().CommandPath(), "auth0 ")
+
+	if ids, ok := s.idsByPath[path]; ok {
+		return ids
+	}
+
+	pickerOptionsByPath := map[string]pickerOptionsFunc{
+		"actions":      s.cli.actionPickerOptions,
+		"apis":         s.cli.apiPickerOptions,
+		"apps":         s.cli.appPickerOptions(),
+		"connections":  s.cli.connectionPickerOptions,
+		"domains":      s.cli.customDomainsPickerOptions,
+		"logs streams": s.cli.allLogStreamsPickerOptions,
+		"network-acls": s.cli.networkACLPickerOptions,
+		"orgs":         s.cli.organizationPickerOptions,
+		"quickstarts":  s.cli.appPickerOptions(),
+		"roles":        s.cli.rolePickerOptions,
+		"test":         s.cli.appPickerOptions(),
+	}
+
+	pickerOptionsFn, ok := pickerOptionsByPath[path]
+	if !ok || s.cli.api == nil {
+		return nil
+	}
+
+	ids, err := pickerOptionsFn(ctx)
+	if err != nil {
+		return nil
+	}
+
+	s.idsByPath[path] = ids
+
+	return ids
+}
+
+// shellCompletions returns the candidates to complete the last word of the line with,
+// along with that partially typed word. The candidates are the subcommands or flags
+// of the command typed so far, or the resource IDs it takes as arguments.
+func shellCompletions(
+	rootCmd *cobra.Command,
+	line string,
+	resourceIDs func(cmd *cobra.Command) pickerOptions,
+) (pickerOptions, string) {
+	words := strings.Fields(line)
+
+	partial := ""
+	if len(words) > 0 && !strings.HasSuffix(line, " ") {
+		partial = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	if len(words) > 0 && words[0] == "auth0" {
+		words = words[1:]
+	}
+
+	cmd := rootCmd
+	args := 0
+	for _, word := range words {
+		if strings.HasPrefix(word, "-") {
+			continue
+		}
+
+		if subCmd := findSubCommand(cmd, word); subCmd != nil {
+			cmd = subCmd
+			continue
+		}
+
+		args++
+	}
+
+	var candidates pickerOptions
+
+	switch {
+	case strings.HasPrefix(partial, "-"):
+		var names []string
+		cmd.InheritedFlags().VisitAll(func(flag *pflag.Flag) {
+			names = append(names, "--"+flag.Name)
+		})
+		cmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+			names = append(names, "--"+flag.Name)
+		})
+		sort.Strings(names)
+
+		for _, name := range names {
+			if strings.HasPrefix(name, partial) {
+				candidates = append(candidates, pickerOption{label: name, value: name})
+			}
+		}
+	case cmd.HasAvailableSubCommands():
+		for _, subCmd := range cmd.Commands() {
+			if subCmd.IsAvailableCommand() && strings.HasPrefix(subCmd.Name(), partial) {
+				candidates = append(candidates, pickerOption{label: subCmd.Name(), value: subCmd.Name()})
+			}
+		}
+	case cmd != rootCmd && cmd.HasParent() && args == 0:
+		for _, option := range resourceIDs(cmd) {
+			if strings.HasPrefix(option.value, partial) {
+				candidates = append(candidates, option)
+			}
+		}
+	}
+
+	return candidates, partial
+}
+
+func findSubCommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == name || subCmd.HasAlias(name) {
+			return subCmd
+		}
+	}
+
+	return nil
+}
+
+func commonPrefix(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	prefix := values[0]
+	for _, value := range values[1:] {
+		for !strings.HasPrefix(value, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellCompletions(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "auth0"}
+	rootCmd.PersistentFlags().String("tenant", "", "")
+
+	appsCmd := &cobra.Command{Use: "apps"}
+	appsListCmd := &cobra.Command{Use: "list", Aliases: []string{"ls"}, Run: func(*cobra.Command, []string) {}}
+	appsListCmd.Flags().Bool("json", false, "")
+	appsListCmd.Flags().Bool("csv", false, "")
+	appsShowCmd := &cobra.Command{Use: "show", Run: func(*cobra.Command, []string) {}}
+	appsCmd.AddCommand(appsListCmd, appsShowCmd)
+
+	apisCmd := &cobra.Command{Use: "apis"}
+	apisCmd.AddCommand(&cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}})
+	rootCmd.AddCommand(appsCmd, apisCmd)
+
+	resourceIDs := func(cmd *cobra.Command) pickerOptions {
+		if cmd.Parent().Name() != "apps" {
+			return nil
+		}
+		return pickerOptions{
+			{label: "App 1", value: "app-id-1"},
+			{label: "App 2", value: "app-id-2"},
+		}
+	}
+
+	values := func(options pickerOptions) []string {
+		var values []string
+		for _, option := range options {
+			values = append(values, option.value)
+		}
+		return values
+	}
+
+	var testCases = []struct {
+		line            string
+		expectedValues  []string
+		expectedPartial string
+	}{
+		{"ap", []string{"apps", "apis"}, "ap"},
+		{"auth0 app", []string{"apps"}, "app"},
+		{"apps ", []string{"list", "show"}, ""},
+		{"apps ls --j", []string{"--json"}, "--j"},
+		{"apps list --", []string{"--csv", "--json", "--tenant"}, "--"},
+		{"apps show ", []string{"app-id-1", "app-id-2"}, ""},
+		{"apps show app-id-2", []string{"app-id-2"}, "app-id-2"},
+		{"apps show app-id-1 ", nil, ""},
+		{"apis list ", nil, ""},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.line, func(t *testing.T) {
+			candidates, partial := shellCompletions(rootCmd, testCase.line, resourceIDs)
+
+			assert.ElementsMatch(t, testCase.expectedValues, values(candidates))
+			assert.Equal(t, testCase.expectedPartial, partial)
+		})
+	}
+}
+
+func TestCommonPrefix(t *testing.T) {
+	assert.Equal(t, "", commonPrefix(nil))
+	assert.Equal(t, "app-id-", commonPrefix([]string{"app-id-1", "app-id-2"}))
+	assert.Equal(t, "list", commonPrefix([]string{"list"}))
+	assert.Equal(t, "", commonPrefix([]string{"apps", "logs"}))
+}
+
+func TestContainsTenantFlag(t *testing.T) {
+	assert.True(t, containsTenantFlag([]string{"apps", "list", "--tenant", "example.us.auth0.com"}))
+	assert.True(t, containsTenantFlag([]string{"apps", "list", "--tenant=example.us.auth0.com"}))
+	assert.False(t, containsTenantFlag([]string{"apps", "list"}))
+}
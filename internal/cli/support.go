@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/buildinfo"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const (
+	// debugLogFile is where the failed commands get logged, next to the config file.
+	debugLogFile = "debug.log"
+
+	// debugLogMaxEntries is how many of the latest failed commands the debug log keeps.
+	debugLogMaxEntries = 50
+)
+
+// debugLogEntry is a failed command, logged for the support bundles.
+type debugLogEntry struct {
+	Time       time.Time `json:"time"`
+	Command    string    `json:"command"`
+	Version    string    `json:"version"`
+	Error      string    `json:"error"`
+	StatusCode int       `json:"status_code,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+}
+
+// supportBundleInfo describes the CLI and the tenant it's used with, for the support tickets.
+type supportBundleInfo struct {
+	Version   string `json:"version"`
+	Revision  string `json:"revision"`
+	BuildDate string `json:"build_date,omitempty"`
+	GoVersion string `json:"go_version"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+	Tenant    string `json:"tenant,omitempty"`
+	Region    string `json:"region,omitempty"`
+}
+
+func supportBundleCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Output artifactOutput
+	}
+
+	cmd := &cobra.Command{
+		Use:   "support-bundle",
+		Args:  cobra.NoArgs,
+		Short: "Collect diagnostics for a support ticket",
+		Long: "Collect the diagnostics to attach to a support ticket into a zip archive.\n\n" +
+			"The archive holds the version of the CLI, the domain and region of the tenant, the config " +
+			"of the CLI with its secrets masked, and the latest failed commands along with the request ID " +
+			"of their Management API errors.\n\n" +
+			"Without `--out`, the archive is named after the tenant and the current time.",
+		Example: `  auth0 support-bundle
+  auth0 support-bundle --tenant <tenant>
+  auth0 support-bundle --out support.zip
+  auth0 support-bundle -o ./tickets/ --force-overwrite`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// The bundle is also meant for the CLI failing to load its config, so it's only best effort.
+			_ = cli.Config.Initialize()
+
+			if cli.tenant == "" {
+				cli.tenant = cli.Config.DefaultTenant
+			}
+
+			info := supportBundleInfo{
+				Version:   buildinfo.Version,
+				Revision:  buildinfo.Revision,
+				BuildDate: buildinfo.BuildDate,
+				GoVersion: buildinfo.GoVersion,
+				OS:        runtime.GOOS,
+				Arch:      runtime.GOARCH,
+				Tenant:    cli.tenant,
+				Region:    tenantRegion(cli.tenant),
+			}
+
+			path := inputs.Output.file(cli, "support-bundle", ".zip")
+			if err := inputs.Output.checkOverwrite(cmd, path); err != nil {
+				return err
+			}
+
+			file, err := createArtifactFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to create the support bundle %q: %w", path, err)
+			}
+			defer func() {
+				_ = file.Close()
+			}()
+
+			configPath := cli.Config.Path()
+			if err := writeSupportBundle(file, info, configPath, debugLogPath(configPath)); err != nil {
+				return fmt.Errorf("failed to write the support bundle %q: %w", path, err)
+			}
+
+			cli.renderer.Infof("Support bundle written to %s", ansi.Bold(path))
+			cli.renderer.Infof("Review its content before attaching it to a support ticket.")
+
+			return nil
+		},
+	}
+
+	registerArtifactOutput(cmd, &inputs.Output, "File or directory to write the support bundle to.", "")
+
+	return cmd
+}
+
+// writeSupportBundle writes the zip archive of the support bundle, made of the info about the CLI,
+// the config with its secrets masked and the debug log, the latter two being skipped when missing.
+func writeSupportBundle(w io.Writer, info supportBundleInfo, configPath, debugLogPath string) error {
+	archive := zip.NewWriter(w)
+
+	infoJSON, err := json.MarshalIndent(info, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := writeSupportBundleFile(archive, "info.json", infoJSON); err != nil {
+		return err
+	}
+
+	config, err := os.ReadFile(configPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if len(config) > 0 {
+		if err := writeSupportBundleFile(archive, "config.json", display.MaskSecrets(config)); err != nil {
+			return err
+		}
+	}
+
+	debugLog, err := os.ReadFile(debugLogPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if len(debugLog) > 0 {
+		if err := writeSupportBundleFile(archive, debugLogFile, debugLog); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+func writeSupportBundleFile(archive *zip.Writer, name string, content []byte) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(content)
+	return err
+}
+
+// tenantRegion returns the region of the tenant from its domain, such as "eu" for
+// "travel0.eu.auth0.com". The tenants without a region in their domain are in the US.
+func tenantRegion(domain string) string {
+	name, ok := strings.CutSuffix(domain, ".auth0.com")
+	if !ok {
+		return ""
+	}
+
+	parts := strings.Split(name, ".")
+	if len(parts) == 1 {
+		return "us"
+	}
+
+	return parts[len(parts)-1]
+}
+
+func debugLogPath(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), debugLogFile)
+}
+
+// logFailedCommand appends the failed command to the debug log, as a JSON line, keeping only
+// its latest entries. Failing to log the command doesn't matter much, so the errors are ignored.
+func logFailedCommand(path string, entry debugLogEntry) {
+	var lines [][]byte
+	if content, err := os.ReadFile(path); err == nil {
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+		for scanner.Scan() {
+			lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+		}
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	lines = append(lines, line)
+
+	if len(lines) > debugLogMaxEntries {
+		lines = lines[len(lines)-debugLogMaxEntries:]
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+
+	_ = os.WriteFile(path, append(bytes.Join(lines, []byte("\n")), '\n'), 0600)
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantRegion(t *testing.T) {
+	assert.Equal(t, "eu", tenantRegion("travel0.eu.auth0.com"))
+	assert.Equal(t, "us", tenantRegion("travel0.us.auth0.com"))
+	assert.Equal(t, "us", tenantRegion("travel0.auth0.com"))
+	assert.Equal(t, "", tenantRegion("login.travel0.com"))
+	assert.Equal(t, "", tenantRegion(""))
+}
+
+func TestLogFailedCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auth0", debugLogFile)
+
+	for i := 0; i < debugLogMaxEntries+2; i++ {
+		logFailedCommand(path, debugLogEntry{
+			Time:    time.Date(2024, time.May, 1, 14, 0, i, 0, time.UTC),
+			Command: "auth0 roles show",
+			Error:   fmt.Sprintf("failure #%d", i),
+		})
+	}
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, debugLogMaxEntries)
+
+	var first, last debugLogEntry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &last))
+	assert.Equal(t, "failure #2", first.Error, "the oldest entries are dropped")
+	assert.Equal(t, fmt.Sprintf("failure #%d", debugLogMaxEntries+1), last.Error)
+}
+
+func TestWriteSupportBundle(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(
+		configPath,
+		[]byte(`{"default_tenant":"travel0.eu.auth0.com","tenants":{"travel0.eu.auth0.com":{"access_token":"eyJ.s3cr3t"}}}`),
+		0600,
+	))
+
+	info := supportBundleInfo{Version: "1.4.0", Tenant: "travel0.eu.auth0.com", Region: "eu"}
+
+	t.Run("it bundles the info, the masked config and the debug log", func(t *testing.T) {
+		logFailedCommand(debugLogPath(configPath), debugLogEntry{Command: "auth0 roles show", RequestID: "8b5c9d1a"})
+
+		var bundle bytes.Buffer
+		require.NoError(t, writeSupportBundle(&bundle, info, configPath, debugLogPath(configPath)))
+
+		files := readSupportBundle(t, bundle.Bytes())
+		assert.Len(t, files, 3)
+		assert.Contains(t, files["info.json"], `"region": "eu"`)
+		assert.Contains(t, files["config.json"], `"access_token":"********"`)
+		assert.NotContains(t, files["config.json"], "s3cr3t")
+		assert.Contains(t, files[debugLogFile], `"request_id":"8b5c9d1a"`)
+	})
+
+	t.Run("it skips the missing config and debug log", func(t *testing.T) {
+		var bundle bytes.Buffer
+		missing := filepath.Join(t.TempDir(), "config.json")
+		require.NoError(t, writeSupportBundle(&bundle, info, missing, debugLogPath(missing)))
+
+		files := readSupportBundle(t, bundle.Bytes())
+		assert.Len(t, files, 1)
+		assert.Contains(t, files, "info.json")
+	})
+}
+
+func readSupportBundle(t *testing.T, bundle []byte) map[string]string {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		content, err := file.Open()
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(content)
+		require.NoError(t, err)
+		require.NoError(t, content.Close())
+
+		files[file.Name] = string(data)
+	}
+
+	return files
+}
@@ -0,0 +1,641 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+// tagMetadataPrefix prefixes the metadata keys holding the tags
+// of a resource, so that they can live alongside other metadata.
+const tagMetadataPrefix = "tag_"
+
+// Resource types that can be tagged.
+const (
+	tagResourceApps        = "apps"
+	tagResourceOrgs        = "orgs"
+	tagResourceConnections = "connections"
+)
+
+var taggableResourceTypes = []string{tagResourceApps, tagResourceOrgs, tagResourceConnections}
+
+var tagKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+var (
+	tagResourceType = Argument{
+		Name: "Resource Type",
+		Help: "Type of the resource: apps, orgs or connections.",
+	}
+	tagResourceID = Argument{
+		Name: "Resource ID",
+		Help: "ID of the resource.",
+	}
+	tagsToAdd = Flag{
+		Name:       "Tags",
+		LongForm:   "tag",
+		Help:       "Tags to add as key=value pairs, e.g. env=prod. Existing tags with the same key are replaced.",
+		IsRequired: true,
+	}
+	tagsToRemove = Flag{
+		Name:       "Tags",
+		LongForm:   "tag",
+		Help:       "Keys of the tags to remove, e.g. env.",
+		IsRequired: true,
+	}
+	tagResourceTypes = Flag{
+		Name:     "Resource Types",
+		LongForm: "type",
+		Help:     "Only list the resources of the given types. Can include apps, orgs and connections.",
+	}
+	tagFilter = Flag{
+		Name:     "Tags",
+		LongForm: "tag",
+		Help:     "Only include the resources with all the given tags, e.g. env=prod.",
+	}
+)
+
+func tagsCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tags",
+		Short: "Manage the tags of your resources",
+		Long: "Tag your applications, organizations and connections to group them across the tenant, " +
+			"e.g. by environment or team.\n\n" +
+			"The tags are stored within the metadata of the resources, under keys prefixed with `" + tagMetadataPrefix +
+			"`. Several commands can filter on them through the `--tag` flag, such as `auth0 apps list`, " +
+			"`auth0 apps delete`, `auth0 orgs list` and `auth0 terraform generate`.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listTagsCmd(cli))
+	cmd.AddCommand(addTagsCmd(cli))
+	cmd.AddCommand(removeTagsCmd(cli))
+
+	return cmd
+}
+
+func listTagsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Types []string
+		Tags  map[string]string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.MaximumNArgs(2),
+		Short:   "List the tags of your resources",
+		Long: "List the tagged resources along with their tags, or the tags of a single resource " +
+			"when passing its type and ID.",
+		Example: `  auth0 tags list
+  auth0 tags ls --type apps,orgs
+  auth0 tags ls --tag env=prod --json
+  auth0 tags ls apps <app-id>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				return fmt.Errorf("both the resource type and ID need to be passed to list the tags of a resource")
+			}
+
+			if len(args) == 2 {
+				store, err := tagStoreFor(cli.api, args[0])
+				if err != nil {
+					return err
+				}
+
+				var resource *display.TaggedResource
+				if err := ansi.Waiting(func() (err error) {
+					resource, err = store.Read(cmd.Context(), args[1])
+					return err
+				}); err != nil {
+					return fmt.Errorf("failed to read the tags of %s with ID %q: %w", args[0], args[1], err)
+				}
+
+				cli.renderer.TaggedResourceShow(resource)
+
+				return nil
+			}
+
+			types := inputs.Types
+			if len(types) == 0 {
+				types = taggableResourceTypes
+			}
+
+			var resources []*display.TaggedResource
+			if err := ansi.Waiting(func() error {
+				for _, resourceType := range types {
+					store, err := tagStoreFor(cli.api, resourceType)
+					if err != nil {
+						return err
+					}
+
+					list, err := store.List(cmd.Context())
+					if err != nil {
+						return fmt.Errorf("failed to list %s: %w", resourceType, err)
+					}
+
+					for _, resource := range list {
+						if len(resource.Tags) > 0 && tagsMatch(resource.Tags, inputs.Tags) {
+							resources = append(resources, resource)
+						}
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			cli.renderer.TaggedResourceList(resources)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+	tagResourceTypes.RegisterStringSlice(cmd, &inputs.Types, nil)
+	tagFilter.RegisterStringMap(cmd, &inputs.Tags, nil)
+
+	return cmd
+}
+
+func addTagsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Type string
+		ID   string
+		Tags map[string]string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Args:  cobra.MaximumNArgs(2),
+		Short: "Add tags to a resource",
+		Long: "Add tags to an application, organization or connection.\n\n" +
+			"To add interactively, use `auth0 tags add` with no arguments.\n\n" +
+			"To add non-interactively, supply the resource type, its ID and the tags through the flags.",
+		Example: `  auth0 tags add
+  auth0 tags add apps <app-id> --tag env=prod
+  auth0 tags add orgs <org-id> --tag env=prod --tag team=payments
+  auth0 tags add connections <connection-id> --tag "env=prod,team=payments" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := pickTaggedResource(cmd, cli, args, &inputs.Type, &inputs.ID)
+			if err != nil {
+				return err
+			}
+
+			if len(inputs.Tags) == 0 {
+				var tags []string
+				if err := tagsToAdd.AskMany(cmd, &tags, nil); err != nil {
+					return err
+				}
+				if inputs.Tags, err = parseTags(tags); err != nil {
+					return err
+				}
+			}
+
+			changes := make(map[string]*string, len(inputs.Tags))
+			for key, value := range inputs.Tags {
+				if !tagKeyPattern.MatchString(key) {
+					return fmt.Errorf("invalid tag key %q, only letters, numbers, '-' and '_' are allowed", key)
+				}
+				changes[key] = auth0.String(value)
+			}
+
+			return updateTags(cmd.Context(), cli, store, inputs.Type, inputs.ID, changes)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	tagsToAdd.RegisterStringMap(cmd, &inputs.Tags, nil)
+
+	return cmd
+}
+
+func removeTagsCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Type string
+		ID   string
+		Keys []string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove",
+		Aliases: []string{"rm"},
+		Args:    cobra.MaximumNArgs(2),
+		Short:   "Remove tags from a resource",
+		Long: "Remove tags from an application, organization or connection.\n\n" +
+			"To remove interactively, use `auth0 tags remove` with no arguments.\n\n" +
+			"To remove non-interactively, supply the resource type, its ID and the keys of the tags through the flags.",
+		Example: `  auth0 tags remove
+  auth0 tags rm apps <app-id> --tag env
+  auth0 tags rm orgs <org-id> --tag env,team --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := pickTaggedResource(cmd, cli, args, &inputs.Type, &inputs.ID)
+			if err != nil {
+				return err
+			}
+
+			if len(inputs.Keys) == 0 {
+				if err := tagsToRemove.AskMany(cmd, &inputs.Keys, nil); err != nil {
+					return err
+				}
+			}
+
+			changes := make(map[string]*string, len(inputs.Keys))
+			for _, key := range inputs.Keys {
+				// Accept the key=value form too, as shown by the list command.
+				key, _, _ = strings.Cut(strings.TrimSpace(key), "=")
+				changes[key] = nil
+			}
+
+			return updateTags(cmd.Context(), cli, store, inputs.Type, inputs.ID, changes)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	tagsToRemove.RegisterStringSlice(cmd, &inputs.Keys, nil)
+
+	return cmd
+}
+
+// pickTaggedResource sets the type and ID of the resource to tag,
+// from the arguments or by asking for them, and returns its tag store.
+func pickTaggedResource(
+	cmd *cobra.Command,
+	cli *cli,
+	args []string,
+	resourceType *string,
+	id *string,
+) (resourceTagStore, error) {
+	if len(args) > 0 {
+		*resourceType = args[0]
+	} else {
+		if err := tagResourceType.Pick(cmd, resourceType, func(_ context.Context) (pickerOptions, error) {
+			var opts pickerOptions
+			for _, t := range taggableResourceTypes {
+				opts = append(opts, pickerOption{label: t, value: t})
+			}
+			return opts, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	store, err := tagStoreFor(cli.api, *resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) > 1 {
+		*id = args[1]
+		return store, nil
+	}
+
+	if err := tagResourceID.Pick(cmd, id, func(ctx context.Context) (pickerOptions, error) {
+		resources, err := store.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var opts pickerOptions
+		for _, resource := range resources {
+			label := fmt.Sprintf("%s %s", resource.Name, ansi.Faint("("+resource.ID+")"))
+			opts = append(opts, pickerOption{label: label, value: resource.ID})
+		}
+
+		if len(opts) == 0 {
+			return nil, fmt.Errorf("there are no %s to tag", *resourceType)
+		}
+
+		return opts, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func updateTags(
+	ctx context.Context,
+	cli *cli,
+	store resourceTagStore,
+	resourceType, id string,
+	changes map[string]*string,
+) error {
+	var resource *display.TaggedResource
+	if err := ansi.Waiting(func() error {
+		if err := store.Update(ctx, id, changes); err != nil {
+			return err
+		}
+
+		var err error
+		resource, err = store.Read(ctx, id)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to update the tags of %s with ID %q: %w", resourceType, id, err)
+	}
+
+	cli.renderer.TaggedResourceUpdate(resource)
+
+	return nil
+}
+
+// parseTags parses tags given as key=value pairs.
+func parseTags(pairs []string) (map[string]string, error) {
+	tags := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid tag %q, tags need to be given as key=value pairs", pair)
+		}
+		tags[key] = value
+	}
+
+	return tags, nil
+}
+
+// tagsFromMetadata returns the tags stored within the metadata of a resource.
+func tagsFromMetadata(metadata map[string]string) map[string]string {
+	tags := make(map[string]string)
+	for key, value := range metadata {
+		if tag, ok := strings.CutPrefix(key, tagMetadataPrefix); ok {
+			tags[tag] = value
+		}
+	}
+
+	return tags
+}
+
+// tagsMatch checks whether the tags include all the tags of the filter.
+func tagsMatch(tags, filter map[string]string) bool {
+	for key, value := range filter {
+		if tagValue, ok := tags[key]; !ok || tagValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tagsMetadataFilter turns a tag filter into the metadata to filter on.
+func tagsMetadataFilter(filter map[string]string) map[string]string {
+	metadata := make(map[string]string, len(filter))
+	for key, value := range filter {
+		metadata[tagMetadataPrefix+key] = value
+	}
+
+	return metadata
+}
+
+// resourceTagStore reads and updates the tags stored within the metadata of a type of resource.
+type resourceTagStore interface {
+	Read(ctx context.Context, id string) (*display.TaggedResource, error)
+
+	// Update sets the tags with a value and removes the tags without one.
+	Update(ctx context.Context, id string, changes map[string]*string) error
+
+	List(ctx context.Context) ([]*display.TaggedResource, error)
+}
+
+func tagStoreFor(api *auth0.API, resourceType string) (resourceTagStore, error) {
+	switch resourceType {
+	case tagResourceApps, "app", "clients":
+		return &appTagStore{api}, nil
+	case tagResourceOrgs, "org", "organizations":
+		return &orgTagStore{api}, nil
+	case tagResourceConnections, "connection":
+		return &connectionTagStore{api}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unsupported resource type %q, possible values: %s",
+			resourceType,
+			strings.Join(taggableResourceTypes, ", "),
+		)
+	}
+}
+
+type appTagStore struct {
+	api *auth0.API
+}
+
+func (s *appTagStore) Read(ctx context.Context, id string) (*display.TaggedResource, error) {
+	client, err := s.api.Client.Read(ctx, id, management.IncludeFields("client_id", "name", "client_metadata"))
+	if err != nil {
+		return nil, err
+	}
+
+	return appTaggedResource(client), nil
+}
+
+func (s *appTagStore) Update(ctx context.Context, id string, changes map[string]*string) error {
+	// The client metadata gets merged, and the keys set to null get removed.
+	metadata := make(map[string]interface{}, len(changes))
+	for key, value := range changes {
+		if value == nil {
+			metadata[tagMetadataPrefix+key] = nil
+			continue
+		}
+		metadata[tagMetadataPrefix+key] = *value
+	}
+
+	return s.api.Client.Update(ctx, id, &management.Client{ClientMetadata: &metadata})
+}
+
+func (s *appTagStore) List(ctx context.Context) ([]*display.TaggedResource, error) {
+	var resources []*display.TaggedResource
+
+	var page int
+	for {
+		clients, err := s.api.Client.List(
+			ctx,
+			management.Page(page),
+			management.Parameter("is_global", "false"),
+			management.IncludeFields("client_id", "name", "client_metadata"),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, client := range clients.Clients {
+			resources = append(resources, appTaggedResource(client))
+		}
+
+		if !clients.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return resources, nil
+}
+
+func appTaggedResource(client *management.Client) *display.TaggedResource {
+	metadata := make(map[string]string)
+	for key, value := range client.GetClientMetadata() {
+		metadata[key] = fmt.Sprint(value)
+	}
+
+	return &display.TaggedResource{
+		Type: tagResourceApps,
+		ID:   client.GetClientID(),
+		Name: client.GetName(),
+		Tags: tagsFromMetadata(metadata),
+	}
+}
+
+type orgTagStore struct {
+	api *auth0.API
+}
+
+func (s *orgTagStore) Read(ctx context.Context, id string) (*display.TaggedResource, error) {
+	org, err := s.api.Organization.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgTaggedResource(org), nil
+}
+
+func (s *orgTagStore) Update(ctx context.Context, id string, changes map[string]*string) error {
+	org, err := s.api.Organization.Read(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// The organization metadata gets replaced as a whole.
+	metadata := applyTagChanges(org.GetMetadata(), changes)
+
+	return s.api.Organization.Update(ctx, id, &management.Organization{Metadata: &metadata})
+}
+
+func (s *orgTagStore) List(ctx context.Context) ([]*display.TaggedResource, error) {
+	list, err := getWithPagination(
+		0,
+		func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+			res, err := s.api.Organization.List(ctx, opts...)
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, item := range res.Organizations {
+				result = append(result, item)
+			}
+
+			return result, res.HasNext(), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*display.TaggedResource, 0, len(list))
+	for _, item := range list {
+		resources = append(resources, orgTaggedResource(item.(*management.Organization)))
+	}
+
+	return resources, nil
+}
+
+func orgTaggedResource(org *management.Organization) *display.TaggedResource {
+	return &display.TaggedResource{
+		Type: tagResourceOrgs,
+		ID:   org.GetID(),
+		Name: org.GetName(),
+		Tags: tagsFromMetadata(org.GetMetadata()),
+	}
+}
+
+type connectionTagStore struct {
+	api *auth0.API
+}
+
+func (s *connectionTagStore) Read(ctx context.Context, id string) (*display.TaggedResource, error) {
+	connection, err := s.api.Connection.Read(ctx, id, management.IncludeFields("id", "name", "metadata"))
+	if err != nil {
+		return nil, err
+	}
+
+	return connectionTaggedResource(connection), nil
+}
+
+func (s *connectionTagStore) Update(ctx context.Context, id string, changes map[string]*string) error {
+	connection, err := s.api.Connection.Read(ctx, id, management.IncludeFields("id", "metadata"))
+	if err != nil {
+		return err
+	}
+
+	// The connection metadata gets replaced as a whole.
+	metadata := applyTagChanges(connection.GetMetadata(), changes)
+
+	return s.api.Connection.Update(ctx, id, &management.Connection{Metadata: &metadata})
+}
+
+func (s *connectionTagStore) List(ctx context.Context) ([]*display.TaggedResource, error) {
+	var resources []*display.TaggedResource
+
+	var page int
+	for {
+		connections, err := s.api.Connection.List(
+			ctx,
+			management.Page(page),
+			management.IncludeFields("id", "name", "metadata"),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, connection := range connections.Connections {
+			resources = append(resources, connectionTaggedResource(connection))
+		}
+
+		if !connections.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return resources, nil
+}
+
+func connectionTaggedResource(connection *management.Connection) *display.TaggedResource {
+	return &display.TaggedResource{
+		Type: tagResourceConnections,
+		ID:   connection.GetID(),
+		Name: connection.GetName(),
+		Tags: tagsFromMetadata(connection.GetMetadata()),
+	}
+}
+
+// applyTagChanges returns a copy of the metadata with the tag changes applied.
+func applyTagChanges(metadata map[string]string, changes map[string]*string) map[string]string {
+	updated := make(map[string]string, len(metadata)+len(changes))
+	for key, value := range metadata {
+		updated[key] = value
+	}
+
+	keys := make([]string, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value := changes[key]; value != nil {
+			updated[tagMetadataPrefix+key] = *value
+		} else {
+			delete(updated, tagMetadataPrefix+key)
+		}
+	}
+
+	return updated
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestParseTags(t *testing.T) {
+	tags, err := parseTags([]string{"env=prod", " team=payments ", "note="})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "prod", "team": "payments", "note": ""}, tags)
+
+	_, err = parseTags([]string{"env"})
+	assert.EqualError(t, err, `invalid tag "env", tags need to be given as key=value pairs`)
+
+	_, err = parseTags([]string{"=prod"})
+	assert.Error(t, err)
+}
+
+func TestTagsFromMetadata(t *testing.T) {
+	tags := tagsFromMetadata(map[string]string{
+		"tag_env":  "prod",
+		"tag_team": "payments",
+		"region":   "eu",
+	})
+
+	assert.Equal(t, map[string]string{"env": "prod", "team": "payments"}, tags)
+	assert.Empty(t, tagsFromMetadata(nil))
+}
+
+func TestTagsMatch(t *testing.T) {
+	tags := map[string]string{"env": "prod", "team": "payments"}
+
+	assert.True(t, tagsMatch(tags, nil))
+	assert.True(t, tagsMatch(tags, map[string]string{"env": "prod"}))
+	assert.True(t, tagsMatch(tags, map[string]string{"env": "prod", "team": "payments"}))
+	assert.False(t, tagsMatch(tags, map[string]string{"env": "staging"}))
+	assert.False(t, tagsMatch(tags, map[string]string{"region": "eu"}))
+	assert.False(t, tagsMatch(nil, map[string]string{"env": "prod"}))
+}
+
+func TestApplyTagChanges(t *testing.T) {
+	metadata := map[string]string{"tag_env": "staging", "tag_team": "payments", "region": "eu"}
+
+	updated := applyTagChanges(metadata, map[string]*string{
+		"env":  auth0.String("prod"),
+		"team": nil,
+		"tier": auth0.String("gold"),
+	})
+
+	assert.Equal(t, map[string]string{"tag_env": "prod", "tag_tier": "gold", "region": "eu"}, updated)
+	assert.Equal(t, "staging", metadata["tag_env"], "the original metadata should be left untouched")
+}
+
+func TestTagStoreFor(t *testing.T) {
+	api := &auth0.API{}
+
+	for _, resourceType := range []string{"apps", "orgs", "connections", "organizations"} {
+		_, err := tagStoreFor(api, resourceType)
+		assert.NoError(t, err, resourceType)
+	}
+
+	_, err := tagStoreFor(api, "users")
+	assert.EqualError(t, err, `unsupported resource type "users", possible values: apps, orgs, connections`)
+}
+
+func TestAppTagStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientAPI := mock.NewMockClientAPI(ctrl)
+	clientAPI.EXPECT().
+		Update(gomock.Any(), "app-id", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, client *management.Client, _ ...management.RequestOption) error {
+			assert.Equal(t, map[string]interface{}{"tag_env": "prod", "tag_team": nil}, client.GetClientMetadata())
+			return nil
+		})
+	clientAPI.EXPECT().
+		Read(gomock.Any(), "app-id", gomock.Any()).
+		Return(&management.Client{
+			ClientID:       auth0.String("app-id"),
+			Name:           auth0.String("My App"),
+			ClientMetadata: &map[string]interface{}{"tag_env": "prod", "owner": "jane"},
+		}, nil)
+
+	store := &appTagStore{api: &auth0.API{Client: clientAPI}}
+
+	err := store.Update(context.Background(), "app-id", map[string]*string{"env": auth0.String("prod"), "team": nil})
+	require.NoError(t, err)
+
+	resource, err := store.Read(context.Background(), "app-id")
+	require.NoError(t, err)
+	assert.Equal(t, &display.TaggedResource{
+		Type: "apps",
+		ID:   "app-id",
+		Name: "My App",
+		Tags: map[string]string{"env": "prod"},
+	}, resource)
+}
+
+func TestOrgTagStore_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgAPI := mock.NewMockOrganizationAPI(ctrl)
+	orgAPI.EXPECT().
+		Read(gomock.Any(), "org-id").
+		Return(&management.Organization{
+			ID:       auth0.String("org-id"),
+			Metadata: &map[string]string{"tag_env": "staging", "region": "eu"},
+		}, nil)
+	orgAPI.EXPECT().
+		Update(gomock.Any(), "org-id", &management.Organization{
+			Metadata: &map[string]string{"tag_env": "prod", "region": "eu"},
+		}).
+		Return(nil)
+
+	store := &orgTagStore{api: &auth0.API{Organization: orgAPI}}
+
+	err := store.Update(context.Background(), "org-id", map[string]*string{"env": auth0.String("prod")})
+	assert.NoError(t, err)
+}
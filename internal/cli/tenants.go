@@ -2,15 +2,59 @@ package cli
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth"
+	"github.com/auth0/auth0-cli/internal/config"
+	"github.com/auth0/auth0-cli/internal/keyring"
+	"github.com/auth0/auth0-cli/internal/prompt"
 )
 
-var tenantDomain = Argument{
-	Name: "Tenant",
-	Help: "Tenant to select",
-}
+var (
+	tenantDomain = Argument{
+		Name: "Tenant",
+		Help: "Tenant to select",
+	}
+
+	tenantPurge = Flag{
+		Name:     "Purge",
+		LongForm: "purge",
+		Help:     "Also revoke the refresh token issued to the CLI for the tenant.",
+	}
+
+	tenantDeleteClient = Flag{
+		Name:     "Delete Client",
+		LongForm: "delete-client",
+		Help: "Also delete the application used to authenticate the CLI via client credentials from the tenant. " +
+			"It requires a confirmation, or the --force flag.",
+	}
+
+	tenantReadOnlyDisable = Flag{
+		Name:     "Disable",
+		LongForm: "disable",
+		Help:     "Disable read-only mode for the tenant.",
+	}
+
+	tenantEnvironment = Flag{
+		Name:      "Environment",
+		LongForm:  "environment",
+		ShortForm: "e",
+		Help: "Environment of the tenant, such as production, staging or development. " +
+			"Destructive commands against production tenants require an extra confirmation.",
+		IsRequired: true,
+	}
+
+	tenantEnvironmentClear = Flag{
+		Name:     "Clear",
+		LongForm: "clear",
+		Help:     "Clear the environment of the tenant.",
+	}
+)
 
 func tenantsCmd(cli *cli) *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,6 +67,12 @@ func tenantsCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(useTenantCmd(cli))
 	cmd.AddCommand(listTenantCmd(cli))
 	cmd.AddCommand(openTenantCmd(cli))
+	cmd.AddCommand(removeTenantCmd(cli))
+	cmd.AddCommand(readOnlyTenantCmd(cli))
+	cmd.AddCommand(environmentTenantCmd(cli))
+	cmd.AddCommand(bootstrapTenantCmd(cli))
+	cmd.AddCommand(errorPageTenantCmd(cli))
+	cmd.AddCommand(localesTenantCmd(cli))
 	return cmd
 }
 
@@ -110,6 +160,233 @@ func openTenantCmd(cli *cli) *cobra.Command {
 	return cmd
 }
 
+func removeTenantCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Purge        bool
+		DeleteClient bool
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove",
+		Aliases: []string{"rm"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Remove a tenant from the CLI config",
+		Long: "Remove a tenant from the CLI config and delete its secrets from the keyring.\n\n" +
+			"Use the `--purge` flag to also revoke the refresh token issued to the CLI when authenticated as a user, " +
+			"leaving no orphaned credentials behind.\n\n" +
+			"When authenticated via client credentials, the application used to authenticate the CLI was supplied " +
+			"to it and may be used elsewhere, so it's kept unless the `--delete-client` flag is used. Deleting it " +
+			"requires a confirmation, or the `--force` flag.",
+		Example: `  auth0 tenants remove
+  auth0 tenants rm <tenant>
+  auth0 tenants remove "example.us.auth0.com"
+  auth0 tenants remove "example.us.auth0.com" --purge
+  auth0 tenants remove "example.us.auth0.com" --delete-client
+  auth0 tenants remove "example.us.auth0.com" --delete-client --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			selectedTenant, err := selectValidTenantFromConfig(cli, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			tenant, err := cli.Config.GetTenant(selectedTenant)
+			if err != nil {
+				return err
+			}
+
+			if inputs.DeleteClient {
+				if !tenant.IsAuthenticatedWithClientCredentials() {
+					return fmt.Errorf(
+						"the CLI isn't authenticated via client credentials with the tenant %q, so there's no application to delete",
+						selectedTenant,
+					)
+				}
+
+				if err := checkProductionTenant(cmd, args, tenant, cli.iKnowThisIsProd); err != nil {
+					return err
+				}
+
+				if !cli.force {
+					if !canPrompt(cmd) {
+						return fmt.Errorf(
+							"deleting the application with client ID %q requires a confirmation, run the command with the %s flag to confirm it",
+							tenant.ClientID,
+							ansi.Bold("--force"),
+						)
+					}
+
+					cli.renderer.Warnf(
+						"The application with client ID %s used to authenticate the CLI will be deleted from the tenant.",
+						ansi.Faint(tenant.ClientID),
+					)
+					if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+						return nil
+					}
+				}
+
+				if err := deleteTenantClient(cmd, cli, tenant); err != nil {
+					return err
+				}
+			}
+
+			if inputs.Purge {
+				if err := purgeTenantCredentials(tenant); err != nil {
+					return err
+				}
+
+				if tenant.IsAuthenticatedWithClientCredentials() && !inputs.DeleteClient {
+					cli.renderer.Infof(
+						"The application with client ID %s used to authenticate the CLI was kept, use %s to delete it.",
+						ansi.Faint(tenant.ClientID),
+						ansi.Bold("--delete-client"),
+					)
+				}
+			}
+
+			if err := cli.Config.RemoveTenant(selectedTenant); err != nil {
+				return fmt.Errorf("failed to remove the tenant %q: %w", selectedTenant, err)
+			}
+
+			if err := keyring.DeleteSecretsForTenant(selectedTenant); err != nil {
+				return fmt.Errorf("failed to delete tenant secrets: %w", err)
+			}
+
+			cli.renderer.Infof("Successfully removed tenant: %s", selectedTenant)
+			return nil
+		},
+	}
+
+	tenantPurge.RegisterBool(cmd, &inputs.Purge, false)
+	tenantDeleteClient.RegisterBool(cmd, &inputs.DeleteClient, false)
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+func readOnlyTenantCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Disable bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "read-only",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Enable or disable read-only mode for a tenant",
+		Long: "Enable read-only mode for a tenant, blocking all the commands that would make changes to it. " +
+			"Useful to protect production tenants from accidental changes during investigations.\n\n" +
+			"Read-only mode can also be enabled for a single command by using the global `--read-only` flag.",
+		Example: `  auth0 tenants read-only
+  auth0 tenants read-only <tenant>
+  auth0 tenants read-only "example.us.auth0.com"
+  auth0 tenants read-only "example.us.auth0.com" --disable`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			selectedTenant, err := selectValidTenantFromConfig(cli, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			if err := cli.Config.SetReadOnlyForTenant(selectedTenant, !inputs.Disable); err != nil {
+				return fmt.Errorf("failed to update the read-only mode for the tenant %q: %w", selectedTenant, err)
+			}
+
+			if inputs.Disable {
+				cli.renderer.Infof("Read-only mode disabled for tenant: %s", selectedTenant)
+				return nil
+			}
+
+			cli.renderer.Infof("Read-only mode enabled for tenant: %s", selectedTenant)
+			return nil
+		},
+	}
+
+	tenantReadOnlyDisable.RegisterBool(cmd, &inputs.Disable, false)
+
+	return cmd
+}
+
+func environmentTenantCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Environment string
+		Clear       bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "environment",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Set the environment of a tenant",
+		Long: "Tag a tenant with the environment it's used for, such as production, staging or development.\n\n" +
+			"Destructive commands against a production tenant, such as deleting resources, require typing " +
+			"in its domain to confirm them. To run them non-interactively, use the global `--i-know-this-is-prod` flag.",
+		Example: `  auth0 tenants environment
+  auth0 tenants environment <tenant>
+  auth0 tenants environment "example.us.auth0.com" --environment production
+  auth0 tenants environment "example.us.auth0.com" --clear`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			selectedTenant, err := selectValidTenantFromConfig(cli, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			if !inputs.Clear {
+				if err := tenantEnvironment.Select(cmd, &inputs.Environment, tenantEnvironments, nil); err != nil {
+					return err
+				}
+			}
+
+			if err := cli.Config.SetEnvironmentForTenant(selectedTenant, strings.ToLower(inputs.Environment)); err != nil {
+				return fmt.Errorf("failed to update the environment of the tenant %q: %w", selectedTenant, err)
+			}
+
+			if inputs.Clear {
+				cli.renderer.Infof("Environment cleared for tenant: %s", selectedTenant)
+				return nil
+			}
+
+			cli.renderer.Infof("Environment of tenant %s set to: %s", selectedTenant, strings.ToLower(inputs.Environment))
+			return nil
+		},
+	}
+
+	tenantEnvironment.RegisterString(cmd, &inputs.Environment, "")
+	tenantEnvironment.RegisterCompletions(cmd, tenantEnvironments)
+	tenantEnvironmentClear.RegisterBool(cmd, &inputs.Clear, false)
+	cmd.MarkFlagsMutuallyExclusive("environment", "clear")
+
+	return cmd
+}
+
+// purgeTenantCredentials revokes the refresh token issued to the CLI, if authenticated as a user. The
+// access tokens issued via client credentials can't be revoked, they expire on their own instead.
+func purgeTenantCredentials(tenant config.Tenant) error {
+	if !tenant.IsAuthenticatedWithDeviceCodeFlow() {
+		return nil
+	}
+
+	if err := ansi.Waiting(func() error {
+		return auth.RevokeRefreshToken(http.DefaultClient, tenant.Domain)
+	}); err != nil {
+		return fmt.Errorf("failed to revoke the refresh token for tenant %q: %w", tenant.Domain, err)
+	}
+
+	return nil
+}
+
+// deleteTenantClient deletes the application used to authenticate the CLI via client credentials.
+func deleteTenantClient(cmd *cobra.Command, cli *cli, tenant config.Tenant) error {
+	cli.tenant = tenant.Domain
+	if err := cli.setupWithAuthentication(cmd.Context()); err != nil {
+		return fmt.Errorf("failed to authenticate with tenant %q: %w", tenant.Domain, err)
+	}
+
+	if err := ansi.Waiting(func() error {
+		return cli.api.Client.Delete(cmd.Context(), tenant.ClientID)
+	}); err != nil {
+		return fmt.Errorf("failed to delete the application with ID %q: %w", tenant.ClientID, err)
+	}
+
+	return nil
+}
+
 func selectValidTenantFromConfig(cli *cli, cmd *cobra.Command, args []string) (string, error) {
 	if len(args) > 0 {
 		tenant, err := cli.Config.GetTenant(args[0])
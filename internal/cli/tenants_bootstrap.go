@@ -0,0 +1,315 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var tenantBootstrapTemplate = Flag{
+	Name:       "Template",
+	LongForm:   "template",
+	ShortForm:  "t",
+	Help:       "Path to the YAML or JSON template describing the tenant settings and resources to set up.",
+	IsRequired: true,
+}
+
+type (
+	// bootstrapTemplate describes how to set up a new tenant. Each section follows
+	// the payloads of the Management API, except for the roles, as their
+	// permissions are assigned by referencing the API identifiers.
+	bootstrapTemplate struct {
+		Tenant           *management.Tenant           `json:"tenant,omitempty"`
+		APIs             []*management.ResourceServer `json:"apis,omitempty"`
+		Apps             []*management.Client         `json:"apps,omitempty"`
+		Roles            []bootstrapRole              `json:"roles,omitempty"`
+		EmailProvider    *management.EmailProvider    `json:"email_provider,omitempty"`
+		AttackProtection *bootstrapAttackProtection   `json:"attack_protection,omitempty"`
+	}
+
+	bootstrapRole struct {
+		Name        string                `json:"name"`
+		Description string                `json:"description,omitempty"`
+		Permissions []bootstrapPermission `json:"permissions,omitempty"`
+	}
+
+	bootstrapPermission struct {
+		API    string   `json:"api"`
+		Scopes []string `json:"scopes"`
+	}
+
+	bootstrapAttackProtection struct {
+		BreachedPasswordDetection *management.BreachedPasswordDetection `json:"breached_password_detection,omitempty"`
+		BruteForceProtection      *management.BruteForceProtection      `json:"brute_force_protection,omitempty"`
+		SuspiciousIPThrottling    *management.SuspiciousIPThrottling    `json:"suspicious_ip_throttling,omitempty"`
+	}
+)
+
+func bootstrapTenantCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Template string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Args:  cobra.NoArgs,
+		Short: "Set up a new tenant from a template",
+		Long: "Set up a fresh tenant from a template, codifying the steps to prepare a new environment.\n\n" +
+			"The template can configure the tenant settings, create the standard applications, APIs and roles, " +
+			"and configure the email provider and attack protection. Each section of the template follows the " +
+			"payloads of the Auth0 Management API, e.g. `friendly_name` for the tenant settings or `app_type` for " +
+			"the applications. Role permissions are assigned by referencing the identifier of an API and its scopes.",
+		Example: `  auth0 tenants bootstrap
+  auth0 tenants bootstrap --template ./bootstrap.yaml
+  auth0 tenants bootstrap -t ./bootstrap.yaml --force
+  auth0 tenants bootstrap -t ./bootstrap.yaml --tenant "example.us.auth0.com"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := tenantBootstrapTemplate.Ask(cmd, &inputs.Template, nil); err != nil {
+				return err
+			}
+
+			template, err := loadBootstrapTemplate(inputs.Template)
+			if err != nil {
+				return err
+			}
+
+			tenant, err := cli.Config.GetTenant(cli.tenant)
+			if err != nil {
+				return err
+			}
+
+			if err := checkScopes(cmd.CommandPath(), template.requiredScopes(), tenant); err != nil {
+				return err
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				message := fmt.Sprintf("Are you sure you want to bootstrap the tenant %s?", tenant.Domain)
+				if confirmed := prompt.Confirm(message); !confirmed {
+					return nil
+				}
+			}
+
+			if err := template.apply(cmd.Context(), cli.api, cli.renderer); err != nil {
+				return err
+			}
+
+			cli.renderer.Infof("Successfully bootstrapped the tenant: %s", tenant.Domain)
+			return nil
+		},
+	}
+
+	tenantBootstrapTemplate.RegisterString(cmd, &inputs.Template, "")
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// loadBootstrapTemplate reads the template from a YAML or JSON file,
+// rejecting any unknown fields to catch typos before touching the tenant.
+func loadBootstrapTemplate(path string) (*bootstrapTemplate, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the template: %w", err)
+	}
+
+	// YAML is a superset of JSON, so both get parsed as YAML and then
+	// converted to JSON to decode them into the Management API payloads.
+	var rawTemplate interface{}
+	if err := yaml.Unmarshal(content, &rawTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse the template: %w", err)
+	}
+
+	jsonTemplate, err := json.Marshal(rawTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the template: %w", err)
+	}
+
+	var template bootstrapTemplate
+	decoder := json.NewDecoder(bytes.NewReader(jsonTemplate))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&template); err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	if template.isEmpty() {
+		return nil, errors.New("invalid template: there's nothing to set up for the tenant")
+	}
+
+	return &template, nil
+}
+
+func (t *bootstrapTemplate) isEmpty() bool {
+	return t.Tenant == nil &&
+		len(t.APIs) == 0 &&
+		len(t.Apps) == 0 &&
+		len(t.Roles) == 0 &&
+		t.EmailProvider == nil &&
+		t.AttackProtection == nil
+}
+
+// requiredScopes returns the scopes needed to set up the sections of the template.
+func (t *bootstrapTemplate) requiredScopes() []string {
+	var scopes []string
+
+	if t.Tenant != nil {
+		scopes = append(scopes, "update:tenant_settings")
+	}
+	if len(t.APIs) > 0 {
+		scopes = append(scopes, "create:resource_servers")
+	}
+	if len(t.Apps) > 0 {
+		scopes = append(scopes, "create:clients")
+	}
+	if len(t.Roles) > 0 {
+		scopes = append(scopes, "create:roles", "update:roles")
+	}
+	if t.EmailProvider != nil {
+		scopes = append(scopes, "create:email_provider", "update:email_provider")
+	}
+	if t.AttackProtection != nil {
+		scopes = append(scopes, "update:attack_protection")
+	}
+
+	return scopes
+}
+
+// apply sets up the tenant following the template. APIs get created
+// before the roles, so permissions can be assigned to the roles.
+func (t *bootstrapTemplate) apply(ctx context.Context, api *auth0.API, renderer *display.Renderer) error {
+	if t.Tenant != nil {
+		if err := ansi.Waiting(func() error {
+			return api.Tenant.Update(ctx, t.Tenant)
+		}); err != nil {
+			return fmt.Errorf("failed to update the tenant settings: %w", err)
+		}
+
+		renderer.Infof("Updated the tenant settings")
+	}
+
+	for _, resourceServer := range t.APIs {
+		if err := ansi.Waiting(func() error {
+			return api.ResourceServer.Create(ctx, resourceServer)
+		}); err != nil {
+			return fmt.Errorf("failed to create API %q: %w", resourceServer.GetName(), err)
+		}
+
+		renderer.Infof("Created API %s: %s", ansi.Bold(resourceServer.GetName()), resourceServer.GetIdentifier())
+	}
+
+	for _, client := range t.Apps {
+		if err := ansi.Waiting(func() error {
+			return api.Client.Create(ctx, client)
+		}); err != nil {
+			return fmt.Errorf("failed to create application %q: %w", client.GetName(), err)
+		}
+
+		renderer.Infof("Created application %s: %s", ansi.Bold(client.GetName()), client.GetClientID())
+	}
+
+	for _, role := range t.Roles {
+		if err := t.createRole(ctx, api, role); err != nil {
+			return err
+		}
+
+		renderer.Infof("Created role %s", ansi.Bold(role.Name))
+	}
+
+	if t.EmailProvider != nil {
+		if err := ansi.Waiting(func() error {
+			err := api.EmailProvider.Create(ctx, t.EmailProvider)
+			if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusConflict {
+				return api.EmailProvider.Update(ctx, t.EmailProvider)
+			}
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to configure the email provider: %w", err)
+		}
+
+		renderer.Infof("Configured the email provider %s", ansi.Bold(t.EmailProvider.GetName()))
+	}
+
+	if t.AttackProtection != nil {
+		if err := t.configureAttackProtection(ctx, api); err != nil {
+			return err
+		}
+
+		renderer.Infof("Configured attack protection")
+	}
+
+	return nil
+}
+
+func (t *bootstrapTemplate) createRole(ctx context.Context, api *auth0.API, role bootstrapRole) error {
+	newRole := &management.Role{
+		Name:        &role.Name,
+		Description: &role.Description,
+	}
+
+	var permissions []*management.Permission
+	for _, permission := range role.Permissions {
+		for _, scope := range permission.Scopes {
+			permissions = append(permissions, &management.Permission{
+				ResourceServerIdentifier: auth0.String(permission.API),
+				Name:                     auth0.String(scope),
+			})
+		}
+	}
+
+	return ansi.Waiting(func() error {
+		if err := api.Role.Create(ctx, newRole); err != nil {
+			return fmt.Errorf("failed to create role %q: %w", role.Name, err)
+		}
+
+		if len(permissions) == 0 {
+			return nil
+		}
+
+		if err := api.Role.AssociatePermissions(ctx, newRole.GetID(), permissions); err != nil {
+			return fmt.Errorf("failed to assign permissions to role %q: %w", role.Name, err)
+		}
+
+		return nil
+	})
+}
+
+func (t *bootstrapTemplate) configureAttackProtection(ctx context.Context, api *auth0.API) error {
+	attackProtection := t.AttackProtection
+
+	return ansi.Waiting(func() error {
+		if attackProtection.BreachedPasswordDetection != nil {
+			err := api.AttackProtection.UpdateBreachedPasswordDetection(ctx, attackProtection.BreachedPasswordDetection)
+			if err != nil {
+				return fmt.Errorf("failed to configure breached password detection: %w", err)
+			}
+		}
+
+		if attackProtection.BruteForceProtection != nil {
+			err := api.AttackProtection.UpdateBruteForceProtection(ctx, attackProtection.BruteForceProtection)
+			if err != nil {
+				return fmt.Errorf("failed to configure brute force protection: %w", err)
+			}
+		}
+
+		if attackProtection.SuspiciousIPThrottling != nil {
+			err := api.AttackProtection.UpdateSuspiciousIPThrottling(ctx, attackProtection.SuspiciousIPThrottling)
+			if err != nil {
+				return fmt.Errorf("failed to configure suspicious IP throttling: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
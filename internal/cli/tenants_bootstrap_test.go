@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestLoadBootstrapTemplate(t *testing.T) {
+	writeTemplate := func(t *testing.T, name, content string) string {
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	t.Run("it loads a YAML template", func(t *testing.T) {
+		path := writeTemplate(t, "bootstrap.yaml", `
+tenant:
+  friendly_name: Acme
+  session_lifetime: 72
+apis:
+  - name: Acme API
+    identifier: https://api.acme.com
+    scopes:
+      - value: read:orders
+apps:
+  - name: Acme Web
+    app_type: regular_web
+    callbacks:
+      - https://acme.com/callback
+roles:
+  - name: Admin
+    permissions:
+      - api: https://api.acme.com
+        scopes: [read:orders]
+email_provider:
+  name: sendgrid
+  credentials:
+    api_key: some-api-key
+attack_protection:
+  brute_force_protection:
+    enabled: true
+    max_attempts: 5
+`)
+
+		template, err := loadBootstrapTemplate(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Acme", template.Tenant.GetFriendlyName())
+		assert.Equal(t, 72.0, template.Tenant.GetSessionLifetime())
+		assert.Equal(t, "https://api.acme.com", template.APIs[0].GetIdentifier())
+		assert.Equal(t, "read:orders", (*template.APIs[0].Scopes)[0].GetValue())
+		assert.Equal(t, "regular_web", template.Apps[0].GetAppType())
+		assert.Equal(t, []string{"https://acme.com/callback"}, template.Apps[0].GetCallbacks())
+		assert.Equal(t, []bootstrapRole{
+			{
+				Name: "Admin",
+				Permissions: []bootstrapPermission{
+					{API: "https://api.acme.com", Scopes: []string{"read:orders"}},
+				},
+			},
+		}, template.Roles)
+		assert.Equal(t, "sendgrid", template.EmailProvider.GetName())
+		assert.Equal(t, 5, template.AttackProtection.BruteForceProtection.GetMaxAttempts())
+	})
+
+	t.Run("it loads a JSON template", func(t *testing.T) {
+		path := writeTemplate(t, "bootstrap.json", `{"tenant": {"friendly_name": "Acme"}}`)
+
+		template, err := loadBootstrapTemplate(path)
+		require.NoError(t, err)
+		assert.Equal(t, "Acme", template.Tenant.GetFriendlyName())
+	})
+
+	t.Run("it fails to load a template with unknown fields", func(t *testing.T) {
+		path := writeTemplate(t, "bootstrap.yaml", "tenant:\n  friendly_nam: Acme\n")
+
+		_, err := loadBootstrapTemplate(path)
+		assert.ErrorContains(t, err, `invalid template: json: unknown field "friendly_nam"`)
+	})
+
+	t.Run("it fails to load an empty template", func(t *testing.T) {
+		path := writeTemplate(t, "bootstrap.yaml", "apps: []\n")
+
+		_, err := loadBootstrapTemplate(path)
+		assert.EqualError(t, err, "invalid template: there's nothing to set up for the tenant")
+	})
+
+	t.Run("it fails to load a missing template", func(t *testing.T) {
+		_, err := loadBootstrapTemplate(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.ErrorContains(t, err, "failed to read the template")
+	})
+}
+
+func TestBootstrapTemplate_RequiredScopes(t *testing.T) {
+	template := &bootstrapTemplate{
+		Tenant: &management.Tenant{},
+		Roles:  []bootstrapRole{{Name: "Admin"}},
+	}
+
+	assert.Equal(t, []string{"update:tenant_settings", "create:roles", "update:roles"}, template.requiredScopes())
+}
+
+func TestBootstrapTemplate_Apply(t *testing.T) {
+	newTemplate := func() *bootstrapTemplate {
+		return &bootstrapTemplate{
+			Tenant: &management.Tenant{FriendlyName: auth0.String("Acme")},
+			APIs: []*management.ResourceServer{
+				{Name: auth0.String("Acme API"), Identifier: auth0.String("https://api.acme.com")},
+			},
+			Apps: []*management.Client{
+				{Name: auth0.String("Acme Web"), AppType: auth0.String("regular_web")},
+			},
+			Roles: []bootstrapRole{
+				{
+					Name: "Admin",
+					Permissions: []bootstrapPermission{
+						{API: "https://api.acme.com", Scopes: []string{"read:orders", "update:orders"}},
+					},
+				},
+			},
+			EmailProvider: &management.EmailProvider{Name: auth0.String("sendgrid")},
+			AttackProtection: &bootstrapAttackProtection{
+				BruteForceProtection: &management.BruteForceProtection{Enabled: auth0.Bool(true)},
+			},
+		}
+	}
+
+	t.Run("it sets up the tenant following the template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		template := newTemplate()
+
+		tenantAPI := mock.NewMockTenantAPI(ctrl)
+		resourceServerAPI := mock.NewMockResourceServerAPI(ctrl)
+		clientAPI := mock.NewMockClientAPI(ctrl)
+		roleAPI := mock.NewMockRoleAPI(ctrl)
+		emailProviderAPI := mock.NewMockEmailProviderAPI(ctrl)
+		attackProtectionAPI := mock.NewMockAttackProtectionAPI(ctrl)
+
+		gomock.InOrder(
+			tenantAPI.EXPECT().Update(gomock.Any(), template.Tenant).Return(nil),
+			resourceServerAPI.EXPECT().Create(gomock.Any(), template.APIs[0]).Return(nil),
+			clientAPI.EXPECT().Create(gomock.Any(), template.Apps[0]).Return(nil),
+			roleAPI.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, role *management.Role, _ ...management.RequestOption) error {
+					assert.Equal(t, "Admin", role.GetName())
+					role.ID = auth0.String("rol_123")
+					return nil
+				}),
+			roleAPI.EXPECT().
+				AssociatePermissions(gomock.Any(), "rol_123", []*management.Permission{
+					{ResourceServerIdentifier: auth0.String("https://api.acme.com"), Name: auth0.String("read:orders")},
+					{ResourceServerIdentifier: auth0.String("https://api.acme.com"), Name: auth0.String("update:orders")},
+				}).
+				Return(nil),
+			emailProviderAPI.EXPECT().Create(gomock.Any(), template.EmailProvider).Return(nil),
+			attackProtectionAPI.EXPECT().
+				UpdateBruteForceProtection(gomock.Any(), template.AttackProtection.BruteForceProtection).
+				Return(nil),
+		)
+
+		api := &auth0.API{
+			Tenant:           tenantAPI,
+			ResourceServer:   resourceServerAPI,
+			Client:           clientAPI,
+			Role:             roleAPI,
+			EmailProvider:    emailProviderAPI,
+			AttackProtection: attackProtectionAPI,
+		}
+
+		messages := &bytes.Buffer{}
+		renderer := &display.Renderer{MessageWriter: messages, ResultWriter: &bytes.Buffer{}}
+
+		err := template.apply(context.Background(), api, renderer)
+		require.NoError(t, err)
+
+		assert.Contains(t, messages.String(), "Updated the tenant settings")
+		assert.Contains(t, messages.String(), "Created API")
+		assert.Contains(t, messages.String(), "Created application")
+		assert.Contains(t, messages.String(), "Created role")
+		assert.Contains(t, messages.String(), "Configured the email provider")
+		assert.Contains(t, messages.String(), "Configured attack protection")
+	})
+
+	t.Run("it updates the email provider if it already exists", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		template := &bootstrapTemplate{
+			EmailProvider: &management.EmailProvider{Name: auth0.String("sendgrid")},
+		}
+
+		conflictErr := mockManagementError{statusCode: http.StatusConflict, error: errors.New("conflict")}
+
+		emailProviderAPI := mock.NewMockEmailProviderAPI(ctrl)
+		gomock.InOrder(
+			emailProviderAPI.EXPECT().Create(gomock.Any(), template.EmailProvider).Return(conflictErr),
+			emailProviderAPI.EXPECT().Update(gomock.Any(), template.EmailProvider).Return(nil),
+		)
+
+		renderer := &display.Renderer{MessageWriter: &bytes.Buffer{}, ResultWriter: &bytes.Buffer{}}
+
+		err := template.apply(context.Background(), &auth0.API{EmailProvider: emailProviderAPI}, renderer)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it stops at the first failing step", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		template := newTemplate()
+
+		tenantAPI := mock.NewMockTenantAPI(ctrl)
+		tenantAPI.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		resourceServerAPI := mock.NewMockResourceServerAPI(ctrl)
+		resourceServerAPI.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("api error"))
+
+		renderer := &display.Renderer{MessageWriter: &bytes.Buffer{}, ResultWriter: &bytes.Buffer{}}
+		api := &auth0.API{Tenant: tenantAPI, ResourceServer: resourceServerAPI}
+
+		err := template.apply(context.Background(), api, renderer)
+		assert.EqualError(t, err, `failed to create API "Acme API": api error`)
+	})
+}
@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+var errorPageFlags = tenantErrorPageFlags{
+	URL: Flag{
+		Name:      "URL",
+		LongForm:  "url",
+		ShortForm: "u",
+		Help: "URL to redirect to instead of showing the error page. " +
+			"Set it to an empty string to show the custom HTML or the default error page instead.",
+		AlwaysPrompt: true,
+	},
+	HTML: Flag{
+		Name:      "HTML",
+		LongForm:  "html",
+		ShortForm: "t",
+		Help:      "Custom HTML of the error page, Liquid syntax is supported. Only used when no URL is set.",
+	},
+	ShowLogLink: Flag{
+		Name:         "Show Log Link",
+		LongForm:     "show-log-link",
+		ShortForm:    "l",
+		Help:         "Show a link to the log on the default error page.",
+		AlwaysPrompt: true,
+	},
+}
+
+type (
+	tenantErrorPageFlags struct {
+		URL         Flag
+		HTML        Flag
+		ShowLogLink Flag
+	}
+
+	tenantErrorPageInputs struct {
+		URL         string
+		HTML        string
+		ShowLogLink bool
+	}
+)
+
+func errorPageTenantCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "error-page",
+		Args:  cobra.NoArgs,
+		Short: "Manage the error page of the tenant",
+		Long: "Manage the error page shown when an error occurs during login that can't be sent back to the " +
+			"application, such as an invalid callback URL.\n\n" +
+			"The error page either redirects to a URL, renders custom HTML or renders the default error page.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+
+	cmd.AddCommand(showErrorPageTenantCmd(cli))
+	cmd.AddCommand(updateErrorPageTenantCmd(cli))
+
+	return cmd
+}
+
+func showErrorPageTenantCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.NoArgs,
+		Short: "Show the error page settings",
+		Long:  "Display the current error page settings of the tenant.",
+		Example: `  auth0 tenants error-page show
+  auth0 tenants error-page show --json`,
+		RunE: showErrorPageTenantCmdRun(cli),
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func updateErrorPageTenantCmd(cli *cli) *cobra.Command {
+	var inputs tenantErrorPageInputs
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.NoArgs,
+		Short: "Update the error page settings",
+		Long:  "Update the error page settings of the tenant.",
+		Example: `  auth0 tenants error-page update
+  auth0 tenants error-page update --url "https://example.com/error"
+  auth0 tenants error-page update --url "" --html "$(cat path/to/error.html)"
+  auth0 tenants error-page update --url "" --html "" --show-log-link=false
+  auth0 tenants error-page update -u "" -t "$(cat path/to/error.html)" --json`,
+		RunE: updateErrorPageTenantCmdRun(cli, &inputs),
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	errorPageFlags.URL.RegisterStringU(cmd, &inputs.URL, "")
+	errorPageFlags.HTML.RegisterStringU(cmd, &inputs.HTML, "")
+	errorPageFlags.ShowLogLink.RegisterBoolU(cmd, &inputs.ShowLogLink, true)
+
+	return cmd
+}
+
+func showErrorPageTenantCmdRun(cli *cli) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var tenant *management.Tenant
+		if err := ansi.Waiting(func() (err error) {
+			tenant, err = cli.api.Tenant.Read(cmd.Context())
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to read the tenant settings: %w", err)
+		}
+
+		cli.renderer.TenantErrorPageShow(tenant.GetErrorPage())
+
+		return nil
+	}
+}
+
+func updateErrorPageTenantCmdRun(
+	cli *cli,
+	inputs *tenantErrorPageInputs,
+) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var tenant *management.Tenant
+		if err := ansi.Waiting(func() (err error) {
+			tenant, err = cli.api.Tenant.Read(cmd.Context())
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to read the tenant settings: %w", err)
+		}
+
+		current := tenant.GetErrorPage()
+		errorPage := &management.TenantErrorPage{
+			URL:         auth0.String(current.GetURL()),
+			HTML:        auth0.String(current.GetHTML()),
+			ShowLogLink: auth0.Bool(true),
+		}
+		if current != nil && current.ShowLogLink != nil {
+			errorPage.ShowLogLink = current.ShowLogLink
+		}
+
+		// The settings are only prompted for when no flags are set, and
+		// an empty URL or HTML is a valid value that clears it.
+		interactive := shouldPromptWhenNoLocalFlagsSet(cmd)
+
+		if err := errorPageFlags.URL.AskU(cmd, &inputs.URL, errorPage.URL); err != nil {
+			return err
+		}
+		if interactive || errorPageFlags.URL.IsSet(cmd) {
+			errorPage.URL = &inputs.URL
+		}
+
+		if errorPageFlags.HTML.IsSet(cmd) {
+			errorPage.HTML = &inputs.HTML
+		}
+
+		if err := errorPageFlags.ShowLogLink.AskBoolU(cmd, &inputs.ShowLogLink, errorPage.ShowLogLink); err != nil {
+			return err
+		}
+		if interactive || errorPageFlags.ShowLogLink.IsSet(cmd) {
+			errorPage.ShowLogLink = &inputs.ShowLogLink
+		}
+
+		if err := ansi.Waiting(func() error {
+			return cli.api.Tenant.Update(cmd.Context(), &management.Tenant{ErrorPage: errorPage})
+		}); err != nil {
+			return fmt.Errorf("failed to update the error page settings: %w", err)
+		}
+
+		cli.renderer.TenantErrorPageUpdate(errorPage)
+
+		return nil
+	}
+}
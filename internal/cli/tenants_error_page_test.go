@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestUpdateErrorPageTenantCmd(t *testing.T) {
+	currentTenant := &management.Tenant{
+		ErrorPage: &management.TenantErrorPage{
+			URL:         auth0.String("https://example.com/error"),
+			HTML:        auth0.String("<p>Oops</p>"),
+			ShowLogLink: auth0.Bool(false),
+		},
+	}
+
+	var tests = []struct {
+		name              string
+		args              []string
+		currentTenant     *management.Tenant
+		expectedErrorPage *management.TenantErrorPage
+	}{
+		{
+			name:          "it updates the url and keeps the other settings",
+			args:          []string{"--url", "https://example.com/other-error"},
+			currentTenant: currentTenant,
+			expectedErrorPage: &management.TenantErrorPage{
+				URL:         auth0.String("https://example.com/other-error"),
+				HTML:        auth0.String("<p>Oops</p>"),
+				ShowLogLink: auth0.Bool(false),
+			},
+		},
+		{
+			name:          "it clears the url to render the custom html",
+			args:          []string{"--url", "", "--html", "<p>Something went wrong</p>"},
+			currentTenant: currentTenant,
+			expectedErrorPage: &management.TenantErrorPage{
+				URL:         auth0.String(""),
+				HTML:        auth0.String("<p>Something went wrong</p>"),
+				ShowLogLink: auth0.Bool(false),
+			},
+		},
+		{
+			name:          "it shows the log link by default when not configured",
+			args:          []string{"--html", ""},
+			currentTenant: &management.Tenant{},
+			expectedErrorPage: &management.TenantErrorPage{
+				URL:         auth0.String(""),
+				HTML:        auth0.String(""),
+				ShowLogLink: auth0.Bool(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tenantAPI := mock.NewMockTenantAPI(ctrl)
+			tenantAPI.EXPECT().Read(gomock.Any()).Return(test.currentTenant, nil)
+			tenantAPI.EXPECT().
+				Update(gomock.Any(), &management.Tenant{ErrorPage: test.expectedErrorPage}).
+				Return(nil)
+
+			cli := &cli{
+				renderer: &display.Renderer{
+					MessageWriter: io.Discard,
+					ResultWriter:  io.Discard,
+				},
+				api: &auth0.API{Tenant: tenantAPI},
+			}
+
+			cmd := updateErrorPageTenantCmd(cli)
+			cmd.SetArgs(test.args)
+
+			require.NoError(t, cmd.Execute())
+		})
+	}
+
+	t.Run("it fails to update the error page when reading the tenant fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantAPI := mock.NewMockTenantAPI(ctrl)
+		tenantAPI.EXPECT().Read(gomock.Any()).Return(nil, errors.New("api error"))
+
+		cli := &cli{
+			renderer: &display.Renderer{
+				MessageWriter: io.Discard,
+				ResultWriter:  io.Discard,
+			},
+			api: &auth0.API{Tenant: tenantAPI},
+		}
+
+		cmd := updateErrorPageTenantCmd(cli)
+		cmd.SetArgs([]string{"--url", ""})
+
+		assert.EqualError(t, cmd.Execute(), "failed to read the tenant settings: api error")
+	})
+}
+
+func TestShowErrorPageTenantCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantAPI := mock.NewMockTenantAPI(ctrl)
+	tenantAPI.EXPECT().Read(gomock.Any()).Return(&management.Tenant{
+		ErrorPage: &management.TenantErrorPage{
+			URL: auth0.String("https://example.com/error"),
+		},
+	}, nil)
+
+	output := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{
+			MessageWriter: io.Discard,
+			ResultWriter:  output,
+			Format:        display.OutputFormatJSON,
+		},
+		api: &auth0.API{Tenant: tenantAPI},
+	}
+
+	cmd := showErrorPageTenantCmd(cli)
+	cmd.SetArgs([]string{})
+
+	require.NoError(t, cmd.Execute())
+	assert.JSONEq(t, `{"url": "https://example.com/error"}`, output.String())
+}
@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+var localesFlags = tenantLocalesFlags{
+	Locales: Flag{
+		Name:      "Locales",
+		LongForm:  "locales",
+		ShortForm: "l",
+		Help: "Comma-separated list of the locales to enable, such as \"en,fr,es\", replacing the enabled ones. " +
+			"The first one is the default locale.",
+		AlwaysPrompt: true,
+	},
+	Add: Flag{
+		Name:     "Add",
+		LongForm: "add",
+		Help:     "Comma-separated list of the locales to enable along with the enabled ones.",
+	},
+	Remove: Flag{
+		Name:     "Remove",
+		LongForm: "remove",
+		Help:     "Comma-separated list of the locales to disable.",
+	},
+	Default: Flag{
+		Name:      "Default",
+		LongForm:  "default",
+		ShortForm: "d",
+		Help: "Default locale of the tenant, used when the locale of the user isn't enabled. " +
+			"It gets enabled if it isn't already.",
+	},
+}
+
+type (
+	tenantLocalesFlags struct {
+		Locales Flag
+		Add     Flag
+		Remove  Flag
+		Default Flag
+	}
+
+	tenantLocalesInputs struct {
+		Locales []string
+		Add     []string
+		Remove  []string
+		Default string
+	}
+)
+
+func localesTenantCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locales",
+		Args:  cobra.NoArgs,
+		Short: "Manage the locales of the tenant",
+		Long: "Manage the locales enabled for the tenant, which Universal Login and the emails are rendered in.\n\n" +
+			"The first enabled locale is the default one, used when the locale of the user isn't enabled.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+
+	cmd.AddCommand(showLocalesTenantCmd(cli))
+	cmd.AddCommand(updateLocalesTenantCmd(cli))
+
+	return cmd
+}
+
+func showLocalesTenantCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.NoArgs,
+		Short: "Show the locales of the tenant",
+		Long:  "Display the default locale of the tenant, along with the enabled ones.",
+		Example: `  auth0 tenants locales show
+  auth0 tenants locales show --json`,
+		RunE: showLocalesTenantCmdRun(cli),
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func updateLocalesTenantCmd(cli *cli) *cobra.Command {
+	var inputs tenantLocalesInputs
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.NoArgs,
+		Short: "Update the locales of the tenant",
+		Long: "Update the locales enabled for the tenant, and the default one.\n\n" +
+			"Either replace the enabled locales with `--locales`, whose first one is the default locale, " +
+			"or enable and disable some of them with `--add` and `--remove`.",
+		Example: `  auth0 tenants locales update
+  auth0 tenants locales update --locales "en,fr,es"
+  auth0 tenants locales update --add de,it --remove es
+  auth0 tenants locales update --default fr
+  auth0 tenants locales update -l "fr,en" --json`,
+		RunE: updateLocalesTenantCmdRun(cli, &inputs),
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	localesFlags.Locales.RegisterStringSliceU(cmd, &inputs.Locales, nil)
+	localesFlags.Add.RegisterStringSliceU(cmd, &inputs.Add, nil)
+	localesFlags.Remove.RegisterStringSliceU(cmd, &inputs.Remove, nil)
+	localesFlags.Default.RegisterStringU(cmd, &inputs.Default, "")
+	cmd.MarkFlagsMutuallyExclusive(localesFlags.Locales.LongForm, localesFlags.Add.LongForm)
+	cmd.MarkFlagsMutuallyExclusive(localesFlags.Locales.LongForm, localesFlags.Remove.LongForm)
+
+	return cmd
+}
+
+func showLocalesTenantCmdRun(cli *cli) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var tenant *management.Tenant
+		if err := ansi.Waiting(func() (err error) {
+			tenant, err = cli.api.Tenant.Read(cmd.Context())
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to read the tenant settings: %w", err)
+		}
+
+		cli.renderer.TenantLocalesShow(tenant.GetEnabledLocales())
+
+		return nil
+	}
+}
+
+func updateLocalesTenantCmdRun(
+	cli *cli,
+	inputs *tenantLocalesInputs,
+) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var tenant *management.Tenant
+		if err := ansi.Waiting(func() (err error) {
+			tenant, err = cli.api.Tenant.Read(cmd.Context())
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to read the tenant settings: %w", err)
+		}
+
+		current := tenant.GetEnabledLocales()
+
+		// The locales are only prompted for when no flags are set, replacing the enabled ones.
+		replace := shouldPromptWhenNoLocalFlagsSet(cmd) || localesFlags.Locales.IsSet(cmd)
+
+		defaultLocales := strings.Join(current, ",")
+		if err := localesFlags.Locales.AskManyU(cmd, &inputs.Locales, &defaultLocales); err != nil {
+			return err
+		}
+
+		locales, err := updatedTenantLocales(current, inputs, replace)
+		if err != nil {
+			return err
+		}
+
+		if err := ansi.Waiting(func() error {
+			return cli.api.Tenant.Update(cmd.Context(), &management.Tenant{EnabledLocales: &locales})
+		}); err != nil {
+			return fmt.Errorf("failed to update the locales: %w", err)
+		}
+
+		cli.renderer.TenantLocalesUpdate(locales)
+
+		return nil
+	}
+}
+
+// updatedTenantLocales applies the inputs to the enabled locales, either replacing them or adding
+// and removing some of them, then moves the default locale first, as the first one is the default.
+func updatedTenantLocales(current []string, inputs *tenantLocalesInputs, replace bool) ([]string, error) {
+	enabled := current
+	if replace {
+		enabled = inputs.Locales
+	}
+
+	var locales []string
+	for _, list := range [][]string{enabled, inputs.Add} {
+		for _, locale := range list {
+			if locale = strings.TrimSpace(locale); locale != "" && !containsStr(locales, locale) {
+				locales = append(locales, locale)
+			}
+		}
+	}
+
+	for _, locale := range inputs.Remove {
+		locale = strings.TrimSpace(locale)
+		if locale == strings.TrimSpace(inputs.Default) {
+			return nil, fmt.Errorf("the default locale %q can't be removed", locale)
+		}
+
+		for i, enabledLocale := range locales {
+			if enabledLocale == locale {
+				locales = append(locales[:i], locales[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if defaultLocale := strings.TrimSpace(inputs.Default); defaultLocale != "" {
+		reordered := []string{defaultLocale}
+		for _, locale := range locales {
+			if locale != defaultLocale {
+				reordered = append(reordered, locale)
+			}
+		}
+		locales = reordered
+	}
+
+	if len(locales) == 0 {
+		return nil, errors.New("at least one locale must be enabled")
+	}
+
+	return locales, nil
+}
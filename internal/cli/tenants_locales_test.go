@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestUpdatedTenantLocales(t *testing.T) {
+	current := []string{"en", "fr", "es"}
+
+	var tests = []struct {
+		name     string
+		inputs   tenantLocalesInputs
+		replace  bool
+		expected []string
+	}{
+		{
+			name:     "it replaces the enabled locales",
+			inputs:   tenantLocalesInputs{Locales: []string{"de", " it ", "de"}},
+			replace:  true,
+			expected: []string{"de", "it"},
+		},
+		{
+			name:     "it adds and removes locales",
+			inputs:   tenantLocalesInputs{Add: []string{"de", "fr"}, Remove: []string{"es", "pt"}},
+			expected: []string{"en", "fr", "de"},
+		},
+		{
+			name:     "it moves the default locale first",
+			inputs:   tenantLocalesInputs{Default: "es"},
+			expected: []string{"es", "en", "fr"},
+		},
+		{
+			name:     "it enables the default locale",
+			inputs:   tenantLocalesInputs{Default: "ja"},
+			expected: []string{"ja", "en", "fr", "es"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			locales, err := updatedTenantLocales(current, &test.inputs, test.replace)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, locales)
+		})
+	}
+
+	t.Run("it fails to remove the default locale", func(t *testing.T) {
+		_, err := updatedTenantLocales(current, &tenantLocalesInputs{Remove: []string{"fr"}, Default: "fr"}, false)
+		assert.EqualError(t, err, `the default locale "fr" can't be removed`)
+	})
+
+	t.Run("it fails to disable all the locales", func(t *testing.T) {
+		_, err := updatedTenantLocales([]string{"en"}, &tenantLocalesInputs{Remove: []string{"en"}}, false)
+		assert.EqualError(t, err, "at least one locale must be enabled")
+	})
+
+	assert.Equal(t, []string{"en", "fr", "es"}, current)
+}
+
+func TestUpdateLocalesTenantCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantAPI := mock.NewMockTenantAPI(ctrl)
+	tenantAPI.EXPECT().Read(gomock.Any()).Return(&management.Tenant{EnabledLocales: &[]string{"en", "fr"}}, nil)
+	tenantAPI.EXPECT().
+		Update(gomock.Any(), &management.Tenant{EnabledLocales: &[]string{"fr", "en", "de"}}).
+		Return(nil)
+
+	result := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: result},
+		api:      &auth0.API{Tenant: tenantAPI},
+		noInput:  true,
+	}
+
+	cmd := updateLocalesTenantCmd(cli)
+	cmd.SetArgs([]string{"--add", "de", "--default", "fr"})
+	cmd.SetOut(io.Discard)
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, result.String(), "fr, en, de")
+}
@@ -1,14 +1,19 @@
 package cli
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -20,14 +25,47 @@ import (
 
 	"github.com/auth0/auth0-cli/internal/ansi"
 	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
 	"github.com/auth0/auth0-cli/internal/prompt"
 )
 
+const (
+	terraformFormatHCL  = "hcl"
+	terraformFormatJSON = "json"
+
+	// defaultTerraformVersion is the version of Terraform installed to generate the resource config by default,
+	// the first one supporting import blocks.
+	defaultTerraformVersion = "1.5.0"
+
+	// defaultProviderVersion is the version constraint of the Terraform provider by default,
+	// any of its GA versions.
+	defaultProviderVersion = ">= 1.0.0"
+)
+
+var (
+	// minTerraformVersion is the first version of Terraform supporting import blocks.
+	minTerraformVersion = version.Must(version.NewVersion("1.5.0"))
+
+	// terraformBackends are the backends that the state of the generated config can be stored in.
+	terraformBackends = []string{"s3", "azurerm", "gcs", "remote"}
+
+	// terraformBackendSettingPattern matches the name of a setting of a backend, or of a setting of
+	// one of its nested blocks, such as workspaces.name for the remote backend.
+	terraformBackendSettingPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.[a-z_][a-z0-9_]*)?$`)
+
+	// terraformResourceScopes lists the scopes needed to fetch the resource types whose scopes
+	// aren't all requested on login by default, so they're checked before generating their config.
+	terraformResourceScopes = map[string][]string{
+		"auth0_hook":        {"read:hooks"},
+		"auth0_rule":        {"read:rules", "read:rules_configs"},
+		"auth0_rule_config": {"read:rules", "read:rules_configs"},
+	}
+)
+
 var tfFlags = terraformFlags{
 	OutputDIR: Flag{
-		Name:      "Output Dir",
-		LongForm:  "output-dir",
-		ShortForm: "o",
+		Name:     "Output Dir",
+		LongForm: "output-dir",
 		Help: "Output directory for the generated Terraform config files. If not provided, the files will be " +
 			"saved in the current working directory.",
 	},
@@ -36,72 +74,540 @@ var tfFlags = terraformFlags{
 		LongForm:  "resources",
 		ShortForm: "r",
 		Help: "Resource types to generate Terraform config for. If not provided, config files for all " +
-			"available resources will be generated.",
+			"available resources will be generated. Run 'auth0 terraform resources' to list the supported " +
+			"resource types.",
+	},
+	Exclude: Flag{
+		Name:     "Exclude",
+		LongForm: "exclude",
+		Help: "Resources to leave out of the generated Terraform config, applied after fetching them. Use " +
+			"'<resource-type>' to exclude all the resources of a type, or '<resource-type>:<name>' to only exclude " +
+			"the ones whose name or ID matches, e.g. 'auth0_client:Terraform Provider'. The name supports * wildcards.",
+	},
+	Filter: Flag{
+		Name:     "Filter",
+		LongForm: "filter",
+		Help: "Only generate the Terraform config of the resources of a type whose name or ID matches, applied " +
+			"after fetching them. Use '<resource-type>.name=<pattern>' or '<resource-type>.id=<pattern>', where the " +
+			"pattern supports * wildcards, or is a regular expression when wrapped in slashes, e.g. " +
+			"'client.name=prod-*' or 'auth0_client.name=/^prod-[0-9]+$/'. The resources of a type with several " +
+			"filters are kept when matching any of them, and the ones of types without filters are all kept.",
+	},
+	Stdout: Flag{
+		Name:     "Stdout",
+		LongForm: "stdout",
+		Help: "Write the combined Terraform config to the standard output instead of files, such as to pipe it " +
+			"into other tools or to preview it without writing to the output directory.",
+	},
+	SplitFiles: Flag{
+		Name:     "Split Files",
+		LongForm: "split-files",
+		Help: "Write the import blocks of each resource type to their own file, such as auth0_client_import.tf, " +
+			"instead of a single auth0_import.tf file, to review and merge the config of large tenants more easily.",
+	},
+	Format: Flag{
+		Name:     "Format",
+		LongForm: "format",
+		Help: "Format of the generated output: 'hcl' for the Terraform config, or 'json' for the list of the " +
+			"resources to import, with their resource name and import ID, written to auth0_import.json or to " +
+			"the standard output with --stdout, for CI pipelines and custom tooling.",
+	},
+	DryRun: Flag{
+		Name:     "Dry Run",
+		LongForm: "dry-run",
+		Help: "Only fetch the resources and list them by type, along with the files that would be generated, " +
+			"without writing anything, to check what the config would cover before generating it.",
+	},
+	TerraformVersion: Flag{
+		Name:     "Terraform Version",
+		LongForm: "terraform-version",
+		Help: "Version of Terraform to generate the resource config with, which the generated config requires " +
+			"the patch releases of through its required_version. It must be 1.5.0 or later, for the import blocks.",
+	},
+	ProviderVersion: Flag{
+		Name:     "Provider Version",
+		LongForm: "provider-version",
+		Help:     "Version constraint of the Auth0 Terraform provider in the generated config, e.g. '~> 1.2'.",
+	},
+	Backend: Flag{
+		Name:     "Backend",
+		LongForm: "backend",
+		Help: "Backend to store the Terraform state in, rendered into the terraform block of the generated " +
+			"auth0_main.tf: s3, azurerm, gcs or remote. If not provided, the state is stored locally.",
+	},
+	BackendConfig: Flag{
+		Name:     "Backend Config",
+		LongForm: "backend-config",
+		Help: "Settings of the backend, e.g. bucket=my-state. Use '<block>.<setting>' for the settings of a " +
+			"nested block, e.g. workspaces.name=auth0 for the remote backend.",
+	},
+	Tags: Flag{
+		Name:     "Tags",
+		LongForm: "tag",
+		Help: "Only generate config for the applications, connections and organizations with all the given " +
+			"tags, e.g. env=prod. The other resource types aren't affected. See 'auth0 tags'.",
 	},
 }
 
 type (
 	terraformFlags struct {
-		OutputDIR Flag
-		Resources Flag
+		OutputDIR        Flag
+		Resources        Flag
+		Exclude          Flag
+		Filter           Flag
+		Stdout           Flag
+		SplitFiles       Flag
+		Format           Flag
+		DryRun           Flag
+		TerraformVersion Flag
+		ProviderVersion  Flag
+		Backend          Flag
+		BackendConfig    Flag
+		Tags             Flag
 	}
 
 	terraformInputs struct {
-		OutputDIR string
-		Resources []string
+		Output           artifactOutput
+		Resources        []string
+		Exclude          []string
+		Filter           []string
+		Stdout           bool
+		SplitFiles       bool
+		Format           string
+		DryRun           bool
+		TerraformVersion string
+		ProviderVersion  string
+		Backend          string
+		BackendConfig    map[string]string
+		Tags             map[string]string
+	}
+
+	// terraformMainConfig are the versions of Terraform and of the Auth0 provider that the generated
+	// config is meant for, along with the backend to store its state in and the domain of the tenant.
+	terraformMainConfig struct {
+		terraform     *version.Version
+		provider      string
+		backend       string
+		backendConfig map[string]string
+		domain        string
+	}
+
+	// terraformExclusion excludes the resources of a type from the generated config, either
+	// all of them or only the ones whose name or ID matches the pattern.
+	terraformExclusion struct {
+		resourceType string
+		pattern      string
+	}
+
+	// terraformFilter only keeps the resources of a type whose name
+	// or ID matches either a glob pattern or a regular expression.
+	terraformFilter struct {
+		resourceType string
+		field        string
+		pattern      string
+		regexp       *regexp.Regexp
 	}
 )
 
+// resourceFetcherFactory creates the fetcher of the import data of one or more resource types.
+type resourceFetcherFactory func(api *auth0.API, inputs *terraformInputs) resourceDataFetcher
+
+// resourceFetcherFactories are the fetchers of the supported resource types, by resource type.
+//
+// Additional resource types, such as custom or early-access ones, can be supported without
+// modifying this list by calling registerResourceFetcher from the init function of a separate
+// file, which can be guarded by a build tag. To generate their config by default, append them
+// to the defaultResources too.
+var resourceFetcherFactories = map[string]resourceFetcherFactory{
+	"auth0_action": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &actionResourceFetcher{api}
+	},
+	"auth0_attack_protection": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &attackProtectionResourceFetcher{api}
+	},
+	"auth0_branding": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &brandingResourceFetcher{api}
+	},
+	"auth0_branding_theme": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &brandingThemeResourceFetcher{api}
+	},
+	"auth0_client":             newClientResourceFetcher,
+	"auth0_client_credentials": newClientResourceFetcher,
+	"auth0_client_grant": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &clientGrantResourceFetcher{api}
+	},
+	"auth0_connection":         newConnectionResourceFetcher,
+	"auth0_connection_clients": newConnectionResourceFetcher,
+	"auth0_custom_domain": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &customDomainResourceFetcher{api}
+	},
+	"auth0_email_provider": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &emailProviderResourceFetcher{api}
+	},
+	"auth0_email_template": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &emailTemplateResourceFetcher{api}
+	},
+	"auth0_guardian": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &guardianResourceFetcher{api}
+	},
+	"auth0_hook": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &hookResourceFetcher{api}
+	},
+	"auth0_log_stream": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &logStreamResourceFetcher{api}
+	},
+	"auth0_organization":             newOrganizationResourceFetcher,
+	"auth0_organization_connections": newOrganizationResourceFetcher,
+	"auth0_organization_member":      newOrganizationResourceFetcher,
+	"auth0_pages": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &pagesResourceFetcher{api}
+	},
+	"auth0_prompt": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &promptResourceFetcher{api}
+	},
+	"auth0_prompt_custom_text": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &promptCustomTextResourceFetcherResourceFetcher{api}
+	},
+	"auth0_resource_server":        newResourceServerResourceFetcher,
+	"auth0_resource_server_scopes": newResourceServerResourceFetcher,
+	"auth0_role":                   newRoleResourceFetcher,
+	"auth0_role_permissions":       newRoleResourceFetcher,
+	"auth0_rule":                   newRuleResourceFetcher,
+	"auth0_rule_config":            newRuleResourceFetcher,
+	"auth0_tenant": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &tenantResourceFetcher{api}
+	},
+	"auth0_trigger_actions": func(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+		return &triggerActionsResourceFetcher{api}
+	},
+}
+
+// resourceFetcherGroups are the resource types sharing the fetcher of another resource type, by
+// the resource type they're grouped with. The fetcher of a group only gets created once, so that
+// the config of its resources isn't generated twice.
+var resourceFetcherGroups = map[string]string{
+	"auth0_client_credentials":       "auth0_client",
+	"auth0_connection_clients":       "auth0_connection",
+	"auth0_organization_connections": "auth0_organization",
+	"auth0_organization_member":      "auth0_organization",
+	"auth0_resource_server_scopes":   "auth0_resource_server",
+	"auth0_role_permissions":         "auth0_role",
+	"auth0_rule_config":              "auth0_rule",
+}
+
+func newClientResourceFetcher(api *auth0.API, inputs *terraformInputs) resourceDataFetcher {
+	return &clientResourceFetcher{api: api, tags: inputs.Tags}
+}
+
+func newConnectionResourceFetcher(api *auth0.API, inputs *terraformInputs) resourceDataFetcher {
+	return &connectionResourceFetcher{api: api, tags: inputs.Tags}
+}
+
+func newOrganizationResourceFetcher(api *auth0.API, inputs *terraformInputs) resourceDataFetcher {
+	return &organizationResourceFetcher{api: api, tags: inputs.Tags}
+}
+
+func newResourceServerResourceFetcher(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+	return &resourceServerResourceFetcher{api}
+}
+
+func newRoleResourceFetcher(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+	return &roleResourceFetcher{api}
+}
+
+func newRuleResourceFetcher(api *auth0.API, _ *terraformInputs) resourceDataFetcher {
+	return &ruleResourceFetcher{api}
+}
+
+// terraformRequiredScopes returns the scopes needed to fetch the resource types
+// listed in terraformResourceScopes, without duplicates.
+func terraformRequiredScopes(resourceTypes []string) []string {
+	var scopes []string
+	for _, resourceType := range resourceTypes {
+		for _, scope := range terraformResourceScopes[resourceType] {
+			if !containsStr(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	return scopes
+}
+
+// resourceFetcherGroup returns the group of the resource type, which is the resource type
+// itself unless its import data gets fetched together with the one of another resource type.
+func resourceFetcherGroup(resourceType string) string {
+	if group, ok := resourceFetcherGroups[resourceType]; ok {
+		return group
+	}
+
+	return resourceType
+}
+
+// registerResourceFetcher adds support for the resource types, whose import data gets fetched by
+// the fetcher the factory creates. The resource types after the first one are grouped with it, so
+// that they're fetched together. It panics if one of the resource types is already supported.
+func registerResourceFetcher(factory resourceFetcherFactory, resourceTypes ...string) {
+	for index, resourceType := range resourceTypes {
+		if _, ok := resourceFetcherFactories[resourceType]; ok {
+			panic(fmt.Sprintf("a fetcher is already registered for the resource type %s", resourceType))
+		}
+
+		resourceFetcherFactories[resourceType] = factory
+		if index > 0 {
+			resourceFetcherGroups[resourceType] = resourceTypes[0]
+		}
+	}
+}
+
+// parseMainConfig validates the versions of Terraform and of the provider to generate the config for,
+// and the backend along with its settings.
+func (i *terraformInputs) parseMainConfig() (terraformMainConfig, error) {
+	terraformVersion, err := version.NewVersion(i.TerraformVersion)
+	if err != nil {
+		return terraformMainConfig{}, fmt.Errorf("invalid Terraform version %q: %w", i.TerraformVersion, err)
+	}
+
+	if terraformVersion.LessThan(minTerraformVersion) {
+		return terraformMainConfig{}, fmt.Errorf(
+			"invalid Terraform version %q: the generated import blocks require Terraform %s or later",
+			i.TerraformVersion,
+			minTerraformVersion,
+		)
+	}
+
+	if _, err := version.NewConstraint(i.ProviderVersion); err != nil {
+		return terraformMainConfig{}, fmt.Errorf("invalid provider version %q: %w", i.ProviderVersion, err)
+	}
+
+	if i.Backend == "" && len(i.BackendConfig) > 0 {
+		return terraformMainConfig{}, errors.New("the backend settings require a backend, set it with --backend")
+	}
+
+	if i.Backend != "" && !containsStr(terraformBackends, i.Backend) {
+		return terraformMainConfig{}, fmt.Errorf(
+			"invalid backend %q: it must be one of %s",
+			i.Backend,
+			strings.Join(terraformBackends, ", "),
+		)
+	}
+
+	for setting := range i.BackendConfig {
+		if !terraformBackendSettingPattern.MatchString(setting) {
+			return terraformMainConfig{}, fmt.Errorf(
+				"invalid backend setting %q: it must be a setting name, or '<block>.<setting>' for a nested block",
+				setting,
+			)
+		}
+	}
+
+	return terraformMainConfig{
+		terraform:     terraformVersion,
+		provider:      i.ProviderVersion,
+		backend:       i.Backend,
+		backendConfig: i.BackendConfig,
+	}, nil
+}
+
+// validateFormat validates the format, along with the flags that only apply to the Terraform config.
+func (i *terraformInputs) validateFormat() error {
+	switch i.Format {
+	case terraformFormatHCL:
+		return nil
+	case terraformFormatJSON:
+		if i.SplitFiles {
+			return errors.New("the import blocks can only be split into files with the hcl format")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid format %q, possible values: %s, %s", i.Format, terraformFormatHCL, terraformFormatJSON)
+	}
+}
+
 func (i *terraformInputs) parseResourceFetchers(api *auth0.API) ([]resourceDataFetcher, error) {
-	fetchers := make([]resourceDataFetcher, 0)
+	groups, err := i.resourceTypeGroups()
+
+	fetchers := make([]resourceDataFetcher, 0, len(groups))
+	for _, group := range groups {
+		fetchers = append(fetchers, resourceFetcherFactories[group[0]](api, i))
+	}
+
+	return fetchers, err
+}
+
+// resourceTypeGroups groups the supported resource types by their resourceFetcherGroups, in the
+// order they're given in. Resource types of the same group get fetched together, so their fetcher
+// is only created once to not generate the config of their resources twice.
+func (i *terraformInputs) resourceTypeGroups() ([][]string, error) {
+	var groups [][]string
+	groupIndexes := make(map[string]int)
 	var err error
 
 	for _, resource := range i.Resources {
-		switch resource {
-		case "auth0_action":
-			fetchers = append(fetchers, &actionResourceFetcher{api})
-		case "auth0_attack_protection":
-			fetchers = append(fetchers, &attackProtectionResourceFetcher{})
-		case "auth0_branding":
-			fetchers = append(fetchers, &brandingResourceFetcher{})
-		case "auth0_client", "auth0_client_credentials":
-			fetchers = append(fetchers, &clientResourceFetcher{api})
-		case "auth0_client_grant":
-			fetchers = append(fetchers, &clientGrantResourceFetcher{api})
-		case "auth0_connection", "auth0_connection_clients":
-			fetchers = append(fetchers, &connectionResourceFetcher{api})
-		case "auth0_custom_domain":
-			fetchers = append(fetchers, &customDomainResourceFetcher{api})
-		case "auth0_email_provider":
-			fetchers = append(fetchers, &emailProviderResourceFetcher{api})
-		case "auth0_email_template":
-			fetchers = append(fetchers, &emailTemplateResourceFetcher{api})
-		case "auth0_guardian":
-			fetchers = append(fetchers, &guardianResourceFetcher{})
-		case "auth0_log_stream":
-			fetchers = append(fetchers, &logStreamResourceFetcher{api})
-		case "auth0_organization", "auth0_organization_connections":
-			fetchers = append(fetchers, &organizationResourceFetcher{api})
-		case "auth0_pages":
-			fetchers = append(fetchers, &pagesResourceFetcher{})
-		case "auth0_prompt":
-			fetchers = append(fetchers, &promptResourceFetcher{})
-		case "auth0_prompt_custom_text":
-			fetchers = append(fetchers, &promptCustomTextResourceFetcherResourceFetcher{api})
-		case "auth0_resource_server", "auth0_resource_server_scopes":
-			fetchers = append(fetchers, &resourceServerResourceFetcher{api})
-		case "auth0_role", "auth0_role_permissions":
-			fetchers = append(fetchers, &roleResourceFetcher{api})
-		case "auth0_tenant":
-			fetchers = append(fetchers, &tenantResourceFetcher{})
-		case "auth0_trigger_actions":
-			fetchers = append(fetchers, &triggerActionsResourceFetcher{api})
-		default:
+		if _, ok := resourceFetcherFactories[resource]; !ok {
 			err = errors.Join(err, fmt.Errorf("unsupported resource type: %s", resource))
+			continue
 		}
+
+		key := resourceFetcherGroup(resource)
+		if index, ok := groupIndexes[key]; ok {
+			groups[index] = append(groups[index], resource)
+			continue
+		}
+
+		groupIndexes[key] = len(groups)
+		groups = append(groups, []string{resource})
 	}
 
-	return fetchers, err
+	return groups, err
+}
+
+// parseTerraformExclusions parses the values of the --exclude flag,
+// given as "<resource-type>" or "<resource-type>:<name-pattern>".
+func parseTerraformExclusions(values []string) ([]terraformExclusion, error) {
+	var exclusions []terraformExclusion
+	for _, value := range values {
+		resourceType, pattern, _ := strings.Cut(strings.TrimSpace(value), ":")
+		if _, ok := resourceFetcherFactories[resourceType]; !ok {
+			return nil, fmt.Errorf("unsupported resource type to exclude: %s", resourceType)
+		}
+
+		pattern = strings.Trim(pattern, `"'`)
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern to exclude %q: %w", value, err)
+		}
+
+		exclusions = append(exclusions, terraformExclusion{resourceType: resourceType, pattern: pattern})
+	}
+
+	return exclusions, nil
+}
+
+func (e terraformExclusion) excludes(item importDataItem) bool {
+	resourceType, _, _ := strings.Cut(item.ResourceName, ".")
+	if resourceType != e.resourceType {
+		return false
+	}
+
+	if e.pattern == "" {
+		return true
+	}
+
+	for _, name := range []string{item.DisplayName, item.ImportID} {
+		if matched, _ := path.Match(e.pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// excludeImportData removes the resources matching any of the exclusions,
+// and returns the remaining resources along with the number of removed ones.
+func excludeImportData(data importDataList, exclusions []terraformExclusion) (importDataList, int) {
+	var kept importDataList
+	for _, item := range data {
+		excluded := false
+		for _, exclusion := range exclusions {
+			if exclusion.excludes(item) {
+				excluded = true
+				break
+			}
+		}
+
+		if !excluded {
+			kept = append(kept, item)
+		}
+	}
+
+	return kept, len(data) - len(kept)
+}
+
+// parseTerraformFilters parses the --filter values, given as "<resource-type>.<field>=<pattern>"
+// where the "auth0_" prefix of the resource type can be omitted and the field is "name" or "id".
+func parseTerraformFilters(values []string) ([]terraformFilter, error) {
+	var filters []terraformFilter
+	for _, value := range values {
+		selector, pattern, ok := strings.Cut(strings.TrimSpace(value), "=")
+		resourceType, field, _ := strings.Cut(selector, ".")
+		if !ok || resourceType == "" || pattern == "" {
+			return nil, fmt.Errorf("invalid filter %q, use '<resource-type>.name=<pattern>' or '<resource-type>.id=<pattern>'", value)
+		}
+
+		if !strings.HasPrefix(resourceType, "auth0_") {
+			resourceType = "auth0_" + resourceType
+		}
+		if _, ok := resourceFetcherFactories[resourceType]; !ok {
+			return nil, fmt.Errorf("unsupported resource type to filter: %s", resourceType)
+		}
+
+		if field != "name" && field != "id" {
+			return nil, fmt.Errorf("unsupported field to filter %q by, possible values: name, id", field)
+		}
+
+		filter := terraformFilter{resourceType: resourceType, field: field}
+
+		pattern = strings.Trim(pattern, `"'`)
+		if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+			expression, err := regexp.Compile(pattern[1 : len(pattern)-1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression to filter %q: %w", value, err)
+			}
+			filter.regexp = expression
+		} else {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid pattern to filter %q: %w", value, err)
+			}
+			filter.pattern = pattern
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func (f terraformFilter) matches(item importDataItem) bool {
+	value := item.DisplayName
+	if f.field == "id" {
+		value = item.ImportID
+	}
+
+	if f.regexp != nil {
+		return f.regexp.MatchString(value)
+	}
+
+	matched, _ := path.Match(f.pattern, value)
+	return matched
+}
+
+// filterImportData keeps the resources of the filtered types that match any of their filters,
+// along with all the resources of the other types, and returns the number of removed resources.
+func filterImportData(data importDataList, filters []terraformFilter) (importDataList, int) {
+	filtersByType := make(map[string][]terraformFilter)
+	for _, filter := range filters {
+		filtersByType[filter.resourceType] = append(filtersByType[filter.resourceType], filter)
+	}
+
+	var kept importDataList
+	for _, item := range data {
+		resourceType, _, _ := strings.Cut(item.ResourceName, ".")
+
+		typeFilters, ok := filtersByType[resourceType]
+		matched := !ok
+		for _, filter := range typeFilters {
+			if filter.matches(item) {
+				matched = true
+				break
+			}
+		}
+
+		if matched {
+			kept = append(kept, item)
+		}
+	}
+
+	return kept, len(data) - len(kept)
 }
 
 func terraformCmd(cli *cli) *cobra.Command {
@@ -115,6 +621,7 @@ func terraformCmd(cli *cli) *cobra.Command {
 
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(generateTerraformCmd(cli))
+	cmd.AddCommand(terraformResourcesCmd(cli))
 
 	return cmd
 }
@@ -129,80 +636,235 @@ func generateTerraformCmd(cli *cli) *cobra.Command {
 		Long: "(Experimental) This command is designed to streamline the process of generating Terraform configuration files for " +
 			"your Auth0 resources, serving as a bridge between the two.\n\nIt automatically scans your Auth0 Tenant " +
 			"and compiles a set of Terraform configuration files (HCL) based on the existing resources and configurations." +
+			"\n\nThe resource config is generated by running `terraform plan` against the import blocks. Unless the " +
+			"credentials of the Terraform provider are set, the access token of the CLI is used to run it, passed to " +
+			"the provider as the `AUTH0_API_TOKEN` environment variable along with the tenant domain as `AUTH0_DOMAIN`." +
+			"\n\nWith `--split-files`, the import blocks are written to a file per resource type, such as " +
+			"`auth0_client_import.tf`, instead of a single `auth0_import.tf` file." +
+			"\n\nWith `--format json`, only the list of the resources to import is written, along with their " +
+			"resource name and import ID, instead of the Terraform config." +
+			"\n\nWith `--dry-run`, the resources are only fetched and listed by type, along with the files that " +
+			"would be generated, without writing anything." +
+			"\n\nEach import block of the generated import files is annotated with the type, the name and the " +
+			"dashboard URL of its resource, to map the resource IDs to the actual resources when reviewing the changes." +
+			"\n\nOnce generated, the sensitive fields left empty in the config, such as the client secrets, the SMTP " +
+			"password or the secrets of the actions, are listed along with the variables suggested to supply them." +
+			"\n\nThe provider is configured through the variables declared in `auth0_variables.tf`, along with the ones " +
+			"of the sensitive fields, and `terraform.tfvars.example` shows how to set them. Left unset, the provider " +
+			"falls back to its `AUTH0_DOMAIN`, `AUTH0_CLIENT_ID` and `AUTH0_CLIENT_SECRET` environment variables." +
+			"\n\nWith `--backend`, the generated `auth0_main.tf` stores the state in the given backend, configured " +
+			"through `--backend-config`. The backend isn't initialized to generate the resource config, so run " +
+			"`terraform init` before applying it." +
 			"\n\nRefer to the [instructional guide](https://registry.terraform.io/providers/auth0/auth0/latest/docs/guides/generate_terraform_config) for specific details on how to use this command." +
 			"\n\n**Warning:** This command is experimental and is subject to change in future versions.",
 		Example: `  auth0 tf generate
   auth0 tf generate -o tmp-auth0-tf
   auth0 tf generate -o tmp-auth0-tf -r auth0_client
-  auth0 tf generate --output-dir tmp-auth0-tf --resources auth0_action,auth0_tenant,auth0_client `,
+  auth0 tf generate --out tmp-auth0-tf --resources auth0_action,auth0_tenant,auth0_client
+  auth0 tf generate -o tmp-auth0-tf --force-overwrite
+  auth0 tf generate -o tmp-auth0-tf --tag env=prod
+  auth0 tf generate -o tmp-auth0-tf -r auth0_rule,auth0_rule_config,auth0_hook
+  auth0 tf generate -o tmp-auth0-tf --exclude auth0_prompt_custom_text --exclude "auth0_client:Terraform Provider"
+  auth0 tf generate -o tmp-auth0-tf --filter "client.name=prod-*" --filter "connection.name=/^prod-[0-9]+$/"
+  auth0 tf generate -o tmp-auth0-tf --split-files
+  auth0 tf generate -r auth0_client,auth0_connection --filter "client.name=prod-*" --dry-run
+  auth0 tf generate -o tmp-auth0-tf --format json
+  auth0 tf generate --format json --stdout | jq -r '.[].import_id'
+  auth0 tf generate -o tmp-auth0-tf --terraform-version 1.7.5 --provider-version "~> 1.2"
+  auth0 tf generate -o tmp-auth0-tf --backend s3 --backend-config bucket=my-state --backend-config key=auth0.tfstate --backend-config region=us-east-1
+  auth0 tf generate -o tmp-auth0-tf --backend remote --backend-config organization=my-org --backend-config workspaces.name=auth0
+  auth0 tf generate --stdout > auth0.tf
+  auth0 tf generate -r auth0_client --stdout | less`,
 		RunE: generateTerraformCmdRun(cli, &inputs),
 	}
 
 	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
-	tfFlags.OutputDIR.RegisterString(cmd, &inputs.OutputDIR, "./")
+	// The deprecated --output-dir shares the value of --out, so it's registered first and
+	// without a default, leaving the default of --out in place.
+	tfFlags.OutputDIR.RegisterString(cmd, &inputs.Output.Out, "")
+	deprecateFlag(cmd, tfFlags.OutputDIR.LongForm, artifactOut.LongForm)
+	registerArtifactOutput(
+		cmd,
+		&inputs.Output,
+		"Output directory for the generated Terraform config files. If not provided, the files will be "+
+			"saved in the current working directory.",
+		"./",
+	)
 	tfFlags.Resources.RegisterStringSlice(cmd, &inputs.Resources, defaultResources)
+	tfFlags.Exclude.RegisterStringSlice(cmd, &inputs.Exclude, nil)
+	tfFlags.Filter.RegisterStringSlice(cmd, &inputs.Filter, nil)
+	tfFlags.Stdout.RegisterBool(cmd, &inputs.Stdout, false)
+	tfFlags.SplitFiles.RegisterBool(cmd, &inputs.SplitFiles, false)
+	tfFlags.Format.RegisterString(cmd, &inputs.Format, terraformFormatHCL)
+	tfFlags.DryRun.RegisterBool(cmd, &inputs.DryRun, false)
+	tfFlags.TerraformVersion.RegisterString(cmd, &inputs.TerraformVersion, defaultTerraformVersion)
+	tfFlags.ProviderVersion.RegisterString(cmd, &inputs.ProviderVersion, defaultProviderVersion)
+	tfFlags.Backend.RegisterString(cmd, &inputs.Backend, "")
+	tfFlags.BackendConfig.RegisterStringMap(cmd, &inputs.BackendConfig, nil)
+	tfFlags.Tags.RegisterStringMap(cmd, &inputs.Tags, nil)
+	cmd.MarkFlagsMutuallyExclusive("stdout", "out", "output-dir")
+	cmd.MarkFlagsMutuallyExclusive("stdout", "split-files")
+	cmd.MarkFlagsMutuallyExclusive("stdout", "dry-run")
 
 	return cmd
 }
 
 func generateTerraformCmdRun(cli *cli, inputs *terraformInputs) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
+		mainConfig, err := inputs.parseMainConfig()
+		if err != nil {
+			return err
+		}
+		mainConfig.domain = cli.tenant
+
+		if err := inputs.validateFormat(); err != nil {
+			return err
+		}
+
 		resources, err := inputs.parseResourceFetchers(cli.api)
 		if err != nil {
 			return err
 		}
 
+		exclusions, err := parseTerraformExclusions(inputs.Exclude)
+		if err != nil {
+			return err
+		}
+
+		filters, err := parseTerraformFilters(inputs.Filter)
+		if err != nil {
+			return err
+		}
+
+		var deprecated []string
+		for _, resource := range inputs.Resources {
+			if containsStr(deprecatedResources, resource) {
+				deprecated = append(deprecated, resource)
+			}
+		}
+		if len(deprecated) > 0 {
+			cli.renderer.Warnf(
+				"The resource types %s are deprecated, consider migrating them to Actions: %s\n",
+				strings.Join(deprecated, ", "),
+				ansi.URL("https://auth0.com/docs/customize/actions/migrate"),
+			)
+		}
+
+		if scopes := terraformRequiredScopes(inputs.Resources); len(scopes) > 0 {
+			tenant, err := cli.Config.GetTenant(cli.tenant)
+			if err != nil {
+				return err
+			}
+
+			if err := checkScopes(cmd.CommandPath(), scopes, tenant); err != nil {
+				return err
+			}
+		}
+
+		// The errors of the unsupported resource types were already reported when parsing the fetchers.
+		groups, _ := inputs.resourceTypeGroups()
+
 		var data importDataList
-		err = ansi.Spinner("Fetching data from Auth0", func() error {
-			data, err = fetchImportData(cmd.Context(), resources...)
+		err = ansi.ProgressSpinner("Fetching data from Auth0", func(progress func(status string)) error {
+			data, err = fetchImportDataWithProgress(cmd.Context(), func(index, collected int) {
+				progress(fetchProgressStatus(groups, index, collected))
+			}, resources...)
 			return err
 		})
 		if err != nil {
 			return err
 		}
 
-		if !checkOutputDirectoryIsEmpty(cli, cmd, inputs.OutputDIR) {
+		if len(exclusions) > 0 {
+			var excluded int
+			data, excluded = excludeImportData(data, exclusions)
+			cli.renderer.Infof("Excluded %d resources from the generated config", excluded)
+		}
+
+		if len(filters) > 0 {
+			var filtered int
+			data, filtered = filterImportData(data, filters)
+			cli.renderer.Infof("Filtered out %d resources not matching the filters from the generated config", filtered)
+		}
+
+		if inputs.DryRun {
+			renderTerraformDryRun(cli, data, inputs.Output.Out, inputs.SplitFiles)
 			return nil
 		}
 
-		if err := cleanOutputDirectory(inputs.OutputDIR); err != nil {
+		if inputs.Format == terraformFormatJSON {
+			return writeImportDataJSON(cli, cmd, inputs, data)
+		}
+
+		manageTenantURL := formatManageTenantURL(cli.tenant, &cli.Config)
+
+		if inputs.Stdout {
+			if err := generateTerraformConfigToStdout(cmd.Context(), cli, data, manageTenantURL, mainConfig); err != nil {
+				return err
+			}
+
+			renderTerraformSecretsReport(cli, data)
+			return nil
+		}
+
+		if !checkOutputDirectoryIsEmpty(cli, cmd, inputs.Output.Out, inputs.Output.ForceOverwrite) {
+			return nil
+		}
+
+		if err := cleanOutputDirectory(inputs.Output.Out); err != nil {
 			return err
 		}
 
-		if err := generateTerraformImportConfig(inputs.OutputDIR, data); err != nil {
+		if err := generateTerraformImportConfig(inputs.Output.Out, data, manageTenantURL, inputs.SplitFiles, mainConfig); err != nil {
 			return err
 		}
 
+		importFiles := "auth0_import.tf file"
+		if inputs.SplitFiles {
+			importFiles = "auth0_*_import.tf files"
+		}
+
 		cdInstructions := ""
-		if inputs.OutputDIR != "./" {
-			cdInstructions = fmt.Sprintf("cd %s && ", inputs.OutputDIR)
+		if inputs.Output.Out != "./" {
+			cdInstructions = fmt.Sprintf("cd %s && ", inputs.Output.Out)
 		}
 
-		if terraformProviderCredentialsAreAvailable() {
-			err := checkTerraformProviderAndCLIDomainsMatch(cli.Config.DefaultTenant)
-			if err != nil {
-				return err
-			}
+		// The backend isn't initialized when generating the resource config, so it needs to be first.
+		initInstructions := ""
+		if mainConfig.backend != "" {
+			initInstructions = "./terraform init && "
+		}
+
+		providerEnv, ok, err := terraformProviderEnv(cli)
+		if err != nil {
+			return err
+		}
 
+		if ok {
 			err = ansi.Spinner("Generating Terraform configuration", func() error {
-				return generateTerraformResourceConfig(cmd.Context(), inputs.OutputDIR)
+				return generateTerraformResourceConfig(cmd.Context(), inputs.Output.Out, providerEnv, mainConfig)
 			})
 
 			if err != nil {
 				cli.renderer.Warnf("Terraform resource config generated successfully but there was an error with terraform plan.\n\n")
-				cli.renderer.Warnf("Run " + ansi.Cyan(cdInstructions+"./terraform plan") + " to troubleshoot\n\n")
+				cli.renderer.Warnf("Run " + ansi.Cyan(cdInstructions+initInstructions+"./terraform plan") + " to troubleshoot\n\n")
 				cli.renderer.Warnf("Once the plan succeeds, run " + ansi.Cyan("./terraform apply") + " to complete the import.\n\n")
-				cli.renderer.Infof("The terraform binary and auth0_import.tf files can be deleted afterwards.\n")
+				cli.renderer.Infof("The terraform binary and " + importFiles + " can be deleted afterwards.\n")
+				renderTerraformSecretsReport(cli, data)
 				return nil
 			}
 
-			cli.renderer.Infof("Terraform resource config files generated successfully in: %s", inputs.OutputDIR)
+			cli.renderer.Infof("Terraform resource config files generated successfully in: %s", inputs.Output.Out)
 			cli.renderer.Infof(
-				"Review the config and generate the terraform state by running: \n\n	" + ansi.Cyan(cdInstructions+"./terraform apply") + "\n",
+				"Review the config and generate the terraform state by running: \n\n	" + ansi.Cyan(cdInstructions+initInstructions+"./terraform apply") + "\n",
 			)
 			cli.renderer.Infof(
-				"Once Terraform files are auto-generated, the terraform binary and auth0_import.tf files can be deleted.\n",
+				"Once Terraform files are auto-generated, the terraform binary and " + importFiles + " can be deleted.\n",
 			)
+			if providerEnv != nil {
+				warnTerraformProviderUsesCLIToken(cli)
+			}
+			renderTerraformSecretsReport(cli, data)
 
 			return nil
 		}
@@ -213,17 +875,32 @@ func generateTerraformCmdRun(cli *cli, inputs *terraformInputs) func(cmd *cobra.
 				ansi.URL("https://registry.terraform.io/providers/auth0/auth0/latest/docs/guides/quickstart") + "\n\n" +
 				"After provider credentials are set, run: \n\n" +
 				ansi.Cyan(cdInstructions+"terraform init && terraform plan -generate-config-out=auth0_generated.tf && terraform apply") + "\n\n" +
-				"Once the Terraform file is auto-generated, the auth0_import.tf file can be deleted.\n",
+				"Once the Terraform file is auto-generated, the " + importFiles + " can be deleted.\n",
 		)
+		renderTerraformSecretsReport(cli, data)
 
 		return nil
 	}
 }
 
 func fetchImportData(ctx context.Context, fetchers ...resourceDataFetcher) (importDataList, error) {
+	return fetchImportDataWithProgress(ctx, nil, fetchers...)
+}
+
+// fetchImportDataWithProgress fetches the import data like fetchImportData, reporting the index
+// of each fetcher before running it along with the number of resources collected so far.
+func fetchImportDataWithProgress(
+	ctx context.Context,
+	progress func(index, collected int),
+	fetchers ...resourceDataFetcher,
+) (importDataList, error) {
 	var importData importDataList
 
-	for _, fetcher := range fetchers {
+	for index, fetcher := range fetchers {
+		if progress != nil {
+			progress(index, len(importData))
+		}
+
 		data, err := fetcher.FetchData(ctx)
 		if err != nil {
 			return nil, err
@@ -232,10 +909,56 @@ func fetchImportData(ctx context.Context, fetchers ...resourceDataFetcher) (impo
 		importData = append(importData, data...)
 	}
 
+	sortImportData(importData)
+
 	return deduplicateResourceNames(importData), nil
 }
 
-func generateTerraformImportConfig(outputDIR string, data importDataList) error {
+// fetchProgressStatus describes the resource types being fetched, such as
+// "auth0_client, auth0_client_credentials (2/12), 154 resources collected".
+func fetchProgressStatus(groups [][]string, index, collected int) string {
+	if index >= len(groups) {
+		return fmt.Sprintf("%d resources collected", collected)
+	}
+
+	return fmt.Sprintf(
+		"%s (%d/%d), %d resources collected",
+		strings.Join(groups[index], ", "),
+		index+1,
+		len(groups),
+		collected,
+	)
+}
+
+// sortImportData sorts the import data by resource type, then by name, so that
+// repeated runs against an unchanged tenant generate byte-identical files.
+// Resources sharing the same name are sorted by their import ID, for their
+// deduplicated names to be stable too.
+func sortImportData(data importDataList) {
+	sort.SliceStable(data, func(i, j int) bool {
+		typeI, nameI, _ := strings.Cut(data[i].ResourceName, ".")
+		typeJ, nameJ, _ := strings.Cut(data[j].ResourceName, ".")
+
+		if typeI != typeJ {
+			return typeI < typeJ
+		}
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+
+		return data[i].ImportID < data[j].ImportID
+	})
+}
+
+// generateTerraformImportConfig writes the main config and the import blocks to the output directory,
+// either to a single auth0_import.tf file, or to a file per resource type when splitFiles is set.
+func generateTerraformImportConfig(
+	outputDIR string,
+	data importDataList,
+	manageTenantURL string,
+	splitFiles bool,
+	mainConfig terraformMainConfig,
+) error {
 	if len(data) == 0 {
 		return errors.New("no import data available")
 	}
@@ -244,11 +967,19 @@ func generateTerraformImportConfig(outputDIR string, data importDataList) error
 		return err
 	}
 
-	if err := createMainFile(outputDIR); err != nil {
+	if err := createMainFile(outputDIR, mainConfig); err != nil {
 		return err
 	}
 
-	return createImportFile(outputDIR, data)
+	if err := createVariablesFiles(outputDIR, terraformVariables(mainConfig.domain, data)); err != nil {
+		return err
+	}
+
+	if splitFiles {
+		return createSplitImportFiles(outputDIR, data, manageTenantURL)
+	}
+
+	return createImportFile(path.Join(outputDIR, "auth0_import.tf"), data, manageTenantURL)
 }
 
 func createOutputDirectory(outputDIR string) error {
@@ -261,7 +992,7 @@ func createOutputDirectory(outputDIR string) error {
 	return nil
 }
 
-func createMainFile(outputDIR string) error {
+func createMainFile(outputDIR string, mainConfig terraformMainConfig) error {
 	filePath := path.Join(outputDIR, "auth0_main.tf")
 
 	file, err := os.Create(filePath)
@@ -272,28 +1003,232 @@ func createMainFile(outputDIR string) error {
 		_ = file.Close()
 	}()
 
+	return writeMainConfig(file, mainConfig)
+}
+
+func writeMainConfig(w io.Writer, mainConfig terraformMainConfig) error {
 	fileContent := `terraform {
-  required_version = "~> 1.5.0"
-  required_providers {
+  required_version = "~> {{ .Terraform }}"
+{{ .Backend }}  required_providers {
     auth0 = {
       source  = "auth0/auth0"
-      version = ">= 1.0.0"
+      version = "{{ .Provider }}"
     }
   }
 }
 
 provider "auth0" {
-  debug = true
+  domain        = var.auth0_domain
+  client_id     = var.auth0_client_id
+  client_secret = var.auth0_client_secret
+  debug         = true
 }
 `
 
-	_, err = file.WriteString(fileContent)
-	return err
+	t, err := template.New("terraform").Parse(fileContent)
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(w, struct {
+		Terraform string
+		Backend   string
+		Provider  string
+	}{
+		Terraform: mainConfig.terraform.String(),
+		Backend:   formatBackendBlock(mainConfig.backend, mainConfig.backendConfig),
+		Provider:  mainConfig.provider,
+	})
+}
+
+// formatBackendBlock formats the backend block of the terraform block, where the settings
+// with a dot, such as workspaces.name, are the ones of a nested block. It's empty without a backend.
+func formatBackendBlock(backend string, config map[string]string) string {
+	if backend == "" {
+		return ""
+	}
+
+	settings := make(map[string]string)
+	nestedSettings := make(map[string]map[string]string)
+	for key, value := range config {
+		block, setting, ok := strings.Cut(key, ".")
+		if !ok {
+			settings[key] = value
+			continue
+		}
+
+		if nestedSettings[block] == nil {
+			nestedSettings[block] = make(map[string]string)
+		}
+		nestedSettings[block][setting] = value
+	}
+
+	var blocks []string
+	for block := range nestedSettings {
+		blocks = append(blocks, block)
+	}
+	sort.Strings(blocks)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "  backend %q {\n", backend)
+	writeHCLAttributes(&b, "    ", settings)
+	for _, block := range blocks {
+		fmt.Fprintf(&b, "    %s {\n", block)
+		writeHCLAttributes(&b, "      ", nestedSettings[block])
+		b.WriteString("    }\n")
+	}
+	b.WriteString("  }\n")
+
+	return b.String()
+}
+
+// writeHCLAttributes writes the attributes as strings sorted by name,
+// with their equal signs aligned the way `terraform fmt` does.
+func writeHCLAttributes(b *strings.Builder, indent string, attributes map[string]string) {
+	var names []string
+	width := 0
+	for name := range attributes {
+		names = append(names, name)
+		width = max(width, len(name))
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, name, quoteHCLString(attributes[name]))
+	}
+}
+
+// quoteHCLString quotes the value as an HCL string, escaping the
+// template sequences so that it's never interpolated.
+func quoteHCLString(value string) string {
+	value = strings.ReplaceAll(value, "${", "$${")
+	value = strings.ReplaceAll(value, "%{", "%%{")
+
+	return strconv.Quote(value)
+}
+
+// createSplitImportFiles writes the import blocks of each resource type to their own file,
+// named after the resource type, such as auth0_client_import.tf.
+func createSplitImportFiles(outputDIR string, data importDataList, manageTenantURL string) error {
+	var resourceTypes []string
+	dataByResourceType := make(map[string]importDataList)
+	for _, item := range data {
+		resourceType, _, _ := strings.Cut(item.ResourceName, ".")
+		if _, ok := dataByResourceType[resourceType]; !ok {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+		dataByResourceType[resourceType] = append(dataByResourceType[resourceType], item)
+	}
+
+	for _, resourceType := range resourceTypes {
+		filePath := path.Join(outputDIR, splitImportFileName(resourceType))
+		if err := createImportFile(filePath, dataByResourceType[resourceType], manageTenantURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeImportDataJSON writes the import data as JSON to the standard output, or to auth0_import.json.
+func writeImportDataJSON(cli *cli, cmd *cobra.Command, inputs *terraformInputs, data importDataList) error {
+	if data == nil {
+		data = importDataList{}
+	}
+
+	content, err := json.MarshalIndent(data, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	if inputs.Stdout {
+		cli.renderer.Output(string(content))
+		return nil
+	}
+
+	filePath := path.Join(inputs.Output.Out, "auth0_import.json")
+	if err := inputs.Output.checkOverwrite(cmd, filePath); err != nil {
+		return err
+	}
+
+	file, err := createArtifactFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create the import data file %q: %w", filePath, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if _, err := file.Write(append(content, '\n')); err != nil {
+		return fmt.Errorf("failed to write the import data file %q: %w", filePath, err)
+	}
+
+	cli.renderer.Infof("The import data of %d resources was written to %s", len(data), ansi.Bold(filePath))
+
+	return nil
+}
+
+// renderTerraformDryRun lists the resources the config would be generated for by type,
+// along with the files that would be written to the output directory.
+func renderTerraformDryRun(cli *cli, data importDataList, outputDIR string, splitFiles bool) {
+	if len(data) == 0 {
+		cli.renderer.Warnf("No resources to generate the Terraform config for, nothing would be written.")
+		return
+	}
+
+	summaries := terraformResourceSummaries(data)
+	cli.renderer.TerraformDryRun(summaries)
+
+	fileNames := []string{"auth0_main.tf", "auth0_variables.tf", "terraform.tfvars.example"}
+	if splitFiles {
+		for _, summary := range summaries {
+			fileNames = append(fileNames, splitImportFileName(summary.Type))
+		}
+	} else {
+		fileNames = append(fileNames, "auth0_import.tf")
+	}
+	fileNames = append(fileNames, "auth0_generated.tf")
+
+	cli.renderer.Newline()
+	cli.renderer.Infof(
+		"Dry run, nothing was written. The config of %d resources would be generated in %s, in the files: %s",
+		len(data),
+		outputDIR,
+		strings.Join(fileNames, ", "),
+	)
+}
+
+// terraformResourceSummaries groups the resources by type, in the order they were fetched.
+func terraformResourceSummaries(data importDataList) []*display.TerraformResourceSummary {
+	var summaries []*display.TerraformResourceSummary
+	summaryByType := make(map[string]*display.TerraformResourceSummary)
+	for _, item := range data {
+		resourceType, resourceName, _ := strings.Cut(item.ResourceName, ".")
+
+		summary, ok := summaryByType[resourceType]
+		if !ok {
+			summary = &display.TerraformResourceSummary{Type: resourceType}
+			summaryByType[resourceType] = summary
+			summaries = append(summaries, summary)
+		}
+
+		name := item.DisplayName
+		if name == "" {
+			name = resourceName
+		}
+
+		summary.Count++
+		summary.Names = append(summary.Names, name)
+	}
+
+	return summaries
 }
 
-func createImportFile(outputDIR string, data importDataList) error {
-	filePath := path.Join(outputDIR, "auth0_import.tf")
+func splitImportFileName(resourceType string) string {
+	return resourceType + "_import.tf"
+}
 
+func createImportFile(filePath string, data importDataList, manageTenantURL string) error {
 	file, err := os.Create(filePath)
 	if err != nil {
 		return err
@@ -302,11 +1237,16 @@ func createImportFile(outputDIR string, data importDataList) error {
 		_ = file.Close()
 	}()
 
+	return writeImportConfig(file, data, manageTenantURL)
+}
+
+func writeImportConfig(w io.Writer, data importDataList, manageTenantURL string) error {
 	fileContent := `# This file is automatically generated via the Auth0 CLI.
 # It can be safely removed after the successful generation
 # of Terraform resource definition files.
 {{range .}}
-import {
+{{ range .Comments }}# {{ . }}
+{{ end }}import {
   id = "{{ .ImportID }}"
   to = {{ .ResourceName }}
 }
@@ -318,18 +1258,152 @@ import {
 		return err
 	}
 
-	return t.Execute(file, data)
+	type importBlock struct {
+		importDataItem
+		Comments []string
+	}
+
+	var blocks []importBlock
+	for _, item := range data {
+		blocks = append(blocks, importBlock{item, importComments(item, manageTenantURL)})
+	}
+
+	return t.Execute(w, blocks)
 }
 
-func generateTerraformResourceConfig(ctx context.Context, outputDIR string) error {
+// importComments annotates the import block of the resource with its type, name and
+// dashboard URL, so that reviewers can map the opaque import IDs to the actual resources.
+func importComments(item importDataItem, manageTenantURL string) []string {
+	resourceType, _, _ := strings.Cut(item.ResourceName, ".")
+	if displayName, ok := resourceTypeDisplayNames[resourceType]; ok {
+		resourceType = displayName
+	}
+
+	comments := []string{resourceType}
+	if item.DisplayName != "" {
+		comments[0] = fmt.Sprintf("%s: %s", resourceType, item.DisplayName)
+	}
+
+	comments = append(comments, item.Notes...)
+
+	if manageTenantURL != "" && item.ManagePath != "" {
+		comments = append(comments, manageTenantURL+item.ManagePath)
+	}
+
+	return comments
+}
+
+// generateTerraformConfigToStdout writes the combined Terraform config to the standard output. The resource
+// config can only be generated by Terraform within a directory, so a temporary one is used in that case.
+func generateTerraformConfigToStdout(
+	ctx context.Context,
+	cli *cli,
+	data importDataList,
+	manageTenantURL string,
+	mainConfig terraformMainConfig,
+) error {
+	if len(data) == 0 {
+		return errors.New("no import data available")
+	}
+
+	var config bytes.Buffer
+
+	providerEnv, ok, err := terraformProviderEnv(cli)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		if err := writeMainConfig(&config, mainConfig); err != nil {
+			return err
+		}
+		config.WriteString("\n")
+		if err := writeVariablesConfig(&config, terraformVariables(mainConfig.domain, data)); err != nil {
+			return err
+		}
+		config.WriteString("\n")
+		if err := writeImportConfig(&config, data, manageTenantURL); err != nil {
+			return err
+		}
+
+		cli.renderer.Output(config.String())
+		cli.renderer.Warnf(
+			"Terraform provider credentials not detected, so only the import config was generated. Refer to " +
+				ansi.URL("https://registry.terraform.io/providers/auth0/auth0/latest/docs/guides/quickstart") +
+				" to configure them and generate the resource config too.",
+		)
+
+		return nil
+	}
+
+	tempDIR, err := os.MkdirTemp("", "auth0-terraform-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(tempDIR)
+	}()
+
+	if err := generateTerraformImportConfig(tempDIR, data, manageTenantURL, false, mainConfig); err != nil {
+		return err
+	}
+
+	if err := ansi.Spinner("Generating Terraform configuration", func() error {
+		return generateTerraformResourceConfig(ctx, tempDIR, providerEnv, mainConfig)
+	}); err != nil {
+		return fmt.Errorf("failed to generate the Terraform resource config: %w", err)
+	}
+
+	for _, fileName := range []string{"auth0_main.tf", "auth0_variables.tf", "auth0_import.tf", "auth0_generated.tf"} {
+		content, err := os.ReadFile(path.Join(tempDIR, fileName))
+		if err != nil {
+			return err
+		}
+
+		if config.Len() > 0 {
+			config.WriteString("\n")
+		}
+		config.Write(content)
+	}
+
+	cli.renderer.Output(config.String())
+	if providerEnv != nil {
+		warnTerraformProviderUsesCLIToken(cli)
+	}
+
+	return nil
+}
+
+// generateTerraformResourceConfig generates the resource config of the import blocks of the output directory
+// through `terraform plan`. The environment variables are added to the ones of the terraform process.
+//
+// The plan doesn't need any state, so the backend of the main config is left out while generating the config,
+// not to require the credentials of the backend, and is only written back once done.
+func generateTerraformResourceConfig(
+	ctx context.Context,
+	outputDIR string,
+	env []string,
+	mainConfig terraformMainConfig,
+) (err error) {
 	absoluteOutputPath, err := filepath.Abs(outputDIR)
 	if err != nil {
 		return err
 	}
 
+	if mainConfig.backend != "" {
+		localConfig := mainConfig
+		localConfig.backend = ""
+		if err := createMainFile(outputDIR, localConfig); err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, createMainFile(outputDIR, mainConfig))
+		}()
+	}
+
 	installer := &releases.ExactVersion{
 		Product:    product.Terraform,
-		Version:    version.Must(version.NewVersion("1.5.0")),
+		Version:    mainConfig.terraform,
 		InstallDir: absoluteOutputPath,
 	}
 
@@ -350,9 +1424,47 @@ func generateTerraformResourceConfig(ctx context.Context, outputDIR string) erro
 	// -generate-config-out flag is not supported by terraform-exec, so we do this through exec.Command.
 	cmd := exec.CommandContext(ctx, execPath, "plan", "-generate-config-out=auth0_generated.tf")
 	cmd.Dir = absoluteOutputPath
+	if env != nil {
+		cmd.Env = append(os.Environ(), env...)
+	}
 	return cmd.Run()
 }
 
+// terraformProviderEnv returns the environment variables to configure the credentials of the Terraform provider
+// with. When none are set, the access token of the CLI is used so that the resource config gets generated right
+// away, instead of requiring to set up the credentials first. It returns false if there are no credentials at all.
+func terraformProviderEnv(cli *cli) ([]string, bool, error) {
+	if terraformProviderCredentialsAreAvailable() {
+		if err := checkTerraformProviderAndCLIDomainsMatch(cli.tenant); err != nil {
+			return nil, false, err
+		}
+
+		return nil, true, nil
+	}
+
+	tenant, err := cli.Config.GetTenant(cli.tenant)
+	if err != nil {
+		return nil, false, err
+	}
+
+	accessToken := tenant.GetAccessToken()
+	if accessToken == "" {
+		return nil, false, nil
+	}
+
+	return []string{"AUTH0_DOMAIN=" + tenant.Domain, "AUTH0_API_TOKEN=" + accessToken}, true, nil
+}
+
+// warnTerraformProviderUsesCLIToken tells that the access token of the CLI only served to generate
+// the config, as it expires and so the Terraform provider needs its own credentials to apply it.
+func warnTerraformProviderUsesCLIToken(cli *cli) {
+	cli.renderer.Warnf(
+		"Terraform provider credentials not detected, so the access token of the CLI was used to generate the config. " +
+			"Refer to " + ansi.URL("https://registry.terraform.io/providers/auth0/auth0/latest/docs/guides/quickstart") +
+			" to configure them before applying it.\n",
+	)
+}
+
 func terraformProviderCredentialsAreAvailable() bool {
 	domain := os.Getenv("AUTH0_DOMAIN")
 	clientID := os.Getenv("AUTH0_CLIENT_ID")
@@ -386,26 +1498,35 @@ func deduplicateResourceNames(data importDataList) importDataList {
 	return deduplicatedList
 }
 
-func checkOutputDirectoryIsEmpty(cli *cli, cmd *cobra.Command, outputDIR string) bool {
+func checkOutputDirectoryIsEmpty(cli *cli, cmd *cobra.Command, outputDIR string, forceOverwrite bool) bool {
 	_, err := os.Stat(outputDIR)
 	if os.IsNotExist(err) {
 		return true
 	}
 
-	_, mainFileErr := os.Stat(path.Join(outputDIR, "auth0_main.tf"))
-	_, importFileErr := os.Stat(path.Join(outputDIR, "auth0_import.tf"))
-	_, generatedFileErr := os.Stat(path.Join(outputDIR, "auth0_generated.tf"))
-	if os.IsNotExist(mainFileErr) && os.IsNotExist(importFileErr) && os.IsNotExist(generatedFileErr) {
+	generatedFiles, err := generatedTerraformFiles(outputDIR)
+	if err != nil {
+		return true
+	}
+
+	var existing bool
+	for _, filePath := range generatedFiles {
+		if _, err := os.Stat(filePath); err == nil {
+			existing = true
+		}
+	}
+	if !existing {
 		return true
 	}
 
 	cli.renderer.Warnf(
 		"Output directory %q is not empty. "+
-			"Proceeding will overwrite the auth0_main.tf, auth0_import.tf and auth0_generated.tf files.",
+			"Proceeding will overwrite the auth0_main.tf, auth0_variables.tf, auth0_import.tf, auth0_generated.tf "+
+			"and terraform.tfvars.example files.",
 		outputDIR,
 	)
 
-	if !cli.force && canPrompt(cmd) {
+	if !cli.force && !forceOverwrite && canPrompt(cmd) {
 		if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
 			return false
 		}
@@ -414,22 +1535,39 @@ func checkOutputDirectoryIsEmpty(cli *cli, cmd *cobra.Command, outputDIR string)
 	return true
 }
 
+// cleanOutputDirectory removes the previously generated files, including the split import files,
+// so that switching between a single and split import files doesn't leave duplicate import blocks.
 func cleanOutputDirectory(outputDIR string) error {
-	var joinedErrors error
-
-	if err := os.Remove(path.Join(outputDIR, "auth0_main.tf")); err != nil && !os.IsNotExist(err) {
-		joinedErrors = errors.Join(err)
+	generatedFiles, err := generatedTerraformFiles(outputDIR)
+	if err != nil {
+		return err
 	}
 
-	if err := os.Remove(path.Join(outputDIR, "auth0_import.tf")); err != nil && !os.IsNotExist(err) {
-		joinedErrors = errors.Join(err)
+	var joinedErrors error
+	for _, filePath := range generatedFiles {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			joinedErrors = errors.Join(joinedErrors, err)
+		}
 	}
 
-	if err := os.Remove(path.Join(outputDIR, "auth0_generated.tf")); err != nil && !os.IsNotExist(err) {
-		joinedErrors = errors.Join(err)
+	return joinedErrors
+}
+
+// generatedTerraformFiles returns the paths of the files the command generates in the output
+// directory, along with the split import files of a previous run, whether they exist or not.
+func generatedTerraformFiles(outputDIR string) ([]string, error) {
+	splitImportFiles, err := filepath.Glob(path.Join(outputDIR, splitImportFileName("auth0_*")))
+	if err != nil {
+		return nil, err
 	}
 
-	return joinedErrors
+	return append([]string{
+		path.Join(outputDIR, "auth0_main.tf"),
+		path.Join(outputDIR, "auth0_variables.tf"),
+		path.Join(outputDIR, "terraform.tfvars.example"),
+		path.Join(outputDIR, "auth0_import.tf"),
+		path.Join(outputDIR, "auth0_generated.tf"),
+	}, splitImportFiles...), nil
 }
 
 // sanitizeResourceName will return a valid terraform resource name.
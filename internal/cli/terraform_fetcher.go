@@ -2,7 +2,10 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/auth0/go-auth0/management"
@@ -11,14 +14,62 @@ import (
 	"github.com/auth0/auth0-cli/internal/auth0"
 )
 
-var defaultResources = []string{"auth0_action", "auth0_attack_protection", "auth0_branding", "auth0_client", "auth0_client_grant", "auth0_connection", "auth0_custom_domain", "auth0_email_provider", "auth0_email_template", "auth0_guardian", "auth0_organization", "auth0_pages", "auth0_prompt", "auth0_prompt_custom_text", "auth0_resource_server", "auth0_role", "auth0_tenant", "auth0_trigger_actions"}
+var defaultResources = []string{"auth0_action", "auth0_attack_protection", "auth0_branding", "auth0_branding_theme", "auth0_client", "auth0_client_grant", "auth0_connection", "auth0_custom_domain", "auth0_email_provider", "auth0_email_template", "auth0_guardian", "auth0_log_stream", "auth0_organization", "auth0_pages", "auth0_prompt", "auth0_prompt_custom_text", "auth0_resource_server", "auth0_role", "auth0_tenant", "auth0_trigger_actions"}
+
+// deprecatedResources are the resource types of deprecated features, which aren't generated by default
+// but are still supported so that the tenants migrating away from them can codify them meanwhile.
+var deprecatedResources = []string{"auth0_hook", "auth0_rule", "auth0_rule_config"}
+
+// deprecatedResourceNote is added to the import blocks of the deprecated resource types.
+const deprecatedResourceNote = "Deprecated: migrate to Actions"
+
+// resourceTypeDisplayNames are the human-readable names of the Terraform resource types.
+var resourceTypeDisplayNames = map[string]string{
+	"auth0_action":                   "Action",
+	"auth0_attack_protection":        "Attack Protection",
+	"auth0_branding":                 "Branding",
+	"auth0_branding_theme":           "Branding Theme",
+	"auth0_client":                   "Application",
+	"auth0_client_credentials":       "Application Credentials",
+	"auth0_client_grant":             "Client Grant",
+	"auth0_connection":               "Connection",
+	"auth0_connection_clients":       "Connection Clients",
+	"auth0_custom_domain":            "Custom Domain",
+	"auth0_email_provider":           "Email Provider",
+	"auth0_email_template":           "Email Template",
+	"auth0_guardian":                 "Multi-factor Authentication",
+	"auth0_hook":                     "Hook",
+	"auth0_log_stream":               "Log Stream",
+	"auth0_organization":             "Organization",
+	"auth0_organization_connections": "Organization Connections",
+	"auth0_organization_member":      "Organization Member",
+	"auth0_pages":                    "Pages",
+	"auth0_prompt":                   "Prompts",
+	"auth0_prompt_custom_text":       "Prompt Custom Text",
+	"auth0_resource_server":          "API",
+	"auth0_resource_server_scopes":   "API Scopes",
+	"auth0_role":                     "Role",
+	"auth0_role_permissions":         "Role Permissions",
+	"auth0_rule":                     "Rule",
+	"auth0_rule_config":              "Rule Config",
+	"auth0_tenant":                   "Tenant",
+	"auth0_trigger_actions":          "Trigger Actions",
+}
 
 type (
 	importDataList []importDataItem
 
 	importDataItem struct {
-		ResourceName string
-		ImportID     string
+		ResourceName string `json:"resource_name"`
+		ImportID     string `json:"import_id"`
+		DisplayName  string `json:"display_name,omitempty"`
+		ManagePath   string `json:"manage_path,omitempty"`
+		// Notes are added to the comments of the import block, for the
+		// state of the resource that reviewers should be aware of.
+		Notes []string `json:"notes,omitempty"`
+		// Secrets are the sensitive fields of the resource that are left
+		// empty in the generated config, to report the ones to supply.
+		Secrets []string `json:"secrets,omitempty"`
 	}
 
 	resourceDataFetcher interface {
@@ -31,19 +82,30 @@ type (
 		api *auth0.API
 	}
 
-	attackProtectionResourceFetcher struct{}
+	attackProtectionResourceFetcher struct {
+		api *auth0.API
+	}
+
+	brandingResourceFetcher struct {
+		api *auth0.API
+	}
 
-	brandingResourceFetcher struct{}
-	clientResourceFetcher   struct {
+	brandingThemeResourceFetcher struct {
 		api *auth0.API
 	}
 
+	clientResourceFetcher struct {
+		api  *auth0.API
+		tags map[string]string
+	}
+
 	clientGrantResourceFetcher struct {
 		api *auth0.API
 	}
 
 	connectionResourceFetcher struct {
-		api *auth0.API
+		api  *auth0.API
+		tags map[string]string
 	}
 
 	customDomainResourceFetcher struct {
@@ -58,20 +120,34 @@ type (
 		api *auth0.API
 	}
 
-	guardianResourceFetcher  struct{}
+	guardianResourceFetcher struct {
+		api *auth0.API
+	}
+	hookResourceFetcher struct {
+		api *auth0.API
+	}
 	logStreamResourceFetcher struct {
 		api *auth0.API
 	}
 	organizationResourceFetcher struct {
-		api *auth0.API
+		api  *auth0.API
+		tags map[string]string
 	}
 
-	pagesResourceFetcher          struct{}
+	pagesResourceFetcher struct {
+		api *auth0.API
+	}
 	resourceServerResourceFetcher struct {
 		api *auth0.API
 	}
 
-	promptResourceFetcher struct{}
+	ruleResourceFetcher struct {
+		api *auth0.API
+	}
+
+	promptResourceFetcher struct {
+		api *auth0.API
+	}
 
 	promptCustomTextResourceFetcherResourceFetcher struct {
 		api *auth0.API
@@ -81,27 +157,110 @@ type (
 		api *auth0.API
 	}
 
-	tenantResourceFetcher struct{}
+	tenantResourceFetcher struct {
+		api *auth0.API
+	}
 
 	triggerActionsResourceFetcher struct {
 		api *auth0.API
 	}
 )
 
-func (f *attackProtectionResourceFetcher) FetchData(_ context.Context) (importDataList, error) {
-	return []importDataItem{
-		{
-			ResourceName: "auth0_attack_protection.attack_protection",
-			ImportID:     uuid.NewString(),
-		},
+// singletonImportID returns the import ID of a resource there's only one of per tenant. Any ID
+// can be used to import it, so it's derived from the resource name for the output to be stable.
+func singletonImportID(resourceName string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(resourceName)).String()
+}
+
+// FetchData notes which of the protections are enabled, as the security
+// posture of the tenant is the main thing to review before importing it.
+// The notes are omitted when the settings can't be read, e.g. with --limited-scopes.
+func (f *attackProtectionResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	item := importDataItem{
+		ResourceName: "auth0_attack_protection.attack_protection",
+		ImportID:     singletonImportID("auth0_attack_protection.attack_protection"),
+		ManagePath:   "security/attack-protection",
+	}
+
+	notes, err := f.attackProtectionNotes(ctx)
+	if err != nil {
+		if mErr, ok := err.(management.Error); !ok || mErr.Status() != http.StatusForbidden {
+			return nil, err
+		}
+	}
+	item.Notes = notes
+
+	return []importDataItem{item}, nil
+}
+
+func (f *attackProtectionResourceFetcher) attackProtectionNotes(ctx context.Context) ([]string, error) {
+	breachedPasswordDetection, err := f.api.AttackProtection.GetBreachedPasswordDetection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bruteForceProtection, err := f.api.AttackProtection.GetBruteForceProtection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	suspiciousIPThrottling, err := f.api.AttackProtection.GetSuspiciousIPThrottling(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		attackProtectionNote("Breached password detection", breachedPasswordDetection.GetEnabled()),
+		attackProtectionNote("Brute force protection", bruteForceProtection.GetEnabled()),
+		attackProtectionNote("Suspicious IP throttling", suspiciousIPThrottling.GetEnabled()),
 	}, nil
 }
 
-func (f *brandingResourceFetcher) FetchData(_ context.Context) (importDataList, error) {
+func attackProtectionNote(protection string, enabled bool) string {
+	if enabled {
+		return protection + ": enabled"
+	}
+
+	return protection + ": disabled"
+}
+
+// FetchData tells whether the branding includes a custom Universal Login page template, as it
+// gets imported along with it. The template requires a custom domain, so it's often missing.
+func (f *brandingResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	item := importDataItem{
+		ResourceName: "auth0_branding.branding",
+		ImportID:     singletonImportID("auth0_branding.branding"),
+	}
+
+	template, err := f.api.Branding.UniversalLogin(ctx)
+	if err != nil {
+		mErr, ok := err.(management.Error)
+		if !ok || (mErr.Status() != http.StatusNotFound && mErr.Status() != http.StatusForbidden) {
+			return nil, err
+		}
+	}
+
+	if template.GetBody() != "" {
+		item.Notes = []string{"Includes the custom Universal Login page template, in its universal_login block"}
+	}
+
+	return []importDataItem{item}, nil
+}
+
+func (f *brandingThemeResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	theme, err := f.api.BrandingTheme.Default(ctx)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
 	return []importDataItem{
 		{
-			ResourceName: "auth0_branding.branding",
-			ImportID:     uuid.NewString(),
+			ResourceName: "auth0_branding_theme.branding_theme",
+			ImportID:     theme.GetID(),
+			DisplayName:  theme.GetDisplayName(),
 		},
 	}, nil
 }
@@ -115,21 +274,30 @@ func (f *clientResourceFetcher) FetchData(ctx context.Context) (importDataList,
 			ctx,
 			management.Page(page),
 			management.Parameter("is_global", "false"),
-			management.IncludeFields("client_id", "name"),
+			management.IncludeFields("client_id", "name", "client_metadata", "token_endpoint_auth_method"),
 		)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, client := range clients.Clients {
+			if !tagsMatch(appTaggedResource(client).Tags, f.tags) {
+				continue
+			}
+
 			data = append(data, importDataItem{
 				ResourceName: "auth0_client." + sanitizeResourceName(client.GetName()),
 				ImportID:     client.GetClientID(),
+				DisplayName:  client.GetName(),
+				ManagePath:   formatAppSettingsPath(client.GetClientID()),
 			})
 
 			data = append(data, importDataItem{
 				ResourceName: "auth0_client_credentials." + sanitizeResourceName(client.GetName()),
 				ImportID:     client.GetClientID(),
+				DisplayName:  client.GetName(),
+				ManagePath:   formatAppSettingsPath(client.GetClientID()),
+				Secrets:      clientCredentialsSecrets(client),
 			})
 		}
 
@@ -143,6 +311,8 @@ func (f *clientResourceFetcher) FetchData(ctx context.Context) (importDataList,
 	return data, nil
 }
 
+// FetchData notes the scopes granted to the applications, and the grants to the Management API
+// in particular, as they're the authorizations to review the most before importing them.
 func (f *clientGrantResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
 	var data importDataList
 
@@ -160,6 +330,9 @@ func (f *clientGrantResourceFetcher) FetchData(ctx context.Context) (importDataL
 			data = append(data, importDataItem{
 				ResourceName: "auth0_client_grant." + sanitizeResourceName(grant.GetClientID()+"_"+grant.GetAudience()),
 				ImportID:     grant.GetID(),
+				DisplayName:  grant.GetClientID() + " to " + grant.GetAudience(),
+				ManagePath:   formatAppSettingsPath(grant.GetClientID()),
+				Notes:        clientGrantNotes(grant),
 			})
 		}
 
@@ -173,6 +346,19 @@ func (f *clientGrantResourceFetcher) FetchData(ctx context.Context) (importDataL
 	return data, nil
 }
 
+func clientGrantNotes(grant *management.ClientGrant) []string {
+	var notes []string
+	if strings.HasSuffix(grant.GetAudience(), "/api/v2/") {
+		notes = append(notes, "Grants access to the Management API")
+	}
+
+	if len(grant.GetScope()) == 0 {
+		return append(notes, "Scopes: none")
+	}
+
+	return append(notes, "Scopes: "+strings.Join(grant.GetScope(), ", "))
+}
+
 func (f *connectionResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
 	var data importDataList
 
@@ -181,21 +367,31 @@ func (f *connectionResourceFetcher) FetchData(ctx context.Context) (importDataLi
 		connections, err := f.api.Connection.List(
 			ctx,
 			management.Page(page),
-			management.IncludeFields("id", "name"),
+			management.PerPage(defaultPageSize),
+			management.IncludeFields("id", "name", "strategy", "metadata"),
 		)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, connection := range connections.Connections {
+			if !tagsMatch(tagsFromMetadata(connection.GetMetadata()), f.tags) {
+				continue
+			}
+
 			data = append(data,
 				importDataItem{
 					ResourceName: "auth0_connection." + sanitizeResourceName(connection.GetName()),
 					ImportID:     connection.GetID(),
+					DisplayName:  connection.GetName(),
+					ManagePath:   formatConnectionSettingsPath(connection.GetStrategy(), connection.GetID()),
+					Secrets:      connectionSecrets(connection.GetStrategy()),
 				},
 				importDataItem{
 					ResourceName: "auth0_connection_clients." + sanitizeResourceName(connection.GetName()),
 					ImportID:     connection.GetID(),
+					DisplayName:  connection.GetName(),
+					ManagePath:   formatConnectionSettingsPath(connection.GetStrategy(), connection.GetID()),
 				},
 			)
 		}
@@ -226,14 +422,32 @@ func (f *customDomainResourceFetcher) FetchData(ctx context.Context) (importData
 		data = append(data, importDataItem{
 			ResourceName: "auth0_custom_domain." + sanitizeResourceName(domain.GetDomain()),
 			ImportID:     domain.GetID(),
+			DisplayName:  domain.GetDomain(),
+			ManagePath:   "tenant/custom_domains",
+			Notes:        customDomainNotes(domain),
 		})
 	}
 
 	return data, nil
 }
 
+// customDomainNotes tells the verification status of the custom domain,
+// and how to verify it when it can't be used yet.
+func customDomainNotes(domain *management.CustomDomain) []string {
+	if domain.GetStatus() == "" {
+		return nil
+	}
+
+	notes := []string{"Status: " + domain.GetStatus()}
+	if domain.GetStatus() != "ready" {
+		notes = append(notes, "Run 'auth0 domains verify "+domain.GetID()+"' once the DNS records are set up")
+	}
+
+	return notes
+}
+
 func (f *emailProviderResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
-	_, err := f.api.EmailProvider.Read(ctx)
+	emailProvider, err := f.api.EmailProvider.Read(ctx)
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusNotFound {
 			return nil, nil
@@ -244,17 +458,35 @@ func (f *emailProviderResourceFetcher) FetchData(ctx context.Context) (importDat
 	return []importDataItem{
 		{
 			ResourceName: "auth0_email_provider.email_provider",
-			ImportID:     uuid.NewString(),
+			ImportID:     singletonImportID("auth0_email_provider.email_provider"),
+			DisplayName:  emailProvider.GetName(),
+			Secrets:      emailProviderSecrets[emailProvider.GetName()],
 		},
 	}, nil
 }
 
+// emailTemplateNames are the names of all the email templates supported by the Management API,
+// including the ones sent with a code rather than a link and the organization invitation.
+var emailTemplateNames = []string{
+	"verify_email",
+	"verify_email_by_code",
+	"reset_email",
+	"reset_email_by_code",
+	"welcome_email",
+	"blocked_account",
+	"stolen_credentials",
+	"enrollment_email",
+	"mfa_oob_code",
+	"user_invitation",
+	"change_password",
+	"password_reset",
+	"async_approval",
+}
+
 func (f *emailTemplateResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
 	var data importDataList
 
-	templates := []string{`verify_email`, `reset_email`, `welcome_email`, `blocked_account`, `stolen_credentials`, `enrollment_email`, `mfa_oob_code`, `change_password`, `password_reset`}
-
-	for _, template := range templates {
+	for _, template := range emailTemplateNames {
 		emailTemplate, err := f.api.EmailTemplate.Read(ctx, template)
 		if err != nil {
 			if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusNotFound {
@@ -266,21 +498,97 @@ func (f *emailTemplateResourceFetcher) FetchData(ctx context.Context) (importDat
 		data = append(data, importDataItem{
 			ResourceName: "auth0_email_template." + sanitizeResourceName(emailTemplate.GetTemplate()),
 			ImportID:     sanitizeResourceName(emailTemplate.GetTemplate()),
+			DisplayName:  emailTemplate.GetTemplate(),
 		})
 	}
 
 	return data, nil
 }
 
-func (f *guardianResourceFetcher) FetchData(_ context.Context) (importDataList, error) {
-	return []importDataItem{
-		{
-			ResourceName: "auth0_guardian.guardian",
-			ImportID:     uuid.NewString(),
-		},
-	}, nil
+// FetchData notes the enabled factors and the policy of the MFA, so that its posture can be reviewed before
+// importing it. They're left out if the CLI wasn't granted the scopes to read them, as they're only notes.
+func (f *guardianResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	item := importDataItem{
+		ResourceName: "auth0_guardian.guardian",
+		ImportID:     singletonImportID("auth0_guardian.guardian"),
+		ManagePath:   "security/mfa",
+	}
+
+	factors, err := f.api.MultiFactor.List(ctx)
+	if err == nil {
+		var policies *management.MultiFactorPolicies
+		if policies, err = f.api.MultiFactor.Policy(ctx); err == nil {
+			item.Notes = guardianNotes(factors, policies)
+		}
+	}
+	if err != nil {
+		if mErr, ok := err.(management.Error); !ok || mErr.Status() != http.StatusForbidden {
+			return nil, err
+		}
+	}
+
+	return []importDataItem{item}, nil
 }
 
+func guardianNotes(factors []*management.MultiFactor, policies *management.MultiFactorPolicies) []string {
+	var enabled []string
+	for _, factor := range factors {
+		if factor.GetEnabled() {
+			enabled = append(enabled, factor.GetName())
+		}
+	}
+	sort.Strings(enabled)
+
+	factorsNote := "Enabled factors: none"
+	if len(enabled) > 0 {
+		factorsNote = "Enabled factors: " + strings.Join(enabled, ", ")
+	}
+
+	policyNote := "Policy: never"
+	if policies != nil && len(*policies) > 0 {
+		policyNote = "Policy: " + strings.Join(*policies, ", ")
+	}
+
+	return []string{factorsNote, policyNote}
+}
+
+// FetchData notes the trigger of the hooks, along with their status when they're disabled.
+func (f *hookResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	var data importDataList
+
+	var page int
+	for {
+		hooks, err := f.api.Hook.List(ctx, management.Page(page), management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hook := range hooks.Hooks {
+			notes := []string{"Trigger: " + hook.GetTriggerID()}
+			if !hook.GetEnabled() {
+				notes = append(notes, "Status: disabled")
+			}
+
+			data = append(data, importDataItem{
+				ResourceName: "auth0_hook." + sanitizeResourceName(hook.GetName()),
+				ImportID:     hook.GetID(),
+				DisplayName:  hook.GetName(),
+				Notes:        append(notes, deprecatedResourceNote),
+			})
+		}
+
+		if !hooks.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	return data, nil
+}
+
+// FetchData notes the type of the log streams, along with their status unless they're active,
+// as paused or suspended streams would otherwise be codified without anyone noticing.
 func (f *logStreamResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
 	var data importDataList
 
@@ -293,17 +601,31 @@ func (f *logStreamResourceFetcher) FetchData(ctx context.Context) (importDataLis
 		data = append(data, importDataItem{
 			ResourceName: "auth0_log_stream." + sanitizeResourceName(log.GetName()),
 			ImportID:     log.GetID(),
+			DisplayName:  log.GetName(),
+			ManagePath:   formatLogStreamSettingsPath(log.GetID()),
+			Notes:        logStreamNotes(log),
+			Secrets:      logStreamSecrets[log.GetType()],
 		})
 	}
 
 	return data, nil
 }
 
+func logStreamNotes(logStream *management.LogStream) []string {
+	notes := []string{"Type: " + logStream.GetType()}
+
+	if status := logStream.GetStatus(); status != "" && status != "active" {
+		notes = append(notes, "Status: "+status)
+	}
+
+	return notes
+}
+
 func (f *organizationResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
 	var data importDataList
 
 	orgs, err := getWithPagination(
-		100,
+		0,
 		func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
 			res, err := f.api.Organization.List(ctx, opts...)
 			if err != nil {
@@ -323,9 +645,15 @@ func (f *organizationResourceFetcher) FetchData(ctx context.Context) (importData
 
 	for _, org := range orgs {
 		organization := org.(*management.Organization)
+		if !tagsMatch(tagsFromMetadata(organization.GetMetadata()), f.tags) {
+			continue
+		}
+
 		data = append(data, importDataItem{
 			ResourceName: "auth0_organization." + sanitizeResourceName(organization.GetName()),
 			ImportID:     organization.GetID(),
+			DisplayName:  organization.GetName(),
+			ManagePath:   formatOrganizationDetailsPath(organization.GetID()),
 		})
 
 		conns, err := f.api.Organization.Connections(ctx, organization.GetID())
@@ -336,6 +664,26 @@ func (f *organizationResourceFetcher) FetchData(ctx context.Context) (importData
 			data = append(data, importDataItem{
 				ResourceName: "auth0_organization_connections." + sanitizeResourceName(organization.GetName()),
 				ImportID:     organization.GetID(),
+				DisplayName:  organization.GetName(),
+				ManagePath:   formatOrganizationDetailsPath(organization.GetID()),
+			})
+		}
+
+		members, err := f.organizationMembers(ctx, organization.GetID())
+		if err != nil {
+			return data, err
+		}
+		for _, member := range members {
+			memberName := member.GetEmail()
+			if memberName == "" {
+				memberName = member.GetUserID()
+			}
+
+			data = append(data, importDataItem{
+				ResourceName: "auth0_organization_member." + sanitizeResourceName(organization.GetName()+"_"+memberName),
+				ImportID:     organization.GetID() + "::" + member.GetUserID(),
+				DisplayName:  memberName + " of " + organization.GetName(),
+				ManagePath:   formatOrganizationDetailsPath(organization.GetID()),
 			})
 		}
 	}
@@ -343,24 +691,115 @@ func (f *organizationResourceFetcher) FetchData(ctx context.Context) (importData
 	return data, nil
 }
 
-func (f *pagesResourceFetcher) FetchData(_ context.Context) (importDataList, error) {
+// organizationMembers lists all the members of the organization.
+func (f *organizationResourceFetcher) organizationMembers(
+	ctx context.Context,
+	orgID string,
+) ([]management.OrganizationMember, error) {
+	list, err := getWithPagination(
+		0,
+		func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
+			res, err := f.api.Organization.Members(ctx, url.PathEscape(orgID), opts...)
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, item := range res.Members {
+				result = append(result, item)
+			}
+
+			return result, res.HasNext(), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]management.OrganizationMember, 0, len(list))
+	for _, item := range list {
+		members = append(members, item.(management.OrganizationMember))
+	}
+
+	return members, nil
+}
+
+// FetchData notes which of the classic pages of the tenant are customized,
+// as the others are only codified with their default settings.
+func (f *pagesResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	tenant, err := f.api.Tenant.Read(ctx, management.IncludeFields("change_password", "guardian_mfa_page", "error_page"))
+	if err != nil {
+		return nil, err
+	}
+
 	return []importDataItem{
 		{
 			ResourceName: "auth0_pages.pages",
-			ImportID:     uuid.NewString(),
+			ImportID:     singletonImportID("auth0_pages.pages"),
+			Notes:        pagesNotes(tenant),
 		},
 	}, nil
 }
 
-func (f *promptResourceFetcher) FetchData(_ context.Context) (importDataList, error) {
+func pagesNotes(tenant *management.Tenant) []string {
+	var notes []string
+
+	if tenant.GetChangePassword().GetEnabled() {
+		notes = append(notes, "Custom change password page: enabled")
+	}
+	if tenant.GetGuardianMFAPage().GetEnabled() {
+		notes = append(notes, "Custom guardian MFA page: enabled")
+	}
+
+	errorPage := tenant.GetErrorPage()
+	switch {
+	case errorPage.GetURL() != "":
+		notes = append(notes, "Error page: redirects to "+errorPage.GetURL())
+	case errorPage.GetHTML() != "":
+		notes = append(notes, "Error page: custom HTML")
+	}
+
+	if len(notes) == 0 {
+		notes = []string{"Customized pages: none"}
+	}
+
+	return notes
+}
+
+// FetchData notes the login experience and the identifier first settings,
+// as they change the prompts the custom texts apply to.
+func (f *promptResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	prompt, err := f.api.Prompt.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return []importDataItem{
 		{
 			ResourceName: "auth0_prompt.prompts",
-			ImportID:     uuid.NewString(),
+			ImportID:     singletonImportID("auth0_prompt.prompts"),
+			Notes:        promptNotes(prompt),
 		},
 	}, nil
 }
 
+func promptNotes(prompt *management.Prompt) []string {
+	experience := prompt.UniversalLoginExperience
+	if experience == "" {
+		experience = "classic"
+	}
+
+	notes := []string{"Universal Login experience: " + experience}
+
+	if prompt.GetIdentifierFirst() {
+		notes = append(notes, "Identifier first: enabled")
+	}
+	if prompt.GetWebAuthnPlatformFirstFactor() {
+		notes = append(notes, "Biometrics first: enabled")
+	}
+
+	return notes
+}
+
 var customTextPromptTypes = []string{"login", "login-id", "login-password", "login-email-verification", "signup", "signup-id", "signup-password", "reset-password", "consent", "mfa-push", "mfa-otp", "mfa-voice", "mfa-phone", "mfa-webauthn", "mfa-sms", "mfa-email", "mfa-recovery-code", "mfa", "status", "device-flow", "email-verification", "email-otp-challenge", "organizations", "invitation", "common"}
 
 func (f *promptCustomTextResourceFetcherResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
@@ -370,11 +809,19 @@ func (f *promptCustomTextResourceFetcherResourceFetcher) FetchData(ctx context.C
 	}
 
 	var data importDataList
-	for _, language := range tenant.GetEnabledLocales() {
+	for i, language := range tenant.GetEnabledLocales() {
+		// The first enabled language is the default one of the tenant.
+		var notes []string
+		if i == 0 {
+			notes = []string{"Default language of the tenant"}
+		}
+
 		for _, promptType := range customTextPromptTypes {
 			data = append(data, importDataItem{
 				ResourceName: "auth0_prompt_custom_text." + sanitizeResourceName(language+"_"+promptType),
 				ImportID:     promptType + "::" + language,
+				DisplayName:  promptType + " (" + language + ")",
+				Notes:        notes,
 			})
 		}
 	}
@@ -390,7 +837,7 @@ func (f *resourceServerResourceFetcher) FetchData(ctx context.Context) (importDa
 		apis, err := f.api.ResourceServer.List(
 			ctx,
 			management.Page(page),
-			management.IncludeFields("id", "name"),
+			management.IncludeFields("id", "name", "scopes"),
 			management.PerPage(100),
 		)
 		if err != nil {
@@ -401,12 +848,16 @@ func (f *resourceServerResourceFetcher) FetchData(ctx context.Context) (importDa
 			data = append(data, importDataItem{
 				ResourceName: "auth0_resource_server." + sanitizeResourceName(api.GetName()),
 				ImportID:     api.GetID(),
+				DisplayName:  api.GetName(),
+				ManagePath:   formatAPISettingsPath(api.GetID()),
 			})
 
 			if len(api.GetScopes()) > 0 {
 				data = append(data, importDataItem{
 					ResourceName: "auth0_resource_server_scopes." + sanitizeResourceName(api.GetName()),
 					ImportID:     api.GetID(),
+					DisplayName:  api.GetName(),
+					ManagePath:   formatAPISettingsPath(api.GetID()),
 				})
 			}
 		}
@@ -436,22 +887,28 @@ func (f *roleResourceFetcher) FetchData(ctx context.Context) (importDataList, er
 		}
 
 		for _, role := range roles.Roles {
+			resourceName := roleResourceName(role)
+
 			data = append(data,
 				importDataItem{
-					ResourceName: "auth0_role." + sanitizeResourceName(role.GetName()),
+					ResourceName: "auth0_role." + resourceName,
 					ImportID:     role.GetID(),
+					DisplayName:  role.GetName(),
+					ManagePath:   formatRoleSettingsPath(role.GetID()),
 				},
 			)
 
 			rolePerms, err := f.api.Role.Permissions(ctx, role.GetID())
 			if err != nil {
-				return data, nil
+				return nil, fmt.Errorf("failed to list the permissions of the role %q: %w", role.GetName(), err)
 			}
 			if len(rolePerms.Permissions) > 0 {
 				// `permissions` block a required field for TF Provider; cannot have empty permissions.
 				data = append(data, importDataItem{
-					ResourceName: "auth0_role_permissions." + sanitizeResourceName(role.GetName()),
+					ResourceName: "auth0_role_permissions." + resourceName,
 					ImportID:     role.GetID(),
+					DisplayName:  role.GetName(),
+					ManagePath:   formatRoleSettingsPath(role.GetID()),
 				})
 			}
 		}
@@ -466,18 +923,107 @@ func (f *roleResourceFetcher) FetchData(ctx context.Context) (importDataList, er
 	return data, nil
 }
 
-func (f *tenantResourceFetcher) FetchData(_ context.Context) (importDataList, error) {
+// FetchData notes the order of the rules, along with their status when they're disabled.
+// The values of the rule configs are never returned, so they'll have to be filled in.
+func (f *ruleResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	var data importDataList
+
+	var page int
+	for {
+		rules, err := f.api.Rule.List(ctx, management.Page(page), management.PerPage(defaultPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules.Rules {
+			notes := []string{fmt.Sprintf("Order: %d", rule.GetOrder())}
+			if !rule.GetEnabled() {
+				notes = append(notes, "Status: disabled")
+			}
+
+			data = append(data, importDataItem{
+				ResourceName: "auth0_rule." + sanitizeResourceName(rule.GetName()),
+				ImportID:     rule.GetID(),
+				DisplayName:  rule.GetName(),
+				ManagePath:   fmt.Sprintf("rules/%s", rule.GetID()),
+				Notes:        append(notes, deprecatedResourceNote),
+			})
+		}
+
+		if !rules.HasNext() {
+			break
+		}
+
+		page++
+	}
+
+	ruleConfigs, err := f.api.RuleConfig.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the rule configs: %w", err)
+	}
+
+	for _, ruleConfig := range ruleConfigs {
+		data = append(data, importDataItem{
+			ResourceName: "auth0_rule_config." + sanitizeResourceName(ruleConfig.GetKey()),
+			ImportID:     ruleConfig.GetKey(),
+			DisplayName:  ruleConfig.GetKey(),
+			ManagePath:   "rules",
+			Notes:        []string{"The value isn't exported, set it in the generated config", deprecatedResourceNote},
+		})
+	}
+
+	return data, nil
+}
+
+// roleResourceName returns the resource label of the role, derived from its name. The
+// ID of the role is used instead when none of the characters of its name can be kept.
+func roleResourceName(role *management.Role) string {
+	if name := sanitizeResourceName(role.GetName()); name != "" {
+		return name
+	}
+
+	return sanitizeResourceName("role_" + role.GetID())
+}
+
+// FetchData reads the tenant settings, so that the import block is labeled with the
+// friendly name and the enabled locales of the tenant and reviewers can tell it apart.
+func (f *tenantResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	tenant, err := f.api.Tenant.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName := tenant.GetFriendlyName()
+	if locales := tenant.GetEnabledLocales(); len(locales) > 0 {
+		displayName = strings.TrimSpace(displayName + " (" + strings.Join(locales, ", ") + ")")
+	}
+
 	return []importDataItem{
 		{
 			ResourceName: "auth0_tenant.tenant",
-			ImportID:     uuid.NewString(),
+			ImportID:     singletonImportID("auth0_tenant.tenant"),
+			DisplayName:  displayName,
+			ManagePath:   "tenant/general",
 		},
 	}, nil
 }
 
 func (f *triggerActionsResourceFetcher) FetchData(ctx context.Context) (importDataList, error) {
 	var data importDataList
-	triggers := []string{"post-login", "credentials-exchange", "pre-user-registration", "post-user-registration", "post-change-password", "send-phone-message", "password-reset-post-challenge", "iga-approval", "iga-certification", "iga-fulfillment-assignment", "iga-fulfillment-execution"}
+
+	// The triggers are listed rather than hard-coded, for the bindings
+	// of newly available triggers not to be left out of the config.
+	triggerList, err := f.api.Action.Triggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var triggers []string
+	for _, trigger := range filterOutDeprecatedActionTriggers(triggerList.Triggers) {
+		if !containsStr(triggers, trigger.GetID()) {
+			triggers = append(triggers, trigger.GetID())
+		}
+	}
 
 	for _, trigger := range triggers {
 		res, err := f.api.Action.Bindings(ctx, trigger)
@@ -488,6 +1034,7 @@ func (f *triggerActionsResourceFetcher) FetchData(ctx context.Context) (importDa
 			data = append(data, importDataItem{
 				ResourceName: "auth0_trigger_actions." + sanitizeResourceName(trigger),
 				ImportID:     trigger,
+				DisplayName:  trigger,
 			})
 		}
 	}
@@ -512,6 +1059,9 @@ func (f *actionResourceFetcher) FetchData(ctx context.Context) (importDataList,
 			data = append(data, importDataItem{
 				ResourceName: "auth0_action." + sanitizeResourceName(action.GetName()),
 				ImportID:     action.GetID(),
+				DisplayName:  action.GetName(),
+				ManagePath:   formatActionDetailsPath(action.GetID()),
+				Secrets:      actionSecrets(action),
 			})
 		}
 
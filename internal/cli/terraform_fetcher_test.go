@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/auth0/auth0-cli/internal/auth0"
 	"github.com/auth0/auth0-cli/internal/auth0/mock"
@@ -76,18 +78,26 @@ func TestActionResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_action.action_1",
 				ImportID:     "07898b80-02ba-42ee-82ad-e5b224a9b450",
+				DisplayName:  "Action 1",
+				ManagePath:   "actions/library/details/07898b80-02ba-42ee-82ad-e5b224a9b450",
 			},
 			{
 				ResourceName: "auth0_action.action_2",
 				ImportID:     "24118aae-8022-4b94-80c1-e8e28511eb92",
+				DisplayName:  "Action 2",
+				ManagePath:   "actions/library/details/24118aae-8022-4b94-80c1-e8e28511eb92",
 			},
 			{
 				ResourceName: "auth0_action.action_3",
 				ImportID:     "fa04d1ff-fe8d-4662-b7c2-32d212719876",
+				DisplayName:  "Action 3",
+				ManagePath:   "actions/library/details/fa04d1ff-fe8d-4662-b7c2-32d212719876",
 			},
 			{
 				ResourceName: "auth0_action.action_4",
 				ImportID:     "9cb897b9-c25c-47be-b5aa-e03e31af2e44",
+				DisplayName:  "Action 4",
+				ManagePath:   "actions/library/details/9cb897b9-c25c-47be-b5aa-e03e31af2e44",
 			},
 		}
 
@@ -118,25 +128,228 @@ func TestActionResourceFetcher_FetchData(t *testing.T) {
 
 func TestAttackProtectionResourceFetcher_FetchData(t *testing.T) {
 	t.Run("it successfully generates attack protection import data", func(t *testing.T) {
-		fetcher := attackProtectionResourceFetcher{}
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		attackProtectionAPI := mock.NewMockAttackProtectionAPI(ctrl)
+		attackProtectionAPI.EXPECT().
+			GetBreachedPasswordDetection(gomock.Any()).
+			Return(&management.BreachedPasswordDetection{Enabled: auth0.Bool(true)}, nil)
+		attackProtectionAPI.EXPECT().
+			GetBruteForceProtection(gomock.Any()).
+			Return(&management.BruteForceProtection{Enabled: auth0.Bool(true)}, nil)
+		attackProtectionAPI.EXPECT().
+			GetSuspiciousIPThrottling(gomock.Any()).
+			Return(&management.SuspiciousIPThrottling{Enabled: auth0.Bool(false)}, nil)
+
+		fetcher := attackProtectionResourceFetcher{
+			api: &auth0.API{
+				AttackProtection: attackProtectionAPI,
+			},
+		}
 
 		data, err := fetcher.FetchData(context.Background())
 		assert.NoError(t, err)
-		assert.Len(t, data, 1)
-		assert.Equal(t, data[0].ResourceName, "auth0_attack_protection.attack_protection")
-		assert.Greater(t, len(data[0].ImportID), 0)
+		assert.Equal(t, importDataList{
+			{
+				ResourceName: "auth0_attack_protection.attack_protection",
+				ImportID:     singletonImportID("auth0_attack_protection.attack_protection"),
+				ManagePath:   "security/attack-protection",
+				Notes: []string{
+					"Breached password detection: enabled",
+					"Brute force protection: enabled",
+					"Suspicious IP throttling: disabled",
+				},
+			},
+		}, data)
+	})
+
+	t.Run("it returns an error if api call fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		attackProtectionAPI := mock.NewMockAttackProtectionAPI(ctrl)
+		attackProtectionAPI.EXPECT().
+			GetBreachedPasswordDetection(gomock.Any()).
+			Return(nil, fmt.Errorf("failed to read breached password detection settings"))
+
+		fetcher := attackProtectionResourceFetcher{
+			api: &auth0.API{
+				AttackProtection: attackProtectionAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to read breached password detection settings")
+	})
+
+	t.Run("it omits the notes when the settings can't be read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		attackProtectionAPI := mock.NewMockAttackProtectionAPI(ctrl)
+		attackProtectionAPI.EXPECT().
+			GetBreachedPasswordDetection(gomock.Any()).
+			Return(&management.BreachedPasswordDetection{Enabled: auth0.Bool(true)}, nil)
+		attackProtectionAPI.EXPECT().
+			GetBruteForceProtection(gomock.Any()).
+			Return(nil, mockManagamentError{status: http.StatusForbidden})
+
+		fetcher := attackProtectionResourceFetcher{
+			api: &auth0.API{
+				AttackProtection: attackProtectionAPI,
+			},
+		}
+
+		data, err := fetcher.FetchData(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, importDataList{
+			{
+				ResourceName: "auth0_attack_protection.attack_protection",
+				ImportID:     singletonImportID("auth0_attack_protection.attack_protection"),
+				ManagePath:   "security/attack-protection",
+			},
+		}, data)
 	})
 }
 
 func TestBrandingResourceFetcher_FetchData(t *testing.T) {
-	t.Run("it successfully generates branding import data", func(t *testing.T) {
-		fetcher := brandingResourceFetcher{}
+	var tests = []struct {
+		name          string
+		template      *management.BrandingUniversalLogin
+		err           error
+		expectedNotes []string
+	}{
+		{
+			name:          "it notes the custom universal login page template",
+			template:      &management.BrandingUniversalLogin{Body: auth0.String("<html>{%- auth0:widget -%}</html>")},
+			expectedNotes: []string{"Includes the custom Universal Login page template, in its universal_login block"},
+		},
+		{
+			name: "it generates branding import data without page template",
+			err:  mockManagamentError{status: http.StatusNotFound},
+		},
+		{
+			name: "it generates branding import data without custom domain",
+			err:  mockManagamentError{status: http.StatusForbidden},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			brandingAPI := mock.NewMockBrandingAPI(ctrl)
+			brandingAPI.EXPECT().
+				UniversalLogin(gomock.Any()).
+				Return(test.template, test.err)
+
+			fetcher := brandingResourceFetcher{
+				api: &auth0.API{
+					Branding: brandingAPI,
+				},
+			}
+
+			data, err := fetcher.FetchData(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, importDataList{
+				{
+					ResourceName: "auth0_branding.branding",
+					ImportID:     singletonImportID("auth0_branding.branding"),
+					Notes:        test.expectedNotes,
+				},
+			}, data)
+		})
+	}
+
+	t.Run("it returns an error if api call fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		brandingAPI := mock.NewMockBrandingAPI(ctrl)
+		brandingAPI.EXPECT().
+			UniversalLogin(gomock.Any()).
+			Return(nil, fmt.Errorf("failed to read universal login template"))
+
+		fetcher := brandingResourceFetcher{
+			api: &auth0.API{
+				Branding: brandingAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to read universal login template")
+	})
+}
+
+func TestBrandingThemeResourceFetcher_FetchData(t *testing.T) {
+	t.Run("it successfully retrieves the branding theme data", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		brandingThemeAPI := mock.NewMockBrandingThemeAPI(ctrl)
+		brandingThemeAPI.EXPECT().
+			Default(gomock.Any()).
+			Return(&management.BrandingTheme{
+				ID:          auth0.String("theme_8fGvMb4PgpAhPpxJkHuRS7"),
+				DisplayName: auth0.String("Travel0"),
+			}, nil)
+
+		fetcher := brandingThemeResourceFetcher{
+			api: &auth0.API{
+				BrandingTheme: brandingThemeAPI,
+			},
+		}
 
 		data, err := fetcher.FetchData(context.Background())
 		assert.NoError(t, err)
-		assert.Len(t, data, 1)
-		assert.Equal(t, data[0].ResourceName, "auth0_branding.branding")
-		assert.Greater(t, len(data[0].ImportID), 0)
+		assert.Equal(t, importDataList{
+			{
+				ResourceName: "auth0_branding_theme.branding_theme",
+				ImportID:     "theme_8fGvMb4PgpAhPpxJkHuRS7",
+				DisplayName:  "Travel0",
+			},
+		}, data)
+	})
+
+	t.Run("it does not generate branding theme import data if there is no theme", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		brandingThemeAPI := mock.NewMockBrandingThemeAPI(ctrl)
+		brandingThemeAPI.EXPECT().
+			Default(gomock.Any()).
+			Return(nil, mockManagamentError{status: http.StatusNotFound})
+
+		fetcher := brandingThemeResourceFetcher{
+			api: &auth0.API{
+				BrandingTheme: brandingThemeAPI,
+			},
+		}
+
+		data, err := fetcher.FetchData(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, data, 0)
+	})
+
+	t.Run("it returns an error if api call fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		brandingThemeAPI := mock.NewMockBrandingThemeAPI(ctrl)
+		brandingThemeAPI.EXPECT().
+			Default(gomock.Any()).
+			Return(nil, fmt.Errorf("failed to read branding theme"))
+
+		fetcher := brandingThemeResourceFetcher{
+			api: &auth0.API{
+				BrandingTheme: brandingThemeAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to read branding theme")
 	})
 }
 
@@ -201,34 +414,50 @@ func TestClientResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_client.my_test_client_1",
 				ImportID:     "clientID_1",
+				DisplayName:  "My Test Client 1",
+				ManagePath:   "applications/clientID_1/settings",
 			},
 			{
 				ResourceName: "auth0_client_credentials.my_test_client_1",
 				ImportID:     "clientID_1",
+				DisplayName:  "My Test Client 1",
+				ManagePath:   "applications/clientID_1/settings",
 			},
 			{
 				ResourceName: "auth0_client.my_test_client_2",
 				ImportID:     "clientID_2",
+				DisplayName:  "My Test Client 2",
+				ManagePath:   "applications/clientID_2/settings",
 			},
 			{
 				ResourceName: "auth0_client_credentials.my_test_client_2",
 				ImportID:     "clientID_2",
+				DisplayName:  "My Test Client 2",
+				ManagePath:   "applications/clientID_2/settings",
 			},
 			{
 				ResourceName: "auth0_client.my_test_client_3",
 				ImportID:     "clientID_3",
+				DisplayName:  "My Test Client 3",
+				ManagePath:   "applications/clientID_3/settings",
 			},
 			{
 				ResourceName: "auth0_client_credentials.my_test_client_3",
 				ImportID:     "clientID_3",
+				DisplayName:  "My Test Client 3",
+				ManagePath:   "applications/clientID_3/settings",
 			},
 			{
 				ResourceName: "auth0_client.my_test_client_4",
 				ImportID:     "clientID_4",
+				DisplayName:  "My Test Client 4",
+				ManagePath:   "applications/clientID_4/settings",
 			},
 			{
 				ResourceName: "auth0_client_credentials.my_test_client_4",
 				ImportID:     "clientID_4",
+				DisplayName:  "My Test Client 4",
+				ManagePath:   "applications/clientID_4/settings",
 			},
 		}
 
@@ -277,6 +506,7 @@ func TestClientGrantResourceFetcher_FetchData(t *testing.T) {
 							ID:       auth0.String("cgr_1"),
 							ClientID: auth0.String("client-id-1"),
 							Audience: auth0.String("https://travel0.com/api"),
+							Scope:    &[]string{"read:bookings", "create:bookings"},
 						},
 						{
 							ID:       auth0.String("cgr_2"),
@@ -301,6 +531,7 @@ func TestClientGrantResourceFetcher_FetchData(t *testing.T) {
 							ID:       auth0.String("cgr_3"),
 							ClientID: auth0.String("client-id-1"),
 							Audience: auth0.String("https://travel0.us.auth0.com/api/v2/"),
+							Scope:    &[]string{"read:users"},
 						},
 						{
 							ID:       auth0.String("cgr_4"),
@@ -322,18 +553,30 @@ func TestClientGrantResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_client_grant.client_id_1_https_travel0_com_api",
 				ImportID:     "cgr_1",
+				DisplayName:  "client-id-1 to https://travel0.com/api",
+				ManagePath:   "applications/client-id-1/settings",
+				Notes:        []string{"Scopes: read:bookings, create:bookings"},
 			},
 			{
 				ResourceName: "auth0_client_grant.client_id_2_https_travel0_com_api",
 				ImportID:     "cgr_2",
+				DisplayName:  "client-id-2 to https://travel0.com/api",
+				ManagePath:   "applications/client-id-2/settings",
+				Notes:        []string{"Scopes: none"},
 			},
 			{
 				ResourceName: "auth0_client_grant.client_id_1_https_travel0_us_auth0_com_api_v2",
 				ImportID:     "cgr_3",
+				DisplayName:  "client-id-1 to https://travel0.us.auth0.com/api/v2/",
+				ManagePath:   "applications/client-id-1/settings",
+				Notes:        []string{"Grants access to the Management API", "Scopes: read:users"},
 			},
 			{
 				ResourceName: "auth0_client_grant.client_id_2_https_travel0_us_auth0_com_api_v2",
 				ImportID:     "cgr_4",
+				DisplayName:  "client-id-2 to https://travel0.us.auth0.com/api/v2/",
+				ManagePath:   "applications/client-id-2/settings",
+				Notes:        []string{"Grants access to the Management API", "Scopes: none"},
 			},
 		}
 
@@ -369,7 +612,7 @@ func TestConnectionResourceFetcher_FetchData(t *testing.T) {
 
 		connAPI := mock.NewMockConnectionAPI(ctrl)
 		connAPI.EXPECT().
-			List(gomock.Any(), gomock.Any(), gomock.Any()).
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(
 				&management.ConnectionList{
 					List: management.List{
@@ -391,7 +634,7 @@ func TestConnectionResourceFetcher_FetchData(t *testing.T) {
 				nil,
 			)
 		connAPI.EXPECT().
-			List(gomock.Any(), gomock.Any(), gomock.Any()).
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(
 				&management.ConnectionList{
 					List: management.List{
@@ -423,34 +666,113 @@ func TestConnectionResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_connection.connection_1",
 				ImportID:     "con_id1",
+				DisplayName:  "Connection 1",
+				ManagePath:   "connections/social/con_id1/settings",
 			},
 			{
 				ResourceName: "auth0_connection_clients.connection_1",
 				ImportID:     "con_id1",
+				DisplayName:  "Connection 1",
+				ManagePath:   "connections/social/con_id1/settings",
 			},
 			{
 				ResourceName: "auth0_connection.connection_2",
 				ImportID:     "con_id2",
+				DisplayName:  "Connection 2",
+				ManagePath:   "connections/social/con_id2/settings",
 			},
 			{
 				ResourceName: "auth0_connection_clients.connection_2",
 				ImportID:     "con_id2",
+				DisplayName:  "Connection 2",
+				ManagePath:   "connections/social/con_id2/settings",
 			},
 			{
 				ResourceName: "auth0_connection.connection_3",
 				ImportID:     "con_id3",
+				DisplayName:  "Connection 3",
+				ManagePath:   "connections/social/con_id3/settings",
 			},
 			{
 				ResourceName: "auth0_connection_clients.connection_3",
 				ImportID:     "con_id3",
+				DisplayName:  "Connection 3",
+				ManagePath:   "connections/social/con_id3/settings",
 			},
 			{
 				ResourceName: "auth0_connection.connection_4",
 				ImportID:     "con_id4",
+				DisplayName:  "Connection 4",
+				ManagePath:   "connections/social/con_id4/settings",
 			},
 			{
 				ResourceName: "auth0_connection_clients.connection_4",
 				ImportID:     "con_id4",
+				DisplayName:  "Connection 4",
+				ManagePath:   "connections/social/con_id4/settings",
+			},
+		}
+
+		data, err := fetcher.FetchData(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedData, data)
+	})
+
+	t.Run("it links database and enterprise connections to their settings", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		connAPI := mock.NewMockConnectionAPI(ctrl)
+		connAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(
+				&management.ConnectionList{
+					Connections: []*management.Connection{
+						{
+							ID:       auth0.String("con_db"),
+							Name:     auth0.String("Username-Password-Authentication"),
+							Strategy: auth0.String("auth0"),
+						},
+						{
+							ID:       auth0.String("con_saml"),
+							Name:     auth0.String("Acme SSO"),
+							Strategy: auth0.String("samlp"),
+						},
+					},
+				},
+				nil,
+			)
+
+		fetcher := connectionResourceFetcher{
+			api: &auth0.API{
+				Connection: connAPI,
+			},
+		}
+
+		expectedData := importDataList{
+			{
+				ResourceName: "auth0_connection.username_password_authentication",
+				ImportID:     "con_db",
+				DisplayName:  "Username-Password-Authentication",
+				ManagePath:   "connections/database/con_db/settings",
+			},
+			{
+				ResourceName: "auth0_connection_clients.username_password_authentication",
+				ImportID:     "con_db",
+				DisplayName:  "Username-Password-Authentication",
+				ManagePath:   "connections/database/con_db/settings",
+			},
+			{
+				ResourceName: "auth0_connection.acme_sso",
+				ImportID:     "con_saml",
+				DisplayName:  "Acme SSO",
+				ManagePath:   "connections/enterprise/samlp/con_saml/settings",
+			},
+			{
+				ResourceName: "auth0_connection_clients.acme_sso",
+				ImportID:     "con_saml",
+				DisplayName:  "Acme SSO",
+				ManagePath:   "connections/enterprise/samlp/con_saml/settings",
 			},
 		}
 
@@ -465,7 +787,7 @@ func TestConnectionResourceFetcher_FetchData(t *testing.T) {
 
 		connAPI := mock.NewMockConnectionAPI(ctrl)
 		connAPI.EXPECT().
-			List(gomock.Any(), gomock.Any(), gomock.Any()).
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, fmt.Errorf("failed to list connections"))
 
 		fetcher := connectionResourceFetcher{
@@ -497,6 +819,16 @@ func TestCustomDomainResourceFetcher_FetchData(t *testing.T) {
 						ID:     auth0.String("cd_XDVfBNsfL2vj7Wm1"),
 						Domain: auth0.String("enterprise.travel0.com"),
 					},
+					{
+						ID:     auth0.String("cd_E4ahLL0mxaTLkcQs"),
+						Domain: auth0.String("login.travel0.com"),
+						Status: auth0.String("ready"),
+					},
+					{
+						ID:     auth0.String("cd_Nk2UlM1Vz8ncVMsO"),
+						Domain: auth0.String("auth.travel0.com"),
+						Status: auth0.String("pending_verification"),
+					},
 				},
 				nil,
 			)
@@ -511,10 +843,31 @@ func TestCustomDomainResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_custom_domain.travel0_com",
 				ImportID:     "cd_XDVfBNsfL2vj7Wm1",
+				DisplayName:  "travel0.com",
+				ManagePath:   "tenant/custom_domains",
 			},
 			{
 				ResourceName: "auth0_custom_domain.enterprise_travel0_com",
 				ImportID:     "cd_XDVfBNsfL2vj7Wm1",
+				DisplayName:  "enterprise.travel0.com",
+				ManagePath:   "tenant/custom_domains",
+			},
+			{
+				ResourceName: "auth0_custom_domain.login_travel0_com",
+				ImportID:     "cd_E4ahLL0mxaTLkcQs",
+				DisplayName:  "login.travel0.com",
+				ManagePath:   "tenant/custom_domains",
+				Notes:        []string{"Status: ready"},
+			},
+			{
+				ResourceName: "auth0_custom_domain.auth_travel0_com",
+				ImportID:     "cd_Nk2UlM1Vz8ncVMsO",
+				DisplayName:  "auth.travel0.com",
+				ManagePath:   "tenant/custom_domains",
+				Notes: []string{
+					"Status: pending_verification",
+					"Run 'auth0 domains verify cd_Nk2UlM1Vz8ncVMsO' once the DNS records are set up",
+				},
 			},
 		}
 
@@ -564,17 +917,89 @@ func TestCustomDomainResourceFetcher_FetchData(t *testing.T) {
 }
 
 func TestGuardianResourceFetcher_FetchData(t *testing.T) {
-	t.Run("it successfully generates pages guardian data", func(t *testing.T) {
-		fetcher := guardianResourceFetcher{}
+	t.Run("it successfully generates guardian data", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		multiFactorAPI := mock.NewMockMultiFactorAPI(ctrl)
+		multiFactorAPI.EXPECT().
+			List(gomock.Any()).
+			Return([]*management.MultiFactor{
+				{Name: auth0.String("webauthn-roaming"), Enabled: auth0.Bool(true)},
+				{Name: auth0.String("sms"), Enabled: auth0.Bool(false)},
+				{Name: auth0.String("otp"), Enabled: auth0.Bool(true)},
+			}, nil)
+		multiFactorAPI.EXPECT().
+			Policy(gomock.Any()).
+			Return(&management.MultiFactorPolicies{"all-applications"}, nil)
+
+		fetcher := guardianResourceFetcher{
+			api: &auth0.API{
+				MultiFactor: multiFactorAPI,
+			},
+		}
+
+		data, err := fetcher.FetchData(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, importDataList{
+			{
+				ResourceName: "auth0_guardian.guardian",
+				ImportID:     singletonImportID("auth0_guardian.guardian"),
+				ManagePath:   "security/mfa",
+				Notes:        []string{"Enabled factors: otp, webauthn-roaming", "Policy: all-applications"},
+			},
+		}, data)
+	})
+
+	t.Run("it generates guardian data without notes if the scopes weren't granted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		multiFactorAPI := mock.NewMockMultiFactorAPI(ctrl)
+		multiFactorAPI.EXPECT().
+			List(gomock.Any()).
+			Return([]*management.MultiFactor{}, nil)
+		multiFactorAPI.EXPECT().
+			Policy(gomock.Any()).
+			Return(nil, mockManagamentError{status: http.StatusForbidden})
+
+		fetcher := guardianResourceFetcher{
+			api: &auth0.API{
+				MultiFactor: multiFactorAPI,
+			},
+		}
 
 		data, err := fetcher.FetchData(context.Background())
 		assert.NoError(t, err)
 		assert.Len(t, data, 1)
-		assert.Equal(t, data[0].ResourceName, "auth0_guardian.guardian")
-		assert.Greater(t, len(data[0].ImportID), 0)
+		assert.Equal(t, "auth0_guardian.guardian", data[0].ResourceName)
+		assert.Empty(t, data[0].Notes)
+	})
+
+	t.Run("it returns an error if api call fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		multiFactorAPI := mock.NewMockMultiFactorAPI(ctrl)
+		multiFactorAPI.EXPECT().
+			List(gomock.Any()).
+			Return(nil, fmt.Errorf("failed to list factors"))
+
+		fetcher := guardianResourceFetcher{
+			api: &auth0.API{
+				MultiFactor: multiFactorAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to list factors")
 	})
 }
 
+func TestGuardianNotes(t *testing.T) {
+	assert.Equal(t, []string{"Enabled factors: none", "Policy: never"}, guardianNotes(nil, &management.MultiFactorPolicies{}))
+}
+
 func TestEmailProviderResourceFetcher_FetchData(t *testing.T) {
 	t.Run("it successfully generates email provider import data", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -583,7 +1008,7 @@ func TestEmailProviderResourceFetcher_FetchData(t *testing.T) {
 
 		emailProviderAPI.EXPECT().
 			Read(gomock.Any(), gomock.Any()).
-			Return(nil, nil)
+			Return(&management.EmailProvider{Name: auth0.String("mailgun")}, nil)
 
 		fetcher := emailProviderResourceFetcher{
 			api: &auth0.API{
@@ -595,6 +1020,7 @@ func TestEmailProviderResourceFetcher_FetchData(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, data, 1)
 		assert.Equal(t, data[0].ResourceName, "auth0_email_provider.email_provider")
+		assert.Equal(t, data[0].DisplayName, "mailgun")
 		assert.Greater(t, len(data[0].ImportID), 0)
 	})
 
@@ -645,14 +1071,7 @@ func TestEmailTemplateResourceFetcher_FetchData(t *testing.T) {
 		defer ctrl.Finish()
 
 		emailTemplateAPI := mock.NewMockEmailTemplateAPI(ctrl)
-		templates := []string{
-			"verify_email", "reset_email", "welcome_email",
-			"blocked_account", "stolen_credentials",
-			"enrollment_email", "mfa_oob_code",
-			"change_password", "password_reset",
-		}
-
-		for _, tmpl := range templates {
+		for _, tmpl := range emailTemplateNames {
 			emailTemplateAPI.EXPECT().
 				Read(gomock.Any(), tmpl).
 				Return(&management.EmailTemplate{Template: auth0.String(tmpl)}, nil)
@@ -668,38 +1087,67 @@ func TestEmailTemplateResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_email_template.verify_email",
 				ImportID:     "verify_email",
+				DisplayName:  "verify_email",
+			},
+			{
+				ResourceName: "auth0_email_template.verify_email_by_code",
+				ImportID:     "verify_email_by_code",
+				DisplayName:  "verify_email_by_code",
 			},
 			{
 				ResourceName: "auth0_email_template.reset_email",
 				ImportID:     "reset_email",
+				DisplayName:  "reset_email",
+			},
+			{
+				ResourceName: "auth0_email_template.reset_email_by_code",
+				ImportID:     "reset_email_by_code",
+				DisplayName:  "reset_email_by_code",
 			},
 			{
 				ResourceName: "auth0_email_template.welcome_email",
 				ImportID:     "welcome_email",
+				DisplayName:  "welcome_email",
 			},
 			{
 				ResourceName: "auth0_email_template.blocked_account",
 				ImportID:     "blocked_account",
+				DisplayName:  "blocked_account",
 			},
 			{
 				ResourceName: "auth0_email_template.stolen_credentials",
 				ImportID:     "stolen_credentials",
+				DisplayName:  "stolen_credentials",
 			},
 			{
 				ResourceName: "auth0_email_template.enrollment_email",
 				ImportID:     "enrollment_email",
+				DisplayName:  "enrollment_email",
 			},
 			{
 				ResourceName: "auth0_email_template.mfa_oob_code",
 				ImportID:     "mfa_oob_code",
+				DisplayName:  "mfa_oob_code",
+			},
+			{
+				ResourceName: "auth0_email_template.user_invitation",
+				ImportID:     "user_invitation",
+				DisplayName:  "user_invitation",
 			},
 			{
 				ResourceName: "auth0_email_template.change_password",
 				ImportID:     "change_password",
+				DisplayName:  "change_password",
 			},
 			{
 				ResourceName: "auth0_email_template.password_reset",
 				ImportID:     "password_reset",
+				DisplayName:  "password_reset",
+			},
+			{
+				ResourceName: "auth0_email_template.async_approval",
+				ImportID:     "async_approval",
+				DisplayName:  "async_approval",
 			},
 		}
 
@@ -714,14 +1162,7 @@ func TestEmailTemplateResourceFetcher_FetchData(t *testing.T) {
 
 		mErr := mockManagamentError{status: http.StatusNotFound}
 		emailTemplateAPI := mock.NewMockEmailTemplateAPI(ctrl)
-		templates := []string{
-			"verify_email", "reset_email", "welcome_email",
-			"blocked_account", "stolen_credentials",
-			"enrollment_email", "mfa_oob_code",
-			"change_password", "password_reset",
-		}
-
-		for _, tmpl := range templates {
+		for _, tmpl := range emailTemplateNames {
 			emailTemplateAPI.EXPECT().
 				Read(gomock.Any(), tmpl).
 				Return(nil, mErr)
@@ -769,12 +1210,16 @@ func TestLogStreamResourceFetcher_FetchData(t *testing.T) {
 			Return(
 				[]*management.LogStream{
 					{
-						ID:   auth0.String("lst_0000000000014444"),
-						Name: auth0.String("DataDog"),
+						ID:     auth0.String("lst_0000000000014444"),
+						Name:   auth0.String("DataDog"),
+						Type:   auth0.String("datadog"),
+						Status: auth0.String("active"),
 					},
 					{
-						ID:   auth0.String("lst_0000000000015555"),
-						Name: auth0.String("HTTP Logs"),
+						ID:     auth0.String("lst_0000000000015555"),
+						Name:   auth0.String("HTTP Logs"),
+						Type:   auth0.String("http"),
+						Status: auth0.String("paused"),
 					},
 				},
 				nil,
@@ -790,10 +1235,18 @@ func TestLogStreamResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_log_stream.datadog",
 				ImportID:     "lst_0000000000014444",
+				DisplayName:  "DataDog",
+				ManagePath:   "log-streams/lst_0000000000014444/settings",
+				Notes:        []string{"Type: datadog"},
+				Secrets:      []string{"sink.datadog_api_key"},
 			},
 			{
 				ResourceName: "auth0_log_stream.http_logs",
 				ImportID:     "lst_0000000000015555",
+				DisplayName:  "HTTP Logs",
+				ManagePath:   "log-streams/lst_0000000000015555/settings",
+				Notes:        []string{"Type: http", "Status: paused"},
+				Secrets:      []string{"sink.http_authorization"},
 			},
 		}
 
@@ -916,6 +1369,35 @@ func TestOrganizationResourceFetcher_FetchData(t *testing.T) {
 				},
 				nil,
 			)
+		orgAPI.EXPECT().
+			Members(gomock.Any(), "org_1", gomock.Any(), gomock.Any()).
+			Return(
+				&management.OrganizationMemberList{
+					Members: []management.OrganizationMember{
+						{
+							UserID: auth0.String("auth0|user_1"),
+							Email:  auth0.String("jane@example.com"),
+						},
+					},
+				},
+				nil,
+			)
+		orgAPI.EXPECT().
+			Members(gomock.Any(), "org_2", gomock.Any(), gomock.Any()).
+			Return(
+				&management.OrganizationMemberList{
+					Members: []management.OrganizationMember{
+						{
+							UserID: auth0.String("auth0|user_2"),
+						},
+					},
+				},
+				nil,
+			)
+		orgAPI.EXPECT().
+			Members(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&management.OrganizationMemberList{}, nil).
+			Times(2)
 
 		fetcher := organizationResourceFetcher{
 			api: &auth0.API{
@@ -927,30 +1409,56 @@ func TestOrganizationResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_organization.organization_1",
 				ImportID:     "org_1",
+				DisplayName:  "Organization 1",
+				ManagePath:   "organizations/org_1/overview",
 			},
 			{
 				ResourceName: "auth0_organization_connections.organization_1",
 				ImportID:     "org_1",
+				DisplayName:  "Organization 1",
+				ManagePath:   "organizations/org_1/overview",
+			},
+			{
+				ResourceName: "auth0_organization_member.organization_1_jane_example_com",
+				ImportID:     "org_1::auth0|user_1",
+				DisplayName:  "jane@example.com of Organization 1",
+				ManagePath:   "organizations/org_1/overview",
 			},
 			{
 				ResourceName: "auth0_organization.organization_2",
 				ImportID:     "org_2",
+				DisplayName:  "Organization 2",
+				ManagePath:   "organizations/org_2/overview",
 			},
 			{
 				ResourceName: "auth0_organization_connections.organization_2",
 				ImportID:     "org_2",
+				DisplayName:  "Organization 2",
+				ManagePath:   "organizations/org_2/overview",
+			},
+			{
+				ResourceName: "auth0_organization_member.organization_2_auth0_user_2",
+				ImportID:     "org_2::auth0|user_2",
+				DisplayName:  "auth0|user_2 of Organization 2",
+				ManagePath:   "organizations/org_2/overview",
 			},
 			{
 				ResourceName: "auth0_organization.organization_3",
 				ImportID:     "org_3",
+				DisplayName:  "Organization 3",
+				ManagePath:   "organizations/org_3/overview",
 			},
 			{
 				ResourceName: "auth0_organization_connections.organization_3",
 				ImportID:     "org_3",
+				DisplayName:  "Organization 3",
+				ManagePath:   "organizations/org_3/overview",
 			},
 			{
 				ResourceName: "auth0_organization.organization_4_no_connections",
 				ImportID:     "org_4",
+				DisplayName:  "Organization 4 - NO CONNECTIONS!",
+				ManagePath:   "organizations/org_4/overview",
 			},
 		}
 
@@ -977,29 +1485,149 @@ func TestOrganizationResourceFetcher_FetchData(t *testing.T) {
 		_, err := fetcher.FetchData(context.Background())
 		assert.EqualError(t, err, "failed to list organizations")
 	})
+
+	t.Run("it returns an error if the members fail to be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orgAPI := mock.NewMockOrganizationAPI(ctrl)
+		orgAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(
+				&management.OrganizationList{
+					Organizations: []*management.Organization{
+						{
+							ID:   auth0.String("org_1"),
+							Name: auth0.String("Organization 1"),
+						},
+					},
+				},
+				nil,
+			)
+		orgAPI.EXPECT().
+			Connections(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&management.OrganizationConnectionList{}, nil)
+		orgAPI.EXPECT().
+			Members(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("failed to list members"))
+
+		fetcher := organizationResourceFetcher{
+			api: &auth0.API{
+				Organization: orgAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to list members")
+	})
 }
 
 func TestPagesResourceFetcher_FetchData(t *testing.T) {
 	t.Run("it successfully generates pages import data", func(t *testing.T) {
-		fetcher := pagesResourceFetcher{}
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantAPI := mock.NewMockTenantAPI(ctrl)
+		tenantAPI.EXPECT().
+			Read(gomock.Any(), gomock.Any()).
+			Return(&management.Tenant{
+				ChangePassword: &management.TenantChangePassword{Enabled: auth0.Bool(true)},
+				ErrorPage:      &management.TenantErrorPage{URL: auth0.String("https://travel0.com/error")},
+			}, nil)
+
+		fetcher := pagesResourceFetcher{
+			api: &auth0.API{
+				Tenant: tenantAPI,
+			},
+		}
 
 		data, err := fetcher.FetchData(context.Background())
 		assert.NoError(t, err)
 		assert.Len(t, data, 1)
 		assert.Equal(t, data[0].ResourceName, "auth0_pages.pages")
 		assert.Greater(t, len(data[0].ImportID), 0)
+		assert.Equal(
+			t,
+			[]string{"Custom change password page: enabled", "Error page: redirects to https://travel0.com/error"},
+			data[0].Notes,
+		)
+	})
+
+	t.Run("it notes when no page is customized", func(t *testing.T) {
+		notes := pagesNotes(&management.Tenant{
+			GuardianMFAPage: &management.TenantGuardianMFAPage{Enabled: auth0.Bool(false)},
+		})
+		assert.Equal(t, []string{"Customized pages: none"}, notes)
+	})
+
+	t.Run("it returns an error if api call fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantAPI := mock.NewMockTenantAPI(ctrl)
+		tenantAPI.EXPECT().
+			Read(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("failed to read tenant"))
+
+		fetcher := pagesResourceFetcher{
+			api: &auth0.API{
+				Tenant: tenantAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to read tenant")
 	})
 }
 
 func TestPromptProviderResourceFetcher_FetchData(t *testing.T) {
 	t.Run("it successfully generates prompts import data", func(t *testing.T) {
-		fetcher := promptResourceFetcher{}
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		promptAPI := mock.NewMockPromptAPI(ctrl)
+		promptAPI.EXPECT().
+			Read(gomock.Any()).
+			Return(&management.Prompt{
+				UniversalLoginExperience: "new",
+				IdentifierFirst:          auth0.Bool(true),
+			}, nil)
+
+		fetcher := promptResourceFetcher{
+			api: &auth0.API{
+				Prompt: promptAPI,
+			},
+		}
 
 		data, err := fetcher.FetchData(context.Background())
 		assert.NoError(t, err)
 		assert.Len(t, data, 1)
 		assert.Equal(t, data[0].ResourceName, "auth0_prompt.prompts")
 		assert.Greater(t, len(data[0].ImportID), 0)
+		assert.Equal(t, []string{"Universal Login experience: new", "Identifier first: enabled"}, data[0].Notes)
+	})
+
+	t.Run("it notes the classic experience by default", func(t *testing.T) {
+		assert.Equal(t, []string{"Universal Login experience: classic"}, promptNotes(&management.Prompt{}))
+	})
+
+	t.Run("it returns an error if api call fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		promptAPI := mock.NewMockPromptAPI(ctrl)
+		promptAPI.EXPECT().
+			Read(gomock.Any()).
+			Return(nil, fmt.Errorf("failed to read prompts"))
+
+		fetcher := promptResourceFetcher{
+			api: &auth0.API{
+				Prompt: promptAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to read prompts")
 	})
 }
 
@@ -1029,11 +1657,18 @@ func TestPromptCustomTextResourceFetcher_FetchData(t *testing.T) {
 		promptTypes := []string{"login", "login-id", "login-password", "login-email-verification", "signup", "signup-id", "signup-password", "reset-password", "consent", "mfa-push", "mfa-otp", "mfa-voice", "mfa-phone", "mfa-webauthn", "mfa-sms", "mfa-email", "mfa-recovery-code", "mfa", "status", "device-flow", "email-verification", "email-otp-challenge", "organizations", "invitation", "common"}
 
 		expectedData := importDataList{}
-		for _, enabledLocale := range mockEnabledLocales {
+		for i, enabledLocale := range mockEnabledLocales {
+			var notes []string
+			if i == 0 {
+				notes = []string{"Default language of the tenant"}
+			}
+
 			for _, promptType := range promptTypes {
 				expectedData = append(expectedData, importDataItem{
 					ResourceName: fmt.Sprintf("auth0_prompt_custom_text.%s_%s", enabledLocale, strings.ReplaceAll(promptType, "-", "_")),
 					ImportID:     fmt.Sprintf("%s::%s", promptType, enabledLocale),
+					DisplayName:  fmt.Sprintf("%s (%s)", promptType, enabledLocale),
+					Notes:        notes,
 				})
 			}
 		}
@@ -1134,30 +1769,44 @@ func TestResourceServerResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_resource_server.auth0_management_api",
 				ImportID:     "610e04b71f71b9003a7eb3df",
+				DisplayName:  "Auth0 Management API",
+				ManagePath:   "apis/610e04b71f71b9003a7eb3df/settings",
 			},
 			{
 				ResourceName: "auth0_resource_server_scopes.auth0_management_api",
 				ImportID:     "610e04b71f71b9003a7eb3df",
+				DisplayName:  "Auth0 Management API",
+				ManagePath:   "apis/610e04b71f71b9003a7eb3df/settings",
 			},
 			{
 				ResourceName: "auth0_resource_server.payments_api",
 				ImportID:     "6358fed7b77d3c391dd78a40",
+				DisplayName:  "Payments API",
+				ManagePath:   "apis/6358fed7b77d3c391dd78a40/settings",
 			},
 			{
 				ResourceName: "auth0_resource_server_scopes.payments_api",
 				ImportID:     "6358fed7b77d3c391dd78a40",
+				DisplayName:  "Payments API",
+				ManagePath:   "apis/6358fed7b77d3c391dd78a40/settings",
 			},
 			{
 				ResourceName: "auth0_resource_server.blog_api",
 				ImportID:     "66ef6f9c435cab03def5fa16",
+				DisplayName:  "Blog API",
+				ManagePath:   "apis/66ef6f9c435cab03def5fa16/settings",
 			},
 			{
 				ResourceName: "auth0_resource_server_scopes.blog_api",
 				ImportID:     "66ef6f9c435cab03def5fa16",
+				DisplayName:  "Blog API",
+				ManagePath:   "apis/66ef6f9c435cab03def5fa16/settings",
 			},
 			{
 				ResourceName: "auth0_resource_server.api_with_no_scopes",
 				ImportID:     "63bf6f9b0e025715cb91ce7c",
+				DisplayName:  "API with no scopes",
+				ManagePath:   "apis/63bf6f9b0e025715cb91ce7c/settings",
 			},
 		}
 
@@ -1184,6 +1833,39 @@ func TestResourceServerResourceFetcher_FetchData(t *testing.T) {
 		_, err := fetcher.FetchData(context.Background())
 		assert.EqualError(t, err, "failed to list resource servers")
 	})
+
+	t.Run("it requests the scopes of the resource servers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v2/resource-servers", r.URL.Path)
+			assert.Equal(t, "id,name,scopes", r.URL.Query().Get("fields"))
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"resource_servers": [{"id": "api_1", "name": "Invoices", "scopes": [{"value": "read:invoices"}]}]}`))
+		}))
+		t.Cleanup(server.Close)
+
+		api, err := management.New(strings.TrimPrefix(server.URL, "http://"), management.WithInsecure())
+		require.NoError(t, err)
+
+		fetcher := resourceServerResourceFetcher{api: auth0.NewAPI(api)}
+
+		data, err := fetcher.FetchData(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, importDataList{
+			{
+				ResourceName: "auth0_resource_server.invoices",
+				ImportID:     "api_1",
+				DisplayName:  "Invoices",
+				ManagePath:   "apis/api_1/settings",
+			},
+			{
+				ResourceName: "auth0_resource_server_scopes.invoices",
+				ImportID:     "api_1",
+				DisplayName:  "Invoices",
+				ManagePath:   "apis/api_1/settings",
+			},
+		}, data)
+	})
 }
 
 func TestRoleResourceFetcher_FetchData(t *testing.T) {
@@ -1303,30 +1985,44 @@ func TestRoleResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_role.role_1_no_permissions",
 				ImportID:     "rol_1",
+				DisplayName:  "Role 1 - No Permissions",
+				ManagePath:   "roles/rol_1/settings",
 			},
 			{
 				ResourceName: "auth0_role.role_2",
 				ImportID:     "rol_2",
+				DisplayName:  "Role 2",
+				ManagePath:   "roles/rol_2/settings",
 			},
 			{
 				ResourceName: "auth0_role_permissions.role_2",
 				ImportID:     "rol_2",
+				DisplayName:  "Role 2",
+				ManagePath:   "roles/rol_2/settings",
 			},
 			{
 				ResourceName: "auth0_role.role_3",
 				ImportID:     "rol_3",
+				DisplayName:  "Role 3",
+				ManagePath:   "roles/rol_3/settings",
 			},
 			{
 				ResourceName: "auth0_role_permissions.role_3",
 				ImportID:     "rol_3",
+				DisplayName:  "Role 3",
+				ManagePath:   "roles/rol_3/settings",
 			},
 			{
 				ResourceName: "auth0_role.role_4",
 				ImportID:     "rol_4",
+				DisplayName:  "Role 4",
+				ManagePath:   "roles/rol_4/settings",
 			},
 			{
 				ResourceName: "auth0_role_permissions.role_4",
 				ImportID:     "rol_4",
+				DisplayName:  "Role 4",
+				ManagePath:   "roles/rol_4/settings",
 			},
 		}
 
@@ -1353,17 +2049,149 @@ func TestRoleResourceFetcher_FetchData(t *testing.T) {
 		_, err := fetcher.FetchData(context.Background())
 		assert.EqualError(t, err, "failed to list roles")
 	})
-}
 
-func TestTenantResourceFetcher_FetchData(t *testing.T) {
-	t.Run("it successfully generates tenant import data", func(t *testing.T) {
-		fetcher := tenantResourceFetcher{}
+	t.Run("it returns an error if the permissions of a role fail to be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		roleAPI := mock.NewMockRoleAPI(ctrl)
+		roleAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&management.RoleList{
+				Roles: []*management.Role{
+					{ID: auth0.String("rol_1"), Name: auth0.String("Admin")},
+				},
+			}, nil)
+		roleAPI.EXPECT().
+			Permissions(gomock.Any(), "rol_1").
+			Return(nil, fmt.Errorf("insufficient scope"))
+
+		fetcher := roleResourceFetcher{
+			api: &auth0.API{
+				Role: roleAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, `failed to list the permissions of the role "Admin": insufficient scope`)
+	})
+
+	t.Run("it labels the roles with the ID when their name can't be kept", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		roleAPI := mock.NewMockRoleAPI(ctrl)
+		roleAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&management.RoleList{
+				Roles: []*management.Role{
+					{ID: auth0.String("rol_AbC1"), Name: auth0.String("管理者")},
+				},
+			}, nil)
+		roleAPI.EXPECT().
+			Permissions(gomock.Any(), "rol_AbC1").
+			Return(&management.PermissionList{
+				Permissions: []*management.Permission{{Name: auth0.String("read:invoices")}},
+			}, nil)
+
+		fetcher := roleResourceFetcher{
+			api: &auth0.API{
+				Role: roleAPI,
+			},
+		}
 
 		data, err := fetcher.FetchData(context.Background())
 		assert.NoError(t, err)
-		assert.Len(t, data, 1)
-		assert.Equal(t, data[0].ResourceName, "auth0_tenant.tenant")
-		assert.Greater(t, len(data[0].ImportID), 0)
+		assert.Equal(t, importDataList{
+			{
+				ResourceName: "auth0_role.role_rol_abc1",
+				ImportID:     "rol_AbC1",
+				DisplayName:  "管理者",
+				ManagePath:   "roles/rol_AbC1/settings",
+			},
+			{
+				ResourceName: "auth0_role_permissions.role_rol_abc1",
+				ImportID:     "rol_AbC1",
+				DisplayName:  "管理者",
+				ManagePath:   "roles/rol_AbC1/settings",
+			},
+		}, data)
+	})
+}
+
+func TestTenantResourceFetcher_FetchData(t *testing.T) {
+	var tests = []struct {
+		name                string
+		tenant              *management.Tenant
+		expectedDisplayName string
+	}{
+		{
+			name: "it labels the tenant with its friendly name and enabled locales",
+			tenant: &management.Tenant{
+				FriendlyName:   auth0.String("Travel0"),
+				EnabledLocales: &[]string{"en", "fr"},
+			},
+			expectedDisplayName: "Travel0 (en, fr)",
+		},
+		{
+			name: "it labels the tenant with its enabled locales only",
+			tenant: &management.Tenant{
+				EnabledLocales: &[]string{"en"},
+			},
+			expectedDisplayName: "(en)",
+		},
+		{
+			name:   "it doesn't label the tenant without friendly name nor locales",
+			tenant: &management.Tenant{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tenantAPI := mock.NewMockTenantAPI(ctrl)
+			tenantAPI.EXPECT().
+				Read(gomock.Any()).
+				Return(test.tenant, nil)
+
+			fetcher := tenantResourceFetcher{
+				api: &auth0.API{
+					Tenant: tenantAPI,
+				},
+			}
+
+			data, err := fetcher.FetchData(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, importDataList{
+				{
+					ResourceName: "auth0_tenant.tenant",
+					ImportID:     singletonImportID("auth0_tenant.tenant"),
+					DisplayName:  test.expectedDisplayName,
+					ManagePath:   "tenant/general",
+				},
+			}, data)
+		})
+	}
+
+	t.Run("it returns an error if api call fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantAPI := mock.NewMockTenantAPI(ctrl)
+		tenantAPI.EXPECT().
+			Read(gomock.Any()).
+			Return(nil, fmt.Errorf("failed to read tenant"))
+
+		fetcher := tenantResourceFetcher{
+			api: &auth0.API{
+				Tenant: tenantAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to read tenant")
 	})
 }
 
@@ -1372,11 +2200,22 @@ func TestTriggerActionsResourceFetcher_FetchData(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 		actionAPI := mock.NewMockActionAPI(ctrl)
+		actionAPI.EXPECT().
+			Triggers(gomock.Any()).
+			Return(&management.ActionTriggerList{
+				Triggers: []*management.ActionTrigger{
+					{ID: auth0.String("post-login"), Version: auth0.String("v1"), Status: auth0.String("DEPRECATED")},
+					{ID: auth0.String("post-login"), Version: auth0.String("v3"), Status: auth0.String("CURRENT")},
+					{ID: auth0.String("credentials-exchange"), Version: auth0.String("v2"), Status: auth0.String("CURRENT")},
+					{ID: auth0.String("pre-user-registration"), Version: auth0.String("v2"), Status: auth0.String("CURRENT")},
+					{ID: auth0.String("custom-token-exchange"), Version: auth0.String("v1"), Status: auth0.String("CURRENT")},
+				},
+			}, nil)
 
-		for _, trigger := range []string{"post-login", "credentials-exchange", "pre-user-registration", "post-user-registration", "post-change-password", "send-phone-message", "password-reset-post-challenge", "iga-approval", "iga-certification", "iga-fulfillment-assignment", "iga-fulfillment-execution"} {
+		for _, trigger := range []string{"post-login", "credentials-exchange", "pre-user-registration", "custom-token-exchange"} {
 			bindings := []*management.ActionBinding{}
 
-			if trigger == "pre-user-registration" {
+			if trigger == "pre-user-registration" || trigger == "custom-token-exchange" {
 				bindings = []*management.ActionBinding{
 					{
 						ID: auth0.String("action1"),
@@ -1388,7 +2227,7 @@ func TestTriggerActionsResourceFetcher_FetchData(t *testing.T) {
 			}
 
 			actionAPI.EXPECT().
-				Bindings(gomock.Any(), gomock.Any()).
+				Bindings(gomock.Any(), trigger).
 				Return(
 					&management.ActionBindingList{
 						Bindings: bindings,
@@ -1407,6 +2246,12 @@ func TestTriggerActionsResourceFetcher_FetchData(t *testing.T) {
 			{
 				ResourceName: "auth0_trigger_actions.pre_user_registration",
 				ImportID:     "pre-user-registration",
+				DisplayName:  "pre-user-registration",
+			},
+			{
+				ResourceName: "auth0_trigger_actions.custom_token_exchange",
+				ImportID:     "custom-token-exchange",
+				DisplayName:  "custom-token-exchange",
 			},
 		}
 
@@ -1420,8 +2265,34 @@ func TestTriggerActionsResourceFetcher_FetchData(t *testing.T) {
 		defer ctrl.Finish()
 
 		actionAPI := mock.NewMockActionAPI(ctrl)
+		actionAPI.EXPECT().
+			Triggers(gomock.Any()).
+			Return(&management.ActionTriggerList{
+				Triggers: []*management.ActionTrigger{
+					{ID: auth0.String("post-login"), Status: auth0.String("CURRENT")},
+				},
+			}, nil)
 		actionAPI.EXPECT().
 			Bindings(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("failed to list action bindings"))
+
+		fetcher := triggerActionsResourceFetcher{
+			api: &auth0.API{
+				Action: actionAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to list action bindings")
+	})
+
+	t.Run("it returns an error if listing the triggers fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		actionAPI := mock.NewMockActionAPI(ctrl)
+		actionAPI.EXPECT().
+			Triggers(gomock.Any()).
 			Return(nil, fmt.Errorf("failed to list action triggers"))
 
 		fetcher := triggerActionsResourceFetcher{
@@ -1434,3 +2305,177 @@ func TestTriggerActionsResourceFetcher_FetchData(t *testing.T) {
 		assert.EqualError(t, err, "failed to list action triggers")
 	})
 }
+
+func TestSingletonImportID(t *testing.T) {
+	assert.Equal(t, singletonImportID("auth0_tenant.tenant"), singletonImportID("auth0_tenant.tenant"))
+	assert.NotEqual(t, singletonImportID("auth0_tenant.tenant"), singletonImportID("auth0_branding.branding"))
+}
+
+func TestHookResourceFetcher_FetchData(t *testing.T) {
+	t.Run("it successfully retrieves hooks data", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		hookAPI := mock.NewMockHookAPI(ctrl)
+		hookAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(
+				&management.HookList{
+					Hooks: []*management.Hook{
+						{
+							ID:        auth0.String("01GZ6B5D0FVWWX4RBSHXTQ8TQ5"),
+							Name:      auth0.String("Pre Registration"),
+							TriggerID: auth0.String("pre-user-registration"),
+							Enabled:   auth0.Bool(true),
+						},
+						{
+							ID:        auth0.String("01GZ6B5D0FVWWX4RBSHXTQ8TQ6"),
+							Name:      auth0.String("Client Credentials"),
+							TriggerID: auth0.String("credentials-exchange"),
+							Enabled:   auth0.Bool(false),
+						},
+					},
+				},
+				nil,
+			)
+
+		fetcher := hookResourceFetcher{
+			api: &auth0.API{
+				Hook: hookAPI,
+			},
+		}
+
+		expectedData := importDataList{
+			{
+				ResourceName: "auth0_hook.pre_registration",
+				ImportID:     "01GZ6B5D0FVWWX4RBSHXTQ8TQ5",
+				DisplayName:  "Pre Registration",
+				Notes:        []string{"Trigger: pre-user-registration", "Deprecated: migrate to Actions"},
+			},
+			{
+				ResourceName: "auth0_hook.client_credentials",
+				ImportID:     "01GZ6B5D0FVWWX4RBSHXTQ8TQ6",
+				DisplayName:  "Client Credentials",
+				Notes:        []string{"Trigger: credentials-exchange", "Status: disabled", "Deprecated: migrate to Actions"},
+			},
+		}
+
+		data, err := fetcher.FetchData(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedData, data)
+	})
+
+	t.Run("it returns an error if api call fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		hookAPI := mock.NewMockHookAPI(ctrl)
+		hookAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("failed to list hooks"))
+
+		fetcher := hookResourceFetcher{
+			api: &auth0.API{
+				Hook: hookAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to list hooks")
+	})
+}
+
+func TestRuleResourceFetcher_FetchData(t *testing.T) {
+	t.Run("it successfully retrieves rules and rule configs data", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ruleAPI := mock.NewMockRuleAPI(ctrl)
+		ruleAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(
+				&management.RuleList{
+					Rules: []*management.Rule{
+						{
+							ID:      auth0.String("rul_1"),
+							Name:    auth0.String("Add Roles"),
+							Order:   auth0.Int(1),
+							Enabled: auth0.Bool(true),
+						},
+						{
+							ID:      auth0.String("rul_2"),
+							Name:    auth0.String("Deny Logins"),
+							Order:   auth0.Int(2),
+							Enabled: auth0.Bool(false),
+						},
+					},
+				},
+				nil,
+			)
+
+		ruleConfigAPI := mock.NewMockRuleConfigAPI(ctrl)
+		ruleConfigAPI.EXPECT().
+			List(gomock.Any()).
+			Return([]*management.RuleConfig{{Key: auth0.String("API_KEY")}}, nil)
+
+		fetcher := ruleResourceFetcher{
+			api: &auth0.API{
+				Rule:       ruleAPI,
+				RuleConfig: ruleConfigAPI,
+			},
+		}
+
+		expectedData := importDataList{
+			{
+				ResourceName: "auth0_rule.add_roles",
+				ImportID:     "rul_1",
+				DisplayName:  "Add Roles",
+				ManagePath:   "rules/rul_1",
+				Notes:        []string{"Order: 1", "Deprecated: migrate to Actions"},
+			},
+			{
+				ResourceName: "auth0_rule.deny_logins",
+				ImportID:     "rul_2",
+				DisplayName:  "Deny Logins",
+				ManagePath:   "rules/rul_2",
+				Notes:        []string{"Order: 2", "Status: disabled", "Deprecated: migrate to Actions"},
+			},
+			{
+				ResourceName: "auth0_rule_config.api_key",
+				ImportID:     "API_KEY",
+				DisplayName:  "API_KEY",
+				ManagePath:   "rules",
+				Notes:        []string{"The value isn't exported, set it in the generated config", "Deprecated: migrate to Actions"},
+			},
+		}
+
+		data, err := fetcher.FetchData(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedData, data)
+	})
+
+	t.Run("it returns an error if listing the rule configs fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ruleAPI := mock.NewMockRuleAPI(ctrl)
+		ruleAPI.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(&management.RuleList{}, nil)
+
+		ruleConfigAPI := mock.NewMockRuleConfigAPI(ctrl)
+		ruleConfigAPI.EXPECT().
+			List(gomock.Any()).
+			Return(nil, fmt.Errorf("insufficient scope"))
+
+		fetcher := ruleResourceFetcher{
+			api: &auth0.API{
+				Rule:       ruleAPI,
+				RuleConfig: ruleConfigAPI,
+			},
+		}
+
+		_, err := fetcher.FetchData(context.Background())
+		assert.EqualError(t, err, "failed to list the rule configs: insufficient scope")
+	})
+}
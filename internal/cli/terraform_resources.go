@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func terraformResourcesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resources",
+		Args:  cobra.NoArgs,
+		Short: "List the resource types terraform config can be generated for",
+		Long: "List the resource types `auth0 terraform generate` can generate config for, whether they're " +
+			"generated by default, and approximately how many resources of each type the tenant has.\n\n" +
+			"Use it to decide which resource types to pass to `auth0 terraform generate --resources`.",
+		Example: `  auth0 terraform resources
+  auth0 tf resources --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resourceTypes []*display.TerraformResourceType
+			if err := ansi.Spinner("Counting the resources of the tenant", func() (err error) {
+				resourceTypes, err = countTerraformResources(cmd.Context(), cli.api)
+				return err
+			}); err != nil {
+				return err
+			}
+
+			var uncounted []string
+			for _, resourceType := range resourceTypes {
+				if resourceType.Count == nil {
+					uncounted = append(uncounted, resourceType.Type)
+				}
+			}
+
+			cli.renderer.TerraformResourceTypeList(resourceTypes)
+
+			if len(uncounted) > 0 {
+				cli.renderer.Warnf(
+					"Failed to count the resources of the types %s, check that the CLI is granted the scopes to read them.",
+					strings.Join(uncounted, ", "),
+				)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+// countTerraformResources counts the resources of each supported resource type by fetching
+// their import data. Resource types sharing the same factory only get fetched once, and the
+// ones whose data fails to get fetched are left uncounted.
+func countTerraformResources(ctx context.Context, api *auth0.API) ([]*display.TerraformResourceType, error) {
+	resourceTypes := make([]string, 0, len(resourceFetcherFactories))
+	for resourceType := range resourceFetcherFactories {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	type fetchResult struct {
+		data importDataList
+		err  error
+	}
+	results := make(map[string]fetchResult)
+
+	var list []*display.TerraformResourceType
+	for _, resourceType := range resourceTypes {
+		factory := resourceFetcherFactories[resourceType]
+
+		key := resourceFetcherGroup(resourceType)
+		result, ok := results[key]
+		if !ok {
+			result.data, result.err = factory(api, &terraformInputs{}).FetchData(ctx)
+			results[key] = result
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		item := &display.TerraformResourceType{
+			Type:    resourceType,
+			Name:    resourceTypeDisplayNames[resourceType],
+			Default: containsStr(defaultResources, resourceType),
+		}
+
+		if result.err == nil {
+			count := 0
+			for _, data := range result.data {
+				if strings.HasPrefix(data.ResourceName, resourceType+".") {
+					count++
+				}
+			}
+			item.Count = &count
+		}
+
+		list = append(list, item)
+	}
+
+	return list, nil
+}
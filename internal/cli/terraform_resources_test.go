@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+type countingFetcher struct {
+	mockFetcher
+	calls int
+}
+
+func (f *countingFetcher) FetchData(ctx context.Context) (importDataList, error) {
+	f.calls++
+	return f.mockFetcher.FetchData(ctx)
+}
+
+func TestCountTerraformResources(t *testing.T) {
+	originalFactories := resourceFetcherFactories
+	t.Cleanup(func() {
+		resourceFetcherFactories = originalFactories
+	})
+
+	clientFetcher := &countingFetcher{mockFetcher: mockFetcher{mockData: importDataList{
+		{ResourceName: "auth0_client.app_1", ImportID: "client-1"},
+		{ResourceName: "auth0_client_credentials.app_1", ImportID: "client-1"},
+		{ResourceName: "auth0_client.app_2", ImportID: "client-2"},
+		{ResourceName: "auth0_client_credentials.app_2", ImportID: "client-2"},
+	}}}
+	newClientFetcher := func(*auth0.API, *terraformInputs) resourceDataFetcher {
+		return clientFetcher
+	}
+
+	resourceFetcherFactories = map[string]resourceFetcherFactory{
+		"auth0_client":             newClientFetcher,
+		"auth0_client_credentials": newClientFetcher,
+		"auth0_custom_domain": func(*auth0.API, *terraformInputs) resourceDataFetcher {
+			return &mockFetcher{mockErr: errors.New("insufficient scope")}
+		},
+		"auth0_log_stream": func(*auth0.API, *terraformInputs) resourceDataFetcher {
+			return &mockFetcher{}
+		},
+	}
+
+	resourceTypes, err := countTerraformResources(context.Background(), &auth0.API{})
+	require.NoError(t, err)
+
+	two, zero := 2, 0
+	assert.Equal(t, []*display.TerraformResourceType{
+		{Type: "auth0_client", Name: "Application", Default: true, Count: &two},
+		{Type: "auth0_client_credentials", Name: "Application Credentials", Default: false, Count: &two},
+		{Type: "auth0_custom_domain", Name: "Custom Domain", Default: true, Count: nil},
+		{Type: "auth0_log_stream", Name: "Log Stream", Default: true, Count: &zero},
+	}, resourceTypes)
+
+	assert.Equal(t, 1, clientFetcher.calls, "resource types sharing a fetcher should only fetch once")
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+var (
+	// emailProviderSecrets are the credentials of the email providers by name.
+	emailProviderSecrets = map[string][]string{
+		"azure_cs":  {"credentials.azure_cs_connection_string"},
+		"mailgun":   {"credentials.api_key"},
+		"mandrill":  {"credentials.api_key"},
+		"ms365":     {"credentials.ms365_client_secret"},
+		"sendgrid":  {"credentials.api_key"},
+		"ses":       {"credentials.access_key_id", "credentials.secret_access_key"},
+		"smtp":      {"credentials.smtp_pass"},
+		"sparkpost": {"credentials.api_key"},
+	}
+
+	// logStreamSecrets are the credentials of the log stream sinks by type.
+	logStreamSecrets = map[string][]string{
+		"datadog":  {"sink.datadog_api_key"},
+		"http":     {"sink.http_authorization"},
+		"mixpanel": {"sink.mixpanel_service_account_password"},
+		"segment":  {"sink.segment_write_key"},
+		"splunk":   {"sink.splunk_token"},
+	}
+
+	// connectionStrategiesWithClientSecret are the strategies of the social and enterprise
+	// connections authenticating to their identity provider with a client secret.
+	connectionStrategiesWithClientSecret = []string{
+		"amazon",
+		"bitbucket",
+		"box",
+		"dropbox",
+		"facebook",
+		"github",
+		"google-oauth2",
+		"linkedin",
+		"oauth2",
+		"oidc",
+		"okta",
+		"paypal",
+		"salesforce",
+		"salesforce-community",
+		"salesforce-sandbox",
+		"twitter",
+		"waad",
+		"windowslive",
+	}
+)
+
+// terraformSecret is a sensitive field that's empty in the generated
+// config, along with the variable suggested to supply it with.
+type terraformSecret struct {
+	ResourceName string
+	Field        string
+	Variable     string
+}
+
+func clientCredentialsSecrets(client *management.Client) []string {
+	switch client.GetTokenEndpointAuthMethod() {
+	case "client_secret_post", "client_secret_basic":
+		return []string{"client_secret"}
+	default:
+		return nil
+	}
+}
+
+func connectionSecrets(strategy string) []string {
+	if containsStr(connectionStrategiesWithClientSecret, strategy) {
+		return []string{"options.client_secret"}
+	}
+
+	return nil
+}
+
+// actionSecrets returns the secrets of the action, whose
+// values are never returned by the Management API.
+func actionSecrets(action *management.Action) []string {
+	var secrets []string
+	for _, secret := range action.GetSecrets() {
+		secrets = append(secrets, "secrets."+secret.GetName())
+	}
+
+	return secrets
+}
+
+// terraformSecrets returns the sensitive fields of the resources that are empty in the
+// generated config, sorted by resource name, with a variable suggested for each of them.
+func terraformSecrets(data importDataList) []terraformSecret {
+	var secrets []terraformSecret
+	for _, item := range data {
+		for _, field := range item.Secrets {
+			secrets = append(secrets, terraformSecret{
+				ResourceName: item.ResourceName,
+				Field:        field,
+				Variable:     terraformSecretVariable(item.ResourceName, field),
+			})
+		}
+	}
+
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].ResourceName < secrets[j].ResourceName
+	})
+
+	return secrets
+}
+
+// terraformSecretVariable returns the name of the variable suggested to supply the field of the resource,
+// such as "client_credentials_my_app_client_secret" for the client_secret of auth0_client_credentials.my_app.
+func terraformSecretVariable(resourceName, field string) string {
+	resourceType, name, _ := strings.Cut(resourceName, ".")
+	resourceType = strings.TrimPrefix(resourceType, "auth0_")
+
+	parts := []string{resourceType}
+	if name != resourceType {
+		parts = append(parts, name)
+	}
+
+	return sanitizeResourceName(strings.Join(append(parts, field), "_"))
+}
+
+// renderTerraformSecretsReport lists the sensitive fields that are empty in the generated config,
+// so that they get supplied before applying the config rather than found missing afterwards.
+func renderTerraformSecretsReport(cli *cli, data importDataList) {
+	secrets := terraformSecrets(data)
+	if len(secrets) == 0 {
+		return
+	}
+
+	cli.renderer.Warnf(
+		"The following %d sensitive fields are left empty in the generated config, as their values are either "+
+			"not returned by the Management API or not written by Terraform. Supply them before running "+
+			"terraform apply, such as with these variables:\n",
+		len(secrets),
+	)
+
+	for _, secret := range secrets {
+		cli.renderer.Warnf("  %s: %s", ansi.Bold(secret.ResourceName+"."+secret.Field), ansi.Cyan("var."+secret.Variable))
+	}
+
+	cli.renderer.Newline()
+	cli.renderer.Infof(
+		"The variables are declared as sensitive along with the generated config, and can be set with " +
+			"TF_VAR_<name> environment variables or a terraform.tfvars file.\n",
+	)
+}
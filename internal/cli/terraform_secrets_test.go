@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestClientCredentialsSecrets(t *testing.T) {
+	var testCases = []struct {
+		authMethod string
+		expected   []string
+	}{
+		{authMethod: "client_secret_post", expected: []string{"client_secret"}},
+		{authMethod: "client_secret_basic", expected: []string{"client_secret"}},
+		{authMethod: "none", expected: nil},
+		{authMethod: "private_key_jwt", expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.authMethod, func(t *testing.T) {
+			client := &management.Client{TokenEndpointAuthMethod: auth0.String(testCase.authMethod)}
+			assert.Equal(t, testCase.expected, clientCredentialsSecrets(client))
+		})
+	}
+}
+
+func TestActionSecrets(t *testing.T) {
+	action := &management.Action{
+		Secrets: &[]management.ActionSecret{
+			{Name: auth0.String("API_KEY")},
+			{Name: auth0.String("WEBHOOK_TOKEN")},
+		},
+	}
+
+	assert.Equal(t, []string{"secrets.API_KEY", "secrets.WEBHOOK_TOKEN"}, actionSecrets(action))
+	assert.Empty(t, actionSecrets(&management.Action{}))
+}
+
+func TestTerraformSecrets(t *testing.T) {
+	data := importDataList{
+		{ResourceName: "auth0_email_provider.email_provider", Secrets: []string{"credentials.smtp_pass"}},
+		{ResourceName: "auth0_client.my_app"},
+		{ResourceName: "auth0_client_credentials.my_app", Secrets: []string{"client_secret"}},
+		{ResourceName: "auth0_action.my_action", Secrets: []string{"secrets.API_KEY"}},
+	}
+
+	expected := []terraformSecret{
+		{
+			ResourceName: "auth0_action.my_action",
+			Field:        "secrets.API_KEY",
+			Variable:     "action_my_action_secrets_api_key",
+		},
+		{
+			ResourceName: "auth0_client_credentials.my_app",
+			Field:        "client_secret",
+			Variable:     "client_credentials_my_app_client_secret",
+		},
+		{
+			ResourceName: "auth0_email_provider.email_provider",
+			Field:        "credentials.smtp_pass",
+			Variable:     "email_provider_credentials_smtp_pass",
+		},
+	}
+
+	assert.Equal(t, expected, terraformSecrets(data))
+}
+
+func TestRenderTerraformSecretsReport(t *testing.T) {
+	t.Run("it lists the sensitive fields along with their variables", func(t *testing.T) {
+		message := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: message, ResultWriter: &bytes.Buffer{}}}
+
+		renderTerraformSecretsReport(cli, importDataList{
+			{ResourceName: "auth0_log_stream.http_logs", Secrets: []string{"sink.http_authorization"}},
+		})
+
+		assert.Contains(t, message.String(), "The following 1 sensitive fields are left empty")
+		assert.Contains(t, message.String(), "auth0_log_stream.http_logs.sink.http_authorization: var.log_stream_http_logs_sink_http_authorization")
+		assert.Contains(t, message.String(), "TF_VAR_<name>")
+	})
+
+	t.Run("it renders nothing without sensitive fields", func(t *testing.T) {
+		message := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: message, ResultWriter: &bytes.Buffer{}}}
+
+		renderTerraformSecretsReport(cli, importDataList{{ResourceName: "auth0_client.my_app"}})
+
+		assert.Empty(t, message.String())
+	})
+}
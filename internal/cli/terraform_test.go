@@ -5,11 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"testing"
-	"text/template"
 
+	"github.com/hashicorp/go-version"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,6 +67,34 @@ func TestFetchImportData(t *testing.T) {
 		assert.Equal(t, expectedData, data)
 	})
 
+	t.Run("it sorts the resources by type then name regardless of the order they're fetched in", func(t *testing.T) {
+		mockData1 := importDataList{
+			{ResourceName: "auth0_client.zeta", ImportID: "client-2"},
+			{ResourceName: "auth0_action.same", ImportID: "action-2"},
+		}
+		mockData2 := importDataList{
+			{ResourceName: "auth0_action.same", ImportID: "action-1"},
+			{ResourceName: "auth0_client.alpha", ImportID: "client-1"},
+			{ResourceName: "auth0_client_grant.alpha", ImportID: "grant-1"},
+		}
+
+		expectedData := importDataList{
+			{ResourceName: "auth0_action.same", ImportID: "action-1"},
+			{ResourceName: "auth0_action.same_2", ImportID: "action-2"},
+			{ResourceName: "auth0_client.alpha", ImportID: "client-1"},
+			{ResourceName: "auth0_client.zeta", ImportID: "client-2"},
+			{ResourceName: "auth0_client_grant.alpha", ImportID: "grant-1"},
+		}
+
+		data, err := fetchImportData(context.Background(), &mockFetcher{mockData: mockData1}, &mockFetcher{mockData: mockData2})
+		assert.NoError(t, err)
+		assert.Equal(t, expectedData, data)
+
+		data, err = fetchImportData(context.Background(), &mockFetcher{mockData: mockData2}, &mockFetcher{mockData: mockData1})
+		assert.NoError(t, err)
+		assert.Equal(t, expectedData, data)
+	})
+
 	t.Run("it returns an error when a data fetcher fails", func(t *testing.T) {
 		expectedErr := errors.New("failed to list clients")
 		mockFetchers := []resourceDataFetcher{
@@ -77,15 +106,193 @@ func TestFetchImportData(t *testing.T) {
 	})
 }
 
+const testManageTenantURL = "https://manage.auth0.com/dashboard/us/my-tenant/"
+
+var testTerraformMainConfig = terraformMainConfig{
+	terraform: version.Must(version.NewVersion(defaultTerraformVersion)),
+	provider:  defaultProviderVersion,
+}
+
+func TestTerraformInputs_ParseMainConfig(t *testing.T) {
+	t.Run("it parses the default mainConfig", func(t *testing.T) {
+		inputs := terraformInputs{TerraformVersion: defaultTerraformVersion, ProviderVersion: defaultProviderVersion}
+
+		mainConfig, err := inputs.parseMainConfig()
+		require.NoError(t, err)
+		assert.Equal(t, testTerraformMainConfig, mainConfig)
+	})
+
+	t.Run("it parses custom mainConfig", func(t *testing.T) {
+		inputs := terraformInputs{TerraformVersion: "v1.7.3", ProviderVersion: "~> 1.2"}
+
+		mainConfig, err := inputs.parseMainConfig()
+		require.NoError(t, err)
+		assert.Equal(t, "1.7.3", mainConfig.terraform.String())
+		assert.Equal(t, "~> 1.2", mainConfig.provider)
+	})
+
+	t.Run("it parses a backend along with its settings", func(t *testing.T) {
+		inputs := terraformInputs{
+			TerraformVersion: defaultTerraformVersion,
+			ProviderVersion:  defaultProviderVersion,
+			Backend:          "remote",
+			BackendConfig:    map[string]string{"organization": "my-org", "workspaces.name": "auth0"},
+		}
+
+		mainConfig, err := inputs.parseMainConfig()
+		require.NoError(t, err)
+		assert.Equal(t, "remote", mainConfig.backend)
+		assert.Equal(t, inputs.BackendConfig, mainConfig.backendConfig)
+	})
+
+	var testCases = []struct {
+		name             string
+		terraformVersion string
+		providerVersion  string
+		backend          string
+		backendConfig    map[string]string
+		expectedError    string
+	}{
+		{
+			name:             "it fails with an invalid Terraform version",
+			terraformVersion: "latest",
+			providerVersion:  defaultProviderVersion,
+			expectedError:    `invalid Terraform version "latest"`,
+		},
+		{
+			name:             "it fails with a Terraform version not supporting import blocks",
+			terraformVersion: "1.4.6",
+			providerVersion:  defaultProviderVersion,
+			expectedError:    `invalid Terraform version "1.4.6": the generated import blocks require Terraform 1.5.0 or later`,
+		},
+		{
+			name:             "it fails with an invalid provider version",
+			terraformVersion: defaultTerraformVersion,
+			providerVersion:  "~> one",
+			expectedError:    `invalid provider version "~> one"`,
+		},
+		{
+			name:             "it fails with an unsupported backend",
+			terraformVersion: defaultTerraformVersion,
+			providerVersion:  defaultProviderVersion,
+			backend:          "consul",
+			expectedError:    `invalid backend "consul": it must be one of s3, azurerm, gcs, remote`,
+		},
+		{
+			name:             "it fails with backend settings without a backend",
+			terraformVersion: defaultTerraformVersion,
+			providerVersion:  defaultProviderVersion,
+			backendConfig:    map[string]string{"bucket": "my-state"},
+			expectedError:    "the backend settings require a backend, set it with --backend",
+		},
+		{
+			name:             "it fails with an invalid backend setting",
+			terraformVersion: defaultTerraformVersion,
+			providerVersion:  defaultProviderVersion,
+			backend:          "remote",
+			backendConfig:    map[string]string{"workspaces.name.prefix": "auth0"},
+			expectedError:    `invalid backend setting "workspaces.name.prefix"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			inputs := terraformInputs{
+				TerraformVersion: testCase.terraformVersion,
+				ProviderVersion:  testCase.providerVersion,
+				Backend:          testCase.backend,
+				BackendConfig:    testCase.backendConfig,
+			}
+
+			_, err := inputs.parseMainConfig()
+			assert.ErrorContains(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestWriteMainConfig(t *testing.T) {
+	mainConfig := terraformMainConfig{
+		terraform: version.Must(version.NewVersion("1.7.3")),
+		provider:  "~> 1.2",
+	}
+
+	var config bytes.Buffer
+	err := writeMainConfig(&config, mainConfig)
+	require.NoError(t, err)
+
+	assert.Contains(t, config.String(), `required_version = "~> 1.7.3"`)
+	assert.Contains(t, config.String(), `version = "~> 1.2"`)
+	assert.NotContains(t, config.String(), "backend")
+}
+
+func TestWriteMainConfig_Backend(t *testing.T) {
+	mainConfig := terraformMainConfig{
+		terraform: version.Must(version.NewVersion("1.7.3")),
+		provider:  "~> 1.2",
+		backend:   "remote",
+		backendConfig: map[string]string{
+			"organization":    "my-org",
+			"hostname":        "app.terraform.io",
+			"workspaces.name": "auth0-${env}",
+		},
+	}
+
+	var config bytes.Buffer
+	err := writeMainConfig(&config, mainConfig)
+	require.NoError(t, err)
+
+	expectedConfig := `terraform {
+  required_version = "~> 1.7.3"
+  backend "remote" {
+    hostname     = "app.terraform.io"
+    organization = "my-org"
+    workspaces {
+      name = "auth0-$${env}"
+    }
+  }
+  required_providers {
+`
+	assert.Contains(t, config.String(), expectedConfig)
+}
+
 func TestGenerateTerraformImportConfig(t *testing.T) {
 	t.Run("it can correctly generate the terraform config files", func(t *testing.T) {
 		outputDIR, importData := setupTestDIRAndImportData(t)
 
-		err := generateTerraformImportConfig(outputDIR, importData)
+		err := generateTerraformImportConfig(outputDIR, importData, testManageTenantURL, false, testTerraformMainConfig)
+		require.NoError(t, err)
+
+		assertTerraformMainFileWasGeneratedCorrectly(t, outputDIR)
+		assertTerraformImportFileWasGeneratedCorrectly(t, outputDIR)
+
+		for _, fileName := range []string{"auth0_variables.tf", "terraform.tfvars.example"} {
+			_, err = os.Stat(path.Join(outputDIR, fileName))
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("it can split the import blocks into a file per resource type", func(t *testing.T) {
+		outputDIR, importData := setupTestDIRAndImportData(t)
+
+		err := generateTerraformImportConfig(outputDIR, importData, testManageTenantURL, true, testTerraformMainConfig)
 		require.NoError(t, err)
 
 		assertTerraformMainFileWasGeneratedCorrectly(t, outputDIR)
-		assertTerraformImportFileWasGeneratedCorrectly(t, outputDIR, importData)
+
+		_, err = os.Stat(path.Join(outputDIR, "auth0_import.tf"))
+		assert.True(t, os.IsNotExist(err))
+
+		clientImports, err := os.ReadFile(path.Join(outputDIR, "auth0_client_import.tf"))
+		require.NoError(t, err)
+		assert.Contains(t, string(clientImports), `id = "clientID_1"`)
+		assert.Contains(t, string(clientImports), `id = "clientID_2"`)
+		assert.NotContains(t, string(clientImports), "actionID")
+
+		actionImports, err := os.ReadFile(path.Join(outputDIR, "auth0_action_import.tf"))
+		require.NoError(t, err)
+		assert.Contains(t, string(actionImports), `id = "actionID_1"`)
+		assert.Contains(t, string(actionImports), `id = "actionID_2"`)
+		assert.NotContains(t, string(actionImports), "clientID")
 	})
 
 	t.Run("it can correctly generate the terraform main config file even if the dir exists", func(t *testing.T) {
@@ -94,24 +301,24 @@ func TestGenerateTerraformImportConfig(t *testing.T) {
 		err := os.MkdirAll(outputDIR, 0755)
 		require.NoError(t, err)
 
-		err = generateTerraformImportConfig(outputDIR, importData)
+		err = generateTerraformImportConfig(outputDIR, importData, testManageTenantURL, false, testTerraformMainConfig)
 		require.NoError(t, err)
 
 		assertTerraformMainFileWasGeneratedCorrectly(t, outputDIR)
-		assertTerraformImportFileWasGeneratedCorrectly(t, outputDIR, importData)
+		assertTerraformImportFileWasGeneratedCorrectly(t, outputDIR)
 	})
 
 	t.Run("it fails to generate the terraform config files if there's no import data", func(t *testing.T) {
 		outputDIR, _ := setupTestDIRAndImportData(t)
 
-		err := generateTerraformImportConfig(outputDIR, importDataList{})
+		err := generateTerraformImportConfig(outputDIR, importDataList{}, testManageTenantURL, false, testTerraformMainConfig)
 		assert.EqualError(t, err, "no import data available")
 	})
 
 	t.Run("it fails to create the directory if path is empty", func(t *testing.T) {
 		_, importData := setupTestDIRAndImportData(t)
 
-		err := generateTerraformImportConfig("", importData)
+		err := generateTerraformImportConfig("", importData, testManageTenantURL, false, testTerraformMainConfig)
 		assert.EqualError(t, err, "mkdir : no such file or directory")
 	})
 
@@ -128,7 +335,7 @@ func TestGenerateTerraformImportConfig(t *testing.T) {
 		err = os.Chmod(mainFilePath, 0444)
 		require.NoError(t, err)
 
-		err = generateTerraformImportConfig(outputDIR, importData)
+		err = generateTerraformImportConfig(outputDIR, importData, testManageTenantURL, false, testTerraformMainConfig)
 		assert.EqualError(t, err, fmt.Sprintf("open %s: permission denied", mainFilePath))
 	})
 
@@ -145,7 +352,7 @@ func TestGenerateTerraformImportConfig(t *testing.T) {
 		err = os.Chmod(importFilePath, 0444)
 		require.NoError(t, err)
 
-		err = generateTerraformImportConfig(outputDIR, importData)
+		err = generateTerraformImportConfig(outputDIR, importData, testManageTenantURL, false, testTerraformMainConfig)
 		assert.EqualError(t, err, fmt.Sprintf("open %s: permission denied", importFilePath))
 	})
 }
@@ -164,6 +371,8 @@ func setupTestDIRAndImportData(t *testing.T) (string, importDataList) {
 		{
 			ResourceName: "auth0_client.MyTestClient1",
 			ImportID:     "clientID_1",
+			DisplayName:  "My Test Client 1",
+			ManagePath:   "applications/clientID_1/settings",
 		},
 		{
 			ResourceName: "auth0_client.MyTestClient2",
@@ -203,7 +412,10 @@ func assertTerraformMainFileWasGeneratedCorrectly(t *testing.T, outputDIR string
 }
 
 provider "auth0" {
-  debug = true
+  domain        = var.auth0_domain
+  client_id     = var.auth0_client_id
+  client_secret = var.auth0_client_secret
+  debug         = true
 }
 `
 	// Read the file content and check if it matches the expected content.
@@ -212,7 +424,7 @@ provider "auth0" {
 	assert.Equal(t, expectedContent, string(content))
 }
 
-func assertTerraformImportFileWasGeneratedCorrectly(t *testing.T, outputDIR string, data importDataList) {
+func assertTerraformImportFileWasGeneratedCorrectly(t *testing.T, outputDIR string) {
 	// Assert that the directory was created.
 	_, err := os.Stat(outputDIR)
 	assert.NoError(t, err)
@@ -222,28 +434,79 @@ func assertTerraformImportFileWasGeneratedCorrectly(t *testing.T, outputDIR stri
 	_, err = os.Stat(filePath)
 	assert.NoError(t, err)
 
-	contentTemplate := `# This file is automatically generated via the Auth0 CLI.
+	expectedContent := `# This file is automatically generated via the Auth0 CLI.
 # It can be safely removed after the successful generation
 # of Terraform resource definition files.
-{{range .}}
+
+# Application: My Test Client 1
+# https://manage.auth0.com/dashboard/us/my-tenant/applications/clientID_1/settings
 import {
-  id = "{{ .ImportID }}"
-  to = {{ .ResourceName }}
+  id = "clientID_1"
+  to = auth0_client.MyTestClient1
 }
-{{end}}
-`
 
-	tmpl, err := template.New("terraform").Parse(contentTemplate)
-	require.NoError(t, err)
+# Application
+import {
+  id = "clientID_2"
+  to = auth0_client.MyTestClient2
+}
 
-	var expectedContent bytes.Buffer
-	err = tmpl.Execute(&expectedContent, data)
-	require.NoError(t, err)
+# Action
+import {
+  id = "actionID_1"
+  to = auth0_action.MyTestAction
+}
+
+# Action
+import {
+  id = "actionID_2"
+  to = auth0_action.MyTestAction
+}
+
+`
 
 	// Read the file content and check if it matches the expected content.
 	content, err := os.ReadFile(filePath)
 	assert.NoError(t, err)
-	assert.Equal(t, expectedContent.String(), string(content))
+	assert.Equal(t, expectedContent, string(content))
+}
+
+func TestGenerateTerraformConfigToStdout(t *testing.T) {
+	t.Run("it writes the main and import config to stdout without provider credentials", func(t *testing.T) {
+		t.Setenv("AUTH0_DOMAIN", "")
+		t.Setenv("AUTH0_API_TOKEN", "")
+		t.Setenv("HOME", t.TempDir())
+
+		configDIR := path.Join(os.Getenv("HOME"), ".config", "auth0")
+		require.NoError(t, os.MkdirAll(configDIR, 0700))
+		require.NoError(t, os.WriteFile(
+			path.Join(configDIR, "config.json"),
+			[]byte(`{"default_tenant": "travel0.us.auth0.com", "tenants": {"travel0.us.auth0.com": {"domain": "travel0.us.auth0.com"}}}`),
+			0600,
+		))
+
+		stdout := &bytes.Buffer{}
+		cli := &cli{
+			tenant: "travel0.us.auth0.com",
+			renderer: &display.Renderer{
+				MessageWriter: io.Discard,
+				ResultWriter:  stdout,
+			},
+		}
+
+		data := importDataList{{ResourceName: "auth0_client.my_app", ImportID: "client-id"}}
+
+		err := generateTerraformConfigToStdout(context.Background(), cli, data, "", testTerraformMainConfig)
+		require.NoError(t, err)
+
+		assert.Contains(t, stdout.String(), `provider "auth0" {`)
+		assert.Contains(t, stdout.String(), "import {\n  id = \"client-id\"\n  to = auth0_client.my_app\n}")
+	})
+
+	t.Run("it fails if there's no import data", func(t *testing.T) {
+		err := generateTerraformConfigToStdout(context.Background(), &cli{}, importDataList{}, "", testTerraformMainConfig)
+		assert.EqualError(t, err, "no import data available")
+	})
 }
 
 func TestTerraformProviderCredentialsAreAvailable(t *testing.T) {
@@ -341,15 +604,44 @@ func TestCheckOutputDirectoryIsEmpty(t *testing.T) {
 	t.Run("it returns true if the directory is empty", func(t *testing.T) {
 		tempDIR := t.TempDir()
 
-		isEmpty := checkOutputDirectoryIsEmpty(&cli{}, &cobra.Command{}, tempDIR)
+		isEmpty := checkOutputDirectoryIsEmpty(&cli{}, &cobra.Command{}, tempDIR, false)
 		assert.True(t, isEmpty)
 	})
 
 	t.Run("it returns true if the directory doesn't exist", func(t *testing.T) {
-		isEmpty := checkOutputDirectoryIsEmpty(&cli{}, &cobra.Command{}, "")
+		isEmpty := checkOutputDirectoryIsEmpty(&cli{}, &cobra.Command{}, "", false)
 		assert.True(t, isEmpty)
 	})
 
+	t.Run("it returns true if the directory only holds unrelated files", func(t *testing.T) {
+		tempDIR := t.TempDir()
+		_, err := os.Create(path.Join(tempDIR, "main.tf"))
+		require.NoError(t, err)
+
+		isEmpty := checkOutputDirectoryIsEmpty(&cli{}, &cobra.Command{}, tempDIR, false)
+		assert.True(t, isEmpty)
+	})
+
+	t.Run("it warns about the split import files of a previous run", func(t *testing.T) {
+		tempDIR := t.TempDir()
+		_, err := os.Create(path.Join(tempDIR, "auth0_client_import.tf"))
+		require.NoError(t, err)
+
+		stdout := &bytes.Buffer{}
+		cli := &cli{
+			renderer: &display.Renderer{
+				MessageWriter: stdout,
+				ResultWriter:  stdout,
+			},
+			force:   true,
+			noInput: true,
+		}
+
+		isEmpty := checkOutputDirectoryIsEmpty(cli, &cobra.Command{}, tempDIR, false)
+		assert.True(t, isEmpty)
+		assert.Contains(t, stdout.String(), "is not empty")
+	})
+
 	t.Run("it returns true if the directory is not empty but we're forcing the command", func(t *testing.T) {
 		tempDIR := t.TempDir()
 		files := []string{"auth0_main.tf", "auth0_import.tf", "auth0_generated.tf"}
@@ -370,9 +662,9 @@ func TestCheckOutputDirectoryIsEmpty(t *testing.T) {
 			noInput: true,
 		}
 
-		isEmpty := checkOutputDirectoryIsEmpty(cli, &cobra.Command{}, tempDIR)
+		isEmpty := checkOutputDirectoryIsEmpty(cli, &cobra.Command{}, tempDIR, false)
 		assert.True(t, isEmpty)
-		assert.Contains(t, stdout.String(), "Proceeding will overwrite the auth0_main.tf, auth0_import.tf and auth0_generated.tf files.")
+		assert.Contains(t, stdout.String(), "Proceeding will overwrite the auth0_main.tf, auth0_variables.tf, auth0_import.tf, auth0_generated.tf and terraform.tfvars.example files.")
 	})
 }
 
@@ -397,6 +689,23 @@ func TestCleanOutputDirectory(t *testing.T) {
 		}
 	})
 
+	t.Run("it removes the split import files but leaves the other files", func(t *testing.T) {
+		tempDIR := t.TempDir()
+
+		for _, file := range []string{"auth0_client_import.tf", "auth0_action_import.tf", "main.tf"} {
+			_, err := os.Create(path.Join(tempDIR, file))
+			require.NoError(t, err)
+		}
+
+		err := cleanOutputDirectory(tempDIR)
+		assert.NoError(t, err)
+
+		entries, err := os.ReadDir(tempDIR)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "main.tf", entries[0].Name())
+	})
+
 	t.Run("it returns an error if it can't remove a file", func(t *testing.T) {
 		files := []string{"auth0_main.tf", "auth0_import.tf", "auth0_generated.tf"}
 
@@ -438,7 +747,7 @@ func TestTerraformInputs_ParseResourceFetchers(t *testing.T) {
 				Resources: []string{"auth0_client"},
 			},
 			expectedDataFetchers: []resourceDataFetcher{
-				&clientResourceFetcher{api},
+				&clientResourceFetcher{api: api},
 			},
 		},
 		{
@@ -447,8 +756,17 @@ func TestTerraformInputs_ParseResourceFetchers(t *testing.T) {
 				Resources: []string{"auth0_client", "auth0_connection"},
 			},
 			expectedDataFetchers: []resourceDataFetcher{
-				&clientResourceFetcher{api},
-				&connectionResourceFetcher{api},
+				&clientResourceFetcher{api: api},
+				&connectionResourceFetcher{api: api},
+			},
+		},
+		{
+			name: "it only creates the fetcher of resource types fetched together once: auth0_client, auth0_client_credentials",
+			input: terraformInputs{
+				Resources: []string{"auth0_client", "auth0_client_credentials", "auth0_client"},
+			},
+			expectedDataFetchers: []resourceDataFetcher{
+				&clientResourceFetcher{api: api},
 			},
 		},
 		{
@@ -489,6 +807,67 @@ func TestTerraformInputs_ParseResourceFetchers(t *testing.T) {
 	}
 }
 
+func TestRegisterResourceFetcher(t *testing.T) {
+	t.Run("it supports the registered resource types", func(t *testing.T) {
+		t.Cleanup(func() {
+			delete(resourceFetcherFactories, "auth0_early_access")
+		})
+
+		fetcher := &mockFetcher{mockData: importDataList{{ResourceName: "auth0_early_access.resource", ImportID: "ea_1"}}}
+		registerResourceFetcher(func(_ *auth0.API, _ *terraformInputs) resourceDataFetcher {
+			return fetcher
+		}, "auth0_early_access")
+
+		inputs := terraformInputs{Resources: []string{"auth0_tenant", "auth0_early_access"}}
+		api := &auth0.API{}
+		fetchers, err := inputs.parseResourceFetchers(api)
+		require.NoError(t, err)
+		assert.Equal(t, []resourceDataFetcher{&tenantResourceFetcher{api}, fetcher}, fetchers)
+	})
+
+	t.Run("it fetches the resource types registered together once", func(t *testing.T) {
+		t.Cleanup(func() {
+			delete(resourceFetcherFactories, "auth0_early_access")
+			delete(resourceFetcherFactories, "auth0_early_access_settings")
+			delete(resourceFetcherGroups, "auth0_early_access_settings")
+		})
+
+		fetcher := &mockFetcher{mockData: importDataList{{ResourceName: "auth0_early_access.resource", ImportID: "ea_1"}}}
+		registerResourceFetcher(func(_ *auth0.API, _ *terraformInputs) resourceDataFetcher {
+			return fetcher
+		}, "auth0_early_access", "auth0_early_access_settings")
+
+		inputs := terraformInputs{Resources: []string{"auth0_early_access_settings", "auth0_tenant", "auth0_early_access"}}
+		groups, err := inputs.resourceTypeGroups()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"auth0_early_access_settings", "auth0_early_access"}, {"auth0_tenant"}}, groups)
+	})
+
+	t.Run("it panics when the resource type is already supported", func(t *testing.T) {
+		assert.Panics(t, func() {
+			registerResourceFetcher(func(_ *auth0.API, _ *terraformInputs) resourceDataFetcher {
+				return &tenantResourceFetcher{}
+			}, "auth0_tenant")
+		})
+	})
+}
+
+func TestImportComments(t *testing.T) {
+	item := importDataItem{
+		ResourceName: "auth0_custom_domain.auth_travel0_com",
+		ImportID:     "cd_Nk2UlM1Vz8ncVMsO",
+		DisplayName:  "auth.travel0.com",
+		ManagePath:   "tenant/custom_domains",
+		Notes:        []string{"Status: pending_verification"},
+	}
+
+	assert.Equal(t, []string{
+		"Custom Domain: auth.travel0.com",
+		"Status: pending_verification",
+		testManageTenantURL + "tenant/custom_domains",
+	}, importComments(item, testManageTenantURL))
+}
+
 func TestSanitizeResourceName(t *testing.T) {
 	testCases := []struct {
 		input    string
@@ -535,3 +914,353 @@ func TestCheckTerraformProviderAndCLIDomainsMatch(t *testing.T) {
 		assert.Equal(t, err.Error(), "terraform provider tenant domain \"different-tenant.eu.auth0.com\" does not match current CLI tenant \"travel0.us.auth0.com\"")
 	})
 }
+
+func TestTerraformProviderEnv(t *testing.T) {
+	t.Run("it uses the credentials of the provider when they're set", func(t *testing.T) {
+		t.Setenv("AUTH0_DOMAIN", "travel0.us.auth0.com")
+		t.Setenv("AUTH0_API_TOKEN", "token")
+
+		env, ok, err := terraformProviderEnv(&cli{tenant: "travel0.us.auth0.com"})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Nil(t, env)
+	})
+
+	t.Run("it returns an error when the provider is set up for another tenant", func(t *testing.T) {
+		t.Setenv("AUTH0_DOMAIN", "different-tenant.eu.auth0.com")
+		t.Setenv("AUTH0_API_TOKEN", "token")
+
+		_, ok, err := terraformProviderEnv(&cli{tenant: "travel0.us.auth0.com"})
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("it falls back to the access token of the cli", func(t *testing.T) {
+		t.Setenv("AUTH0_DOMAIN", "")
+		t.Setenv("AUTH0_API_TOKEN", "")
+		t.Setenv("HOME", t.TempDir())
+
+		configDIR := path.Join(os.Getenv("HOME"), ".config", "auth0")
+		require.NoError(t, os.MkdirAll(configDIR, 0700))
+		require.NoError(t, os.WriteFile(
+			path.Join(configDIR, "config.json"),
+			[]byte(`{"default_tenant": "travel0.us.auth0.com", "tenants": {"travel0.us.auth0.com": {"domain": "travel0.us.auth0.com", "access_token": "cli-token"}}}`),
+			0600,
+		))
+
+		env, ok, err := terraformProviderEnv(&cli{tenant: "travel0.us.auth0.com"})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"AUTH0_DOMAIN=travel0.us.auth0.com", "AUTH0_API_TOKEN=cli-token"}, env)
+	})
+
+	t.Run("it returns false when there are no credentials at all", func(t *testing.T) {
+		t.Setenv("AUTH0_DOMAIN", "")
+		t.Setenv("AUTH0_API_TOKEN", "")
+		t.Setenv("HOME", t.TempDir())
+
+		configDIR := path.Join(os.Getenv("HOME"), ".config", "auth0")
+		require.NoError(t, os.MkdirAll(configDIR, 0700))
+		require.NoError(t, os.WriteFile(
+			path.Join(configDIR, "config.json"),
+			[]byte(`{"default_tenant": "travel0.us.auth0.com", "tenants": {"travel0.us.auth0.com": {"domain": "travel0.us.auth0.com"}}}`),
+			0600,
+		))
+
+		env, ok, err := terraformProviderEnv(&cli{tenant: "travel0.us.auth0.com"})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, env)
+	})
+
+	t.Run("it returns an error when the tenant can't be found", func(t *testing.T) {
+		t.Setenv("AUTH0_DOMAIN", "")
+		t.Setenv("AUTH0_API_TOKEN", "")
+		t.Setenv("HOME", t.TempDir())
+
+		_, ok, err := terraformProviderEnv(&cli{tenant: "travel0.us.auth0.com"})
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestExcludeImportData(t *testing.T) {
+	data := importDataList{
+		{ResourceName: "auth0_client.my_app", ImportID: "client-1", DisplayName: "My App"},
+		{ResourceName: "auth0_client.terraform_provider", ImportID: "client-2", DisplayName: "Terraform Provider"},
+		{ResourceName: "auth0_client_credentials.terraform_provider", ImportID: "client-2", DisplayName: "Terraform Provider"},
+		{ResourceName: "auth0_connection.test_users", ImportID: "con-1", DisplayName: "Test-Users"},
+		{ResourceName: "auth0_connection.customers", ImportID: "con-2", DisplayName: "Customers"},
+		{ResourceName: "auth0_prompt_custom_text.en_login", ImportID: "login::en", DisplayName: "login (en)"},
+	}
+
+	exclusions, err := parseTerraformExclusions([]string{
+		`auth0_client:"Terraform Provider"`,
+		"auth0_connection:Test-*",
+		"auth0_prompt_custom_text",
+	})
+	require.NoError(t, err)
+
+	kept, excluded := excludeImportData(data, exclusions)
+	assert.Equal(t, 3, excluded)
+	assert.Equal(t, importDataList{
+		{ResourceName: "auth0_client.my_app", ImportID: "client-1", DisplayName: "My App"},
+		{ResourceName: "auth0_client_credentials.terraform_provider", ImportID: "client-2", DisplayName: "Terraform Provider"},
+		{ResourceName: "auth0_connection.customers", ImportID: "con-2", DisplayName: "Customers"},
+	}, kept)
+
+	exclusions, err = parseTerraformExclusions([]string{"auth0_client:client-1"})
+	require.NoError(t, err)
+
+	kept, excluded = excludeImportData(data, exclusions)
+	assert.Equal(t, 1, excluded, "the resources can be excluded by ID too")
+	assert.Len(t, kept, 5)
+}
+
+func TestParseTerraformExclusions(t *testing.T) {
+	_, err := parseTerraformExclusions([]string{"auth0_unknown:name"})
+	assert.EqualError(t, err, "unsupported resource type to exclude: auth0_unknown")
+
+	_, err = parseTerraformExclusions([]string{"auth0_client:[app"})
+	assert.EqualError(t, err, `invalid pattern to exclude "auth0_client:[app": syntax error in pattern`)
+}
+
+func TestFilterImportData(t *testing.T) {
+	data := importDataList{
+		{ResourceName: "auth0_client.prod_api", ImportID: "client-1", DisplayName: "prod-api"},
+		{ResourceName: "auth0_client.test_app_1", ImportID: "client-2", DisplayName: "test-app-1"},
+		{ResourceName: "auth0_client.test_app_2", ImportID: "client-3", DisplayName: "test-app-2"},
+		{ResourceName: "auth0_connection.prod_42", ImportID: "con-1", DisplayName: "prod-42"},
+		{ResourceName: "auth0_connection.prod_users", ImportID: "con-2", DisplayName: "prod-users"},
+		{ResourceName: "auth0_tenant.tenant", ImportID: "22f4f21b-017a-319d-92e7-2291c1ca36c4", DisplayName: "tenant"},
+	}
+
+	filters, err := parseTerraformFilters([]string{
+		"client.name=prod-*",
+		"auth0_client.id='client-3'",
+		"connection.name=/^prod-[0-9]+$/",
+	})
+	require.NoError(t, err)
+
+	kept, filtered := filterImportData(data, filters)
+	assert.Equal(t, 2, filtered)
+	assert.Equal(t, importDataList{
+		{ResourceName: "auth0_client.prod_api", ImportID: "client-1", DisplayName: "prod-api"},
+		{ResourceName: "auth0_client.test_app_2", ImportID: "client-3", DisplayName: "test-app-2"},
+		{ResourceName: "auth0_connection.prod_42", ImportID: "con-1", DisplayName: "prod-42"},
+		{ResourceName: "auth0_tenant.tenant", ImportID: "22f4f21b-017a-319d-92e7-2291c1ca36c4", DisplayName: "tenant"},
+	}, kept)
+}
+
+func TestParseTerraformFilters(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		filter string
+		err    string
+	}{
+		{
+			name:   "missing pattern",
+			filter: "client.name",
+			err:    `invalid filter "client.name", use '<resource-type>.name=<pattern>' or '<resource-type>.id=<pattern>'`,
+		},
+		{
+			name:   "unsupported resource type",
+			filter: "computer.name=prod-*",
+			err:    "unsupported resource type to filter: auth0_computer",
+		},
+		{
+			name:   "unsupported field",
+			filter: "client.description=prod-*",
+			err:    `unsupported field to filter "description" by, possible values: name, id`,
+		},
+		{
+			name:   "invalid regular expression",
+			filter: "client.name=/prod-(/",
+			err:    "invalid regular expression to filter \"client.name=/prod-(/\": error parsing regexp: missing closing ): `prod-(`",
+		},
+		{
+			name:   "invalid pattern",
+			filter: "client.name=[prod",
+			err:    `invalid pattern to filter "client.name=[prod": syntax error in pattern`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := parseTerraformFilters([]string{testCase.filter})
+			assert.EqualError(t, err, testCase.err)
+		})
+	}
+}
+
+func TestFetchImportDataWithProgress(t *testing.T) {
+	fetchers := []resourceDataFetcher{
+		&mockFetcher{mockData: importDataList{
+			{ResourceName: "auth0_client.app_1", ImportID: "client-1"},
+			{ResourceName: "auth0_client.app_2", ImportID: "client-2"},
+		}},
+		&mockFetcher{mockData: importDataList{{ResourceName: "auth0_role.admin", ImportID: "rol_1"}}},
+	}
+
+	inputs := &terraformInputs{Resources: []string{"auth0_client", "auth0_role", "auth0_client_credentials"}}
+	groups, err := inputs.resourceTypeGroups()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"auth0_client", "auth0_client_credentials"}, {"auth0_role"}}, groups)
+
+	var statuses []string
+	data, err := fetchImportDataWithProgress(context.Background(), func(index, collected int) {
+		statuses = append(statuses, fetchProgressStatus(groups, index, collected))
+	}, fetchers...)
+	require.NoError(t, err)
+
+	assert.Len(t, data, 3)
+	assert.Equal(t, []string{
+		"auth0_client, auth0_client_credentials (1/2), 0 resources collected",
+		"auth0_role (2/2), 2 resources collected",
+	}, statuses)
+}
+
+func TestTerraformResourceSummaries(t *testing.T) {
+	data := importDataList{
+		{ResourceName: "auth0_client.my_app", DisplayName: "My App"},
+		{ResourceName: "auth0_connection.google", DisplayName: "google-oauth2"},
+		{ResourceName: "auth0_client.other_app", DisplayName: "Other App"},
+		{ResourceName: "auth0_tenant.tenant"},
+	}
+
+	expected := []*display.TerraformResourceSummary{
+		{Type: "auth0_client", Count: 2, Names: []string{"My App", "Other App"}},
+		{Type: "auth0_connection", Count: 1, Names: []string{"google-oauth2"}},
+		{Type: "auth0_tenant", Count: 1, Names: []string{"tenant"}},
+	}
+
+	assert.Equal(t, expected, terraformResourceSummaries(data))
+}
+
+func TestRenderTerraformDryRun(t *testing.T) {
+	data := importDataList{
+		{ResourceName: "auth0_client.my_app", DisplayName: "My App"},
+		{ResourceName: "auth0_connection.google", DisplayName: "google-oauth2"},
+	}
+
+	t.Run("it lists the resources and the files to generate", func(t *testing.T) {
+		message, result := &bytes.Buffer{}, &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: message, ResultWriter: result}}
+
+		renderTerraformDryRun(cli, data, "tmp-auth0-tf", true)
+
+		assert.Contains(t, result.String(), "auth0_client")
+		assert.Contains(t, result.String(), "My App")
+		assert.Contains(t, message.String(), "The config of 2 resources would be generated in tmp-auth0-tf")
+		assert.Contains(t, message.String(), "auth0_client_import.tf, auth0_connection_import.tf, auth0_generated.tf")
+	})
+
+	t.Run("it warns when there are no resources", func(t *testing.T) {
+		message, result := &bytes.Buffer{}, &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: message, ResultWriter: result}}
+
+		renderTerraformDryRun(cli, importDataList{}, "./", false)
+
+		assert.Empty(t, result.String())
+		assert.Contains(t, message.String(), "No resources to generate the Terraform config for")
+	})
+}
+
+func TestTerraformInputs_ValidateFormat(t *testing.T) {
+	assert.NoError(t, (&terraformInputs{Format: "hcl", SplitFiles: true}).validateFormat())
+	assert.NoError(t, (&terraformInputs{Format: "json"}).validateFormat())
+	assert.EqualError(
+		t,
+		(&terraformInputs{Format: "json", SplitFiles: true}).validateFormat(),
+		"the import blocks can only be split into files with the hcl format",
+	)
+	assert.EqualError(
+		t,
+		(&terraformInputs{Format: "yaml"}).validateFormat(),
+		`invalid format "yaml", possible values: hcl, json`,
+	)
+}
+
+func TestWriteImportDataJSON(t *testing.T) {
+	data := importDataList{
+		{ResourceName: "auth0_client.my_app", ImportID: "client-id", DisplayName: "My App"},
+		{ResourceName: "auth0_tenant.tenant", ImportID: "6e4e9e40-2c20-4f4b-9e5c-1b7c3d1a0f3e"},
+	}
+
+	t.Run("it writes the import data to the standard output", func(t *testing.T) {
+		result := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: result}}
+
+		err := writeImportDataJSON(cli, &cobra.Command{}, &terraformInputs{Stdout: true}, data)
+		require.NoError(t, err)
+
+		expected := `[
+    {
+        "resource_name": "auth0_client.my_app",
+        "import_id": "client-id",
+        "display_name": "My App"
+    },
+    {
+        "resource_name": "auth0_tenant.tenant",
+        "import_id": "6e4e9e40-2c20-4f4b-9e5c-1b7c3d1a0f3e"
+    }
+]`
+		assert.Equal(t, expected, result.String())
+	})
+
+	t.Run("it writes an empty list when there are no resources", func(t *testing.T) {
+		result := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: io.Discard, ResultWriter: result}}
+
+		err := writeImportDataJSON(cli, &cobra.Command{}, &terraformInputs{Stdout: true}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "[]", result.String())
+	})
+
+	t.Run("it writes the import data to a file", func(t *testing.T) {
+		outputDIR := t.TempDir()
+		message := &bytes.Buffer{}
+		cli := &cli{renderer: &display.Renderer{MessageWriter: message, ResultWriter: io.Discard}}
+		inputs := &terraformInputs{Output: artifactOutput{Out: outputDIR}}
+
+		err := writeImportDataJSON(cli, &cobra.Command{}, inputs, data)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(path.Join(outputDIR, "auth0_import.json"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"import_id": "client-id"`)
+		assert.Contains(t, message.String(), "The import data of 2 resources was written to")
+
+		err = writeImportDataJSON(cli, &cobra.Command{}, inputs, data)
+		assert.ErrorContains(t, err, "auth0_import.json already exists")
+	})
+}
+
+func TestGenerateTerraformCmd_OutputDirectory(t *testing.T) {
+	t.Run("it defaults to the current working directory", func(t *testing.T) {
+		cmd := generateTerraformCmd(&cli{})
+		require.NoError(t, cmd.ParseFlags([]string{}))
+
+		out, err := cmd.Flags().GetString("out")
+		require.NoError(t, err)
+		assert.Equal(t, "./", out)
+	})
+
+	t.Run("it keeps supporting the deprecated output-dir flag", func(t *testing.T) {
+		cmd := generateTerraformCmd(&cli{})
+		require.NoError(t, cmd.ParseFlags([]string{"--output-dir", "tmp-auth0-tf"}))
+
+		out, err := cmd.Flags().GetString("out")
+		require.NoError(t, err)
+		assert.Equal(t, "tmp-auth0-tf", out)
+	})
+}
+
+func TestTerraformRequiredScopes(t *testing.T) {
+	assert.Empty(t, terraformRequiredScopes(defaultResources))
+	assert.Equal(
+		t,
+		[]string{"read:rules", "read:rules_configs", "read:hooks"},
+		terraformRequiredScopes([]string{"auth0_client", "auth0_rule", "auth0_rule_config", "auth0_hook"}),
+	)
+}
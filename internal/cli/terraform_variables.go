@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// terraformVariable is a variable declared in the generated auth0_variables.tf, along with
+// the value it's given in the generated terraform.tfvars.example.
+type terraformVariable struct {
+	Name        string
+	Description string
+	Sensitive   bool
+	Example     string
+}
+
+// terraformVariables returns the variables of the credentials of the provider, followed by the
+// ones suggested to supply the sensitive fields that are left empty in the generated config.
+func terraformVariables(domain string, data importDataList) []terraformVariable {
+	variables := []terraformVariable{
+		{
+			Name:        "auth0_domain",
+			Description: "Domain of the Auth0 tenant. Defaults to the AUTH0_DOMAIN environment variable.",
+			Example:     domain,
+		},
+		{
+			Name: "auth0_client_id",
+			Description: "Client ID of the application the provider authenticates with. " +
+				"Defaults to the AUTH0_CLIENT_ID environment variable.",
+		},
+		{
+			Name: "auth0_client_secret",
+			Description: "Client secret of the application the provider authenticates with. " +
+				"Defaults to the AUTH0_CLIENT_SECRET environment variable.",
+			Sensitive: true,
+		},
+	}
+
+	declared := make(map[string]bool)
+	for _, variable := range variables {
+		declared[variable.Name] = true
+	}
+
+	for _, secret := range terraformSecrets(data) {
+		if declared[secret.Variable] {
+			continue
+		}
+		declared[secret.Variable] = true
+
+		variables = append(variables, terraformVariable{
+			Name:        secret.Variable,
+			Description: fmt.Sprintf("Value of %s.%s, left empty in the generated config.", secret.ResourceName, secret.Field),
+			Sensitive:   true,
+		})
+	}
+
+	return variables
+}
+
+// createVariablesFiles writes the variables to auth0_variables.tf, and an example
+// of their values to terraform.tfvars.example, to copy to terraform.tfvars.
+func createVariablesFiles(outputDIR string, variables []terraformVariable) error {
+	if err := createVariablesFile(path.Join(outputDIR, "auth0_variables.tf"), variables, writeVariablesConfig); err != nil {
+		return err
+	}
+
+	return createVariablesFile(path.Join(outputDIR, "terraform.tfvars.example"), variables, writeVariablesExample)
+}
+
+func createVariablesFile(
+	filePath string,
+	variables []terraformVariable,
+	write func(w io.Writer, variables []terraformVariable) error,
+) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return write(file, variables)
+}
+
+// writeVariablesConfig writes the declarations of the variables. They all default to null, which leaves
+// the arguments they're assigned to unset, so the provider falls back to its environment variables.
+func writeVariablesConfig(w io.Writer, variables []terraformVariable) error {
+	var b strings.Builder
+	for i, variable := range variables {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "variable %q {\n", variable.Name)
+		fmt.Fprintf(&b, "  description = %s\n", quoteHCLString(variable.Description))
+		b.WriteString("  type        = string\n")
+		b.WriteString("  default     = null\n")
+		if variable.Sensitive {
+			b.WriteString("  sensitive   = true\n")
+		}
+		b.WriteString("}\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeVariablesExample writes an example of the values of the variables, with
+// their equal signs aligned the way `terraform fmt` does.
+func writeVariablesExample(w io.Writer, variables []terraformVariable) error {
+	width := 0
+	for _, variable := range variables {
+		width = max(width, len(variable.Name))
+	}
+
+	var b strings.Builder
+	b.WriteString("# Copy this file to terraform.tfvars and fill in the values, or set them through TF_VAR_<name>\n")
+	b.WriteString("# environment variables. Keep terraform.tfvars out of version control, as it holds secrets.\n")
+	for _, variable := range variables {
+		fmt.Fprintf(&b, "%-*s = %s\n", width, variable.Name, strconv.Quote(variable.Example))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
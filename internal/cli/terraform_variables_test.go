@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraformVariables(t *testing.T) {
+	data := importDataList{
+		{ResourceName: "auth0_client.my_app"},
+		{ResourceName: "auth0_client_credentials.my_app", Secrets: []string{"client_secret"}},
+	}
+
+	variables := terraformVariables("travel0.us.auth0.com", data)
+
+	var names []string
+	for _, variable := range variables {
+		names = append(names, variable.Name)
+	}
+
+	assert.Equal(
+		t,
+		[]string{"auth0_domain", "auth0_client_id", "auth0_client_secret", "client_credentials_my_app_client_secret"},
+		names,
+	)
+	assert.Equal(t, "travel0.us.auth0.com", variables[0].Example)
+	assert.False(t, variables[1].Sensitive)
+	assert.True(t, variables[2].Sensitive)
+	assert.Equal(t, terraformVariable{
+		Name:        "client_credentials_my_app_client_secret",
+		Description: "Value of auth0_client_credentials.my_app.client_secret, left empty in the generated config.",
+		Sensitive:   true,
+	}, variables[3])
+}
+
+func TestWriteVariablesConfig(t *testing.T) {
+	variables := []terraformVariable{
+		{Name: "auth0_domain", Description: "Domain of the Auth0 tenant."},
+		{Name: "auth0_client_secret", Description: "Client secret.", Sensitive: true},
+	}
+
+	var config bytes.Buffer
+	err := writeVariablesConfig(&config, variables)
+	require.NoError(t, err)
+
+	expectedConfig := `variable "auth0_domain" {
+  description = "Domain of the Auth0 tenant."
+  type        = string
+  default     = null
+}
+
+variable "auth0_client_secret" {
+  description = "Client secret."
+  type        = string
+  default     = null
+  sensitive   = true
+}
+`
+	assert.Equal(t, expectedConfig, config.String())
+}
+
+func TestWriteVariablesExample(t *testing.T) {
+	variables := []terraformVariable{
+		{Name: "auth0_domain", Example: "travel0.us.auth0.com"},
+		{Name: "auth0_client_secret"},
+	}
+
+	var example bytes.Buffer
+	err := writeVariablesExample(&example, variables)
+	require.NoError(t, err)
+
+	assert.Contains(t, example.String(), "# Copy this file to terraform.tfvars")
+	assert.Contains(t, example.String(), "auth0_domain        = \"travel0.us.auth0.com\"\n")
+	assert.Contains(t, example.String(), "auth0_client_secret = \"\"\n")
+}
@@ -62,6 +62,19 @@ var (
 		Help:      "One of your custom domains.",
 	}
 
+	testPAR = Flag{
+		Name:     "Pushed Authorization Request",
+		LongForm: "par",
+		Help:     "Push the authorization request to the /oauth/par endpoint (PAR) before redirecting to it.",
+	}
+
+	testRequestObject = Flag{
+		Name:     "Request Object",
+		LongForm: "request-object",
+		Help: "Path to the PEM encoded RSA private key to sign the authorization request into a " +
+			"request object (JAR) with. The application must have the matching public key registered.",
+	}
+
 	errNoCustomDomains = errors.New("there are currently no custom domains. Create one by running: `auth0 domains create`")
 )
 
@@ -71,6 +84,9 @@ type testCmdInputs struct {
 	Scopes         []string
 	ConnectionName string
 	CustomDomain   string
+	Copy           bool
+	PAR            bool
+	RequestObject  string
 }
 
 func testCmd(cli *cli) *cobra.Command {
@@ -83,6 +99,8 @@ func testCmd(cli *cli) *cobra.Command {
 	cmd.SetUsageTemplate(resourceUsageTemplate())
 	cmd.AddCommand(testTokenCmd(cli))
 	cmd.AddCommand(testLoginCmd(cli))
+	cmd.AddCommand(testLogoutCmd(cli))
+	cmd.AddCommand(testSilentAuthCmd(cli))
 
 	return cmd
 }
@@ -94,7 +112,9 @@ func testLoginCmd(cli *cli) *cobra.Command {
 		Use:   "login",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Try out your tenant's Universal Login experience",
-		Long:  "Try out your tenant's Universal Login experience in a browser.",
+		Long: "Try out your tenant's Universal Login experience in a browser.\n\n" +
+			"Use `--par` to push the authorization request to the /oauth/par endpoint (PAR), and " +
+			"`--request-object` to sign it into a request object (JAR), to debug advanced OAuth configurations.",
 		Example: `  auth0 test login
   auth0 test login <client-id>
   auth0 test login <client-id> --connection-name <connection-name>
@@ -103,7 +123,10 @@ func testLoginCmd(cli *cli) *cobra.Command {
   auth0 test login <client-id> --connection-name <connection-name> --audience <api-identifier|api-audience> --domain <domain> --scopes <scope1,scope2>
   auth0 test login <client-id> -c <connection-name> -a <api-identifier|api-audience> -d <domain> -s <scope1,scope2> --force
   auth0 test login <client-id> -c <connection-name> -a <api-identifier|api-audience> -d <domain> -s <scope1,scope2> --json
-  auth0 test login <client-id> -c <connection-name> -a <api-identifier|api-audience> -d <domain> -s <scope1,scope2> --force --json`,
+  auth0 test login <client-id> -c <connection-name> -a <api-identifier|api-audience> -d <domain> -s <scope1,scope2> --force --json
+  auth0 test login <client-id> --par
+  auth0 test login <client-id> --request-object <private-key-file>
+  auth0 test login <client-id> --par --request-object <private-key-file>`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := selectClientToUseForTestsAndValidateExistence(cli, cmd, args, &inputs)
 			if err != nil {
@@ -119,11 +142,18 @@ func testLoginCmd(cli *cli) *cobra.Command {
 				)
 			}
 
-			err = testDomain.Pick(cmd, &inputs.CustomDomain, cli.customDomainPickerOptions)
-			if err != nil && err != errNoCustomDomains {
+			if err := pickTestDomain(cmd, cli, &inputs.CustomDomain); err != nil {
 				return err
 			}
 
+			var requestOptions loginRequestOptions
+			requestOptions.PushedAuthorizationRequest = inputs.PAR
+			if inputs.RequestObject != "" {
+				if requestOptions.RequestObjectKey, err = authutil.ReadRequestObjectKey(inputs.RequestObject); err != nil {
+					return err
+				}
+			}
+
 			if proceed := runLoginFlowPreflightChecks(cli, client); !proceed {
 				return nil
 			}
@@ -143,6 +173,7 @@ func testLoginCmd(cli *cli) *cobra.Command {
 				"login", // Force a login page when using the test login command.
 				inputs.Scopes,
 				inputs.CustomDomain,
+				requestOptions,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to log into the client with ID %q: %w", inputs.ClientID, err)
@@ -150,7 +181,7 @@ func testLoginCmd(cli *cli) *cobra.Command {
 
 			var userInfo *authutil.UserInfo
 			if err := ansi.Spinner("Fetching user metadata", func() (err error) {
-				userInfo, err = authutil.FetchUserInfo(http.DefaultClient, cli.tenant, tokenResponse.AccessToken)
+				userInfo, err = authutil.FetchUserInfo(http.DefaultClient, testDomainOrTenant(cli, inputs.CustomDomain), tokenResponse.AccessToken)
 				return err
 			}); err != nil {
 				return fmt.Errorf("failed to fetch user info: %w", err)
@@ -168,6 +199,8 @@ func testLoginCmd(cli *cli) *cobra.Command {
 	testScopes.RegisterStringSlice(cmd, &inputs.Scopes, cliLoginTestingScopes)
 	testConnectionName.RegisterString(cmd, &inputs.ConnectionName, "")
 	testDomain.RegisterString(cmd, &inputs.CustomDomain, "")
+	testPAR.RegisterBool(cmd, &inputs.PAR, false)
+	testRequestObject.RegisterString(cmd, &inputs.RequestObject, "")
 
 	return cmd
 }
@@ -181,13 +214,16 @@ func testTokenCmd(cli *cli) *cobra.Command {
 		Short: "Request an access token for a given application and API",
 		Long: "Request an access token for a given application. " +
 			"Specify the API you want this token for with `--audience` (API Identifier). " +
-			"Additionally, you can also specify the `--scopes` to grant.",
+			"Additionally, you can also specify the `--scopes` to grant, and the `--domain` " +
+			"to request the token from one of your custom domains instead of the tenant domain.",
 		Example: `  auth0 test token
   auth0 test token <client-id> --audience <api-audience|api-identifier> --scopes <scope1,scope2>
   auth0 test token <client-id> -a <api-audience|api-identifier> -s <scope1,scope2>
   auth0 test token <client-id> -a <api-audience|api-identifier> -s <scope1,scope2> --force
   auth0 test token <client-id> -a <api-audience|api-identifier> -s <scope1,scope2> --json
-  auth0 test token <client-id> -a <api-audience|api-identifier> -s <scope1,scope2> --force --json`,
+  auth0 test token <client-id> -a <api-audience|api-identifier> -s <scope1,scope2> --force --json
+  auth0 test token <client-id> -a <api-audience|api-identifier> -s <scope1,scope2> --copy
+  auth0 test token <client-id> -a <api-audience|api-identifier> -s <scope1,scope2> --domain <domain>`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := selectClientToUseForTestsAndValidateExistence(cli, cmd, args, &inputs)
 			if err != nil {
@@ -202,7 +238,13 @@ func testTokenCmd(cli *cli) *cobra.Command {
 				return err
 			}
 
-			cli.renderer.Infof("Domain    : " + ansi.Blue(cli.tenant))
+			if err := pickTestDomain(cmd, cli, &inputs.CustomDomain); err != nil {
+				return err
+			}
+
+			domain := testDomainOrTenant(cli, inputs.CustomDomain)
+
+			cli.renderer.Infof("Domain    : " + ansi.Blue(domain))
 			cli.renderer.Infof("Client ID : " + ansi.Bold(client.GetClientID()))
 			cli.renderer.Infof("Type      : " + display.ApplyColorToFriendlyAppType(display.FriendlyAppType(client.GetAppType())))
 			cli.renderer.Newline()
@@ -212,7 +254,7 @@ func testTokenCmd(cli *cli) *cobra.Command {
 					cli.renderer.Warnf("Passed in scopes do not apply to Machine to Machine applications.\n")
 				}
 
-				tokenResponse, err := runClientCredentialsFlow(cmd.Context(), cli, client, inputs.Audience, cli.tenant)
+				tokenResponse, err := runClientCredentialsFlow(cmd.Context(), cli, client, inputs.Audience, domain)
 				if err != nil {
 					return fmt.Errorf(
 						"failed to log in with client credentials for client with ID %q: %w",
@@ -221,9 +263,7 @@ func testTokenCmd(cli *cli) *cobra.Command {
 					)
 				}
 
-				cli.renderer.TestToken(client, tokenResponse)
-
-				return nil
+				return renderTestToken(cli.renderer, client, tokenResponse, inputs.Copy)
 			}
 
 			if len(inputs.Scopes) == 0 {
@@ -244,15 +284,14 @@ func testTokenCmd(cli *cli) *cobra.Command {
 				inputs.Audience,
 				"", // We don't want to force a prompt for the test token command.
 				inputs.Scopes,
-				"", // Specifying a custom domain is only supported for the test login command.
+				inputs.CustomDomain,
+				loginRequestOptions{},
 			)
 			if err != nil {
 				return fmt.Errorf("failed to log into the client with ID %q: %w", inputs.ClientID, err)
 			}
 
-			cli.renderer.TestToken(client, tokenResponse)
-
-			return nil
+			return renderTestToken(cli.renderer, client, tokenResponse, inputs.Copy)
 		},
 	}
 
@@ -261,6 +300,8 @@ func testTokenCmd(cli *cli) *cobra.Command {
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 	testAudienceRequired.RegisterString(cmd, &inputs.Audience, "")
 	testScopes.RegisterStringSlice(cmd, &inputs.Scopes, nil)
+	copySecret.RegisterBool(cmd, &inputs.Copy, false)
+	testDomain.RegisterString(cmd, &inputs.CustomDomain, "")
 
 	return cmd
 }
@@ -306,6 +347,72 @@ func selectClientToUseForTestsAndValidateExistence(cli *cli, cmd *cobra.Command,
 	return client, nil
 }
 
+// pickTestDomain picks the custom domain to test against, if any. A domain given through
+// the flag must be one of the custom domains of the tenant, and be ready to be used.
+func pickTestDomain(cmd *cobra.Command, cli *cli, domain *string) error {
+	if !testDomain.IsSet(cmd) {
+		err := testDomain.Pick(cmd, domain, cli.customDomainPickerOptions)
+		if err != nil && err != errNoCustomDomains {
+			return err
+		}
+		return nil
+	}
+
+	if *domain == "" || *domain == cli.tenant {
+		return nil
+	}
+
+	return checkCustomDomainIsReady(cmd.Context(), cli.api, *domain)
+}
+
+func checkCustomDomainIsReady(ctx context.Context, api *auth0.API, domain string) error {
+	var customDomains []*management.CustomDomain
+	if err := ansi.Waiting(func() (err error) {
+		customDomains, err = api.CustomDomain.List(ctx)
+		return err
+	}); err != nil {
+		var mErr management.Error
+		if errors.As(err, &mErr) && mErr.Status() == http.StatusForbidden {
+			return errNoCustomDomains
+		}
+
+		return fmt.Errorf("failed to list custom domains: %w", err)
+	}
+
+	for _, customDomain := range customDomains {
+		if customDomain.GetDomain() != domain {
+			continue
+		}
+
+		if customDomain.GetStatus() != "ready" {
+			return fmt.Errorf(
+				"the custom domain %q is not ready to be used, as its status is %q.\n\n"+
+					"Run: 'auth0 domains verify %s' to verify it.",
+				domain,
+				customDomain.GetStatus(),
+				customDomain.GetID(),
+			)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf(
+		"the custom domain %q is not configured for the tenant.\n\n"+
+			"Run: 'auth0 domains list' to see the custom domains of the tenant.",
+		domain,
+	)
+}
+
+// testDomainOrTenant returns the custom domain to test against, or the tenant domain if none.
+func testDomainOrTenant(cli *cli, customDomain string) string {
+	if customDomain != "" {
+		return customDomain
+	}
+
+	return cli.tenant
+}
+
 func (c *cli) customDomainPickerOptions(ctx context.Context) (pickerOptions, error) {
 	var opts pickerOptions
 
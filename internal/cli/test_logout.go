@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/pkg/browser"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth/authutil"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var testFederated = Flag{
+	Name:     "Federated",
+	LongForm: "federated",
+	Help:     "Also log the user out of the identity provider they logged in with, such as Google or an enterprise connection.",
+}
+
+func testLogoutCmd(cli *cli) *cobra.Command {
+	var inputs testCmdInputs
+	var federated bool
+
+	cmd := &cobra.Command{
+		Use:   "logout",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Try out the logout flow of an application",
+		Long: "Try out the logout flow of an application in a browser, to debug logout misconfigurations.\n\n" +
+			"This logs the browser out of the session it has with the tenant, then attempts a silent " +
+			"authentication with `prompt=none` to verify that the session is gone.\n\n" +
+			"Run `auth0 test login` first so the browser has a session to log out of.",
+		Example: `  auth0 test logout
+  auth0 test logout <client-id>
+  auth0 test logout <client-id> --federated
+  auth0 test logout <client-id> --domain <domain>
+  auth0 test logout <client-id> --federated --domain <domain> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := selectClientToUseForTestsAndValidateExistence(cli, cmd, args, &inputs)
+			if err != nil {
+				return err
+			}
+
+			if client.GetAppType() == appTypeNonInteractive {
+				return fmt.Errorf(
+					"cannot test the logout with a %s application, as it has no user sessions",
+					ansi.Bold("Machine to Machine"),
+				)
+			}
+
+			if err := pickTestDomain(cmd, cli, &inputs.CustomDomain); err != nil {
+				return err
+			}
+
+			if proceed := runLogoutFlowPreflightChecks(cli, client); !proceed {
+				return nil
+			}
+
+			sessionEnded, err := runLogoutFlow(cmd.Context(), cli, client, federated, inputs.CustomDomain)
+			if err != nil {
+				return fmt.Errorf("failed to log out of the client with ID %q: %w", inputs.ClientID, err)
+			}
+
+			cli.renderer.Newline()
+
+			if !sessionEnded {
+				cli.renderer.Warnf("The session is still active after logging out.")
+				cli.renderer.Warnf(
+					"Check that the application logs out through %s, and that it doesn't log the user back in.",
+					ansi.Bold("/v2/logout"),
+				)
+				return nil
+			}
+
+			cli.renderer.Infof("%s The session is gone, the logout flow is working!", ansi.Green("✓"))
+			if !federated {
+				cli.renderer.Infof(
+					"%s The user may still be logged in to their identity provider, run %s to log them out of it too.",
+					ansi.Faint("Hint:"),
+					fmt.Sprintf("`auth0 test logout %s --federated`", client.GetClientID()),
+				)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	testFederated.RegisterBool(cmd, &federated, false)
+	testDomain.RegisterString(cmd, &inputs.CustomDomain, "")
+
+	return cmd
+}
+
+// runLogoutFlowPreflightChecks checks if we need to make any updates
+// to the client being tested in order to log out successfully.
+// If so, it asks the user to confirm whether to proceed.
+func runLogoutFlowPreflightChecks(cli *cli, c *management.Client) (proceed bool) {
+	if !cli.noInput {
+		cli.renderer.Infof("A browser window needs to be opened to complete this client's logout flow.")
+		cli.renderer.Infof("Once logout is complete, a silent authentication checks whether the session is gone.")
+		cli.renderer.Newline()
+	}
+
+	if !hasLocalCallbackURL(c) || !hasLocalLogoutURL(c) {
+		cli.renderer.Warnf("The client you are using does not currently allow callbacks and logouts to localhost.")
+		cli.renderer.Warnf("To complete the logout flow the CLI needs to redirect to a local server and record the result.\n")
+		cli.renderer.Warnf("The client will be modified to update the allowed callback and logout URLs, we'll remove them when done.")
+		cli.renderer.Warnf("If you do not wish to modify the client, you can abort now.")
+		cli.renderer.Newline()
+	}
+
+	if !cli.force && !cli.noInput {
+		if confirmed := prompt.Confirm("Do you wish to proceed?"); !confirmed {
+			return false
+		}
+	}
+
+	cli.renderer.Newline()
+
+	return true
+}
+
+// runLogoutFlow logs the browser out of its session, then attempts
+// a silent authentication to find out whether the session ended.
+func runLogoutFlow(ctx context.Context, cli *cli, c *management.Client, federated bool, customDomain string) (sessionEnded bool, err error) {
+	domain := testDomainOrTenant(cli, customDomain)
+
+	callbackAdded, logoutURLAdded, err := addLocalURLsToClient(ctx, cli.api.Client, c)
+	if err != nil {
+		return false, err
+	}
+
+	// If we added the local URLs to the client then we need to
+	// remove them when we're done.
+	defer func() {
+		if callbackAdded || logoutURLAdded {
+			if err := removeLocalURLsFromClient(ctx, cli.api.Client, c, callbackAdded, logoutURLAdded); err != nil {
+				cli.renderer.Warnf("Failed to remove the URL '%s' from the client: %s", cliLoginTestingCallbackURL, err)
+			}
+		}
+	}()
+
+	if err := ansi.Spinner("Waiting for logout flow to complete", func() error {
+		logoutURL := authutil.BuildLogoutURL(domain, c.GetClientID(), cliLoginTestingCallbackURL, federated)
+
+		if err := openTestURL(cli, logoutURL); err != nil {
+			return err
+		}
+
+		return authutil.WaitForBrowserLogout(cliLoginTestingCallbackAddr)
+	}); err != nil {
+		return false, err
+	}
+
+	var callbackErr *authutil.CallbackError
+	if err := ansi.Spinner("Checking whether the session is gone", func() (err error) {
+		callbackErr, err = runSilentAuthFlow(cli, c, domain, "", cliLoginTestingScopes)
+		return err
+	}); err != nil {
+		return false, err
+	}
+
+	return silentAuthSessionEnded(callbackErr)
+}
+
+func openTestURL(cli *cli, testURL string) error {
+	if cli.noInput {
+		cli.renderer.Infof("Open the following URL in a browser: %s\n", testURL)
+		return nil
+	}
+
+	return browser.OpenURL(testURL)
+}
+
+// silentAuthSessionEnded interprets the result of a silent authentication. The session
+// ended when it fails with login_required, while a code or an error asking for the user
+// to interact, such as to give consent, means the session is still active.
+func silentAuthSessionEnded(callbackErr *authutil.CallbackError) (bool, error) {
+	if callbackErr == nil {
+		return false, nil
+	}
+
+	switch callbackErr.Code {
+	case "login_required":
+		return true, nil
+	case "interaction_required", "consent_required":
+		return false, nil
+	default:
+		return false, fmt.Errorf("the silent authentication failed: %w", callbackErr)
+	}
+}
+
+// check if a client is already configured with our local URL as a logout URL.
+func hasLocalLogoutURL(client *management.Client) bool {
+	return containsStr(client.GetAllowedLogoutURLs(), cliLoginTestingCallbackURL)
+}
+
+// adds the localhost URL to the callback and logout URLs of a given application,
+// and returns which of them it was added to.
+func addLocalURLsToClient(ctx context.Context, clientManager auth0.ClientAPI, client *management.Client) (callbackAdded, logoutURLAdded bool, err error) {
+	updatedClient := &management.Client{}
+
+	if !hasLocalCallbackURL(client) {
+		callbacks := append(client.GetCallbacks(), cliLoginTestingCallbackURL)
+		updatedClient.Callbacks = &callbacks
+		client.Callbacks = updatedClient.Callbacks
+		callbackAdded = true
+	}
+
+	if !hasLocalLogoutURL(client) {
+		logoutURLs := append(client.GetAllowedLogoutURLs(), cliLoginTestingCallbackURL)
+		updatedClient.AllowedLogoutURLs = &logoutURLs
+		client.AllowedLogoutURLs = updatedClient.AllowedLogoutURLs
+		logoutURLAdded = true
+	}
+
+	if !callbackAdded && !logoutURLAdded {
+		return false, false, nil
+	}
+
+	return callbackAdded, logoutURLAdded, clientManager.Update(ctx, client.GetClientID(), updatedClient)
+}
+
+func removeLocalURLsFromClient(ctx context.Context, clientManager auth0.ClientAPI, client *management.Client, callbackAdded, logoutURLAdded bool) error {
+	updatedClient := &management.Client{}
+
+	// Can't update a client to have 0 callback URLs, so leave them as they are.
+	if callbacks := removeLocalURL(client.GetCallbacks()); callbackAdded && len(callbacks) > 0 {
+		updatedClient.Callbacks = &callbacks
+	}
+
+	if logoutURLAdded {
+		logoutURLs := removeLocalURL(client.GetAllowedLogoutURLs())
+		updatedClient.AllowedLogoutURLs = &logoutURLs
+	}
+
+	if updatedClient.Callbacks == nil && updatedClient.AllowedLogoutURLs == nil {
+		return nil
+	}
+
+	return clientManager.Update(ctx, client.GetClientID(), updatedClient)
+}
+
+// removeLocalURL returns the URLs without our local URL.
+func removeLocalURL(urls []string) []string {
+	remaining := make([]string, 0)
+	for _, u := range urls {
+		if u != cliLoginTestingCallbackURL {
+			remaining = append(remaining, u)
+		}
+	}
+
+	return remaining
+}
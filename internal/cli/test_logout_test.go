@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth/authutil"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestSilentAuthSessionEnded(t *testing.T) {
+	var tests = []struct {
+		name          string
+		callbackErr   *authutil.CallbackError
+		expected      bool
+		expectedError string
+	}{
+		{
+			name:        "it ended when a login is required",
+			callbackErr: &authutil.CallbackError{Code: "login_required", Description: "Login required"},
+			expected:    true,
+		},
+		{
+			name:        "it is active when a code is returned",
+			callbackErr: nil,
+			expected:    false,
+		},
+		{
+			name:        "it is active when consent is required",
+			callbackErr: &authutil.CallbackError{Code: "consent_required", Description: "Consent required"},
+			expected:    false,
+		},
+		{
+			name:          "it fails on other errors",
+			callbackErr:   &authutil.CallbackError{Code: "unauthorized_client", Description: "Callback URL mismatch"},
+			expectedError: "the silent authentication failed: unauthorized_client: Callback URL mismatch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sessionEnded, err := silentAuthSessionEnded(test.callbackErr)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, sessionEnded)
+		})
+	}
+}
+
+func TestAddAndRemoveLocalURLsToClient(t *testing.T) {
+	t.Run("it only removes the URLs it added", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := &management.Client{
+			ClientID:          auth0.String("some-client-id"),
+			Callbacks:         &[]string{"https://example.com/callback", cliLoginTestingCallbackURL},
+			AllowedLogoutURLs: &[]string{"https://example.com"},
+		}
+
+		clientAPI := mock.NewMockClientAPI(ctrl)
+		clientAPI.EXPECT().
+			Update(gomock.Any(), "some-client-id", &management.Client{
+				AllowedLogoutURLs: &[]string{"https://example.com", cliLoginTestingCallbackURL},
+			}).
+			Return(nil)
+		clientAPI.EXPECT().
+			Update(gomock.Any(), "some-client-id", &management.Client{
+				AllowedLogoutURLs: &[]string{"https://example.com"},
+			}).
+			Return(nil)
+
+		callbackAdded, logoutURLAdded, err := addLocalURLsToClient(context.Background(), clientAPI, client)
+		assert.NoError(t, err)
+		assert.False(t, callbackAdded)
+		assert.True(t, logoutURLAdded)
+
+		err = removeLocalURLsFromClient(context.Background(), clientAPI, client, callbackAdded, logoutURLAdded)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it leaves the client alone when it already allows the URLs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := &management.Client{
+			ClientID:          auth0.String("some-client-id"),
+			Callbacks:         &[]string{cliLoginTestingCallbackURL},
+			AllowedLogoutURLs: &[]string{cliLoginTestingCallbackURL},
+		}
+
+		callbackAdded, logoutURLAdded, err := addLocalURLsToClient(context.Background(), mock.NewMockClientAPI(ctrl), client)
+		assert.NoError(t, err)
+		assert.False(t, callbackAdded)
+		assert.False(t, logoutURLAdded)
+	})
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth/authutil"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var testClient = Flag{
+	Name:     "Client ID",
+	LongForm: "client",
+	Help:     "Client ID of an Auth0 application.",
+}
+
+func testSilentAuthCmd(cli *cli) *cobra.Command {
+	var inputs testCmdInputs
+
+	cmd := &cobra.Command{
+		Use:   "silent-auth",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Try out a silent authentication for an application",
+		Long: "Try out a silent authentication for an application, to diagnose single sign-on issues.\n\n" +
+			"This performs an authorization request with `prompt=none` in a browser, reusing the session " +
+			"the browser has with the tenant. It reports whether single sign-on succeeded, or the error " +
+			"that came back, such as `login_required` or `consent_required`.",
+		Example: `  auth0 test silent-auth
+  auth0 test silent-auth <client-id>
+  auth0 test silent-auth --client <client-id>
+  auth0 test silent-auth --client <client-id> --audience <api-identifier|api-audience> --scopes <scope1,scope2>
+  auth0 test silent-auth --client <client-id> --domain <domain> --force
+  auth0 test silent-auth --client <client-id> -a <api-identifier|api-audience> -s <scope1,scope2> -d <domain> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && inputs.ClientID != "" {
+				args = []string{inputs.ClientID}
+			}
+
+			client, err := selectClientToUseForTestsAndValidateExistence(cli, cmd, args, &inputs)
+			if err != nil {
+				return err
+			}
+
+			if client.GetAppType() == appTypeNonInteractive {
+				return fmt.Errorf(
+					"cannot test a silent authentication with a %s application, as it has no user sessions",
+					ansi.Bold("Machine to Machine"),
+				)
+			}
+
+			if err := pickTestDomain(cmd, cli, &inputs.CustomDomain); err != nil {
+				return err
+			}
+
+			if proceed := runSilentAuthPreflightChecks(cli, client); !proceed {
+				return nil
+			}
+
+			domain := testDomainOrTenant(cli, inputs.CustomDomain)
+
+			callbackAdded, err := addLocalCallbackURLToClient(cmd.Context(), cli.api.Client, client)
+			if err != nil {
+				return err
+			}
+
+			// If we added the local callback URL to the client then we need to
+			// remove it when we're done.
+			defer func() {
+				if callbackAdded {
+					if err := removeLocalCallbackURLFromClient(cmd.Context(), cli.api.Client, client); err != nil {
+						cli.renderer.Warnf("Failed to remove the callback URL '%s' from the client: %s", cliLoginTestingCallbackURL, err)
+					}
+				}
+			}()
+
+			var callbackErr *authutil.CallbackError
+			if err := ansi.Spinner("Waiting for silent authentication to complete", func() (err error) {
+				callbackErr, err = runSilentAuthFlow(cli, client, domain, inputs.Audience, inputs.Scopes)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to silently authenticate with the client with ID %q: %w", client.GetClientID(), err)
+			}
+
+			cli.renderer.TestSilentAuth(client.GetClientID(), domain, callbackErr)
+
+			return nil
+		},
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	testClient.RegisterString(cmd, &inputs.ClientID, "")
+	testAudience.RegisterString(cmd, &inputs.Audience, "")
+	testScopes.RegisterStringSlice(cmd, &inputs.Scopes, cliLoginTestingScopes)
+	testDomain.RegisterString(cmd, &inputs.CustomDomain, "")
+
+	return cmd
+}
+
+// runSilentAuthPreflightChecks checks if we need to make any updates
+// to the client being tested in order to authenticate silently.
+// If so, it asks the user to confirm whether to proceed.
+func runSilentAuthPreflightChecks(cli *cli, c *management.Client) (proceed bool) {
+	if !cli.noInput {
+		cli.renderer.Infof("A browser window needs to be opened to attempt the silent authentication.")
+		cli.renderer.Infof("It reuses the session of the browser, so run `auth0 test login` first to test single sign-on.")
+		cli.renderer.Newline()
+	}
+
+	if !hasLocalCallbackURL(c) {
+		cli.renderer.Warnf("The client you are using does not currently allow callbacks to localhost.")
+		cli.renderer.Warnf("To complete the silent authentication the CLI needs to redirect to a local server and record the result.\n")
+		cli.renderer.Warnf("The client will be modified to update the allowed callback URLs, we'll remove them when done.")
+		cli.renderer.Warnf("If you do not wish to modify the client, you can abort now.")
+		cli.renderer.Newline()
+	}
+
+	if !cli.force && !cli.noInput {
+		if confirmed := prompt.Confirm("Do you wish to proceed?"); !confirmed {
+			return false
+		}
+	}
+
+	cli.renderer.Newline()
+
+	return true
+}
+
+// runSilentAuthFlow performs an authorization request with prompt=none in a browser window.
+// It returns the error the request redirected back with, or nil when single sign-on succeeded.
+func runSilentAuthFlow(cli *cli, c *management.Client, domain, audience string, scopes []string) (*authutil.CallbackError, error) {
+	state, err := generateState(cli.random(), cliLoginTestingStateSize)
+	if err != nil {
+		return nil, err
+	}
+
+	loginURL, err := authutil.BuildLoginURL(domain, c.GetClientID(), cliLoginTestingCallbackURL, state, "", audience, "none", scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := openTestURL(cli, loginURL); err != nil {
+		return nil, err
+	}
+
+	_, authState, err := authutil.WaitForBrowserCallback(cliLoginTestingCallbackAddr)
+
+	var callbackErr *authutil.CallbackError
+	if errors.As(err, &callbackErr) {
+		return callbackErr, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if state != authState {
+		return nil, fmt.Errorf("unexpected auth state")
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestCheckCustomDomainIsReady(t *testing.T) {
+	customDomains := []*management.CustomDomain{
+		{
+			ID:     auth0.String("cd_ready"),
+			Domain: auth0.String("login.example.com"),
+			Status: auth0.String("ready"),
+		},
+		{
+			ID:     auth0.String("cd_pending"),
+			Domain: auth0.String("auth.example.com"),
+			Status: auth0.String("pending_verification"),
+		},
+	}
+
+	var tests = []struct {
+		name          string
+		domain        string
+		customDomains []*management.CustomDomain
+		apiError      error
+		expectedError string
+	}{
+		{
+			name:          "it accepts a ready custom domain",
+			domain:        "login.example.com",
+			customDomains: customDomains,
+		},
+		{
+			name:          "it rejects a custom domain that isn't ready",
+			domain:        "auth.example.com",
+			customDomains: customDomains,
+			expectedError: "the custom domain \"auth.example.com\" is not ready to be used, as its status is \"pending_verification\".\n\n" +
+				"Run: 'auth0 domains verify cd_pending' to verify it.",
+		},
+		{
+			name:          "it rejects a domain that isn't configured",
+			domain:        "unknown.example.com",
+			customDomains: customDomains,
+			expectedError: "the custom domain \"unknown.example.com\" is not configured for the tenant.\n\n" +
+				"Run: 'auth0 domains list' to see the custom domains of the tenant.",
+		},
+		{
+			name:          "it fails when custom domains are disabled",
+			domain:        "login.example.com",
+			apiError:      &mockManagementError{statusCode: http.StatusForbidden},
+			expectedError: errNoCustomDomains.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customDomainAPI := mock.NewMockCustomDomainAPI(ctrl)
+			customDomainAPI.EXPECT().
+				List(gomock.Any()).
+				Return(test.customDomains, test.apiError)
+
+			err := checkCustomDomainIsReady(context.Background(), &auth0.API{CustomDomain: customDomainAPI}, test.domain)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTestDomainOrTenant(t *testing.T) {
+	cli := &cli{tenant: "example.auth0.com"}
+
+	assert.Equal(t, "login.example.com", testDomainOrTenant(cli, "login.example.com"))
+	assert.Equal(t, "example.auth0.com", testDomainOrTenant(cli, ""))
+}
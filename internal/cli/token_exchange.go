@@ -0,0 +1,426 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const (
+	tokenExchangeDocsURL           = "https://auth0.com/docs/authenticate/custom-token-exchange"
+	tokenExchangeProfileTypeCustom = "custom_authentication"
+	tokenExchangeActionTrigger     = "custom-token-exchange"
+	tokenExchangeProfilesPageSize  = 100
+)
+
+var (
+	tokenExchangeProfileID = Argument{
+		Name: "Id",
+		Help: "Id of the token exchange profile.",
+	}
+
+	tokenExchangeProfileName = Flag{
+		Name:       "Name",
+		LongForm:   "name",
+		ShortForm:  "n",
+		Help:       "Name of the token exchange profile.",
+		IsRequired: true,
+	}
+
+	tokenExchangeProfileSubjectTokenType = Flag{
+		Name:      "Subject Token Type",
+		LongForm:  "subject-token-type",
+		ShortForm: "s",
+		Help: "Type of the subject tokens handled by the profile, as a unique URI under a namespace " +
+			"you control, e.g. urn://acme/legacy-token.",
+		IsRequired: true,
+	}
+
+	tokenExchangeProfileActionID = Flag{
+		Name:      "Action Id",
+		LongForm:  "action-id",
+		ShortForm: "a",
+		Help: "Id of the action validating the subject tokens. " +
+			"The action needs to be deployed and use the custom-token-exchange trigger.",
+		IsRequired: true,
+	}
+
+	errNoTokenExchangeProfiles = errors.New("there are currently no token exchange profiles to choose from. " +
+		"Create one by running: `auth0 token-exchange create`")
+)
+
+func tokenExchangeCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-exchange",
+		Short: "Manage token exchange profiles",
+		Long: fmt.Sprintf(
+			"Manage the profiles of the [Custom Token Exchange](%s), which bind a subject token type "+
+				"to the action validating the tokens exchanged for Auth0 tokens.",
+			tokenExchangeDocsURL,
+		),
+		Aliases: []string{"tep"},
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(listTokenExchangeProfilesCmd(cli))
+	cmd.AddCommand(showTokenExchangeProfileCmd(cli))
+	cmd.AddCommand(createTokenExchangeProfileCmd(cli))
+	cmd.AddCommand(updateTokenExchangeProfileCmd(cli))
+	cmd.AddCommand(deleteTokenExchangeProfileCmd(cli))
+
+	return cmd
+}
+
+func listTokenExchangeProfilesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List your token exchange profiles",
+		Long:    "List your existing token exchange profiles. To create one, run: `auth0 token-exchange create`.",
+		Example: `  auth0 token-exchange list
+  auth0 token-exchange ls
+  auth0 token-exchange ls --json
+  auth0 token-exchange ls --csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var profiles []*auth0.TokenExchangeProfile
+			if err := ansi.Waiting(func() (err error) {
+				profiles, err = listAllTokenExchangeProfiles(cmd.Context(), cli.api)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to list token exchange profiles: %w", err)
+			}
+
+			cli.renderer.TokenExchangeProfileList(profiles)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	cmd.Flags().BoolVar(&cli.csv, "csv", false, "Output in csv format.")
+	cmd.MarkFlagsMutuallyExclusive("json", "csv")
+
+	return cmd
+}
+
+func showTokenExchangeProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show a token exchange profile",
+		Long:  "Display information about a token exchange profile.",
+		Example: `  auth0 token-exchange show
+  auth0 token-exchange show <id>
+  auth0 token-exchange show <id> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := tokenExchangeProfileID.Pick(cmd, &inputs.ID, cli.tokenExchangeProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var profile *auth0.TokenExchangeProfile
+			if err := ansi.Waiting(func() (err error) {
+				profile, err = cli.api.TokenExchange.Read(cmd.Context(), url.PathEscape(inputs.ID))
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read token exchange profile with ID %q: %w", inputs.ID, err)
+			}
+
+			cli.renderer.TokenExchangeProfileShow(profile)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func createTokenExchangeProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Name             string
+		SubjectTokenType string
+		ActionID         string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Args:  cobra.NoArgs,
+		Short: "Create a new token exchange profile",
+		Long: "Create a new token exchange profile.\n\n" +
+			"To create interactively, use `auth0 token-exchange create` with no flags.\n\n" +
+			"To create non-interactively, supply the name, subject token type and action id through the flags.",
+		Example: `  auth0 token-exchange create
+  auth0 token-exchange create --name "Legacy tokens" --subject-token-type "urn://acme/legacy-token"
+  auth0 token-exchange create --name "Legacy tokens" --subject-token-type "urn://acme/legacy-token" --action-id <action-id>
+  auth0 token-exchange create -n "Legacy tokens" -s "urn://acme/legacy-token" -a <action-id> --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := tokenExchangeProfileName.Ask(cmd, &inputs.Name, nil); err != nil {
+				return err
+			}
+
+			if err := tokenExchangeProfileSubjectTokenType.Ask(cmd, &inputs.SubjectTokenType, nil); err != nil {
+				return err
+			}
+
+			if err := tokenExchangeProfileActionID.Pick(
+				cmd,
+				&inputs.ActionID,
+				cli.tokenExchangeActionPickerOptions,
+			); err != nil {
+				return err
+			}
+
+			profile := &auth0.TokenExchangeProfile{
+				Name:             &inputs.Name,
+				SubjectTokenType: &inputs.SubjectTokenType,
+				ActionID:         &inputs.ActionID,
+				Type:             auth0.String(tokenExchangeProfileTypeCustom),
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.TokenExchange.Create(cmd.Context(), profile)
+			}); err != nil {
+				return fmt.Errorf("failed to create token exchange profile %q: %w", inputs.Name, err)
+			}
+
+			cli.renderer.TokenExchangeProfileCreate(profile)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	tokenExchangeProfileName.RegisterString(cmd, &inputs.Name, "")
+	tokenExchangeProfileSubjectTokenType.RegisterString(cmd, &inputs.SubjectTokenType, "")
+	tokenExchangeProfileActionID.RegisterString(cmd, &inputs.ActionID, "")
+
+	return cmd
+}
+
+func updateTokenExchangeProfileCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		ID               string
+		Name             string
+		SubjectTokenType string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Update a token exchange profile",
+		Long: "Update a token exchange profile.\n\n" +
+			"To update interactively, use `auth0 token-exchange update` with no arguments.\n\n" +
+			"To update non-interactively, supply the profile id, name and subject token type through the flags. " +
+			"The action of a profile can't be changed, create a new profile instead.",
+		Example: `  auth0 token-exchange update
+  auth0 token-exchange update <id> --name "Legacy tokens"
+  auth0 token-exchange update <id> --subject-token-type "urn://acme/legacy-token-v2"
+  auth0 token-exchange update <id> -n "Legacy tokens" -s "urn://acme/legacy-token-v2" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if err := tokenExchangeProfileID.Pick(cmd, &inputs.ID, cli.tokenExchangeProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				inputs.ID = args[0]
+			}
+
+			var current *auth0.TokenExchangeProfile
+			if err := ansi.Waiting(func() (err error) {
+				current, err = cli.api.TokenExchange.Read(cmd.Context(), url.PathEscape(inputs.ID))
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to read token exchange profile with ID %q: %w", inputs.ID, err)
+			}
+
+			if err := tokenExchangeProfileName.AskU(cmd, &inputs.Name, current.Name); err != nil {
+				return err
+			}
+
+			if err := tokenExchangeProfileSubjectTokenType.AskU(
+				cmd,
+				&inputs.SubjectTokenType,
+				current.SubjectTokenType,
+			); err != nil {
+				return err
+			}
+
+			// Start with an empty profile object. We'll conditionally
+			// hydrate it based on the provided parameters since
+			// we'll do PATCH semantics.
+			profile := &auth0.TokenExchangeProfile{}
+
+			if inputs.Name != "" {
+				profile.Name = &inputs.Name
+			}
+
+			if inputs.SubjectTokenType != "" {
+				profile.SubjectTokenType = &inputs.SubjectTokenType
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.TokenExchange.Update(cmd.Context(), url.PathEscape(inputs.ID), profile)
+			}); err != nil {
+				return fmt.Errorf("failed to update token exchange profile with ID %q: %w", inputs.ID, err)
+			}
+
+			if profile.Name != nil {
+				current.Name = profile.Name
+			}
+			if profile.SubjectTokenType != nil {
+				current.SubjectTokenType = profile.SubjectTokenType
+			}
+
+			cli.renderer.TokenExchangeProfileUpdate(current)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	tokenExchangeProfileName.RegisterStringU(cmd, &inputs.Name, "")
+	tokenExchangeProfileSubjectTokenType.RegisterStringU(cmd, &inputs.SubjectTokenType, "")
+
+	return cmd
+}
+
+func deleteTokenExchangeProfileCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a token exchange profile",
+		Long: "Delete a token exchange profile.\n\n" +
+			"To delete interactively, use `auth0 token-exchange delete` with no arguments.\n\n" +
+			"To delete non-interactively, supply the profile id and the `--force` flag to skip confirmation.",
+		Example: `  auth0 token-exchange delete
+  auth0 token-exchange rm
+  auth0 token-exchange delete <id>
+  auth0 token-exchange delete <id> --force
+  auth0 token-exchange delete <id> <id2> --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := make([]string, len(args))
+			if len(args) == 0 {
+				if err := tokenExchangeProfileID.PickMany(cmd, &ids, cli.tokenExchangeProfilePickerOptions); err != nil {
+					return err
+				}
+			} else {
+				ids = append(ids, args...)
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				if confirmed := prompt.Confirm("Are you sure you want to proceed?"); !confirmed {
+					return nil
+				}
+			}
+
+			return ansi.ProgressBar("Deleting token exchange profile", ids, func(_ int, id string) error {
+				if id != "" {
+					if _, err := cli.api.TokenExchange.Read(cmd.Context(), url.PathEscape(id)); err != nil {
+						return fmt.Errorf("failed to delete token exchange profile with ID %q: %w", id, err)
+					}
+
+					if err := cli.api.TokenExchange.Delete(cmd.Context(), url.PathEscape(id)); err != nil {
+						return fmt.Errorf("failed to delete token exchange profile with ID %q: %w", id, err)
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// listAllTokenExchangeProfiles follows the checkpoint pagination to fetch all the profiles.
+func listAllTokenExchangeProfiles(ctx context.Context, api *auth0.API) ([]*auth0.TokenExchangeProfile, error) {
+	var (
+		profiles []*auth0.TokenExchangeProfile
+		from     string
+	)
+
+	for {
+		opts := []management.RequestOption{management.Take(tokenExchangeProfilesPageSize)}
+		if from != "" {
+			opts = append(opts, management.From(from))
+		}
+
+		list, err := api.TokenExchange.List(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, list.TokenExchangeProfiles...)
+
+		if list.Next == "" || len(list.TokenExchangeProfiles) == 0 {
+			return profiles, nil
+		}
+
+		from = list.Next
+	}
+}
+
+func (c *cli) tokenExchangeProfilePickerOptions(ctx context.Context) (pickerOptions, error) {
+	profiles, err := listAllTokenExchangeProfiles(ctx, c.api)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list token exchange profiles: %w", err)
+	}
+
+	var opts pickerOptions
+	for _, profile := range profiles {
+		value := profile.GetID()
+		label := fmt.Sprintf("%s %s", profile.GetName(), ansi.Faint("("+value+")"))
+		opts = append(opts, pickerOption{value: value, label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, errNoTokenExchangeProfiles
+	}
+
+	return opts, nil
+}
+
+// tokenExchangeActionPickerOptions lists the actions that can be bound to a
+// token exchange profile, which are the ones using the custom-token-exchange trigger.
+func (c *cli) tokenExchangeActionPickerOptions(ctx context.Context) (pickerOptions, error) {
+	list, err := c.api.Action.List(ctx, management.Parameter("triggerId", tokenExchangeActionTrigger))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list actions: %w", err)
+	}
+
+	var opts pickerOptions
+	for _, action := range list.Actions {
+		label := fmt.Sprintf("%s %s", action.GetName(), ansi.Faint("("+action.GetID()+")"))
+		opts = append(opts, pickerOption{value: action.GetID(), label: label})
+	}
+
+	if len(opts) == 0 {
+		return nil, fmt.Errorf(
+			"there are currently no actions using the %s trigger to choose from. "+
+				"Create one by running: `auth0 actions create --trigger %s`",
+			tokenExchangeActionTrigger,
+			tokenExchangeActionTrigger,
+		)
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestListAllTokenExchangeProfiles(t *testing.T) {
+	t.Run("it follows the checkpoint pagination", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenExchangeAPI := mock.NewMockTokenExchangeProfileAPI(ctrl)
+		gomock.InOrder(
+			tokenExchangeAPI.EXPECT().
+				List(gomock.Any(), gomock.Any()).
+				Return(&auth0.TokenExchangeProfileList{
+					TokenExchangeProfiles: []*auth0.TokenExchangeProfile{{ID: auth0.String("tep_1")}},
+					Next:                  "checkpoint",
+				}, nil),
+			tokenExchangeAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&auth0.TokenExchangeProfileList{
+					TokenExchangeProfiles: []*auth0.TokenExchangeProfile{{ID: auth0.String("tep_2")}},
+				}, nil),
+		)
+
+		profiles, err := listAllTokenExchangeProfiles(context.Background(), &auth0.API{TokenExchange: tokenExchangeAPI})
+		require.NoError(t, err)
+		require.Len(t, profiles, 2)
+		assert.Equal(t, "tep_1", profiles[0].GetID())
+		assert.Equal(t, "tep_2", profiles[1].GetID())
+	})
+
+	t.Run("it returns the API errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenExchangeAPI := mock.NewMockTokenExchangeProfileAPI(ctrl)
+		tokenExchangeAPI.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("api error"))
+
+		_, err := listAllTokenExchangeProfiles(context.Background(), &auth0.API{TokenExchange: tokenExchangeAPI})
+		assert.EqualError(t, err, "api error")
+	})
+}
@@ -0,0 +1,355 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+const (
+	// loginTraceClockSkew widens the time range the log events of the login are searched in,
+	// in case the clock of the machine is ahead of the one of the tenant.
+	loginTraceClockSkew = 5 * time.Second
+
+	loginTraceLogsPerPage = 100
+)
+
+// loginTracePollInterval is how long to wait between searches for the log events of the login.
+var loginTracePollInterval = time.Second
+
+var traceWait = Flag{
+	Name:      "Wait",
+	LongForm:  "wait",
+	ShortForm: "w",
+	Help: "Maximum duration to wait for the log events of the login to be indexed once it's over, " +
+		"e.g. 30s or 1m.",
+}
+
+type traceLoginInputs struct {
+	testCmdInputs
+	Wait time.Duration
+}
+
+func traceCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Trace the flows of your tenant",
+		Long:  "Trace the flows of your tenant, correlating the log events they result in.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(traceLoginCmd(cli))
+
+	return cmd
+}
+
+func traceLoginCmd(cli *cli) *cobra.Command {
+	var inputs traceLoginInputs
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Args:  cobra.NoArgs,
+		Short: "Trace a test login with an application",
+		Long: "Perform a test login with an application in a browser, then correlate the log events of the " +
+			"tenant it resulted in into a single chronological trace, to find out why a login failed.\n\n" +
+			"The trace lists the prompts the login went through, such as the MFA ones, the actions executed " +
+			"along with their duration and errors, and the failures, such as the errors of the rules.\n\n" +
+			"Log events take a few seconds to be indexed, so once the login is over the trace waits for " +
+			"them for up to the duration given with `--wait`.",
+		Example: `  auth0 trace login
+  auth0 trace login --client <client-id>
+  auth0 trace login --client <client-id> --connection-name <connection-name>
+  auth0 trace login --client <client-id> --audience <api-identifier|api-audience> --scopes <scope1,scope2>
+  auth0 trace login --client <client-id> --domain <domain> --wait 1m
+  auth0 trace login --client <client-id> -c <connection-name> -d <domain> -w 1m --force --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.Wait < 0 {
+				return fmt.Errorf("invalid --%s duration %s, it can't be negative, e.g. 30s or 1m", traceWait.LongForm, inputs.Wait)
+			}
+
+			if inputs.ClientID != "" {
+				args = []string{inputs.ClientID}
+			}
+
+			client, err := selectClientToUseForTestsAndValidateExistence(cli, cmd, args, &inputs.testCmdInputs)
+			if err != nil {
+				return err
+			}
+
+			if client.GetAppType() == appTypeNonInteractive {
+				return fmt.Errorf(
+					"cannot trace a login with a %s application, as users can't log into it",
+					ansi.Bold("Machine to Machine"),
+				)
+			}
+
+			if err := pickTestDomain(cmd, cli, &inputs.CustomDomain); err != nil {
+				return err
+			}
+
+			if proceed := runLoginFlowPreflightChecks(cli, client); !proceed {
+				return nil
+			}
+
+			start := cli.now()
+
+			_, loginErr := runLoginFlow(
+				cmd.Context(),
+				cli,
+				client,
+				inputs.ConnectionName,
+				inputs.Audience,
+				"login", // Force a login page, so that the trace covers the whole login.
+				inputs.Scopes,
+				inputs.CustomDomain,
+				loginRequestOptions{},
+			)
+
+			var (
+				logs     []*management.Log
+				complete bool
+			)
+			if err := ansi.Waiting(func() (err error) {
+				logs, complete, err = waitForLoginTraceLogs(
+					cmd.Context(),
+					cli,
+					client.GetClientID(),
+					start,
+					inputs.Wait,
+					loginErr != nil,
+				)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to list the log events of the login: %w", err)
+			}
+
+			trace := &display.LoginTrace{
+				ClientID: client.GetClientID(),
+				Success:  loginErr == nil,
+				Complete: complete,
+			}
+			if loginErr != nil {
+				trace.Error = loginErr.Error()
+			}
+
+			logs, trace.UserID = correlateLoginTraceLogs(logs)
+			trace.Events = loginTraceEvents(cmd.Context(), cli, logs, start)
+
+			cli.renderer.LoginTrace(trace)
+
+			return nil
+		},
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	testClient.RegisterString(cmd, &inputs.ClientID, "")
+	testConnectionName.RegisterString(cmd, &inputs.ConnectionName, "")
+	testAudience.RegisterString(cmd, &inputs.Audience, "")
+	testScopes.RegisterStringSlice(cmd, &inputs.Scopes, cliLoginTestingScopes)
+	testDomain.RegisterString(cmd, &inputs.CustomDomain, "")
+	cmd.Flags().DurationVarP(&inputs.Wait, traceWait.LongForm, traceWait.ShortForm, 30*time.Second, traceWait.Help)
+
+	return cmd
+}
+
+// waitForLoginTraceLogs searches for the log events of the client since the login started, until
+// the ones ending the login are found or the wait is over, and tells whether they were found.
+func waitForLoginTraceLogs(
+	ctx context.Context,
+	cli *cli,
+	clientID string,
+	since time.Time,
+	wait time.Duration,
+	loginFailed bool,
+) ([]*management.Log, bool, error) {
+	query := fmt.Sprintf(
+		"client_id:%q AND date:[%s TO *]",
+		clientID,
+		since.Add(-loginTraceClockSkew).UTC().Format("2006-01-02T15:04:05.000Z"),
+	)
+	deadline := cli.now().Add(wait)
+
+	for {
+		logs, err := cli.api.Log.List(
+			ctx,
+			management.Query(query),
+			management.Parameter("sort", "date:1"),
+			management.Parameter("page", "0"),
+			management.Parameter("per_page", fmt.Sprintf("%d", loginTraceLogsPerPage)),
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if loginTraceComplete(logs, loginFailed) {
+			return logs, true, nil
+		}
+
+		if !cli.now().Add(loginTracePollInterval).Before(deadline) {
+			return logs, false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		case <-time.After(loginTracePollInterval):
+		}
+	}
+}
+
+// loginTraceComplete tells whether the log events ending the login are among the logs. A login
+// that succeeded ends with the exchange of the authorization code, and one that failed with a failure.
+func loginTraceComplete(logs []*management.Log, loginFailed bool) bool {
+	for _, log := range logs {
+		switch logType := log.GetType(); {
+		case logType == "seacft" || logType == "feacft":
+			return true
+		case loginFailed && strings.HasPrefix(logType, "f"):
+			return true
+		}
+	}
+
+	return false
+}
+
+// correlateLoginTraceLogs keeps the log events of the user that logged in, along with the ones of no user,
+// as other users could be logging into the same client at the same time. The user is the one of the last
+// successful login, if any, as the users that failed to log in can't be told apart from the others.
+func correlateLoginTraceLogs(logs []*management.Log) ([]*management.Log, string) {
+	var userID string
+	for _, log := range logs {
+		if log.GetType() == "s" && log.GetUserID() != "" {
+			userID = log.GetUserID()
+		}
+	}
+
+	correlated := make([]*management.Log, 0, len(logs))
+	for _, log := range logs {
+		if userID == "" || log.GetUserID() == "" || log.GetUserID() == userID {
+			correlated = append(correlated, log)
+		}
+	}
+
+	sort.SliceStable(correlated, func(i, j int) bool {
+		return correlated[i].GetDate().Before(correlated[j].GetDate())
+	})
+
+	return correlated, userID
+}
+
+// loginTraceEvents turns the logs into the events of the trace, with the steps they went through.
+func loginTraceEvents(ctx context.Context, cli *cli, logs []*management.Log, start time.Time) []*display.LoginTraceEvent {
+	events := make([]*display.LoginTraceEvent, 0, len(logs))
+	for _, log := range logs {
+		name := log.TypeName()
+		if name == "" {
+			name = log.GetType()
+		}
+
+		elapsed := log.GetDate().Sub(start).Milliseconds()
+		if elapsed < 0 {
+			elapsed = 0
+		}
+
+		var steps []string
+		steps = append(steps, loginTracePromptSteps(log.Details)...)
+		steps = append(steps, loginTraceActionSteps(ctx, cli, log.Details)...)
+		if message := loginTraceErrorMessage(log.Details); message != "" {
+			steps = append(steps, "error: "+message)
+		}
+
+		events = append(events, &display.LoginTraceEvent{
+			LogID:       log.GetLogID(),
+			Date:        log.GetDate(),
+			ElapsedMS:   elapsed,
+			Type:        log.GetType(),
+			Name:        name,
+			Description: log.GetDescription(),
+			Steps:       steps,
+		})
+	}
+
+	return events
+}
+
+// loginTracePromptSteps lists the prompts of the login, such as the login and MFA ones.
+func loginTracePromptSteps(details map[string]interface{}) []string {
+	prompts, _ := details["prompts"].([]interface{})
+
+	var steps []string
+	for _, item := range prompts {
+		prompt, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := prompt["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		step := "prompt " + name
+		if connection, _ := prompt["connection"].(string); connection != "" {
+			step += fmt.Sprintf(" (%s)", connection)
+		}
+		if elapsed, ok := prompt["elapsedTime"].(float64); ok {
+			step += fmt.Sprintf(" in %dms", int64(elapsed))
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps
+}
+
+// loginTraceActionSteps lists the actions executed during the login, along with their
+// duration and error. An execution that can't be read is listed by its ID instead.
+func loginTraceActionSteps(ctx context.Context, cli *cli, details map[string]interface{}) []string {
+	actions, _ := details["actions"].(map[string]interface{})
+	executions, _ := actions["executions"].([]interface{})
+
+	var steps []string
+	for _, item := range executions {
+		executionID, ok := item.(string)
+		if !ok || executionID == "" {
+			continue
+		}
+
+		execution, err := cli.api.Action.Execution(ctx, executionID)
+		if err != nil {
+			steps = append(steps, fmt.Sprintf("actions execution %s %s", executionID, ansi.Faint("(details unavailable)")))
+			continue
+		}
+
+		for _, result := range execution.Results {
+			step := fmt.Sprintf("action %q", result.GetActionName())
+			if result.StartedAt != nil && result.EndedAt != nil {
+				step += fmt.Sprintf(" in %dms", result.EndedAt.Sub(*result.StartedAt).Milliseconds())
+			}
+			if message, _ := result.Error["message"].(string); message != "" {
+				step += " failed: " + message
+			}
+
+			steps = append(steps, step)
+		}
+	}
+
+	return steps
+}
+
+func loginTraceErrorMessage(details map[string]interface{}) string {
+	loginErr, _ := details["error"].(map[string]interface{})
+	message, _ := loginErr["message"].(string)
+
+	return message
+}
@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+)
+
+func TestLoginTraceComplete(t *testing.T) {
+	logs := func(types ...string) []*management.Log {
+		var list []*management.Log
+		for _, logType := range types {
+			list = append(list, &management.Log{Type: auth0.String(logType)})
+		}
+		return list
+	}
+
+	assert.True(t, loginTraceComplete(logs("s", "seacft"), false))
+	assert.True(t, loginTraceComplete(logs("fp", "s", "feacft"), false))
+	assert.False(t, loginTraceComplete(logs("fp", "s"), false))
+	assert.True(t, loginTraceComplete(logs("f"), true))
+	assert.False(t, loginTraceComplete(logs("gd_send_sms"), true))
+	assert.False(t, loginTraceComplete(nil, true))
+}
+
+func TestCorrelateLoginTraceLogs(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("it keeps the logs of the user that logged in", func(t *testing.T) {
+		logs := []*management.Log{
+			{LogID: auth0.String("3"), Type: auth0.String("seacft"), Date: auth0.Time(start.Add(3 * time.Second))},
+			{LogID: auth0.String("1"), Type: auth0.String("s"), UserID: auth0.String("auth0|jane"), Date: auth0.Time(start.Add(time.Second))},
+			{LogID: auth0.String("2"), Type: auth0.String("s"), UserID: auth0.String("auth0|john"), Date: auth0.Time(start.Add(2 * time.Second))},
+			{LogID: auth0.String("4"), Type: auth0.String("s"), UserID: auth0.String("auth0|jane"), Date: auth0.Time(start.Add(2 * time.Second))},
+		}
+
+		correlated, userID := correlateLoginTraceLogs(logs)
+		assert.Equal(t, "auth0|jane", userID)
+
+		var ids []string
+		for _, log := range correlated {
+			ids = append(ids, log.GetLogID())
+		}
+		assert.Equal(t, []string{"1", "4", "3"}, ids)
+	})
+
+	t.Run("it keeps all the logs when no login succeeded", func(t *testing.T) {
+		logs := []*management.Log{
+			{LogID: auth0.String("1"), Type: auth0.String("fp"), UserID: auth0.String("auth0|jane"), Date: auth0.Time(start)},
+			{LogID: auth0.String("2"), Type: auth0.String("f"), Date: auth0.Time(start.Add(time.Second))},
+		}
+
+		correlated, userID := correlateLoginTraceLogs(logs)
+		assert.Empty(t, userID)
+		assert.Len(t, correlated, 2)
+	})
+}
+
+func TestLoginTraceEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	actionAPI := mock.NewMockActionAPI(ctrl)
+	actionAPI.EXPECT().
+		Execution(gomock.Any(), "exec-1").
+		Return(&management.ActionExecution{
+			Results: []*management.ActionExecutionResult{
+				{
+					ActionName: auth0.String("Add roles"),
+					StartedAt:  auth0.Time(start),
+					EndedAt:    auth0.Time(start.Add(12 * time.Millisecond)),
+				},
+				{
+					ActionName: auth0.String("Deny blocked countries"),
+					Error:      map[string]interface{}{"message": "Access denied from your country"},
+				},
+			},
+		}, nil)
+	actionAPI.EXPECT().
+		Execution(gomock.Any(), "exec-2").
+		Return(nil, fmt.Errorf("not found"))
+
+	cli := &cli{api: &auth0.API{Action: actionAPI}}
+
+	logs := []*management.Log{
+		{
+			LogID:       auth0.String("1"),
+			Type:        auth0.String("f"),
+			Description: auth0.String("Access denied from your country"),
+			Date:        auth0.Time(start.Add(1500 * time.Millisecond)),
+			Details: map[string]interface{}{
+				"prompts": []interface{}{
+					map[string]interface{}{"name": "login", "connection": "Username-Password-Authentication", "elapsedTime": float64(840)},
+					map[string]interface{}{"name": "mfa"},
+				},
+				"actions": map[string]interface{}{
+					"executions": []interface{}{"exec-1", "exec-2"},
+				},
+				"error": map[string]interface{}{"message": "Access denied from your country"},
+			},
+		},
+	}
+
+	events := loginTraceEvents(context.Background(), cli, logs, start)
+	require.Len(t, events, 1)
+	assert.Equal(t, &display.LoginTraceEvent{
+		LogID:       "1",
+		Date:        start.Add(1500 * time.Millisecond),
+		ElapsedMS:   1500,
+		Type:        "f",
+		Name:        "Failed Login",
+		Description: "Access denied from your country",
+		Steps: []string{
+			"prompt login (Username-Password-Authentication) in 840ms",
+			"prompt mfa",
+			`action "Add roles" in 12ms`,
+			`action "Deny blocked countries" failed: Access denied from your country`,
+			"actions execution exec-2 (details unavailable)",
+			"error: Access denied from your country",
+		},
+	}, events[0])
+}
+
+func TestWaitForLoginTraceLogs(t *testing.T) {
+	since := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("it searches the logs of the client until the login ends", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		defaultPollInterval := loginTracePollInterval
+		loginTracePollInterval = time.Millisecond
+		t.Cleanup(func() {
+			loginTracePollInterval = defaultPollInterval
+		})
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		gomock.InOrder(
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{{Type: auth0.String("s")}}, nil),
+			logAPI.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*management.Log{{Type: auth0.String("s")}, {Type: auth0.String("seacft")}}, nil),
+		)
+
+		cli := &cli{api: &auth0.API{Log: logAPI}}
+
+		logs, complete, err := waitForLoginTraceLogs(context.Background(), cli, "client-id", since, time.Minute, false)
+		require.NoError(t, err)
+		assert.True(t, complete)
+		assert.Len(t, logs, 2)
+	})
+
+	t.Run("it stops waiting once the wait is over", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*management.Log{{Type: auth0.String("fp")}}, nil)
+
+		cli := &cli{api: &auth0.API{Log: logAPI}}
+
+		logs, complete, err := waitForLoginTraceLogs(context.Background(), cli, "client-id", since, 0, false)
+		require.NoError(t, err)
+		assert.False(t, complete)
+		assert.Len(t, logs, 1)
+	})
+
+	t.Run("it returns an error when it fails to list the logs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logAPI := mock.NewMockLogAPI(ctrl)
+		logAPI.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("rate limited"))
+
+		cli := &cli{api: &auth0.API{Log: logAPI}}
+
+		_, _, err := waitForLoginTraceLogs(context.Background(), cli, "client-id", since, time.Minute, true)
+		assert.EqualError(t, err, "rate limited")
+	})
+}
+
+func TestTraceLoginCmd_Wait(t *testing.T) {
+	t.Run("it fails with a negative wait", func(t *testing.T) {
+		cmd := traceLoginCmd(&cli{})
+		cmd.SetArgs([]string{"--client", "client-id", "--wait", "-1s"})
+
+		err := cmd.Execute()
+		assert.EqualError(t, err, "invalid --wait duration -1s, it can't be negative, e.g. 30s or 1m")
+	})
+
+	t.Run("it fails with an invalid wait", func(t *testing.T) {
+		cmd := traceLoginCmd(&cli{})
+		cmd.SetArgs([]string{"--client", "client-id", "--wait", "soon"})
+
+		err := cmd.Execute()
+		assert.ErrorContains(t, err, `invalid argument "soon" for "-w, --wait" flag`)
+	})
+}
@@ -58,7 +58,7 @@ func universalLoginCmd(cli *cli) *cobra.Command {
 		Short: "Manage the Universal Login experience",
 		Long: "Manage a consistent, branded Universal Login experience that can " +
 			"handle all of your authentication flows.",
-		Aliases: []string{"ul"},
+		Aliases: []string{"ul", "branding"},
 	}
 
 	cmd.SetUsageTemplate(resourceUsageTemplate())
@@ -68,6 +68,8 @@ func universalLoginCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(updateUniversalLoginCmd(cli))
 	cmd.AddCommand(universalLoginTemplatesCmd(cli))
 	cmd.AddCommand(universalLoginPromptsTextCmd(cli))
+	cmd.AddCommand(universalLoginRenderingCmd(cli))
+	cmd.AddCommand(universalLoginThemesCmd(cli))
 
 	return cmd
 }
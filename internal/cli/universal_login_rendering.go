@@ -0,0 +1,259 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+const renderingDocsURL = "https://auth0.com/docs/customize/login-pages/advanced-customizations"
+
+var (
+	renderingPrompt = Argument{
+		Name: "Prompt",
+		Help: "Name of the prompt, e.g. login-id.",
+	}
+
+	renderingScreen = Argument{
+		Name: "Screen",
+		Help: "Name of the screen of the prompt, e.g. login-id.",
+	}
+
+	renderingMode = Flag{
+		Name:      "Rendering Mode",
+		LongForm:  "rendering-mode",
+		ShortForm: "m",
+		Help:      "Rendering mode of the screen. Possible values: standard, advanced.",
+	}
+
+	renderingContextConfiguration = Flag{
+		Name:      "Context Configuration",
+		LongForm:  "context-configuration",
+		ShortForm: "c",
+		Help:      "Comma-separated list of context values to make available to the screen, e.g. branding.settings,tenant.name.",
+	}
+
+	renderingDefaultHeadTagsDisabled = Flag{
+		Name:     "Default Head Tags Disabled",
+		LongForm: "default-head-tags-disabled",
+		Help:     "Disable the default head tags of the screen.",
+	}
+
+	renderingHeadTags = Flag{
+		Name:     "Head Tags",
+		LongForm: "head-tags",
+		Help:     "JSON array of the tags to inject into the head of the screen.",
+	}
+
+	renderingSettingsFile = Flag{
+		Name:      "Settings File",
+		LongForm:  "settings-file",
+		ShortForm: "f",
+		Help: "Path to a JSON file with the rendering settings of the screen. " +
+			"Any other flags passed take precedence over the settings in the file.",
+	}
+
+	renderingModes = []string{"standard", "advanced"}
+)
+
+func universalLoginRenderingCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rendering",
+		Short: "Manage the rendering settings of the screens",
+		Long: fmt.Sprintf(
+			"Manage the rendering settings of the Universal Login screens, "+
+				"used by the [Advanced Customizations for Universal Login](%s).",
+			renderingDocsURL,
+		),
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+
+	cmd.AddCommand(showUniversalLoginRenderingCmd(cli))
+	cmd.AddCommand(updateUniversalLoginRenderingCmd(cli))
+
+	return cmd
+}
+
+func showUniversalLoginRenderingCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Prompt string
+		Screen string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.MaximumNArgs(2),
+		Short: "Show the rendering settings of a screen",
+		Long:  "Display the rendering settings of a screen of a prompt.",
+		Example: `  auth0 universal-login rendering show
+  auth0 universal-login rendering show <prompt> <screen>
+  auth0 ul rendering show login-id login-id
+  auth0 ul rendering show login-id login-id --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := askRenderingPromptAndScreen(cmd, args, &inputs.Prompt, &inputs.Screen); err != nil {
+				return err
+			}
+
+			var rendering *auth0.PromptRendering
+			if err := ansi.Waiting(func() (err error) {
+				rendering, err = cli.api.PromptRendering.Read(cmd.Context(), inputs.Prompt, inputs.Screen)
+				return err
+			}); err != nil {
+				return fmt.Errorf(
+					"failed to read the rendering settings of prompt %q and screen %q: %w",
+					inputs.Prompt,
+					inputs.Screen,
+					err,
+				)
+			}
+
+			cli.renderer.PromptRenderingShow(rendering)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func updateUniversalLoginRenderingCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Prompt                  string
+		Screen                  string
+		RenderingMode           string
+		ContextConfiguration    []string
+		DefaultHeadTagsDisabled bool
+		HeadTags                string
+		SettingsFile            string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Args:  cobra.MaximumNArgs(2),
+		Short: "Update the rendering settings of a screen",
+		Long: "Update the rendering settings of a screen of a prompt.\n\n" +
+			"The settings can be passed through the flags or read from a JSON file through the `--settings-file` flag, " +
+			"which follows the payload of the Management API, making it possible to keep the settings of each screen " +
+			"in source control and apply them from CI.",
+		Example: `  auth0 universal-login rendering update <prompt> <screen>
+  auth0 ul rendering update login-id login-id --rendering-mode advanced
+  auth0 ul rendering update login-id login-id -m advanced -c branding.settings,tenant.name
+  auth0 ul rendering update login-id login-id --head-tags '[{"tag":"script","attributes":{"src":"https://cdn.example.com/login.js","defer":true}}]'
+  auth0 ul rendering update login-id login-id --default-head-tags-disabled
+  auth0 ul rendering update login-id login-id --settings-file ./rendering/login-id.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := askRenderingPromptAndScreen(cmd, args, &inputs.Prompt, &inputs.Screen); err != nil {
+				return err
+			}
+
+			rendering := &auth0.PromptRendering{}
+			if inputs.SettingsFile != "" {
+				settings, err := os.ReadFile(inputs.SettingsFile)
+				if err != nil {
+					return fmt.Errorf("failed to read the settings file: %w", err)
+				}
+
+				if err := json.Unmarshal(settings, rendering); err != nil {
+					return fmt.Errorf("invalid settings file: %w", err)
+				}
+			}
+
+			if renderingMode.IsSet(cmd) {
+				rendering.RenderingMode = &inputs.RenderingMode
+			}
+			if renderingContextConfiguration.IsSet(cmd) {
+				rendering.ContextConfiguration = &inputs.ContextConfiguration
+			}
+			if renderingDefaultHeadTagsDisabled.IsSet(cmd) {
+				rendering.DefaultHeadTagsDisabled = &inputs.DefaultHeadTagsDisabled
+			}
+			if renderingHeadTags.IsSet(cmd) {
+				if err := json.Unmarshal([]byte(inputs.HeadTags), &rendering.HeadTags); err != nil {
+					return fmt.Errorf("invalid head tags, expected a JSON array: %w", err)
+				}
+			}
+
+			if rendering.RenderingMode == nil &&
+				rendering.ContextConfiguration == nil &&
+				rendering.DefaultHeadTagsDisabled == nil &&
+				rendering.HeadTags == nil {
+				if !canPrompt(cmd) {
+					return errors.New("nothing to update, pass at least one of the rendering settings flags")
+				}
+
+				if err := renderingMode.Select(cmd, &inputs.RenderingMode, renderingModes, nil); err != nil {
+					return err
+				}
+				rendering.RenderingMode = &inputs.RenderingMode
+			}
+
+			if err := validateRenderingMode(rendering.GetRenderingMode()); err != nil {
+				return err
+			}
+
+			if err := ansi.Waiting(func() error {
+				return cli.api.PromptRendering.Update(cmd.Context(), inputs.Prompt, inputs.Screen, rendering)
+			}); err != nil {
+				return fmt.Errorf(
+					"failed to update the rendering settings of prompt %q and screen %q: %w",
+					inputs.Prompt,
+					inputs.Screen,
+					err,
+				)
+			}
+
+			cli.renderer.PromptRenderingUpdate(rendering)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	renderingMode.RegisterString(cmd, &inputs.RenderingMode, "")
+	renderingMode.RegisterCompletions(cmd, renderingModes)
+	renderingContextConfiguration.RegisterStringSlice(cmd, &inputs.ContextConfiguration, nil)
+	renderingDefaultHeadTagsDisabled.RegisterBool(cmd, &inputs.DefaultHeadTagsDisabled, false)
+	renderingHeadTags.RegisterString(cmd, &inputs.HeadTags, "")
+	renderingSettingsFile.RegisterString(cmd, &inputs.SettingsFile, "")
+
+	return cmd
+}
+
+func askRenderingPromptAndScreen(cmd *cobra.Command, args []string, prompt, screen *string) error {
+	if len(args) > 0 {
+		*prompt = args[0]
+	} else if err := renderingPrompt.Ask(cmd, prompt); err != nil {
+		return err
+	}
+
+	if len(args) > 1 {
+		*screen = args[1]
+	} else if err := renderingScreen.Ask(cmd, screen); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateRenderingMode(mode string) error {
+	if mode == "" {
+		return nil
+	}
+
+	for _, validMode := range renderingModes {
+		if mode == validMode {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid rendering mode %q, possible values: standard, advanced", mode)
+}
@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRenderingMode(t *testing.T) {
+	for _, mode := range []string{"", "standard", "advanced"} {
+		assert.NoError(t, validateRenderingMode(mode))
+	}
+
+	assert.EqualError(
+		t,
+		validateRenderingMode("custom"),
+		`invalid rendering mode "custom", possible values: standard, advanced`,
+	)
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+var (
+	themeSourceTenant = Flag{
+		Name:       "Source Tenant",
+		LongForm:   "from",
+		Help:       "Tenant to copy the theme from.",
+		IsRequired: true,
+	}
+
+	themeTargetTenant = Flag{
+		Name:       "Target Tenant",
+		LongForm:   "to",
+		Help:       "Tenant to copy the theme to. Its current theme gets overwritten.",
+		IsRequired: true,
+	}
+)
+
+func universalLoginThemesCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "themes",
+		Short: "Manage Universal Login themes",
+		Long:  "Manage the themes setting the look and feel of the Universal Login pages, such as their colors and fonts.",
+	}
+
+	cmd.SetUsageTemplate(resourceUsageTemplate())
+	cmd.AddCommand(copyUniversalLoginThemeCmd(cli))
+
+	return cmd
+}
+
+func copyUniversalLoginThemeCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		From string
+		To   string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "copy",
+		Args:  cobra.NoArgs,
+		Short: "Copy the theme of a tenant to another tenant",
+		Long: "Copy the theme of a tenant to another tenant, e.g. once it has been iterated on in a development tenant.\n\n" +
+			"Both tenants must be logged in. The theme of the target tenant gets overwritten, " +
+			"or created if it has none yet.\n\n" +
+			"To copy non-interactively, supply both tenants and the `--force` flag to skip confirmation.",
+		Example: `  auth0 universal-login themes copy
+  auth0 universal-login themes copy --from <tenant> --to <tenant>
+  auth0 ul themes copy --from dev.us.auth0.com --to prod.us.auth0.com --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := themeSourceTenant.Pick(cmd, &inputs.From, cli.tenantPickerOptions); err != nil {
+				return err
+			}
+
+			if err := themeTargetTenant.Pick(cmd, &inputs.To, cli.tenantPickerOptions); err != nil {
+				return err
+			}
+
+			if inputs.From == inputs.To {
+				return errors.New("the source and target tenants must be different")
+			}
+
+			currentTenant := cli.tenant
+			defer func() {
+				cli.tenant = currentTenant
+			}()
+
+			sourceAPI, err := cli.authenticateTenant(cmd, inputs.From)
+			if err != nil {
+				return fmt.Errorf("failed to authenticate the tenant %s: %w", inputs.From, err)
+			}
+
+			targetAPI, err := cli.authenticateTenant(cmd, inputs.To)
+			if err != nil {
+				return fmt.Errorf("failed to authenticate the tenant %s: %w", inputs.To, err)
+			}
+
+			targetTenant, err := cli.Config.GetTenant(inputs.To)
+			if err != nil {
+				return err
+			}
+
+			if err := checkReadOnlyMode(cmd.CommandPath(), targetTenant, cli.readOnly); err != nil {
+				return err
+			}
+
+			var theme *management.BrandingTheme
+			if err := ansi.Waiting(func() (err error) {
+				theme, err = sourceAPI.BrandingTheme.Default(cmd.Context())
+				return err
+			}); err != nil {
+				if isNotFoundError(err) {
+					return fmt.Errorf("the tenant %s has no theme to copy", inputs.From)
+				}
+
+				return fmt.Errorf("failed to read the theme of the tenant %s: %w", inputs.From, err)
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				message := fmt.Sprintf("Are you sure you want to overwrite the theme of the tenant %s?", inputs.To)
+				if confirmed := prompt.Confirm(message); !confirmed {
+					return nil
+				}
+			}
+
+			if err := ansi.Waiting(func() error {
+				return copyBrandingTheme(cmd.Context(), targetAPI, theme)
+			}); err != nil {
+				return fmt.Errorf("failed to copy the theme to the tenant %s: %w", inputs.To, err)
+			}
+
+			cli.renderer.Infof(
+				"Successfully copied the theme of the tenant %s to the tenant %s",
+				ansi.Bold(inputs.From),
+				ansi.Bold(inputs.To),
+			)
+
+			return nil
+		},
+	}
+
+	themeSourceTenant.RegisterString(cmd, &inputs.From, "")
+	themeTargetTenant.RegisterString(cmd, &inputs.To, "")
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// copyBrandingTheme overwrites the theme of the tenant, or creates it if it has none yet.
+// The IDs of the themes differ across tenants, so the one of the copied theme is dropped.
+func copyBrandingTheme(ctx context.Context, api *auth0.API, theme *management.BrandingTheme) error {
+	theme.ID = nil
+
+	current, err := api.BrandingTheme.Default(ctx)
+	if err != nil {
+		if !isNotFoundError(err) {
+			return err
+		}
+
+		return api.BrandingTheme.Create(ctx, theme)
+	}
+
+	return api.BrandingTheme.Update(ctx, current.GetID(), theme)
+}
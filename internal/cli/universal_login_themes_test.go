@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+)
+
+func TestCopyBrandingTheme(t *testing.T) {
+	t.Run("it overwrites the theme of the tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		themeAPI := mock.NewMockBrandingThemeAPI(ctrl)
+
+		theme := &management.BrandingTheme{ID: auth0.String("theme_dev"), DisplayName: auth0.String("Travel0")}
+
+		themeAPI.EXPECT().
+			Default(gomock.Any()).
+			Return(&management.BrandingTheme{ID: auth0.String("theme_prod")}, nil)
+		themeAPI.EXPECT().
+			Update(gomock.Any(), "theme_prod", &management.BrandingTheme{DisplayName: auth0.String("Travel0")}).
+			Return(nil)
+
+		err := copyBrandingTheme(context.Background(), &auth0.API{BrandingTheme: themeAPI}, theme)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it creates the theme when the tenant has none", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		themeAPI := mock.NewMockBrandingThemeAPI(ctrl)
+
+		theme := &management.BrandingTheme{ID: auth0.String("theme_dev"), DisplayName: auth0.String("Travel0")}
+
+		themeAPI.EXPECT().
+			Default(gomock.Any()).
+			Return(nil, newTestManagementError(t, []byte(`{"statusCode":404,"error":"Not Found","message":"There was an error retrieving branding settings: invalid theme ID"}`)))
+		themeAPI.EXPECT().
+			Create(gomock.Any(), &management.BrandingTheme{DisplayName: auth0.String("Travel0")}).
+			Return(nil)
+
+		err := copyBrandingTheme(context.Background(), &auth0.API{BrandingTheme: themeAPI}, theme)
+		assert.NoError(t, err)
+	})
+
+	t.Run("it returns the errors reading the theme of the tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		themeAPI := mock.NewMockBrandingThemeAPI(ctrl)
+
+		themeAPI.EXPECT().
+			Default(gomock.Any()).
+			Return(nil, assert.AnError)
+
+		err := copyBrandingTheme(context.Background(), &auth0.API{BrandingTheme: themeAPI}, &management.BrandingTheme{})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
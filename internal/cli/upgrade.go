@@ -0,0 +1,396 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/mholt/archiver/v3"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/buildinfo"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+const (
+	latestReleaseURL      = "https://api.github.com/repos/auth0/auth0-cli/releases/latest"
+	releaseChecksumsAsset = "checksums.txt"
+)
+
+// cliReleases fetches the releases of the CLI, it's overridden by the tests.
+var cliReleases = &releaseClient{
+	httpClient: http.DefaultClient,
+	latestURL:  latestReleaseURL,
+}
+
+var versionCheck = Flag{
+	Name:     "Check",
+	LongForm: "check",
+	Help:     "Check whether a newer version of the CLI got released.",
+}
+
+type (
+	releaseClient struct {
+		httpClient *http.Client
+		latestURL  string
+	}
+
+	// githubRelease is the subset of a GitHub release needed to upgrade the CLI.
+	githubRelease struct {
+		TagName string               `json:"tag_name"`
+		URL     string               `json:"html_url"`
+		Assets  []githubReleaseAsset `json:"assets"`
+	}
+
+	githubReleaseAsset struct {
+		Name        string `json:"name"`
+		DownloadURL string `json:"browser_download_url"`
+	}
+
+	// updateCheck compares the version of the CLI to its latest release.
+	updateCheck struct {
+		current   *version.Version
+		latest    *version.Version
+		release   *githubRelease
+		available bool
+	}
+)
+
+func versionCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		Check bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Args:  cobra.NoArgs,
+		Short: "Show the version of the CLI",
+		Long: "Show the version of the CLI.\n\n" +
+			"With `--check`, the latest release of the CLI is looked up on GitHub to report whether an update " +
+			"is available, which can then be installed with `auth0 upgrade`.",
+		Example: `  auth0 version
+  auth0 version --check
+  auth0 version --check --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !inputs.Check {
+				cli.renderer.Version(buildinfo.Version, buildinfo.Revision, "", false, "")
+				return nil
+			}
+
+			var check *updateCheck
+			if err := ansi.Waiting(func() (err error) {
+				check, err = checkForUpdate(cmd.Context())
+				return err
+			}); err != nil {
+				return err
+			}
+
+			cli.renderer.Version(
+				buildinfo.Version,
+				buildinfo.Revision,
+				check.latest.String(),
+				check.available,
+				check.release.URL,
+			)
+
+			return nil
+		},
+	}
+
+	versionCheck.RegisterBool(cmd, &inputs.Check, false)
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+func upgradeCmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Args:  cobra.NoArgs,
+		Short: "Upgrade the CLI to its latest version",
+		Long: "Upgrade the CLI to its latest version, for installs without a package manager such as " +
+			"locked-down servers.\n\n" +
+			"The release archive for the current platform is downloaded from GitHub and its SHA-256 checksum " +
+			"is verified against the checksums published with the release, before replacing the current " +
+			"executable.\n\n" +
+			"The release isn't signature-verified: the checksum only guards against corrupted downloads, as " +
+			"it comes from the same GitHub release as the archive. Use a package manager, or verify the " +
+			"release yourself, when that isn't enough.\n\n" +
+			"When the CLI was installed with a package manager such as Homebrew or Scoop, upgrade it " +
+			"with the package manager instead.",
+		Example: `  auth0 upgrade
+  auth0 upgrade --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var check *updateCheck
+			if err := ansi.Waiting(func() (err error) {
+				check, err = checkForUpdate(cmd.Context())
+				return err
+			}); err != nil {
+				return err
+			}
+
+			if !check.available {
+				cli.renderer.Infof("The CLI is already at its latest version %s", ansi.Bold(check.current.String()))
+				return nil
+			}
+
+			executable, err := os.Executable()
+			if err != nil {
+				return fmt.Errorf("failed to find the current executable: %w", err)
+			}
+			if executable, err = filepath.EvalSymlinks(executable); err != nil {
+				return fmt.Errorf("failed to find the current executable: %w", err)
+			}
+
+			if !cli.force && canPrompt(cmd) {
+				message := fmt.Sprintf(
+					"Are you sure you want to upgrade %s from %s to %s?",
+					executable,
+					check.current,
+					check.latest,
+				)
+				if confirmed := prompt.Confirm(message); !confirmed {
+					return nil
+				}
+			}
+
+			if err := ansi.Spinner("Downloading and verifying version "+check.latest.String(), func() error {
+				return upgradeExecutable(cmd.Context(), check, executable)
+			}); err != nil {
+				return fmt.Errorf("failed to upgrade the CLI: %w", err)
+			}
+
+			cli.renderer.Infof("Successfully upgraded the CLI to version %s", ansi.Bold(check.latest.String()))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+
+	return cmd
+}
+
+// checkForUpdate compares the version of the CLI to its latest release.
+func checkForUpdate(ctx context.Context) (*updateCheck, error) {
+	current, err := version.NewVersion(buildinfo.Version)
+	if err != nil {
+		return nil, fmt.Errorf("the version %q of this build can't be compared to the released versions", buildinfo.Version)
+	}
+
+	release, err := cliReleases.latest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the latest release: %w", err)
+	}
+
+	latest, err := version.NewVersion(release.TagName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the version of the latest release %q: %w", release.TagName, err)
+	}
+
+	return &updateCheck{
+		current:   current,
+		latest:    latest,
+		release:   release,
+		available: latest.GreaterThan(current),
+	}, nil
+}
+
+func (c *releaseClient) latest(ctx context.Context) (*githubRelease, error) {
+	var body bytes.Buffer
+	if err := c.download(ctx, c.latestURL, &body); err != nil {
+		return nil, err
+	}
+
+	var release githubRelease
+	if err := json.Unmarshal(body.Bytes(), &release); err != nil {
+		return nil, err
+	}
+
+	return &release, nil
+}
+
+func (c *releaseClient) download(ctx context.Context, url string, w io.Writer) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d when downloading %s", response.StatusCode, url)
+	}
+
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+func (r *githubRelease) asset(name string) (githubReleaseAsset, bool) {
+	for _, asset := range r.Assets {
+		if asset.Name == name {
+			return asset, true
+		}
+	}
+
+	return githubReleaseAsset{}, false
+}
+
+// releaseArchiveName returns the name of the release archive of a platform,
+// following the name template of the archives in .goreleaser.yml.
+func releaseArchiveName(version, goos, goarch string) string {
+	arch := "x86_64"
+	if goarch == "arm64" {
+		arch = "arm64"
+	}
+
+	extension := ".tar.gz"
+	if goos == "windows" {
+		extension = ".zip"
+	}
+
+	return fmt.Sprintf("auth0_%s_%s_%s%s", version, strings.ToUpper(goos[:1])+goos[1:], arch, extension)
+}
+
+// upgradeExecutable downloads the release archive of the current platform,
+// verifies its checksum and replaces the executable with the binary it holds.
+// The checksum comes from the same release, so it doesn't authenticate the archive.
+func upgradeExecutable(ctx context.Context, check *updateCheck, executable string) error {
+	archiveName := releaseArchiveName(check.latest.String(), runtime.GOOS, runtime.GOARCH)
+
+	archiveAsset, ok := check.release.asset(archiveName)
+	if !ok {
+		return fmt.Errorf("the release %s has no archive %s for this platform", check.release.TagName, archiveName)
+	}
+
+	checksumsAsset, ok := check.release.asset(releaseChecksumsAsset)
+	if !ok {
+		return fmt.Errorf("the release %s has no %s to verify the archive with", check.release.TagName, releaseChecksumsAsset)
+	}
+
+	var checksums, archive bytes.Buffer
+	if err := cliReleases.download(ctx, checksumsAsset.DownloadURL, &checksums); err != nil {
+		return err
+	}
+	if err := cliReleases.download(ctx, archiveAsset.DownloadURL, &archive); err != nil {
+		return err
+	}
+
+	if err := verifyReleaseChecksum(checksums.Bytes(), archiveName, archive.Bytes()); err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "auth0-upgrade")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	archivePath := filepath.Join(dir, archiveName)
+	if err := os.WriteFile(archivePath, archive.Bytes(), 0600); err != nil {
+		return err
+	}
+
+	binaryDir := filepath.Join(dir, "release")
+	if err := archiver.Unarchive(archivePath, binaryDir); err != nil {
+		return fmt.Errorf("failed to extract %s: %w", archiveName, err)
+	}
+
+	binaryName := "auth0"
+	if runtime.GOOS == "windows" {
+		binaryName += ".exe"
+	}
+
+	return replaceExecutable(executable, filepath.Join(binaryDir, binaryName))
+}
+
+// verifyReleaseChecksum checks the SHA-256 checksum of the archive against
+// the checksums.txt of the release, which lists "<checksum>  <name>" lines.
+func verifyReleaseChecksum(checksums []byte, name string, archive []byte) error {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[1] != name {
+			continue
+		}
+
+		sum := sha256.Sum256(archive)
+		if actual := hex.EncodeToString(sum[:]); !strings.EqualFold(actual, fields[0]) {
+			return fmt.Errorf("the checksum of %s is %s, but the release lists %s", name, actual, fields[0])
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("the checksum of %s isn't listed in %s", name, releaseChecksumsAsset)
+}
+
+// replaceExecutable replaces the executable with the binary. The binary is first copied next to
+// the executable, so that renaming it over the executable doesn't cross file systems, and the
+// executable is moved aside rather than overwritten, as Windows doesn't allow that while it runs.
+func replaceExecutable(executable, binary string) error {
+	source, err := os.Open(binary)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	target, err := os.CreateTemp(filepath.Dir(executable), ".auth0-upgrade-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(target.Name())
+	}()
+
+	if _, err := io.Copy(target, source); err != nil {
+		_ = target.Close()
+		return err
+	}
+	if err := target.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(target.Name(), 0755); err != nil {
+		return err
+	}
+
+	previous := executable + ".old"
+	_ = os.Remove(previous)
+
+	if err := os.Rename(executable, previous); err != nil {
+		return err
+	}
+
+	if err := os.Rename(target.Name(), executable); err != nil {
+		_ = os.Rename(previous, executable)
+		return err
+	}
+
+	// Removing the previous executable fails on Windows while it runs,
+	// in which case it gets removed by the next upgrade instead.
+	_ = os.Remove(previous)
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/buildinfo"
+)
+
+func TestReleaseArchiveName(t *testing.T) {
+	assert.Equal(t, "auth0_1.4.0_Linux_x86_64.tar.gz", releaseArchiveName("1.4.0", "linux", "amd64"))
+	assert.Equal(t, "auth0_1.4.0_Darwin_arm64.tar.gz", releaseArchiveName("1.4.0", "darwin", "arm64"))
+	assert.Equal(t, "auth0_1.4.0_Windows_x86_64.zip", releaseArchiveName("1.4.0", "windows", "amd64"))
+}
+
+func TestVerifyReleaseChecksum(t *testing.T) {
+	archive := []byte("archive")
+	sum := sha256.Sum256(archive)
+	checksums := []byte(fmt.Sprintf(
+		"%s  auth0_1.4.0_Linux_x86_64.tar.gz\n%s  auth0_1.4.0_Darwin_arm64.tar.gz\n",
+		hex.EncodeToString(sum[:]),
+		"0000",
+	))
+
+	assert.NoError(t, verifyReleaseChecksum(checksums, "auth0_1.4.0_Linux_x86_64.tar.gz", archive))
+	assert.EqualError(
+		t,
+		verifyReleaseChecksum(checksums, "auth0_1.4.0_Darwin_arm64.tar.gz", archive),
+		"the checksum of auth0_1.4.0_Darwin_arm64.tar.gz is "+hex.EncodeToString(sum[:])+", but the release lists 0000",
+	)
+	assert.EqualError(
+		t,
+		verifyReleaseChecksum(checksums, "auth0_1.4.0_Windows_x86_64.zip", archive),
+		"the checksum of auth0_1.4.0_Windows_x86_64.zip isn't listed in checksums.txt",
+	)
+}
+
+func TestCheckForUpdate(t *testing.T) {
+	server := newReleaseTestServer(t, "v1.4.0", nil)
+	setBuildVersion(t, "1.3.2")
+
+	check, err := checkForUpdate(context.Background())
+	require.NoError(t, err)
+	assert.True(t, check.available)
+	assert.Equal(t, "1.4.0", check.latest.String())
+	assert.Equal(t, server.URL+"/releases/v1.4.0", check.release.URL)
+
+	setBuildVersion(t, "1.4.0")
+
+	check, err = checkForUpdate(context.Background())
+	require.NoError(t, err)
+	assert.False(t, check.available)
+
+	setBuildVersion(t, "")
+
+	_, err = checkForUpdate(context.Background())
+	assert.EqualError(t, err, `the version "" of this build can't be compared to the released versions`)
+}
+
+func TestUpgradeExecutable(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the release archives for Windows are zip files")
+	}
+
+	archive := releaseTestArchive(t, "new binary")
+	setBuildVersion(t, "1.3.2")
+
+	executable := filepath.Join(t.TempDir(), "auth0")
+	require.NoError(t, os.WriteFile(executable, []byte("old binary"), 0755))
+
+	t.Run("it replaces the executable once the checksum is verified", func(t *testing.T) {
+		sum := sha256.Sum256(archive)
+		newReleaseTestServer(t, "v1.4.0", map[string][]byte{
+			releaseArchiveName("1.4.0", runtime.GOOS, runtime.GOARCH): archive,
+			releaseChecksumsAsset: []byte(fmt.Sprintf(
+				"%s  %s\n",
+				hex.EncodeToString(sum[:]),
+				releaseArchiveName("1.4.0", runtime.GOOS, runtime.GOARCH),
+			)),
+		})
+
+		check, err := checkForUpdate(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, upgradeExecutable(context.Background(), check, executable))
+
+		content, err := os.ReadFile(executable)
+		require.NoError(t, err)
+		assert.Equal(t, "new binary", string(content))
+		assert.NoFileExists(t, executable+".old")
+	})
+
+	t.Run("it doesn't replace the executable when the checksum doesn't match", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(executable, []byte("old binary"), 0755))
+
+		newReleaseTestServer(t, "v1.4.0", map[string][]byte{
+			releaseArchiveName("1.4.0", runtime.GOOS, runtime.GOARCH): archive,
+			releaseChecksumsAsset: []byte("0000  " + releaseArchiveName("1.4.0", runtime.GOOS, runtime.GOARCH) + "\n"),
+		})
+
+		check, err := checkForUpdate(context.Background())
+		require.NoError(t, err)
+		assert.ErrorContains(t, upgradeExecutable(context.Background(), check, executable), "but the release lists 0000")
+
+		content, err := os.ReadFile(executable)
+		require.NoError(t, err)
+		assert.Equal(t, "old binary", string(content))
+	})
+}
+
+// newReleaseTestServer serves the latest release of the CLI with the given assets.
+func newReleaseTestServer(t *testing.T, tag string, assets map[string][]byte) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/releases/latest" {
+			release := githubRelease{TagName: tag, URL: server.URL + "/releases/" + tag}
+			for name := range assets {
+				release.Assets = append(release.Assets, githubReleaseAsset{
+					Name:        name,
+					DownloadURL: server.URL + "/download/" + name,
+				})
+			}
+
+			_ = json.NewEncoder(writer).Encode(release)
+			return
+		}
+
+		content, ok := assets[filepath.Base(request.URL.Path)]
+		if !ok {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = writer.Write(content)
+	}))
+	t.Cleanup(server.Close)
+
+	previous := cliReleases
+	cliReleases = &releaseClient{httpClient: server.Client(), latestURL: server.URL + "/releases/latest"}
+	t.Cleanup(func() {
+		cliReleases = previous
+	})
+
+	return server
+}
+
+func releaseTestArchive(t *testing.T, binary string) []byte {
+	t.Helper()
+
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: "auth0", Mode: 0755, Size: int64(len(binary))}))
+	_, err := tarWriter.Write([]byte(binary))
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	return archive.Bytes()
+}
+
+func setBuildVersion(t *testing.T, version string) {
+	t.Helper()
+
+	previous := buildinfo.Version
+	buildinfo.Version = version
+	t.Cleanup(func() {
+		buildinfo.Version = previous
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/spf13/cobra"
@@ -70,6 +71,12 @@ var (
 		Help:       "Search query in Lucene query syntax.\n\nFor example: `email:\"user123@*.com\" OR (user_id:\"user-id-123\" AND name:\"Bob\")`\n\n For more info: https://auth0.com/docs/users/user-search/user-search-query-syntax.",
 		IsRequired: true,
 	}
+	userIdentity = Flag{
+		Name:     "Identity",
+		LongForm: "identity",
+		Help: "Show the identity linked to the user from this provider, e.g. 'google-oauth2', including the profile " +
+			"returned by the provider. Use '<provider>|<user-id>' when several identities share the same provider.",
+	}
 	userSort = Flag{
 		Name:      "Sort",
 		LongForm:  "sort",
@@ -134,6 +141,7 @@ func usersCmd(cli *cli) *cobra.Command {
 	cmd.AddCommand(openUserCmd(cli))
 	cmd.AddCommand(userBlocksCmd(cli))
 	cmd.AddCommand(importUsersCmd(cli))
+	cmd.AddCommand(migrateUsersCmd(cli))
 
 	return cmd
 }
@@ -172,6 +180,7 @@ func searchUsersCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("number flag invalid, please pass a number between 1 and 1000")
 			}
 
+			var total int
 			list, err := getWithPagination(
 				inputs.number,
 				func(opts ...management.RequestOption) (result []interface{}, hasNext bool, err error) {
@@ -186,6 +195,7 @@ func searchUsersCmd(cli *cli) *cobra.Command {
 					for _, user := range userList.Users {
 						output = append(output, user)
 					}
+					total = userList.Total
 
 					return output, userList.HasNext(), nil
 				},
@@ -200,6 +210,7 @@ func searchUsersCmd(cli *cli) *cobra.Command {
 			}
 
 			cli.renderer.UserSearch(foundUsers)
+			cli.renderer.Truncated("users", len(foundUsers), total)
 
 			return nil
 		},
@@ -316,17 +327,21 @@ func createUserCmd(cli *cli) *cobra.Command {
 
 func showUserCmd(cli *cli) *cobra.Command {
 	var inputs struct {
-		ID string
+		ID       string
+		Identity string
 	}
 
 	cmd := &cobra.Command{
 		Use:   "show",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Show an existing user",
-		Long:  "Display information about an existing user.",
+		Long: "Display information about an existing user, along with the identities linked to it.\n\n" +
+			"To debug account linking, use the `--identity` flag to show the details of one of the identities.",
 		Example: `  auth0 users show 
   auth0 users show <user-id>
-  auth0 users show <user-id> --json`,
+  auth0 users show <user-id> --json
+  auth0 users show <user-id> --identity google-oauth2
+  auth0 users show <user-id> --identity "auth0|64b7f0c6e5a1b2c3d4e5f6a7" --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				if err := userID.Ask(cmd, &inputs.ID); err != nil {
@@ -346,6 +361,16 @@ func showUserCmd(cli *cli) *cobra.Command {
 				return fmt.Errorf("failed to load user with ID %q: %w", inputs.ID, err)
 			}
 
+			if inputs.Identity != "" {
+				identity, err := findUserIdentity(a, inputs.Identity)
+				if err != nil {
+					return err
+				}
+
+				cli.renderer.UserIdentityShow(identity)
+				return nil
+			}
+
 			// Get the current connection.
 			conn := stringSliceToCommaSeparatedString(cli.getUserConnection(a))
 			a.Connection = auth0.String(conn)
@@ -360,6 +385,7 @@ func showUserCmd(cli *cli) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+	userIdentity.RegisterString(cmd, &inputs.Identity, "")
 
 	return cmd
 }
@@ -423,6 +449,7 @@ func updateUserCmd(cli *cli) *cobra.Command {
 		Password       string
 		Name           string
 		ConnectionName string
+		Patch          string
 	}
 
 	cmd := &cobra.Command{
@@ -431,11 +458,17 @@ func updateUserCmd(cli *cli) *cobra.Command {
 		Short: "Update a user",
 		Long: "Update a user.\n\n" +
 			"To update interactively, use `auth0 users update` with no arguments.\n\n" +
-			"To update non-interactively, supply the user id and other information through the available flags.",
+			"To update non-interactively, supply the user id and other information through the available flags.\n\n" +
+			"To update the fields that aren't available as flags, such as the metadata, supply a JSON patch of " +
+			"these fields through `--patch`. Auth0 merges the `app_metadata` and `user_metadata` one level deep, " +
+			"removing their properties set to null, and replaces every other field. The changes to the user are " +
+			"then shown as a diff, on the standard error with `--json`.",
 		Example: `  auth0 users update 
   auth0 users update <user-id> 
   auth0 users update <user-id> --name "John Doe"
-  auth0 users update <user-id> --name "John Doe" --email john.doe@example.com`,
+  auth0 users update <user-id> --name "John Doe" --email john.doe@example.com
+  auth0 users update <user-id> --patch patch.json
+  cat patch.json | auth0 users update <user-id> --patch -`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				if err := userID.Ask(cmd, &inputs.ID); err != nil {
@@ -445,6 +478,10 @@ func updateUserCmd(cli *cli) *cobra.Command {
 				inputs.ID = args[0]
 			}
 
+			if inputs.Patch != "" {
+				return patchUser(cmd.Context(), cli, inputs.ID, inputs.Patch)
+			}
+
 			var current *management.User
 
 			if err := ansi.Waiting(func() error {
@@ -517,6 +554,10 @@ func updateUserCmd(cli *cli) *cobra.Command {
 	userConnectionName.RegisterStringU(cmd, &inputs.ConnectionName, "")
 	userPassword.RegisterStringU(cmd, &inputs.Password, "")
 	userEmail.RegisterStringU(cmd, &inputs.Email, "")
+	userPatch.RegisterString(cmd, &inputs.Patch, "")
+	for _, flag := range []Flag{userName, userConnectionName, userPassword, userEmail} {
+		cmd.MarkFlagsMutuallyExclusive(userPatch.LongForm, flag.LongForm)
+	}
 
 	return cmd
 }
@@ -738,3 +779,39 @@ func (c *cli) getConnReqUsername(ctx context.Context, s string) *bool {
 func (c *cli) userImportEditorHint() {
 	c.renderer.Infof("%s Once you close the editor, the user(s) will be imported. To cancel, CTRL+C.", ansi.Faint("Hint:"))
 }
+
+// findUserIdentity returns the identity linked to the user from the provider, which
+// can also be given as "<provider>|<user-id>" to tell apart the identities sharing it.
+func findUserIdentity(user *management.User, selector string) (*management.UserIdentity, error) {
+	var matches, available []string
+	var found *management.UserIdentity
+
+	for _, identity := range user.Identities {
+		id := identity.GetProvider() + "|" + identity.GetUserID()
+		available = append(available, id)
+
+		if selector == id || selector == identity.GetProvider() {
+			matches = append(matches, id)
+			found = identity
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf(
+			"the user with ID %q has no identity from %q, its identities are: %s",
+			user.GetID(),
+			selector,
+			strings.Join(available, ", "),
+		)
+	case 1:
+		return found, nil
+	default:
+		return nil, fmt.Errorf(
+			"the user with ID %q has several identities from %q, use one of: %s",
+			user.GetID(),
+			selector,
+			strings.Join(matches, ", "),
+		)
+	}
+}
@@ -0,0 +1,379 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/prompt"
+)
+
+// userImportMaxSize is the size limit of the users file of an import users job.
+const userImportMaxSize = 500 * 1024
+
+var (
+	userMigrateFile = Flag{
+		Name:       "File",
+		LongForm:   "from-file",
+		ShortForm:  "f",
+		Help:       "Path to a JSON file holding the array of users to migrate, in the user import schema.",
+		IsRequired: true,
+	}
+
+	userMigrateConnection = Flag{
+		Name:       "Connection",
+		LongForm:   "connection",
+		ShortForm:  "c",
+		Help:       "Name of the database connection to migrate the users to.",
+		IsRequired: true,
+	}
+
+	userMigrateDryRun = Flag{
+		Name:     "Dry Run",
+		LongForm: "dry-run",
+		Help:     "Validate the users without importing them.",
+	}
+
+	passwordHashAlgorithms = []string{
+		"argon2", "bcrypt", "hmac", "ldap", "md4", "md5", "sha1", "sha256", "sha512", "pbkdf2", "scrypt",
+	}
+	passwordHashEncodings = []string{"base64", "hex", "utf8"}
+	passwordHashDigests   = []string{
+		"md4", "md5", "ripemd160", "sha1", "sha224", "sha256", "sha384", "sha512", "whirlpool",
+	}
+	passwordEncodings   = []string{"ascii", "utf8", "utf16le", "ucs2", "latin1", "binary"}
+	passwordSaltOptions = []string{"prefix", "suffix"}
+)
+
+// customPasswordHash is the custom_password_hash of the users to import,
+// describing how their password got hashed by the system they come from.
+type customPasswordHash struct {
+	Algorithm string `json:"algorithm"`
+	Hash      *struct {
+		Value    string `json:"value"`
+		Encoding string `json:"encoding"`
+		Digest   string `json:"digest"`
+		Key      *struct {
+			Value    string `json:"value"`
+			Encoding string `json:"encoding"`
+		} `json:"key"`
+	} `json:"hash"`
+	Salt *struct {
+		Value    string `json:"value"`
+		Encoding string `json:"encoding"`
+		Position string `json:"position"`
+	} `json:"salt"`
+	Password *struct {
+		Encoding string `json:"encoding"`
+	} `json:"password"`
+	KeyLength int `json:"keylen"`
+}
+
+func migrateUsersCmd(cli *cli) *cobra.Command {
+	var inputs struct {
+		File                string
+		ConnectionName      string
+		Upsert              bool
+		SendCompletionEmail bool
+		DryRun              bool
+	}
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Args:  cobra.NoArgs,
+		Short: "Migrate users along with their password hashes",
+		Long: "Migrate users from a legacy system to a database connection, keeping their passwords.\n\n" +
+			"The users are read from a JSON file in the user import schema, in which the passwords are given either as " +
+			"bcrypt hashes through `password_hash`, or as hashes of another algorithm through `custom_password_hash`. " +
+			"See https://auth0.com/docs/manage-users/user-migration/bulk-user-import-database-schema-and-examples.\n\n" +
+			"The password hashes are validated before anything is imported, and the users are split into " +
+			"as many import users jobs as needed to stay within the size limit of each job.",
+		Example: `  auth0 users migrate --from-file users-with-hashes.json --connection "Username-Password-Authentication"
+  auth0 users migrate --from-file users-with-hashes.json --connection "Username-Password-Authentication" --dry-run
+  auth0 users migrate --from-file users-with-hashes.json --connection "Username-Password-Authentication" --upsert
+  auth0 users migrate -f users-with-hashes.json -c "Username-Password-Authentication" --upsert --email-results=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := userMigrateFile.Ask(cmd, &inputs.File, nil); err != nil {
+				return err
+			}
+
+			content, err := os.ReadFile(inputs.File)
+			if err != nil {
+				return fmt.Errorf("failed to read the users file: %w", err)
+			}
+
+			var users []map[string]interface{}
+			if err := json.Unmarshal(content, &users); err != nil {
+				return fmt.Errorf("invalid JSON input, the file needs to hold an array of users: %w", err)
+			}
+
+			if err := validateUsersToMigrate(users); err != nil {
+				return err
+			}
+
+			if inputs.DryRun {
+				cli.renderer.Infof("The %d user(s) are valid to migrate.", len(users))
+				return nil
+			}
+
+			dbConnectionOptions, err := cli.databaseAndPasswordlessConnectionOptions(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if err := userMigrateConnection.Select(cmd, &inputs.ConnectionName, dbConnectionOptions, nil); err != nil {
+				return err
+			}
+
+			connection, err := cli.api.Connection.ReadByName(cmd.Context(), inputs.ConnectionName)
+			if err != nil {
+				return fmt.Errorf("failed to read connection with name %q: %w", inputs.ConnectionName, err)
+			}
+
+			if connection.GetStrategy() != management.ConnectionStrategyAuth0 {
+				return fmt.Errorf(
+					"failed to migrate users to the connection with name %q, passwords can only be migrated to database connections",
+					inputs.ConnectionName,
+				)
+			}
+
+			batches, err := userImportBatches(users, userImportMaxSize)
+			if err != nil {
+				return err
+			}
+
+			if canPrompt(cmd) && !cli.force {
+				message := fmt.Sprintf(
+					"Do you want to migrate %d user(s) to %s through %d import job(s)?",
+					len(users),
+					inputs.ConnectionName,
+					len(batches),
+				)
+				if !prompt.Confirm(message) {
+					return nil
+				}
+			}
+
+			var jobs []*management.Job
+			if err := ansi.Spinner("Starting import users jobs", func() error {
+				for _, batch := range batches {
+					job := &management.Job{
+						ConnectionID:        connection.ID,
+						Users:               batch,
+						Upsert:              &inputs.Upsert,
+						SendCompletionEmail: &inputs.SendCompletionEmail,
+					}
+
+					if err := cli.api.Jobs.ImportUsers(cmd.Context(), job); err != nil {
+						return fmt.Errorf("failed to import users: %w", err)
+					}
+
+					jobs = append(jobs, job)
+				}
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			cli.renderer.Heading("started user migration")
+			for _, job := range jobs {
+				cli.renderer.Infof("Job with ID '%s' successfully started.", ansi.Bold(job.GetID()))
+			}
+			cli.renderer.Infof("Run '%s' to get the status of a job.", ansi.Cyan("auth0 api jobs/<job-id>"))
+
+			if inputs.SendCompletionEmail {
+				cli.renderer.Infof("Results of your user import jobs will be sent to your email.")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.force, "force", false, "Skip confirmation.")
+	userMigrateFile.RegisterString(cmd, &inputs.File, "")
+	userMigrateConnection.RegisterString(cmd, &inputs.ConnectionName, "")
+	userEmailResults.RegisterBool(cmd, &inputs.SendCompletionEmail, true)
+	userImportUpsert.RegisterBool(cmd, &inputs.Upsert, false)
+	userMigrateDryRun.RegisterBool(cmd, &inputs.DryRun, false)
+
+	return cmd
+}
+
+// validateUsersToMigrate checks that the users can be imported along with their
+// password hashes, reporting the problems of all the users at once.
+func validateUsersToMigrate(users []map[string]interface{}) error {
+	if len(users) == 0 {
+		return fmt.Errorf("there are no users to migrate within the file")
+	}
+
+	var problems []string
+	for i, user := range users {
+		identifier := fmt.Sprintf("#%d", i+1)
+		if email, ok := user["email"].(string); ok && email != "" {
+			identifier += fmt.Sprintf(" (%s)", email)
+		}
+
+		for _, problem := range validateUserToMigrate(user) {
+			problems = append(problems, fmt.Sprintf("  - user %s: %s", identifier, problem))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problem(s) with the users to migrate:\n%s", len(problems), strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
+
+func validateUserToMigrate(user map[string]interface{}) []string {
+	var problems []string
+
+	if email, _ := user["email"].(string); email == "" {
+		problems = append(problems, "email is required")
+	}
+
+	passwordHash, hasPasswordHash := user["password_hash"]
+	rawCustomHash, hasCustomHash := user["custom_password_hash"]
+
+	if hasPasswordHash && hasCustomHash {
+		return append(problems, "password_hash and custom_password_hash can't be given together")
+	}
+
+	if hasPasswordHash {
+		if hash, _ := passwordHash.(string); !strings.HasPrefix(hash, "$2") {
+			problems = append(problems, "password_hash needs to be a bcrypt hash, use custom_password_hash for other algorithms")
+		}
+	}
+
+	if hasCustomHash {
+		problems = append(problems, validateCustomPasswordHash(rawCustomHash)...)
+	}
+
+	return problems
+}
+
+func validateCustomPasswordHash(raw interface{}) []string {
+	content, err := json.Marshal(raw)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid custom_password_hash: %v", err)}
+	}
+
+	var hash customPasswordHash
+	if err := json.Unmarshal(content, &hash); err != nil {
+		return []string{fmt.Sprintf("invalid custom_password_hash: %v", err)}
+	}
+
+	var problems []string
+	invalid := func(field, value string, options []string) {
+		if value != "" && !slices.Contains(options, value) {
+			problems = append(problems, fmt.Sprintf(
+				"unsupported custom_password_hash.%s %q, possible values: %s",
+				field,
+				value,
+				strings.Join(options, ", "),
+			))
+		}
+	}
+
+	if hash.Algorithm == "" {
+		problems = append(problems, "custom_password_hash.algorithm is required")
+	}
+	invalid("algorithm", hash.Algorithm, passwordHashAlgorithms)
+
+	if hash.Hash == nil || hash.Hash.Value == "" {
+		return append(problems, "custom_password_hash.hash.value is required")
+	}
+
+	invalid("hash.encoding", hash.Hash.Encoding, passwordHashEncodings)
+
+	// The algorithms taking their parameters from the hash expect it in the PHC string format.
+	phcPrefixes := map[string]string{
+		"argon2": "$argon2",
+		"bcrypt": "$2",
+		"pbkdf2": "$pbkdf2",
+	}
+	if prefix, ok := phcPrefixes[hash.Algorithm]; ok && !strings.HasPrefix(hash.Hash.Value, prefix) {
+		problems = append(problems, fmt.Sprintf(
+			"custom_password_hash.hash.value needs to be a %s hash starting with %q",
+			hash.Algorithm,
+			prefix,
+		))
+	}
+
+	switch hash.Algorithm {
+	case "hmac":
+		if hash.Hash.Digest == "" {
+			problems = append(problems, "custom_password_hash.hash.digest is required by hmac")
+		}
+		invalid("hash.digest", hash.Hash.Digest, passwordHashDigests)
+
+		if hash.Hash.Key == nil || hash.Hash.Key.Value == "" {
+			problems = append(problems, "custom_password_hash.hash.key.value is required by hmac")
+		} else {
+			invalid("hash.key.encoding", hash.Hash.Key.Encoding, passwordHashEncodings)
+		}
+	case "scrypt":
+		if hash.Salt == nil || hash.Salt.Value == "" {
+			problems = append(problems, "custom_password_hash.salt.value is required by scrypt")
+		}
+		if hash.KeyLength <= 0 {
+			problems = append(problems, "custom_password_hash.keylen is required by scrypt")
+		}
+	}
+
+	if hash.Salt != nil {
+		if hash.Salt.Value == "" {
+			problems = append(problems, "custom_password_hash.salt.value is required when giving a salt")
+		}
+		invalid("salt.encoding", hash.Salt.Encoding, passwordHashEncodings)
+		invalid("salt.position", hash.Salt.Position, passwordSaltOptions)
+	}
+
+	if hash.Password != nil {
+		invalid("password.encoding", hash.Password.Encoding, passwordEncodings)
+	}
+
+	return problems
+}
+
+// userImportBatches splits the users into batches that each fit within an import users job.
+func userImportBatches(users []map[string]interface{}, maxSize int) ([][]map[string]interface{}, error) {
+	var (
+		batches [][]map[string]interface{}
+		batch   []map[string]interface{}
+		size    = 2 // The brackets of the JSON array.
+	)
+
+	for i, user := range users {
+		content, err := json.Marshal(user)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode user #%d: %w", i+1, err)
+		}
+
+		userSize := len(content) + 1 // The comma separating the users.
+		if userSize+2 > maxSize {
+			return nil, fmt.Errorf("user #%d exceeds the size limit of an import users job", i+1)
+		}
+
+		if size+userSize > maxSize {
+			batches = append(batches, batch)
+			batch, size = nil, 2
+		}
+
+		batch = append(batch, user)
+		size += userSize
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUserToMigrate(t *testing.T) {
+	var tests = []struct {
+		name     string
+		user     string
+		problems []string
+	}{
+		{
+			name: "it accepts a bcrypt password hash",
+			user: `{"email": "jane@example.com", "password_hash": "$2b$10$C9hRnkd6HrNqP0pYuTfmVeQzjdRBTnPq1jrnmzhKnmyuvP6ldwMm2"}`,
+		},
+		{
+			name: "it accepts a user without password",
+			user: `{"email": "jane@example.com"}`,
+		},
+		{
+			name: "it accepts a salted sha256 hash",
+			user: `{
+				"email": "jane@example.com",
+				"custom_password_hash": {
+					"algorithm": "sha256",
+					"hash": {"value": "d24e794fd5f2d9d4c1b9aa5e4d2ab9d2", "encoding": "hex"},
+					"salt": {"value": "abc123", "position": "prefix"}
+				}
+			}`,
+		},
+		{
+			name: "it accepts an hmac hash",
+			user: `{
+				"email": "jane@example.com",
+				"custom_password_hash": {
+					"algorithm": "hmac",
+					"hash": {"value": "oc+b3Y1lvEGaWBzcXXRtjg==", "encoding": "base64", "digest": "sha256", "key": {"value": "secret", "encoding": "utf8"}}
+				}
+			}`,
+		},
+		{
+			name:     "it requires the email",
+			user:     `{"password_hash": "$2b$10$C9hRnkd6HrNqP0pYuTfmVe"}`,
+			problems: []string{"email is required"},
+		},
+		{
+			name:     "it rejects a password hash that isn't bcrypt",
+			user:     `{"email": "jane@example.com", "password_hash": "5f4dcc3b5aa765d61d8327deb882cf99"}`,
+			problems: []string{"password_hash needs to be a bcrypt hash, use custom_password_hash for other algorithms"},
+		},
+		{
+			name:     "it rejects both password hashes at once",
+			user:     `{"email": "jane@example.com", "password_hash": "$2b$10$C9hRnkd6HrNqP0pYuTfmVe", "custom_password_hash": {}}`,
+			problems: []string{"password_hash and custom_password_hash can't be given together"},
+		},
+		{
+			name: "it rejects unsupported algorithms and encodings",
+			user: `{
+				"email": "jane@example.com",
+				"custom_password_hash": {
+					"algorithm": "crc32",
+					"hash": {"value": "cbf43926", "encoding": "binary"}
+				}
+			}`,
+			problems: []string{
+				`unsupported custom_password_hash.algorithm "crc32", possible values: argon2, bcrypt, hmac, ldap, md4, md5, sha1, sha256, sha512, pbkdf2, scrypt`,
+				`unsupported custom_password_hash.hash.encoding "binary", possible values: base64, hex, utf8`,
+			},
+		},
+		{
+			name:     "it requires the hash value",
+			user:     `{"email": "jane@example.com", "custom_password_hash": {"algorithm": "md5"}}`,
+			problems: []string{"custom_password_hash.hash.value is required"},
+		},
+		{
+			name:     "it requires the phc string format",
+			user:     `{"email": "jane@example.com", "custom_password_hash": {"algorithm": "pbkdf2", "hash": {"value": "abc"}}}`,
+			problems: []string{`custom_password_hash.hash.value needs to be a pbkdf2 hash starting with "$pbkdf2"`},
+		},
+		{
+			name: "it requires the parameters of hmac",
+			user: `{"email": "jane@example.com", "custom_password_hash": {"algorithm": "hmac", "hash": {"value": "abc"}}}`,
+			problems: []string{
+				"custom_password_hash.hash.digest is required by hmac",
+				"custom_password_hash.hash.key.value is required by hmac",
+			},
+		},
+		{
+			name: "it requires the parameters of scrypt",
+			user: `{"email": "jane@example.com", "custom_password_hash": {"algorithm": "scrypt", "hash": {"value": "abc"}}}`,
+			problems: []string{
+				"custom_password_hash.salt.value is required by scrypt",
+				"custom_password_hash.keylen is required by scrypt",
+			},
+		},
+		{
+			name: "it rejects an invalid salt position",
+			user: `{
+				"email": "jane@example.com",
+				"custom_password_hash": {"algorithm": "md5", "hash": {"value": "abc"}, "salt": {"value": "s", "position": "middle"}}
+			}`,
+			problems: []string{`unsupported custom_password_hash.salt.position "middle", possible values: prefix, suffix`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var user map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(test.user), &user))
+
+			assert.Equal(t, test.problems, validateUserToMigrate(user))
+		})
+	}
+}
+
+func TestValidateUsersToMigrate(t *testing.T) {
+	err := validateUsersToMigrate(nil)
+	assert.EqualError(t, err, "there are no users to migrate within the file")
+
+	err = validateUsersToMigrate([]map[string]interface{}{
+		{"email": "jane@example.com"},
+		{"email": "john@example.com", "password_hash": "plain"},
+		{"name": "John"},
+	})
+	assert.EqualError(
+		t,
+		err,
+		"found 2 problem(s) with the users to migrate:\n"+
+			"  - user #2 (john@example.com): password_hash needs to be a bcrypt hash, use custom_password_hash for other algorithms\n"+
+			"  - user #3: email is required",
+	)
+}
+
+func TestUserImportBatches(t *testing.T) {
+	users := []map[string]interface{}{
+		{"email": "a@example.com"}, // 25 bytes once encoded.
+		{"email": "b@example.com"},
+		{"email": "c@example.com"},
+	}
+
+	batches, err := userImportBatches(users, 60)
+	require.NoError(t, err)
+	assert.Equal(t, [][]map[string]interface{}{users[:2], users[2:]}, batches)
+
+	batches, err = userImportBatches(users, userImportMaxSize)
+	require.NoError(t, err)
+	assert.Equal(t, [][]map[string]interface{}{users}, batches)
+
+	_, err = userImportBatches(users, 20)
+	assert.EqualError(t, err, "user #1 exceeds the size limit of an import users job")
+}
@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/iostream"
+)
+
+var userPatch = Flag{
+	Name:     "Patch",
+	LongForm: "patch",
+	Help: "Path to a JSON patch of the fields to update, or '-' to read it from the standard input, " +
+		"e.g. '{\"blocked\": true, \"app_metadata\": {\"plan\": \"pro\", \"trial\": null}}'. " +
+		"The app_metadata and user_metadata are merged one level deep, removing their properties set to null, " +
+		"while the other fields are replaced. It can't be used along with the other flags.",
+}
+
+// userPatchCredentialFields are the fields of the user that the Management API
+// only updates along with the connection the user belongs to.
+var userPatchCredentialFields = []string{
+	"email",
+	"email_verified",
+	"verify_email",
+	"phone_number",
+	"phone_verified",
+	"verify_phone_number",
+	"username",
+	"password",
+}
+
+// patchUser applies the JSON patch read from the source to the user, and renders the changes as a diff.
+func patchUser(ctx context.Context, cli *cli, id, source string) error {
+	patch, err := readUserPatch(source)
+	if err != nil {
+		return err
+	}
+
+	user, err := parseUserPatch(patch)
+	if err != nil {
+		return err
+	}
+
+	var current *management.User
+	if err := ansi.Waiting(func() (err error) {
+		current, err = cli.api.User.Read(ctx, id)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to read user with ID %q: %w", id, err)
+	}
+
+	if user.Connection == nil && userPatchUpdatesCredentials(patch) && len(current.Identities) > 0 {
+		user.Connection = current.Identities[0].Connection
+	}
+
+	if err := ansi.Waiting(func() error {
+		return cli.api.User.Update(ctx, current.GetID(), user)
+	}); err != nil {
+		return fmt.Errorf("failed to update user with ID %q: %w", id, err)
+	}
+
+	// The user is the one returned once updated, except for the password of the patch.
+	user.Password = nil
+
+	diff, err := userPatchDiff(current, user)
+	if err != nil {
+		return fmt.Errorf("failed to compare the user with ID %q: %w", id, err)
+	}
+
+	// The diff goes to the standard error with the json output, to keep the standard output parsable.
+	if cli.json {
+		fmt.Fprint(cli.renderer.MessageWriter, colorizeDiff(diff))
+		cli.renderer.JSONResult(user)
+		return nil
+	}
+
+	cli.renderer.Heading("user updated")
+	if diff == "" {
+		cli.renderer.Infof("The patch didn't change the user %s", ansi.Bold(id))
+		return nil
+	}
+
+	cli.renderer.Output(colorizeDiff(diff))
+
+	return nil
+}
+
+// readUserPatch reads the patch from the file, or from the standard input when the source is "-".
+func readUserPatch(source string) ([]byte, error) {
+	if source == "-" {
+		patch, err := io.ReadAll(iostream.Input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the patch from the standard input: %w", err)
+		}
+
+		return patch, nil
+	}
+
+	patch, err := os.ReadFile(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the patch file: %w", err)
+	}
+
+	return patch, nil
+}
+
+// parseUserPatch parses the JSON patch into the fields of the user to update. The Management API merges the
+// metadata one level deep, removing their null properties, and replaces the other fields, which can't be removed.
+func parseUserPatch(patch []byte) (*management.User, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return nil, fmt.Errorf("invalid patch, it must be a JSON object: %w", err)
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.New("the patch doesn't update any field")
+	}
+
+	var nullFields []string
+	for field, value := range fields {
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			nullFields = append(nullFields, field)
+		}
+	}
+	if len(nullFields) > 0 {
+		sort.Strings(nullFields)
+		return nil, fmt.Errorf("invalid patch, these fields can't be removed: %s", strings.Join(nullFields, ", "))
+	}
+
+	user := &management.User{}
+	if err := json.Unmarshal(patch, user); err != nil {
+		return nil, fmt.Errorf("invalid patch: %w", err)
+	}
+
+	// The fields that don't survive a round trip through the user are the ones the user doesn't have.
+	userJSON, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var userFields map[string]json.RawMessage
+	if err := json.Unmarshal(userJSON, &userFields); err != nil {
+		return nil, err
+	}
+
+	var unknownFields []string
+	for field := range fields {
+		if _, ok := userFields[field]; !ok {
+			unknownFields = append(unknownFields, field)
+		}
+	}
+	if len(unknownFields) > 0 {
+		sort.Strings(unknownFields)
+		return nil, fmt.Errorf("invalid patch, unknown user fields: %s", strings.Join(unknownFields, ", "))
+	}
+
+	return user, nil
+}
+
+// userPatchUpdatesCredentials tells whether the patch updates any of the fields requiring the connection.
+func userPatchUpdatesCredentials(patch []byte) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return false
+	}
+
+	for _, field := range userPatchCredentialFields {
+		if _, ok := fields[field]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// userPatchDiff returns the unified diff between the user before and after the patch.
+func userPatchDiff(before, after *management.User) (string, error) {
+	from, err := json.MarshalIndent(before, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	to, err := json.MarshalIndent(after, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(from)),
+		B:        difflib.SplitLines(string(to)),
+		FromFile: before.GetID() + " (before)",
+		ToFile:   before.GetID() + " (after)",
+		Context:  3,
+	})
+}
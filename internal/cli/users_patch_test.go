@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
+	"github.com/auth0/auth0-cli/internal/iostream"
+)
+
+func TestParseUserPatch(t *testing.T) {
+	t.Run("it parses the fields of the user to update", func(t *testing.T) {
+		user, err := parseUserPatch([]byte(`{"blocked": true, "app_metadata": {"plan": "pro", "trial": null}}`))
+		require.NoError(t, err)
+		assert.Equal(t, &management.User{
+			Blocked:     auth0.Bool(true),
+			AppMetadata: &map[string]interface{}{"plan": "pro", "trial": nil},
+		}, user)
+	})
+
+	var testCases = []struct {
+		name          string
+		patch         string
+		expectedError string
+	}{
+		{
+			name:          "it fails with a patch that isn't an object",
+			patch:         `["blocked"]`,
+			expectedError: "invalid patch, it must be a JSON object",
+		},
+		{
+			name:          "it fails with an empty patch",
+			patch:         `{}`,
+			expectedError: "the patch doesn't update any field",
+		},
+		{
+			name:          "it fails to remove fields",
+			patch:         `{"nickname": null, "given_name": null, "blocked": false}`,
+			expectedError: "invalid patch, these fields can't be removed: given_name, nickname",
+		},
+		{
+			name:          "it fails with unknown fields",
+			patch:         `{"blocked": true, "plan": "pro"}`,
+			expectedError: "invalid patch, unknown user fields: plan",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := parseUserPatch([]byte(testCase.patch))
+			assert.ErrorContains(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestUserPatchUpdatesCredentials(t *testing.T) {
+	assert.True(t, userPatchUpdatesCredentials([]byte(`{"email": "jane@example.com"}`)))
+	assert.True(t, userPatchUpdatesCredentials([]byte(`{"password": "secret"}`)))
+	assert.False(t, userPatchUpdatesCredentials([]byte(`{"user_metadata": {"theme": "dark"}}`)))
+}
+
+func TestReadUserPatch(t *testing.T) {
+	patchFile := path.Join(t.TempDir(), "patch.json")
+	require.NoError(t, os.WriteFile(patchFile, []byte(`{"blocked": true}`), 0600))
+
+	t.Run("it reads the patch from a file", func(t *testing.T) {
+		patch, err := readUserPatch(patchFile)
+		require.NoError(t, err)
+		assert.Equal(t, `{"blocked": true}`, string(patch))
+	})
+
+	t.Run("it reads the patch from the standard input", func(t *testing.T) {
+		input, err := os.Open(patchFile)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = input.Close()
+		})
+
+		defaultInput := iostream.Input
+		iostream.Input = input
+		t.Cleanup(func() {
+			iostream.Input = defaultInput
+		})
+
+		patch, err := readUserPatch("-")
+		require.NoError(t, err)
+		assert.Equal(t, `{"blocked": true}`, string(patch))
+	})
+
+	t.Run("it fails when the file is missing", func(t *testing.T) {
+		_, err := readUserPatch(path.Join(t.TempDir(), "missing.json"))
+		assert.ErrorContains(t, err, "failed to read the patch file")
+	})
+}
+
+func TestUpdateUserCmdPatch(t *testing.T) {
+	patchFile := path.Join(t.TempDir(), "patch.json")
+	require.NoError(t, os.WriteFile(patchFile, []byte(`{"email": "jane@example.com", "password": "secret"}`), 0600))
+
+	newCLI := func(t *testing.T, messages, result io.Writer) *cli {
+		ctrl := gomock.NewController(t)
+
+		userAPI := mock.NewMockUserAPI(ctrl)
+		userAPI.EXPECT().
+			Read(gomock.Any(), "auth0|123").
+			Return(&management.User{
+				ID:         auth0.String("auth0|123"),
+				Email:      auth0.String("jane@old.example.com"),
+				Identities: []*management.UserIdentity{{Connection: auth0.String("Username-Password-Authentication")}},
+			}, nil)
+		userAPI.EXPECT().
+			Update(gomock.Any(), "auth0|123", &management.User{
+				Email:      auth0.String("jane@example.com"),
+				Password:   auth0.String("secret"),
+				Connection: auth0.String("Username-Password-Authentication"),
+			}).
+			DoAndReturn(func(_ interface{}, _ string, user *management.User, _ ...management.RequestOption) error {
+				user.ID = auth0.String("auth0|123")
+				user.Connection = nil
+				user.Identities = []*management.UserIdentity{{Connection: auth0.String("Username-Password-Authentication")}}
+				return nil
+			})
+
+		return &cli{
+			renderer: &display.Renderer{MessageWriter: messages, ResultWriter: result},
+			api:      &auth0.API{User: userAPI},
+			noInput:  true,
+		}
+	}
+
+	t.Run("it shows the changes to the user as a diff", func(t *testing.T) {
+		result := &bytes.Buffer{}
+		cmd := updateUserCmd(newCLI(t, io.Discard, result))
+		cmd.SetArgs([]string{"auth0|123", "--patch", patchFile})
+		cmd.SetOut(io.Discard)
+
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, result.String(), `-    "email": "jane@old.example.com",`)
+		assert.Contains(t, result.String(), `+    "email": "jane@example.com",`)
+		assert.NotContains(t, result.String(), "secret")
+	})
+
+	t.Run("it shows the diff on the standard error along with the json output", func(t *testing.T) {
+		messages, result := &bytes.Buffer{}, &bytes.Buffer{}
+		cmd := updateUserCmd(newCLI(t, messages, result))
+		cmd.SetArgs([]string{"auth0|123", "--patch", patchFile, "--json"})
+		cmd.SetOut(io.Discard)
+
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, messages.String(), `+    "email": "jane@example.com",`)
+		assert.NotContains(t, messages.String(), "secret")
+		assert.Contains(t, result.String(), `"email": "jane@example.com"`)
+		assert.NotContains(t, result.String(), `-    "email"`)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/auth0/go-auth0/management"
@@ -32,13 +33,21 @@ var (
 		IsRequired: true,
 	}
 
+	userRolesExpiresIn = Flag{
+		Name:     "Expires In",
+		LongForm: "expires-in",
+		Help: "Assign the roles temporarily, for a duration such as \"8h\" or \"7d\". " +
+			"Run `auth0 roles expire-sweep` on a schedule to remove them once they expire.",
+	}
+
 	errNoRolesSelected = errors.New("required to select at least one role")
 )
 
 type userRolesInput struct {
-	ID     string
-	Number int
-	Roles  []string
+	ID        string
+	Number    int
+	Roles     []string
+	ExpiresIn string
 }
 
 type userRolesFetcher func(ctx context.Context, cli *cli, userID string) ([]string, error)
@@ -134,11 +143,24 @@ func addUserRolesCmd(cli *cli) *cobra.Command {
 		Aliases: []string{"add"},
 		Args:    cobra.MaximumNArgs(1),
 		Short:   "Assign roles to a user",
-		Long:    "Assign existing roles to a user.",
+		Long: "Assign existing roles to a user.\n\n" +
+			"To grant just-in-time access, assign the roles temporarily with `--expires-in`. " +
+			"The expiration is recorded in the `app_metadata` of the user, and the roles get " +
+			"removed by `auth0 roles expire-sweep` once they expire.",
 		Example: `  auth0 users roles assign <user-id>
   auth0 users roles add <user-id> --roles <role-id1,role-id2>
-  auth0 users roles add <user-id> -r "rol_1eKJp3jV04SiU04h,rol_2eKJp3jV04SiU04h" --json`,
+  auth0 users roles add <user-id> -r "rol_1eKJp3jV04SiU04h,rol_2eKJp3jV04SiU04h" --json
+  auth0 users roles add <user-id> --roles <role-id1> --expires-in 8h`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			var expiresAt time.Time
+			if inputs.ExpiresIn != "" {
+				expiresIn, ok := parseDurationWithDays(inputs.ExpiresIn)
+				if !ok {
+					return fmt.Errorf("invalid expiration %q, use a duration such as \"8h\" or \"7d\"", inputs.ExpiresIn)
+				}
+				expiresAt = cli.now().Add(expiresIn).Truncate(time.Second)
+			}
+
 			if len(args) == 0 {
 				if err := userID.Ask(cmd, &inputs.ID); err != nil {
 					return err
@@ -160,8 +182,26 @@ func addUserRolesCmd(cli *cli) *cobra.Command {
 				})
 			}
 
-			if err := ansi.Waiting(func() (err error) {
-				return cli.api.User.AssignRoles(cmd.Context(), inputs.ID, rolesToAssign)
+			// Temporary roles get their expiration recorded before they're assigned, so they can't
+			// end up assigned without one, while permanent roles only get theirs cleared afterwards.
+			if err := ansi.Waiting(func() error {
+				if !expiresAt.IsZero() {
+					if err := recordRoleExpirations(cmd.Context(), cli.api.User, inputs.ID, inputs.Roles, expiresAt); err != nil {
+						return fmt.Errorf("failed to record the expiration of the roles: %w", err)
+					}
+				}
+
+				if err := cli.api.User.AssignRoles(cmd.Context(), inputs.ID, rolesToAssign); err != nil {
+					return err
+				}
+
+				if expiresAt.IsZero() {
+					if err := recordRoleExpirations(cmd.Context(), cli.api.User, inputs.ID, inputs.Roles, expiresAt); err != nil {
+						return fmt.Errorf("failed to clear the expiration of the roles: %w", err)
+					}
+				}
+
+				return nil
 			}); err != nil {
 				return fmt.Errorf("failed to assign roles for user with ID %q: %w", inputs.ID, err)
 			}
@@ -181,6 +221,7 @@ func addUserRolesCmd(cli *cli) *cobra.Command {
 	}
 
 	userRoles.RegisterStringSlice(cmd, &inputs.Roles, nil)
+	userRolesExpiresIn.RegisterString(cmd, &inputs.ExpiresIn, "")
 	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
 
 	return cmd
@@ -220,8 +261,16 @@ func removeUserRolesCmd(cli *cli) *cobra.Command {
 				})
 			}
 
-			if err := ansi.Waiting(func() (err error) {
-				return cli.api.User.RemoveRoles(cmd.Context(), inputs.ID, rolesToRemove)
+			if err := ansi.Waiting(func() error {
+				if err := cli.api.User.RemoveRoles(cmd.Context(), inputs.ID, rolesToRemove); err != nil {
+					return err
+				}
+
+				if err := recordRoleExpirations(cmd.Context(), cli.api.User, inputs.ID, inputs.Roles, time.Time{}); err != nil {
+					return fmt.Errorf("failed to clear the expiration of the roles: %w", err)
+				}
+
+				return nil
 			}); err != nil {
 				return fmt.Errorf("failed to remove roles for user with ID %q: %w", inputs.ID, err)
 			}
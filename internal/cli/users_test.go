@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/auth0/go-auth0/management"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/auth0/auth0-cli/internal/auth0"
 	"github.com/auth0/auth0-cli/internal/auth0/mock"
+	"github.com/auth0/auth0-cli/internal/display"
 )
 
 func TestConnectionsPickerOptions(t *testing.T) {
@@ -144,3 +147,84 @@ func TestConnectionsPickerOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestFindUserIdentity(t *testing.T) {
+	user := &management.User{
+		ID: auth0.String("auth0|1"),
+		Identities: []*management.UserIdentity{
+			{Provider: auth0.String("auth0"), UserID: auth0.String("1"), Connection: auth0.String("Username-Password-Authentication")},
+			{Provider: auth0.String("google-oauth2"), UserID: auth0.String("2"), Connection: auth0.String("google-oauth2")},
+			{Provider: auth0.String("google-oauth2"), UserID: auth0.String("3"), Connection: auth0.String("google-oauth2")},
+		},
+	}
+
+	identity, err := findUserIdentity(user, "auth0")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", identity.GetUserID())
+
+	identity, err = findUserIdentity(user, "google-oauth2|3")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", identity.GetUserID())
+
+	_, err = findUserIdentity(user, "google-oauth2")
+	assert.EqualError(
+		t,
+		err,
+		`the user with ID "auth0|1" has several identities from "google-oauth2", use one of: google-oauth2|2, google-oauth2|3`,
+	)
+
+	_, err = findUserIdentity(user, "github")
+	assert.EqualError(
+		t,
+		err,
+		`the user with ID "auth0|1" has no identity from "github", its identities are: auth0|1, google-oauth2|2, google-oauth2|3`,
+	)
+}
+
+func TestShowUserCmdIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userAPI := mock.NewMockUserAPI(ctrl)
+	userAPI.EXPECT().
+		Read(gomock.Any(), "google-oauth2|2").
+		Return(&management.User{
+			ID: auth0.String("google-oauth2|2"),
+			Identities: []*management.UserIdentity{
+				{
+					Provider:    auth0.String("google-oauth2"),
+					UserID:      auth0.String("2"),
+					Connection:  auth0.String("google-oauth2"),
+					IsSocial:    auth0.Bool(true),
+					AccessToken: auth0.String("ya29.token"),
+					ProfileData: &map[string]interface{}{"email": "jane@travel0.com"},
+				},
+			},
+		}, nil)
+
+	result := &bytes.Buffer{}
+	cli := &cli{
+		renderer: &display.Renderer{
+			MessageWriter: io.Discard,
+			ResultWriter:  result,
+			Format:        display.OutputFormatJSON,
+		},
+		api: &auth0.API{User: userAPI},
+	}
+
+	cmd := showUserCmd(cli)
+	cmd.SetArgs([]string{"google-oauth2|2", "--identity", "google-oauth2"})
+	cmd.SetOut(io.Discard)
+
+	assert.NoError(t, cmd.Execute())
+	assert.JSONEq(
+		t,
+		`{
+			"provider": "google-oauth2",
+			"user_id": "2",
+			"connection": "google-oauth2",
+			"isSocial": true,
+			"access_token": "********",
+			"profileData": {"email": "jane@travel0.com"}
+		}`,
+		result.String(),
+	)
+}
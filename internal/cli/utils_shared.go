@@ -1,15 +1,18 @@
 package cli
 
 import (
-	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/auth0/go-auth0/management"
+	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/pkg/browser"
 	"golang.org/x/net/context"
 
@@ -121,9 +124,26 @@ func runLoginFlowPreflightChecks(cli *cli, c *management.Client) (abort bool) {
 	return true
 }
 
+// loginRequestOptions configure how the authorization request of the login flow gets sent.
+type loginRequestOptions struct {
+	// PushedAuthorizationRequest pushes the request to the /oauth/par endpoint (PAR).
+	PushedAuthorizationRequest bool
+
+	// RequestObjectKey signs the request into a request object (JAR), if set.
+	RequestObjectKey jwk.Key
+}
+
 // runLoginFlow initiates a full user-facing login flow, waits for a response
 // and returns the retrieved tokens to the caller when done.
-func runLoginFlow(ctx context.Context, cli *cli, c *management.Client, connName, audience, prompt string, scopes []string, customDomain string) (*authutil.TokenResponse, error) {
+func runLoginFlow(
+	ctx context.Context,
+	cli *cli,
+	c *management.Client,
+	connName, audience, prompt string,
+	scopes []string,
+	customDomain string,
+	requestOptions loginRequestOptions,
+) (*authutil.TokenResponse, error) {
 	var tokenResponse *authutil.TokenResponse
 
 	err := ansi.Spinner("Waiting for login flow to complete", func() error {
@@ -132,18 +152,16 @@ func runLoginFlow(ctx context.Context, cli *cli, c *management.Client, connName,
 			return err
 		}
 
-		state, err := generateState(cliLoginTestingStateSize)
+		state, err := generateState(cli.random(), cliLoginTestingStateSize)
 		if err != nil {
 			return err
 		}
 
-		domain := cli.tenant
-		if customDomain != "" {
-			domain = customDomain
-		}
+		domain := testDomainOrTenant(cli, customDomain)
 
 		// Build a login URL and initiate login in a browser window.
-		loginURL, err := authutil.BuildLoginURL(domain, c.GetClientID(), cliLoginTestingCallbackURL, state, connName, audience, prompt, scopes)
+		params := authutil.BuildLoginParams(c.GetClientID(), cliLoginTestingCallbackURL, state, connName, audience, prompt, scopes)
+		loginURL, err := buildLoginRequestURL(domain, c, params, requestOptions)
 		if err != nil {
 			return err
 		}
@@ -171,7 +189,7 @@ func runLoginFlow(ctx context.Context, cli *cli, c *management.Client, connName,
 		// token.
 		tokenResponse, err = authutil.ExchangeCodeForToken(
 			http.DefaultClient,
-			cli.tenant,
+			domain,
 			c.GetClientID(),
 			c.GetClientSecret(),
 			authCode,
@@ -197,6 +215,36 @@ func runLoginFlow(ctx context.Context, cli *cli, c *management.Client, connName,
 	return tokenResponse, err
 }
 
+// buildLoginRequestURL builds the URL of the authorization request, sending the
+// parameters as a request object, through a pushed request, or both.
+func buildLoginRequestURL(domain string, c *management.Client, params url.Values, requestOptions loginRequestOptions) (string, error) {
+	if requestOptions.RequestObjectKey != nil {
+		requestObject, err := authutil.SignRequestObject(domain, params, requestOptions.RequestObjectKey)
+		if err != nil {
+			return "", err
+		}
+
+		params = url.Values{
+			"client_id": {c.GetClientID()},
+			"request":   {requestObject},
+		}
+	}
+
+	if requestOptions.PushedAuthorizationRequest {
+		requestURI, err := authutil.PushAuthorizationRequest(http.DefaultClient, domain, c.GetClientSecret(), params)
+		if err != nil {
+			return "", err
+		}
+
+		params = url.Values{
+			"client_id":   {c.GetClientID()},
+			"request_uri": {requestURI},
+		}
+	}
+
+	return authutil.BuildAuthorizeURL(domain, params), nil
+}
+
 // check if a client is already configured with our local callback URL.
 func hasLocalCallbackURL(client *management.Client) bool {
 	for _, callbackURL := range client.GetCallbacks() {
@@ -252,9 +300,9 @@ func removeLocalCallbackURLFromClient(ctx context.Context, clientManager auth0.C
 
 // generate state parameter value used to mitigate CSRF attacks
 // more: https://auth0.com/docs/protocols/state-parameters
-func generateState(size int) (string, error) {
+func generateState(random io.Reader, size int) (string, error) {
 	b := make([]byte, size)
-	_, err := rand.Read(b)
+	_, err := io.ReadFull(random, b)
 	if err != nil {
 		return "", err
 	}
@@ -319,3 +367,17 @@ func formatManageTenantURL(tenant string, cfg *config.Config) string {
 		tenantName,
 	)
 }
+
+// parseDurationWithDays parses a positive duration such as "12h",
+// also allowing it to be expressed in days, such as "7d".
+func parseDurationWithDays(s string) (time.Duration, bool) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n > 0 {
+			return time.Duration(n) * 24 * time.Hour, true
+		}
+	} else if duration, err := time.ParseDuration(s); err == nil && duration > 0 {
+		return duration, true
+	}
+
+	return 0, false
+}
@@ -2,12 +2,19 @@ package cli
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"errors"
+	"net/url"
 	"testing"
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/golang/mock/gomock"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jwt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/auth0/auth0-cli/internal/auth0"
 	"github.com/auth0/auth0-cli/internal/auth0/mock"
@@ -24,6 +31,37 @@ func TestBuildOauthTokenParams(t *testing.T) {
 	assert.Equal(t, "audience=https%3A%2F%2Fcli-demo.auth0.us.auth0.com%2Fapi%2Fv2%2F&client_id=some-client-id&client_secret=some-client-secret&grant_type=client_credentials", params.Encode())
 }
 
+func TestBuildLoginRequestURL(t *testing.T) {
+	client := &management.Client{ClientID: auth0.String("some-client-id")}
+	params := url.Values{"client_id": {"some-client-id"}, "response_type": {"code"}}
+
+	t.Run("it sends the parameters in the query string", func(t *testing.T) {
+		loginURL, err := buildLoginRequestURL("cli-demo.us.auth0.com", client, params, loginRequestOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://cli-demo.us.auth0.com/authorize?client_id=some-client-id&response_type=code", loginURL)
+	})
+
+	t.Run("it sends the parameters in a request object", func(t *testing.T) {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		key, err := jwk.New(privateKey)
+		require.NoError(t, err)
+
+		loginURL, err := buildLoginRequestURL("cli-demo.us.auth0.com", client, params, loginRequestOptions{RequestObjectKey: key})
+		require.NoError(t, err)
+
+		u, err := url.Parse(loginURL)
+		require.NoError(t, err)
+		assert.Equal(t, "some-client-id", u.Query().Get("client_id"))
+		assert.Empty(t, u.Query().Get("response_type"))
+
+		token, err := jwt.ParseString(u.Query().Get("request"), jwt.WithVerify(jwa.RS256, &privateKey.PublicKey))
+		require.NoError(t, err)
+		responseType, _ := token.Get("response_type")
+		assert.Equal(t, "code", responseType)
+	})
+}
+
 func TestHasLocalCallbackURL(t *testing.T) {
 	assert.False(t, hasLocalCallbackURL(&management.Client{
 		Callbacks: &[]string{"http://localhost:3000"},
@@ -56,11 +94,11 @@ func TestContainsStr(t *testing.T) {
 }
 
 func TestGenerateState(t *testing.T) {
-	state, err := generateState(0)
+	state, err := generateState(rand.Reader, 0)
 	assert.Equal(t, "", state)
 	assert.Nil(t, err)
 
-	state, err = generateState(cliLoginTestingStateSize)
+	state, err = generateState(rand.Reader, cliLoginTestingStateSize)
 	assert.IsType(t, "string", state)
 	assert.Nil(t, err)
 }
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/lestrrat-go/jwx/jwt"
+	"github.com/spf13/cobra"
+
+	"github.com/auth0/auth0-cli/internal/config"
+)
+
+const (
+	principalTypeUser   = "user"
+	principalTypeClient = "client"
+)
+
+func whoAmICmd(cli *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Args:  cobra.NoArgs,
+		Short: "Show the current authentication context",
+		Long: "Display the active tenant, the authenticated principal (user or client), the granted scopes, " +
+			"the access token expiry and where the credentials are stored.\n\n" +
+			"Useful to verify the context the CLI operates in before running commands that mutate resources.",
+		Example: `  auth0 whoami
+  auth0 whoami --tenant <tenant>
+  auth0 whoami --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.Config.Validate(); err != nil {
+				return err
+			}
+
+			if cli.tenant == "" {
+				cli.tenant = cli.Config.DefaultTenant
+			}
+
+			tenant, err := cli.Config.GetTenant(cli.tenant)
+			if err != nil {
+				return err
+			}
+
+			principal, principalType := tenantPrincipal(tenant)
+
+			credentialStorage := cli.Config.Path()
+			if tenant.IsAccessTokenStoredInKeyring() {
+				credentialStorage = "System keyring"
+			}
+
+			cli.renderer.Tenant = tenant.Domain
+			cli.renderer.WhoAmI(
+				tenant.Domain,
+				principal,
+				principalType,
+				tenant.GetGrantedScopes(),
+				tenant.ExpiresAt,
+				credentialStorage,
+				cli.readOnly || tenant.ReadOnly,
+			)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&cli.json, "json", false, "Output in json format.")
+
+	return cmd
+}
+
+// tenantPrincipal returns the identifier and the type
+// of the principal the CLI is authenticated as.
+func tenantPrincipal(tenant config.Tenant) (string, string) {
+	if tenant.IsAuthenticatedWithClientCredentials() {
+		return tenant.ClientID, principalTypeClient
+	}
+
+	token, err := jwt.ParseString(tenant.GetAccessToken())
+	if err != nil || token.Subject() == "" {
+		return fmt.Sprintf("unknown (%s)", tenant.Name), principalTypeUser
+	}
+
+	return token.Subject(), principalTypeUser
+}
@@ -0,0 +1,109 @@
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnavailable is returned when no clipboard tool can be found on the system.
+var ErrUnavailable = errors.New(
+	"no clipboard tool found, install one of pbcopy, wl-copy, xclip or xsel",
+)
+
+// tool is a command line tool to write to and read from the clipboard.
+type tool struct {
+	write []string
+	read  []string
+}
+
+// lookPath is overridden in tests.
+var lookPath = exec.LookPath
+
+// toolFor picks the clipboard tool to use for the operating system, preferring
+// the Wayland tools over the X11 ones when running within a Wayland session.
+func toolFor(goos string, getenv func(string) string) (*tool, error) {
+	var candidates []tool
+	switch goos {
+	case "darwin":
+		candidates = []tool{
+			{write: []string{"pbcopy"}, read: []string{"pbpaste"}},
+		}
+	case "windows":
+		candidates = []tool{
+			{write: []string{"clip.exe"}, read: []string{"powershell.exe", "-NoProfile", "-Command", "Get-Clipboard"}},
+		}
+	default:
+		if getenv("WAYLAND_DISPLAY") != "" {
+			candidates = append(candidates, tool{write: []string{"wl-copy"}, read: []string{"wl-paste", "--no-newline"}})
+		}
+		candidates = append(candidates,
+			tool{
+				write: []string{"xclip", "-selection", "clipboard"},
+				read:  []string{"xclip", "-selection", "clipboard", "-out"},
+			},
+			tool{
+				write: []string{"xsel", "--clipboard", "--input"},
+				read:  []string{"xsel", "--clipboard", "--output"},
+			},
+		)
+	}
+
+	for _, candidate := range candidates {
+		if _, err := lookPath(candidate.write[0]); err == nil {
+			return &candidate, nil
+		}
+	}
+
+	return nil, ErrUnavailable
+}
+
+// Write places the text on the clipboard.
+func Write(text string) error {
+	t, err := toolFor(runtime.GOOS, os.Getenv)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(t.write[0], t.write[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+
+	return cmd.Run()
+}
+
+// Read returns the text currently on the clipboard.
+func Read() (string, error) {
+	t, err := toolFor(runtime.GOOS, os.Getenv)
+	if err != nil {
+		return "", err
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command(t.read[0], t.read[1:]...)
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		return "", err
+	}
+
+	// Some of the tools append a trailing newline to the text.
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+// ClearIfUnchanged clears the clipboard, unless it got overwritten since placing
+// the text on it, so that something else copied in the meantime isn't lost.
+func ClearIfUnchanged(text string) error {
+	current, err := Read()
+	if err != nil {
+		return err
+	}
+
+	if current != text {
+		return nil
+	}
+
+	return Write("")
+}
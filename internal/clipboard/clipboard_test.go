@@ -0,0 +1,71 @@
+package clipboard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolFor(t *testing.T) {
+	withInstalledTools := func(t *testing.T, tools ...string) {
+		original := lookPath
+		t.Cleanup(func() {
+			lookPath = original
+		})
+
+		lookPath = func(file string) (string, error) {
+			for _, tool := range tools {
+				if tool == file {
+					return "/usr/bin/" + file, nil
+				}
+			}
+			return "", errors.New("not found")
+		}
+	}
+
+	noEnv := func(string) string { return "" }
+	wayland := func(key string) string {
+		if key == "WAYLAND_DISPLAY" {
+			return "wayland-0"
+		}
+		return ""
+	}
+
+	t.Run("it uses pbcopy on macOS", func(t *testing.T) {
+		withInstalledTools(t, "pbcopy")
+
+		tool, err := toolFor("darwin", noEnv)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pbcopy"}, tool.write)
+		assert.Equal(t, []string{"pbpaste"}, tool.read)
+	})
+
+	t.Run("it prefers wl-copy within a Wayland session", func(t *testing.T) {
+		withInstalledTools(t, "wl-copy", "xclip")
+
+		tool, err := toolFor("linux", wayland)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"wl-copy"}, tool.write)
+
+		tool, err = toolFor("linux", noEnv)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"xclip", "-selection", "clipboard"}, tool.write)
+	})
+
+	t.Run("it falls back to xsel", func(t *testing.T) {
+		withInstalledTools(t, "xsel")
+
+		tool, err := toolFor("linux", wayland)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"xsel", "--clipboard", "--input"}, tool.write)
+	})
+
+	t.Run("it fails when no tool is installed", func(t *testing.T) {
+		withInstalledTools(t)
+
+		_, err := toolFor("linux", noEnv)
+		assert.ErrorIs(t, err, ErrUnavailable)
+	})
+}
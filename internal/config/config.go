@@ -40,6 +40,15 @@ func (c *Config) Initialize() error {
 	return c.initError
 }
 
+// Path returns the location of the config file on disk.
+func (c *Config) Path() string {
+	if c.path == "" {
+		return defaultPath()
+	}
+
+	return c.path
+}
+
 // Validate checks to see if the config is not corrupted,
 // and we have an authenticated tenant saved.
 // If we have at least one tenant saved but the DefaultTenant
@@ -205,6 +214,32 @@ func (c *Config) SetDefaultAppIDForTenant(tenantName, appID string) error {
 	return c.saveToDisk()
 }
 
+// SetReadOnlyForTenant saves whether the tenant is in read-only mode to the disk.
+func (c *Config) SetReadOnlyForTenant(tenantName string, readOnly bool) error {
+	tenant, err := c.GetTenant(tenantName)
+	if err != nil {
+		return err
+	}
+
+	tenant.ReadOnly = readOnly
+	c.Tenants[tenant.Domain] = tenant
+
+	return c.saveToDisk()
+}
+
+// SetEnvironmentForTenant saves the environment of the tenant to the disk.
+func (c *Config) SetEnvironmentForTenant(tenantName, environment string) error {
+	tenant, err := c.GetTenant(tenantName)
+	if err != nil {
+		return err
+	}
+
+	tenant.Environment = environment
+	c.Tenants[tenant.Domain] = tenant
+
+	return c.saveToDisk()
+}
+
 func (c *Config) ensureInstallIDAssigned() {
 	if c.InstallID != "" {
 		return
@@ -683,6 +683,96 @@ func TestConfig_SetDefaultAppIDForTenant(t *testing.T) {
 	})
 }
 
+func TestConfig_SetReadOnlyForTenant(t *testing.T) {
+	t.Run("it successfully enables the read-only mode for the tenant", func(t *testing.T) {
+		tempFile := createTempConfigFile(t, []byte(`{
+			"install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+			"default_tenant": "auth0-cli.eu.auth0.com",
+			"tenants": {
+				"auth0-cli.eu.auth0.com": {
+					"name": "auth0-cli",
+					"domain": "auth0-cli.eu.auth0.com",
+					"access_token": "eyfSaswe",
+					"expires_at": "2023-04-18T11:18:07.998809Z",
+					"client_id": "secret"
+				}
+			}
+		}`))
+
+		expectedConfig := `{
+    "install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+    "default_tenant": "auth0-cli.eu.auth0.com",
+    "tenants": {
+        "auth0-cli.eu.auth0.com": {
+            "name": "auth0-cli",
+            "domain": "auth0-cli.eu.auth0.com",
+            "access_token": "eyfSaswe",
+            "expires_at": "2023-04-18T11:18:07.998809Z",
+            "client_id": "secret",
+            "read_only": true
+        }
+    }
+}`
+
+		config := &Config{path: tempFile}
+		err := config.SetReadOnlyForTenant("auth0-cli.eu.auth0.com", true)
+		assert.NoError(t, err)
+		assertConfigFileMatches(t, config.path, expectedConfig)
+	})
+
+	t.Run("it throws an error if there's an issue with the config file", func(t *testing.T) {
+		config := &Config{path: "i-dont-exist.json"}
+
+		err := config.SetReadOnlyForTenant("tenant", true)
+		assert.EqualError(t, err, "config.json file is missing")
+	})
+}
+
+func TestConfig_SetEnvironmentForTenant(t *testing.T) {
+	t.Run("it successfully sets the environment of the tenant", func(t *testing.T) {
+		tempFile := createTempConfigFile(t, []byte(`{
+			"install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+			"default_tenant": "auth0-cli.eu.auth0.com",
+			"tenants": {
+				"auth0-cli.eu.auth0.com": {
+					"name": "auth0-cli",
+					"domain": "auth0-cli.eu.auth0.com",
+					"access_token": "eyfSaswe",
+					"expires_at": "2023-04-18T11:18:07.998809Z",
+					"client_id": "secret"
+				}
+			}
+		}`))
+
+		expectedConfig := `{
+    "install_id": "3998b053-dd7f-4bfe-bb10-c4f3a96a0180",
+    "default_tenant": "auth0-cli.eu.auth0.com",
+    "tenants": {
+        "auth0-cli.eu.auth0.com": {
+            "name": "auth0-cli",
+            "domain": "auth0-cli.eu.auth0.com",
+            "access_token": "eyfSaswe",
+            "expires_at": "2023-04-18T11:18:07.998809Z",
+            "client_id": "secret",
+            "environment": "production"
+        }
+    }
+}`
+
+		config := &Config{path: tempFile}
+		err := config.SetEnvironmentForTenant("auth0-cli.eu.auth0.com", EnvironmentProduction)
+		assert.NoError(t, err)
+		assertConfigFileMatches(t, config.path, expectedConfig)
+	})
+
+	t.Run("it throws an error if there's an issue with the config file", func(t *testing.T) {
+		config := &Config{path: "i-dont-exist.json"}
+
+		err := config.SetEnvironmentForTenant("tenant", EnvironmentProduction)
+		assert.EqualError(t, err, "config.json file is missing")
+	})
+}
+
 func TestConfig_IsLoggedInWithTenant(t *testing.T) {
 	t.Run("it returns true when there is a tenant in the config and its access token is valid", func(t *testing.T) {
 		tempFile := createTempConfigFile(t, []byte(`{
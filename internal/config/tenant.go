@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/lestrrat-go/jwx/jwt"
 	"golang.org/x/exp/slices"
 
 	"github.com/auth0/auth0-cli/internal/auth"
@@ -37,9 +39,28 @@ type (
 		ExpiresAt    time.Time `json:"expires_at"`
 		DefaultAppID string    `json:"default_app_id,omitempty"`
 		ClientID     string    `json:"client_id"`
+
+		// LimitedScopes is set when the user logged in through the device code
+		// flow with a custom set of scopes, instead of the required scopes.
+		LimitedScopes bool `json:"limited_scopes,omitempty"`
+
+		// ReadOnly blocks all the commands that would make changes to the tenant.
+		ReadOnly bool `json:"read_only,omitempty"`
+
+		// Environment is what the tenant is used for, such as production or staging.
+		Environment string `json:"environment,omitempty"`
 	}
 )
 
+// EnvironmentProduction is the environment of the tenants
+// requiring an extra confirmation for destructive commands.
+const EnvironmentProduction = "production"
+
+// IsProduction returns true if the tenant is tagged as a production tenant.
+func (t *Tenant) IsProduction() bool {
+	return strings.EqualFold(t.Environment, EnvironmentProduction)
+}
+
 // HasAllRequiredScopes returns true if the tenant
 // has all the required scopes, false otherwise.
 func (t *Tenant) HasAllRequiredScopes() bool {
@@ -75,6 +96,16 @@ func (t *Tenant) GetExtraRequestedScopes() []string {
 	return additionallyRequestedScopes
 }
 
+// GetScopesToRequest retrieves the scopes to request when the
+// tenant needs to log in again through the device code flow.
+func (t *Tenant) GetScopesToRequest() []string {
+	if t.LimitedScopes {
+		return t.Scopes
+	}
+
+	return append(slices.Clone(auth.RequiredScopes), t.GetExtraRequestedScopes()...)
+}
+
 // IsAuthenticatedWithClientCredentials checks to see if the
 // tenant has been authenticated through client credentials.
 func (t *Tenant) IsAuthenticatedWithClientCredentials() bool {
@@ -102,10 +133,39 @@ func (t *Tenant) GetAccessToken() string {
 	return t.AccessToken
 }
 
+// GetGrantedScopes retrieves the scopes granted to the tenant's access token.
+// It falls back to the scopes requested at login if the token can't be parsed.
+func (t *Tenant) GetGrantedScopes() []string {
+	token, err := jwt.ParseString(t.GetAccessToken())
+	if err != nil {
+		return t.Scopes
+	}
+
+	scope, ok := token.Get("scope")
+	if !ok {
+		return t.Scopes
+	}
+
+	scopeValue, ok := scope.(string)
+	if !ok {
+		return t.Scopes
+	}
+
+	return strings.Fields(scopeValue)
+}
+
+// IsAccessTokenStoredInKeyring checks whether the tenant's
+// access token is kept in the system keyring instead of the config file.
+func (t *Tenant) IsAccessTokenStoredInKeyring() bool {
+	accessToken, err := keyring.GetAccessToken(t.Domain)
+	return err == nil && accessToken != ""
+}
+
 // CheckAuthenticationStatus checks to see if the tenant in the config
 // has all the required scopes and that the access token is not expired.
+// Tenants logged in with limited scopes are not checked for the required scopes.
 func (t *Tenant) CheckAuthenticationStatus() error {
-	if !t.HasAllRequiredScopes() && t.IsAuthenticatedWithDeviceCodeFlow() {
+	if !t.LimitedScopes && !t.HasAllRequiredScopes() && t.IsAuthenticatedWithDeviceCodeFlow() {
 		return ErrTokenMissingRequiredScopes
 	}
 
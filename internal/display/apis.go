@@ -218,3 +218,63 @@ func getScopes(scopes []management.ResourceServerScope) (string, bool) {
 
 	return scopesForDisplay, true
 }
+
+// ScopeChange is a difference between the live scopes of an API and their definition.
+type ScopeChange struct {
+	Change          string `json:"change"`
+	Scope           string `json:"scope"`
+	Description     string `json:"description,omitempty"`
+	LiveDescription string `json:"live_description,omitempty"`
+}
+
+const (
+	ScopeAdded   = "added"
+	ScopeRemoved = "removed"
+	ScopeChanged = "changed"
+)
+
+type scopeChangeView struct {
+	ScopeChange
+}
+
+func (v *scopeChangeView) AsTableHeader() []string {
+	return []string{"Change", "Scope", "Description"}
+}
+
+func (v *scopeChangeView) AsTableRow() []string {
+	switch v.Change {
+	case ScopeAdded:
+		return []string{ansi.Green("+ " + v.Change), v.Scope, v.Description}
+	case ScopeRemoved:
+		return []string{ansi.Red("- " + v.Change), v.Scope, v.LiveDescription}
+	default:
+		return []string{ansi.Yellow("~ " + v.Change), v.Scope, v.LiveDescription + " => " + v.Description}
+	}
+}
+
+func (v *scopeChangeView) Object() interface{} {
+	return v.ScopeChange
+}
+
+// ScopesDiff renders the scopes to add to the API and to remove from it, for its
+// scopes to match their definition, along with the ones whose description changed.
+func (r *Renderer) ScopesDiff(api, definition string, changes []ScopeChange) {
+	r.Heading(fmt.Sprintf("scopes of %s compared to %s", ansi.Bold(api), definition))
+
+	if len(changes) == 0 {
+		if r.Format == OutputFormatJSON {
+			r.JSONResult([]interface{}{})
+			return
+		}
+
+		r.Infof("The scopes of the API are in sync with their definition.")
+		return
+	}
+
+	var results []View
+	for _, change := range changes {
+		results = append(results, &scopeChangeView{change})
+	}
+
+	r.Results(results)
+}
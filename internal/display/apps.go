@@ -108,6 +108,8 @@ func (v *applicationView) Object() interface{} {
 }
 
 func (r *Renderer) ApplicationList(clients []*management.Client, revealSecrets bool) {
+	revealSecrets = revealSecrets || r.RevealSecrets
+
 	resource := "applications"
 
 	r.Heading(fmt.Sprintf("%s (%v)", resource, len(clients)))
@@ -130,10 +132,14 @@ func (r *Renderer) ApplicationList(clients []*management.Client, revealSecrets b
 }
 
 func (r *Renderer) ApplicationShow(client *management.Client, revealSecrets bool) {
+	revealSecrets = revealSecrets || r.RevealSecrets
+
 	r.Heading("application")
 	r.Result(makeApplicationView(client, revealSecrets))
 }
 
+// ApplicationCreate renders the created application. Unlike the other application renderers, it
+// doesn't account for the global --reveal flag, as the secret is kept out of it when copying it.
 func (r *Renderer) ApplicationCreate(client *management.Client, revealSecrets bool) {
 	r.Heading("application created")
 
@@ -157,6 +163,8 @@ func (r *Renderer) ApplicationCreate(client *management.Client, revealSecrets bo
 }
 
 func (r *Renderer) ApplicationUpdate(client *management.Client, revealSecrets bool) {
+	revealSecrets = revealSecrets || r.RevealSecrets
+
 	r.Heading("application updated")
 
 	if !revealSecrets {
@@ -251,3 +259,73 @@ func safeRaw(c *management.Client, revealSecrets bool) *management.Client {
 	c.SigningKeys = nil
 	return c
 }
+
+// EffectiveSetting is the value a login through an application gets
+// for a setting, along with where that value is resolved from.
+type EffectiveSetting struct {
+	Value  string `json:"value"`
+	Source string `json:"source"`
+}
+
+// EffectiveConfiguration is the configuration a login through an application
+// gets, once the tenant defaults, the API settings and the app overrides are resolved.
+type EffectiveConfiguration struct {
+	ClientID                  string           `json:"client_id"`
+	Name                      string           `json:"name"`
+	Audience                  EffectiveSetting `json:"audience"`
+	IDTokenLifetime           EffectiveSetting `json:"id_token_lifetime"`
+	IDTokenAlgorithm          EffectiveSetting `json:"id_token_algorithm"`
+	AccessTokenFormat         EffectiveSetting `json:"access_token_format"`
+	AccessTokenLifetime       EffectiveSetting `json:"access_token_lifetime"`
+	AccessTokenLifetimeForWeb EffectiveSetting `json:"access_token_lifetime_for_web"`
+	AccessTokenAlgorithm      EffectiveSetting `json:"access_token_algorithm"`
+	RefreshTokens             EffectiveSetting `json:"refresh_tokens"`
+	RefreshTokenRotation      EffectiveSetting `json:"refresh_token_rotation"`
+	RefreshTokenLifetime      EffectiveSetting `json:"refresh_token_lifetime"`
+	RefreshTokenIdleLifetime  EffectiveSetting `json:"refresh_token_idle_lifetime"`
+	SessionLifetime           EffectiveSetting `json:"session_lifetime"`
+	IdleSessionLifetime       EffectiveSetting `json:"idle_session_lifetime"`
+	SessionCookie             EffectiveSetting `json:"session_cookie"`
+}
+
+func (c *EffectiveConfiguration) AsTableHeader() []string {
+	return []string{}
+}
+
+func (c *EffectiveConfiguration) AsTableRow() []string {
+	return []string{}
+}
+
+func (c *EffectiveConfiguration) KeyValues() [][]string {
+	setting := func(s EffectiveSetting) string {
+		return fmt.Sprintf("%s %s", s.Value, ansi.Faint("("+s.Source+")"))
+	}
+
+	return [][]string{
+		{"CLIENT ID", ansi.Faint(c.ClientID)},
+		{"NAME", c.Name},
+		{"AUDIENCE", setting(c.Audience)},
+		{"ID TOKEN LIFETIME", setting(c.IDTokenLifetime)},
+		{"ID TOKEN ALGORITHM", setting(c.IDTokenAlgorithm)},
+		{"ACCESS TOKEN FORMAT", setting(c.AccessTokenFormat)},
+		{"ACCESS TOKEN LIFETIME", setting(c.AccessTokenLifetime)},
+		{"ACCESS TOKEN LIFETIME (BROWSER)", setting(c.AccessTokenLifetimeForWeb)},
+		{"ACCESS TOKEN ALGORITHM", setting(c.AccessTokenAlgorithm)},
+		{"REFRESH TOKENS", setting(c.RefreshTokens)},
+		{"REFRESH TOKEN ROTATION", setting(c.RefreshTokenRotation)},
+		{"REFRESH TOKEN LIFETIME", setting(c.RefreshTokenLifetime)},
+		{"REFRESH TOKEN IDLE LIFETIME", setting(c.RefreshTokenIdleLifetime)},
+		{"SESSION LIFETIME", setting(c.SessionLifetime)},
+		{"IDLE SESSION LIFETIME", setting(c.IdleSessionLifetime)},
+		{"SESSION COOKIE", setting(c.SessionCookie)},
+	}
+}
+
+func (c *EffectiveConfiguration) Object() interface{} {
+	return c
+}
+
+func (r *Renderer) ApplicationEffectiveConfiguration(config *EffectiveConfiguration) {
+	r.Heading("effective configuration")
+	r.Result(config)
+}
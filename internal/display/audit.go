@@ -0,0 +1,122 @@
+package display
+
+import (
+	"time"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// RefreshRotationAuditResult is an application granted refresh tokens that don't rotate.
+type RefreshRotationAuditResult struct {
+	ClientID       string     `json:"client_id"`
+	Name           string     `json:"name"`
+	AppType        string     `json:"app_type"`
+	ExpirationType string     `json:"expiration_type"`
+	LastActivity   *time.Time `json:"last_activity"`
+}
+
+type refreshRotationAuditView struct {
+	ClientID       string
+	Name           string
+	AppType        string
+	ExpirationType string
+	LastActivity   string
+	raw            interface{}
+}
+
+func (v *refreshRotationAuditView) AsTableHeader() []string {
+	return []string{"Client ID", "Name", "Type", "Expiration", "Last Activity"}
+}
+
+func (v *refreshRotationAuditView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ClientID), v.Name, v.AppType, v.ExpirationType, v.LastActivity}
+}
+
+func (v *refreshRotationAuditView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) RefreshRotationAudit(results []RefreshRotationAuditResult) {
+	resource := "applications without refresh token rotation"
+
+	r.Heading(resource)
+
+	if len(results) == 0 {
+		r.EmptyState(resource, "All the applications granted refresh tokens rotate them.")
+		return
+	}
+
+	var res []View
+	for _, result := range results {
+		expirationType := result.ExpirationType
+		if expirationType == "" {
+			expirationType = "non-expiring"
+		}
+
+		lastActivity := ansi.Faint("none within the log retention")
+		if result.LastActivity != nil {
+			lastActivity = timeAgo(*result.LastActivity)
+		}
+
+		res = append(res, &refreshRotationAuditView{
+			ClientID:       result.ClientID,
+			Name:           result.Name,
+			AppType:        FriendlyAppType(result.AppType),
+			ExpirationType: expirationType,
+			LastActivity:   lastActivity,
+			raw:            result,
+		})
+	}
+
+	r.Results(res)
+}
+
+// ReferenceAuditResult is a reference of a resource to another one that was deleted or disabled.
+type ReferenceAuditResult struct {
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	Reference    string `json:"reference"`
+	Problem      string `json:"problem"`
+	Suggestion   string `json:"suggestion"`
+}
+
+type referenceAuditView struct {
+	ReferenceAuditResult
+	raw interface{}
+}
+
+func (v *referenceAuditView) AsTableHeader() []string {
+	return []string{"Resource", "ID", "Reference", "Problem", "Suggestion"}
+}
+
+func (v *referenceAuditView) AsTableRow() []string {
+	return []string{
+		v.ResourceType,
+		ansi.Faint(v.ResourceID),
+		v.Reference,
+		ansi.BrightRed(v.Problem),
+		v.Suggestion,
+	}
+}
+
+func (v *referenceAuditView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) ReferenceAudit(results []ReferenceAuditResult) {
+	resource := "dangling references"
+
+	r.Heading(resource)
+
+	if len(results) == 0 {
+		r.EmptyState(resource, "All the references between the resources are intact.")
+		return
+	}
+
+	var res []View
+	for _, result := range results {
+		res = append(res, &referenceAuditView{ReferenceAuditResult: result, raw: result})
+	}
+
+	r.Results(res)
+}
@@ -0,0 +1,55 @@
+package display
+
+import (
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type clientGrantView struct {
+	ID                string
+	ClientID          string
+	Audience          string
+	Scopes            string
+	OrganizationUsage string
+	raw               interface{}
+}
+
+func (v *clientGrantView) AsTableHeader() []string {
+	return []string{"ID", "Client ID", "Audience", "Scopes", "Organization Usage"}
+}
+
+func (v *clientGrantView) AsTableRow() []string {
+	return []string{ansi.Faint(v.ID), v.ClientID, v.Audience, v.Scopes, v.OrganizationUsage}
+}
+
+func (v *clientGrantView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) ClientGrantList(grants []*management.ClientGrant) {
+	resource := "client grants"
+
+	r.Heading(resource)
+
+	if len(grants) == 0 {
+		r.EmptyState(resource, "Use 'auth0 orgs client-grants add' to add one")
+		return
+	}
+
+	var res []View
+	for _, grant := range grants {
+		res = append(res, &clientGrantView{
+			ID:                grant.GetID(),
+			ClientID:          grant.GetClientID(),
+			Audience:          grant.GetAudience(),
+			Scopes:            strings.Join(grant.GetScope(), " "),
+			OrganizationUsage: grant.GetOrganizationUsage(),
+			raw:               grant,
+		})
+	}
+
+	r.Results(res)
+}
@@ -0,0 +1,179 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type connectionView struct {
+	ID            string
+	Name          string
+	Strategy      string
+	Scopes        string
+	revealSecrets bool
+	raw           interface{}
+}
+
+func (v *connectionView) AsTableHeader() []string {
+	return []string{"ID", "Name", "Strategy"}
+}
+
+func (v *connectionView) AsTableRow() []string {
+	return []string{
+		ansi.Faint(v.ID),
+		v.Name,
+		v.Strategy,
+	}
+}
+
+func (v *connectionView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"STRATEGY", v.Strategy},
+		{"SCOPES", v.Scopes},
+	}
+}
+
+func (v *connectionView) Object() interface{} {
+	if v.revealSecrets {
+		return v.raw
+	}
+
+	// The options hold the credentials of the identity provider.
+	data, err := json.Marshal(v.raw)
+	if err != nil {
+		return v.raw
+	}
+
+	return json.RawMessage(MaskSecrets(data))
+}
+
+func (r *Renderer) ConnectionCreate(connection *management.Connection) {
+	r.Heading("connection created")
+	r.Result(makeConnectionView(connection, r.RevealSecrets))
+}
+
+func makeConnectionView(connection *management.Connection, revealSecrets bool) *connectionView {
+	var scopes []string
+	if options, ok := connection.Options.(interface{ Scopes() []string }); ok {
+		scopes = options.Scopes()
+	}
+
+	return &connectionView{
+		ID:            connection.GetID(),
+		Name:          connection.GetName(),
+		Strategy:      connection.GetStrategy(),
+		Scopes:        strings.Join(scopes, " "),
+		revealSecrets: revealSecrets,
+		raw:           connection,
+	}
+}
+
+// ConnectionAttributes holds how the attributes of the identity provider of a
+// connection end up in the profile of its users.
+type ConnectionAttributes struct {
+	ID                      string                 `json:"id"`
+	Name                    string                 `json:"name"`
+	Strategy                string                 `json:"strategy"`
+	SyncUserProfile         string                 `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttributes []string               `json:"non_persistent_attrs,omitempty"`
+	MappingMode             string                 `json:"mapping_mode,omitempty"`
+	AttributeMap            map[string]interface{} `json:"attribute_map,omitempty"`
+}
+
+type connectionAttributesView struct {
+	ID                      string
+	Name                    string
+	Strategy                string
+	SyncUserProfile         string
+	NonPersistentAttributes string
+	MappingMode             string
+	AttributeMap            string
+	raw                     interface{}
+}
+
+func (v *connectionAttributesView) AsTableHeader() []string {
+	return []string{}
+}
+
+func (v *connectionAttributesView) AsTableRow() []string {
+	return []string{}
+}
+
+func (v *connectionAttributesView) KeyValues() [][]string {
+	keyValues := [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"STRATEGY", v.Strategy},
+		{"SYNC USER PROFILE", v.SyncUserProfile},
+		{"NON-PERSISTENT ATTRIBUTES", v.NonPersistentAttributes},
+	}
+
+	if v.MappingMode != "" {
+		keyValues = append(keyValues, []string{"MAPPING MODE", v.MappingMode})
+	}
+
+	return append(keyValues, []string{"ATTRIBUTE MAP", v.AttributeMap})
+}
+
+func (v *connectionAttributesView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) ConnectionAttributesShow(attributes *ConnectionAttributes) {
+	r.Heading("connection attributes")
+	r.Result(makeConnectionAttributesView(attributes))
+}
+
+func (r *Renderer) ConnectionAttributesUpdate(attributes *ConnectionAttributes) {
+	r.Heading("connection attributes updated")
+	r.Result(makeConnectionAttributesView(attributes))
+}
+
+func makeConnectionAttributesView(attributes *ConnectionAttributes) *connectionAttributesView {
+	syncUserProfile := attributes.SyncUserProfile
+	if syncUserProfile == "" {
+		syncUserProfile = ansi.Faint("on_each_login (default)")
+	}
+
+	return &connectionAttributesView{
+		ID:                      attributes.ID,
+		Name:                    attributes.Name,
+		Strategy:                attributes.Strategy,
+		SyncUserProfile:         syncUserProfile,
+		NonPersistentAttributes: strings.Join(attributes.NonPersistentAttributes, ", "),
+		MappingMode:             attributes.MappingMode,
+		AttributeMap:            formatAttributeMap(attributes.AttributeMap),
+		raw:                     attributes,
+	}
+}
+
+// formatAttributeMap formats the mappings sorted by user attribute, one per line, where the
+// attributes of the identity provider that aren't strings, such as lists, are formatted as JSON.
+func formatAttributeMap(attributeMap map[string]interface{}) string {
+	var attributes []string
+	for attribute := range attributeMap {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	var lines []string
+	for _, attribute := range attributes {
+		value, ok := attributeMap[attribute].(string)
+		if !ok {
+			raw, _ := json.Marshal(attributeMap[attribute])
+			value = string(raw)
+		}
+
+		lines = append(lines, fmt.Sprintf("%s=%s", attribute, value))
+	}
+
+	return strings.Join(lines, "\n")
+}
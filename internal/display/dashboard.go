@@ -0,0 +1,360 @@
+package display
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// Views of the dashboard.
+const (
+	DashboardLogs  = "logs"
+	DashboardApps  = "apps"
+	DashboardUsers = "users"
+)
+
+// dashboardFailedLogins is how many of the recent failed logins are shown.
+const dashboardFailedLogins = 5
+
+var ansiEscapes = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// RateLimit is the Management API rate limit, as reported by the latest response.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+}
+
+// Dashboard holds what the terminal dashboard shows.
+type Dashboard struct {
+	Tenant string
+	View   string
+
+	// Logs are the latest logs, from the oldest to the newest.
+	Logs []*management.Log
+
+	// FailedLogins are the failed logins of the last hour, from the newest to the oldest.
+	FailedLogins []*management.Log
+
+	Apps     []*management.Client
+	Users    []*management.User
+	Selected int
+
+	RateLimit *RateLimit
+	Status    string
+	UpdatedAt time.Time
+}
+
+// Frame renders the dashboard to fit within a terminal of the given size.
+func (d *Dashboard) Frame(width, height int) string {
+	if width < 40 {
+		width = 40
+	}
+
+	separator := ansi.Faint(strings.Repeat("─", width))
+
+	lines := []string{
+		fmt.Sprintf(
+			"%s %s %s",
+			ansi.Bold("Auth0 Dashboard"),
+			ansi.Faint("·"),
+			d.Tenant,
+		),
+		d.rateLimitLine(),
+		separator,
+	}
+	lines = append(lines, d.failedLoginsLines(width)...)
+	lines = append(lines, separator, d.tabsLine())
+
+	footer := []string{
+		separator,
+		d.footerLine(),
+	}
+
+	mainHeight := height - len(lines) - len(footer)
+	if mainHeight < 1 {
+		mainHeight = 1
+	}
+
+	var main []string
+	switch d.View {
+	case DashboardApps:
+		main = d.appsLines(width, mainHeight)
+	case DashboardUsers:
+		main = d.usersLines(width, mainHeight)
+	default:
+		main = d.logsLines(width, mainHeight)
+	}
+
+	for len(main) < mainHeight {
+		main = append(main, "")
+	}
+
+	lines = append(lines, main...)
+	lines = append(lines, footer...)
+
+	return strings.Join(lines, "\r\n")
+}
+
+// SelectedApp returns the application selected within the apps view, if any.
+func (d *Dashboard) SelectedApp() *management.Client {
+	if d.Selected < 0 || d.Selected >= len(d.Apps) {
+		return nil
+	}
+	return d.Apps[d.Selected]
+}
+
+// SelectedUser returns the user selected within the users view, if any.
+func (d *Dashboard) SelectedUser() *management.User {
+	if d.Selected < 0 || d.Selected >= len(d.Users) {
+		return nil
+	}
+	return d.Users[d.Selected]
+}
+
+func (d *Dashboard) rateLimitLine() string {
+	if d.RateLimit == nil || d.RateLimit.Limit == 0 {
+		return ansi.Faint("Rate limit: waiting for the first Management API response")
+	}
+
+	headroom := d.RateLimit.Remaining * 100 / d.RateLimit.Limit
+	// The colors format their text, so the percent sign needs escaping.
+	remaining := fmt.Sprintf("%d/%d requests remaining (%d%%%%)", d.RateLimit.Remaining, d.RateLimit.Limit, headroom)
+
+	switch {
+	case headroom < 20:
+		remaining = ansi.BrightRed(remaining)
+	case headroom < 50:
+		remaining = ansi.BrightYellow(remaining)
+	default:
+		remaining = ansi.Green(remaining)
+	}
+
+	resetIn := time.Until(d.RateLimit.ResetAt).Round(time.Second)
+	if resetIn < 0 {
+		resetIn = 0
+	}
+
+	return fmt.Sprintf("Rate limit: %s, resets in %s", remaining, resetIn)
+}
+
+func (d *Dashboard) failedLoginsLines(width int) []string {
+	heading := fmt.Sprintf("Failed logins in the last hour: %d", len(d.FailedLogins))
+	if len(d.FailedLogins) > 0 {
+		heading = ansi.BrightRed(heading)
+	}
+
+	lines := []string{heading}
+	for i, log := range d.FailedLogins {
+		if i == dashboardFailedLogins {
+			break
+		}
+
+		user := log.GetUserName()
+		if user == "" {
+			user = notApplicable
+		}
+
+		lines = append(lines, fmt.Sprintf(
+			"  %s %s %s %s",
+			ansi.Faint(log.GetDate().Format("15:04:05")),
+			truncate(user, 30),
+			truncate(log.GetIP(), 16),
+			truncateLine(log.GetDescription(), width-60),
+		))
+	}
+
+	return lines
+}
+
+func (d *Dashboard) tabsLine() string {
+	tabs := []struct{ view, label string }{
+		{DashboardLogs, "[l] Live logs"},
+		{DashboardApps, "[a] Applications"},
+		{DashboardUsers, "[u] Users"},
+	}
+
+	labels := make([]string, 0, len(tabs))
+	for _, tab := range tabs {
+		if tab.view == d.View || (d.View == "" && tab.view == DashboardLogs) {
+			labels = append(labels, ansi.Bold(ansi.Cyan(tab.label)))
+			continue
+		}
+		labels = append(labels, ansi.Faint(tab.label))
+	}
+
+	return strings.Join(labels, "   ")
+}
+
+func (d *Dashboard) logsLines(width, height int) []string {
+	logs := d.Logs
+	if len(logs) > height {
+		logs = logs[len(logs)-height:]
+	}
+
+	if len(logs) == 0 {
+		return []string{ansi.Faint("Waiting for logs...")}
+	}
+
+	lines := make([]string, 0, len(logs))
+	for _, log := range logs {
+		typ, desc := (&logView{Log: log}).typeDesc()
+
+		client := log.GetClientName()
+		if client == "" {
+			client = notApplicable
+		}
+
+		lines = append(lines, fmt.Sprintf(
+			"%s %s %s %s",
+			ansi.Faint(log.GetDate().Format("15:04:05")),
+			padRight(typ, 23),
+			truncate(client, 24),
+			truncateLine(desc, width-60),
+		))
+	}
+
+	return lines
+}
+
+func (d *Dashboard) appsLines(width, height int) []string {
+	if len(d.Apps) == 0 {
+		return []string{ansi.Faint("No applications.")}
+	}
+
+	details := d.appDetailsLines(d.SelectedApp(), width)
+
+	var rows []string
+	for _, app := range d.Apps {
+		rows = append(rows, fmt.Sprintf(
+			"%s %s %s",
+			truncate(app.GetName(), 36),
+			truncate(FriendlyAppType(app.GetAppType()), 28),
+			truncateLine(app.GetClientID(), width-70),
+		))
+	}
+
+	return d.selectableLines(rows, details, height)
+}
+
+func (d *Dashboard) appDetailsLines(app *management.Client, width int) []string {
+	if app == nil {
+		return nil
+	}
+
+	return []string{
+		fmt.Sprintf("%s %s", ansi.Faint("Callbacks:   "), truncateLine(strings.Join(app.GetCallbacks(), ", "), width-14)),
+		fmt.Sprintf("%s %s", ansi.Faint("Grant types: "), truncateLine(strings.Join(app.GetGrantTypes(), ", "), width-14)),
+		fmt.Sprintf("%s auth0 apps show %s", ansi.Faint("More:        "), app.GetClientID()),
+	}
+}
+
+func (d *Dashboard) usersLines(width, height int) []string {
+	if len(d.Users) == 0 {
+		return []string{ansi.Faint("No users.")}
+	}
+
+	details := d.userDetailsLines(d.SelectedUser(), width)
+
+	var rows []string
+	for _, user := range d.Users {
+		rows = append(rows, fmt.Sprintf(
+			"%s %s %s",
+			truncate(user.GetEmail(), 36),
+			truncate(user.GetLastLogin().Format("Jan 02 15:04"), 14),
+			truncateLine(user.GetID(), width-52),
+		))
+	}
+
+	return d.selectableLines(rows, details, height)
+}
+
+func (d *Dashboard) userDetailsLines(user *management.User, width int) []string {
+	if user == nil {
+		return nil
+	}
+
+	return []string{
+		fmt.Sprintf("%s %s", ansi.Faint("Name:        "), truncateLine(user.GetName(), width-14)),
+		fmt.Sprintf("%s %d", ansi.Faint("Logins:      "), user.GetLoginsCount()),
+		fmt.Sprintf("%s %s", ansi.Faint("Blocked:     "), boolean(user.GetBlocked())),
+		fmt.Sprintf("%s auth0 users show %s", ansi.Faint("More:        "), user.GetID()),
+	}
+}
+
+// selectableLines renders the rows around the selected one, followed by its details.
+func (d *Dashboard) selectableLines(rows, details []string, height int) []string {
+	rowsHeight := height - len(details) - 1
+	if rowsHeight < 1 {
+		rowsHeight = 1
+		details = nil
+	}
+
+	start := 0
+	if d.Selected >= rowsHeight {
+		start = d.Selected - rowsHeight + 1
+	}
+	end := start + rowsHeight
+	if end > len(rows) {
+		end = len(rows)
+	}
+
+	var lines []string
+	for i := start; i < end; i++ {
+		if i == d.Selected {
+			lines = append(lines, ansi.Cyan("▸ "+rows[i]))
+			continue
+		}
+		lines = append(lines, "  "+rows[i])
+	}
+
+	if len(details) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, details...)
+	}
+
+	return lines
+}
+
+func (d *Dashboard) footerLine() string {
+	keys := "[l/a/u] switch view  [↑/↓] select  [r] refresh  [q] quit"
+
+	status := ""
+	if !d.UpdatedAt.IsZero() {
+		status = "updated " + d.UpdatedAt.Format("15:04:05")
+	}
+	if d.Status != "" {
+		status = ansi.BrightYellow(d.Status)
+	}
+
+	return fmt.Sprintf("%s   %s", ansi.Faint(keys), status)
+}
+
+// truncateLine shortens the text to fit the remaining width of a line.
+func truncateLine(text string, width int) string {
+	text = strings.TrimSpace(text)
+	if width < 10 {
+		width = 10
+	}
+
+	if len([]rune(text)) <= width {
+		return text
+	}
+
+	return string([]rune(text)[:width-3]) + "..."
+}
+
+// padRight pads the colored text to the given visible width.
+func padRight(text string, width int) string {
+	visible := len([]rune(ansiEscapes.ReplaceAllString(text, "")))
+	if visible >= width {
+		return text
+	}
+
+	return text + strings.Repeat(" ", width-visible)
+}
@@ -0,0 +1,64 @@
+package display
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+func TestDashboard_Frame(t *testing.T) {
+	dashboard := &Dashboard{
+		Tenant: "example.us.auth0.com",
+		View:   DashboardLogs,
+		Logs: []*management.Log{
+			{
+				LogID:       auth0.String("log-1"),
+				Type:        auth0.String("s"),
+				Description: auth0.String("Successful login"),
+				Date:        auth0.Time(time.Now()),
+			},
+		},
+		FailedLogins: []*management.Log{
+			{
+				Type:        auth0.String("fp"),
+				UserName:    auth0.String("jane@example.com"),
+				Description: auth0.String("Wrong email or password."),
+				Date:        auth0.Time(time.Now()),
+			},
+		},
+		RateLimit: &RateLimit{Limit: 50, Remaining: 5, ResetAt: time.Now().Add(time.Second)},
+	}
+
+	frame := dashboard.Frame(120, 30)
+	lines := strings.Split(frame, "\r\n")
+
+	assert.Len(t, lines, 30)
+	assert.Contains(t, frame, "example.us.auth0.com")
+	assert.Contains(t, frame, "5/50 requests remaining (10%)")
+	assert.Contains(t, frame, "Failed logins in the last hour: 1")
+	assert.Contains(t, frame, "jane@example.com")
+	assert.Contains(t, frame, "Successful login")
+
+	dashboard.View = DashboardApps
+	dashboard.Apps = []*management.Client{
+		{ClientID: auth0.String("client-id-1"), Name: auth0.String("App 1")},
+		{ClientID: auth0.String("client-id-2"), Name: auth0.String("App 2")},
+	}
+	dashboard.Selected = 1
+
+	frame = dashboard.Frame(120, 30)
+	assert.Contains(t, frame, "App 1")
+	assert.Contains(t, frame, "auth0 apps show client-id-2")
+	assert.Equal(t, "client-id-2", dashboard.SelectedApp().GetClientID())
+	assert.Nil(t, dashboard.SelectedUser())
+}
+
+func TestPadRight(t *testing.T) {
+	assert.Equal(t, "\x1b[32mok\x1b[0m   ", padRight("\x1b[32mok\x1b[0m", 5))
+	assert.Equal(t, "toolong", padRight("toolong", 3))
+}
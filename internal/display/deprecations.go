@@ -0,0 +1,42 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// Deprecation is a deprecated command, alias or flag used by a command,
+// rendered as json for the tools parsing the output.
+type Deprecation struct {
+	Kind        string `json:"kind"`
+	Name        string `json:"name"`
+	Replacement string `json:"replacement,omitempty"`
+	Message     string `json:"message"`
+}
+
+// DeprecationWarning warns about the use of a deprecated command, alias or flag.
+// In json mode the warning is written as json to the message writer, to keep the results apart.
+func (r *Renderer) DeprecationWarning(deprecation Deprecation) {
+	if r.Format != OutputFormatJSON {
+		r.Warnf("%s %s", ansi.Bold(ansi.Yellow("DEPRECATED!")), deprecation.Message)
+		return
+	}
+
+	warning := struct {
+		Code string `json:"code"`
+		Deprecation
+	}{
+		Code:        "deprecated",
+		Deprecation: deprecation,
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"warning": warning})
+	if err != nil {
+		r.Errorf("couldn't marshal warning as JSON: %v", err)
+		return
+	}
+
+	fmt.Fprintln(r.MessageWriter, string(b))
+}
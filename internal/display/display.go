@@ -33,6 +33,12 @@ type Renderer struct {
 
 	// Format indicates how the results are rendered. Default (empty) will write as table.
 	Format OutputFormat
+
+	// RevealSecrets indicates whether the secrets are shown, instead of being masked.
+	RevealSecrets bool
+
+	// PlainJSON indicates whether the JSON results are written without colors, e.g. to be combined with other results.
+	PlainJSON bool
 }
 
 type View interface {
@@ -84,12 +90,25 @@ func (r *Renderer) EmptyState(resource string, hint string) {
 	r.Warnf("No %s available. %s\n", resource, hint)
 }
 
+// Truncated tells that only some of the resources were listed, out of their total.
+func (r *Renderer) Truncated(resource string, shown, total int) {
+	if shown >= total {
+		return
+	}
+
+	r.Infof("Showing %d of %d %s. Use --number to list more.", shown, total, resource)
+}
+
 func (r *Renderer) JSONResult(data interface{}) {
 	b, err := json.MarshalIndent(data, "", "    ")
 	if err != nil {
 		r.Errorf("couldn't marshal results as JSON: %v", err)
 		return
 	}
+	if r.PlainJSON {
+		r.Output(string(b))
+		return
+	}
 	r.Output(ansi.ColorizeJSON(string(b)))
 }
 
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
 )
 
 func TestTimeAgo(t *testing.T) {
@@ -121,3 +123,69 @@ func TestRenderer_Results(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderer_Truncated(t *testing.T) {
+	t.Run("it tells when only some of the resources were listed", func(t *testing.T) {
+		var stderr bytes.Buffer
+		renderer := &Renderer{MessageWriter: &stderr, ResultWriter: io.Discard}
+
+		renderer.Truncated("applications", 100, 250)
+
+		assert.Contains(t, stderr.String(), "Showing 100 of 250 applications. Use --number to list more.")
+	})
+
+	t.Run("it stays silent when all the resources were listed", func(t *testing.T) {
+		var stderr bytes.Buffer
+		renderer := &Renderer{MessageWriter: &stderr, ResultWriter: io.Discard}
+
+		renderer.Truncated("applications", 3, 3)
+
+		assert.Empty(t, stderr.String())
+	})
+}
+
+func TestRenderer_JSONError(t *testing.T) {
+	var stderr, stdout bytes.Buffer
+	renderer := &Renderer{MessageWriter: &stderr, ResultWriter: &stdout}
+
+	renderer.JSONError(ErrorResult{
+		Code:       "inexistent_client",
+		Message:    "failed to find application",
+		StatusCode: 404,
+		Payload:    []byte(`{"errorCode":"inexistent_client"}`),
+	})
+
+	assert.JSONEq(
+		t,
+		`{"error": {"code": "inexistent_client", "message": "failed to find application", "status_code": 404, "payload": {"errorCode": "inexistent_client"}}}`,
+		stderr.String(),
+	)
+	assert.Empty(t, stdout.String())
+}
+
+func TestRenderer_JSONResult(t *testing.T) {
+	forceColors := ansi.ForceColors
+	ansi.ForceColors = true
+	t.Cleanup(func() {
+		ansi.ForceColors = forceColors
+	})
+	t.Setenv("CLICOLOR_FORCE", "1")
+
+	t.Run("it colorizes the json results", func(t *testing.T) {
+		var stdout bytes.Buffer
+		renderer := &Renderer{MessageWriter: io.Discard, ResultWriter: &stdout}
+
+		renderer.JSONResult(map[string]string{"name": "Default App"})
+
+		assert.Contains(t, stdout.String(), "\x1b[")
+	})
+
+	t.Run("it doesn't colorize the plain json results", func(t *testing.T) {
+		var stdout bytes.Buffer
+		renderer := &Renderer{MessageWriter: io.Discard, ResultWriter: &stdout, PlainJSON: true}
+
+		renderer.JSONResult(map[string]string{"name": "Default App"})
+
+		assert.Equal(t, "{\n    \"name\": \"Default App\"\n}", stdout.String())
+	})
+}
@@ -0,0 +1,26 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ErrorResult is the error of a command, rendered as json for the tools parsing the output.
+type ErrorResult struct {
+	Code       string          `json:"code"`
+	Message    string          `json:"message"`
+	StatusCode int             `json:"status_code,omitempty"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
+
+// JSONError writes the error as json to the message writer, to keep the results apart.
+func (r *Renderer) JSONError(result ErrorResult) {
+	b, err := json.MarshalIndent(map[string]ErrorResult{"error": result}, "", "    ")
+	if err != nil {
+		r.Errorf("couldn't marshal error as JSON: %v", err)
+		return
+	}
+
+	fmt.Fprintln(r.MessageWriter, string(b))
+}
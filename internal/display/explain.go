@@ -0,0 +1,45 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// CodeExplanation explains what a log event type or an OAuth error code means.
+type CodeExplanation struct {
+	Code        string   `json:"code"`
+	Kind        string   `json:"kind"`
+	Name        string   `json:"name"`
+	Meaning     string   `json:"meaning"`
+	Causes      []string `json:"causes,omitempty"`
+	Remediation []string `json:"remediation,omitempty"`
+}
+
+func (r *Renderer) Explain(e *CodeExplanation) {
+	if r.Format == OutputFormatJSON {
+		r.JSONResult(e)
+		return
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "  %s  %s %s\n\n", ansi.Bold(e.Code), e.Name, ansi.Faint("("+e.Kind+")"))
+	fmt.Fprintf(&b, "  %s\n", e.Meaning)
+
+	writeList := func(heading string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+
+		fmt.Fprintf(&b, "\n  %s\n", ansi.Bold(heading))
+		for _, item := range items {
+			fmt.Fprintf(&b, "    • %s\n", item)
+		}
+	}
+	writeList("Common causes", e.Causes)
+	writeList("Remediation", e.Remediation)
+
+	r.Output(b.String())
+}
@@ -0,0 +1,71 @@
+package display
+
+import (
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// FAPICheckStatus is the status of checking an application against a FAPI requirement.
+type FAPICheckStatus string
+
+const (
+	FAPICheckPass FAPICheckStatus = "pass"
+	FAPICheckWarn FAPICheckStatus = "warn"
+	FAPICheckFail FAPICheckStatus = "fail"
+)
+
+// FAPICheckResult holds the outcome of checking an application against a FAPI requirement.
+type FAPICheckResult struct {
+	Requirement string          `json:"requirement"`
+	Status      FAPICheckStatus `json:"status"`
+	Details     string          `json:"details,omitempty"`
+}
+
+type fapiCheckView struct {
+	Requirement string
+	Status      string
+	Details     string
+	raw         interface{}
+}
+
+func (v *fapiCheckView) AsTableHeader() []string {
+	return []string{"Requirement", "Status", "Details"}
+}
+
+func (v *fapiCheckView) AsTableRow() []string {
+	return []string{v.Requirement, v.Status, v.Details}
+}
+
+func (v *fapiCheckView) KeyValues() [][]string {
+	return [][]string{}
+}
+
+func (v *fapiCheckView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) FAPICheck(appName string, results []FAPICheckResult) {
+	r.Heading("FAPI check for", appName)
+
+	var res []View
+	for _, result := range results {
+		res = append(res, &fapiCheckView{
+			Requirement: result.Requirement,
+			Status:      fapiCheckStatusFor(result.Status),
+			Details:     result.Details,
+			raw:         result,
+		})
+	}
+
+	r.Results(res)
+}
+
+func fapiCheckStatusFor(status FAPICheckStatus) string {
+	switch status {
+	case FAPICheckPass:
+		return ansi.Green("✓ pass")
+	case FAPICheckWarn:
+		return ansi.Yellow("! warn")
+	default:
+		return ansi.Red("✗ fail")
+	}
+}
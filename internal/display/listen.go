@@ -0,0 +1,71 @@
+package display
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// logStreamEvent is how log streams wrap each of the logs they send.
+type logStreamEvent struct {
+	LogID string          `json:"log_id"`
+	Data  *management.Log `json:"data"`
+}
+
+// WebhookRequest renders a request received by `auth0 listen`, along with the
+// JSON payloads it carries. The logs sent by log streams get summarized as well.
+func (r *Renderer) WebhookRequest(receivedAt time.Time, method, path string, payloads []json.RawMessage, raw []byte) {
+	r.Newline()
+	r.Infof("%s %s %s", ansi.Faint(receivedAt.Format("15:04:05")), ansi.Bold(method), path)
+
+	if payloads == nil {
+		if len(bytes.TrimSpace(raw)) > 0 {
+			r.Output(fmt.Sprintf("%s\n", raw))
+		}
+		return
+	}
+
+	for _, payload := range payloads {
+		if summary := logEventSummary(payload); summary != "" {
+			fmt.Fprintf(r.MessageWriter, "      %s\n", summary)
+		}
+
+		if r.Format == OutputFormatJSON {
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, payload); err == nil {
+				r.Output(compact.String() + "\n")
+				continue
+			}
+		}
+
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, payload, "", "    "); err != nil {
+			r.Output(fmt.Sprintf("%s\n", payload))
+			continue
+		}
+		r.Output(ansi.ColorizeJSON(indented.String()) + "\n")
+	}
+}
+
+// logEventSummary describes the payload in a line when it's a log sent by a log stream.
+func logEventSummary(payload json.RawMessage) string {
+	var event logStreamEvent
+	if err := json.Unmarshal(payload, &event); err != nil || event.LogID == "" || event.Data == nil {
+		return ""
+	}
+
+	typ, desc := (&logView{Log: event.Data}).typeDesc()
+
+	summary := fmt.Sprintf("%s %s", typ, strings.TrimSpace(desc))
+	if client := event.Data.GetClientName(); client != "" {
+		summary += ansi.Faint(fmt.Sprintf(" (%s)", client))
+	}
+
+	return summary
+}
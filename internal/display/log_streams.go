@@ -1,17 +1,20 @@
 package display
 
 import (
+	"encoding/json"
+
 	"github.com/auth0/go-auth0/management"
 
 	"github.com/auth0/auth0-cli/internal/ansi"
 )
 
 type logStreamView struct {
-	ID     string
-	Name   string
-	Type   string
-	Status string
-	raw    interface{}
+	ID            string
+	Name          string
+	Type          string
+	Status        string
+	revealSecrets bool
+	raw           *management.LogStream
 }
 
 func (v *logStreamView) AsTableHeader() []string {
@@ -37,7 +40,17 @@ func (v *logStreamView) KeyValues() [][]string {
 }
 
 func (v *logStreamView) Object() interface{} {
-	return v.raw
+	if v.revealSecrets {
+		return v.raw
+	}
+
+	// The sink holds the credentials of the log stream.
+	data, err := json.Marshal(v.raw)
+	if err != nil {
+		return v.raw
+	}
+
+	return json.RawMessage(MaskSecrets(data))
 }
 
 func (r *Renderer) LogStreamList(logs []*management.LogStream) {
@@ -52,7 +65,7 @@ func (r *Renderer) LogStreamList(logs []*management.LogStream) {
 
 	var res []View
 	for _, ls := range logs {
-		res = append(res, makeLogStreamView(ls))
+		res = append(res, makeLogStreamView(ls, r.RevealSecrets))
 	}
 
 	r.Results(res)
@@ -60,25 +73,26 @@ func (r *Renderer) LogStreamList(logs []*management.LogStream) {
 
 func (r *Renderer) LogStreamShow(logs *management.LogStream) {
 	r.Heading("log streams")
-	r.Result(makeLogStreamView(logs))
+	r.Result(makeLogStreamView(logs, r.RevealSecrets))
 }
 
 func (r *Renderer) LogStreamCreate(logs *management.LogStream) {
 	r.Heading("log streams created")
-	r.Result(makeLogStreamView(logs))
+	r.Result(makeLogStreamView(logs, r.RevealSecrets))
 }
 
 func (r *Renderer) LogStreamUpdate(logs *management.LogStream) {
 	r.Heading("log streams updated")
-	r.Result(makeLogStreamView(logs))
+	r.Result(makeLogStreamView(logs, r.RevealSecrets))
 }
 
-func makeLogStreamView(logs *management.LogStream) *logStreamView {
+func makeLogStreamView(logs *management.LogStream, revealSecrets bool) *logStreamView {
 	return &logStreamView{
-		ID:     ansi.Faint(logs.GetID()),
-		Name:   logs.GetName(),
-		Type:   logs.GetType(),
-		Status: logs.GetStatus(),
-		raw:    logs,
+		ID:            ansi.Faint(logs.GetID()),
+		Name:          logs.GetName(),
+		Type:          logs.GetType(),
+		Status:        logs.GetStatus(),
+		revealSecrets: revealSecrets,
+		raw:           logs,
 	}
 }
@@ -1,7 +1,9 @@
 package display
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/auth0/auth0-cli/internal/ansi"
@@ -27,7 +29,8 @@ var _ View = &logView{}
 type logView struct {
 	silent bool
 	*management.Log
-	raw interface{}
+	raw       interface{}
+	highlight *regexp.Regexp
 }
 
 func (v *logView) AsTableHeader() []string {
@@ -71,13 +74,22 @@ func (v *logView) AsTableRow() []string {
 
 	return []string{
 		typ,
-		truncate(desc, 54),
+		v.highlightMatches(truncate(desc, 54)),
 		truncate(v.GetDate().Format("Jan 02 15:04:05.000"), 20),
-		conn,
-		clientName,
+		v.highlightMatches(conn),
+		v.highlightMatches(clientName),
 	}
 }
 
+// highlightMatches highlights the parts of the text that match the pattern being tailed for, if any.
+func (v *logView) highlightMatches(text string) string {
+	if v.highlight == nil {
+		return text
+	}
+
+	return v.highlight.ReplaceAllStringFunc(text, ansi.Highlight)
+}
+
 func (v *logView) Object() interface{} {
 	return v.raw
 }
@@ -169,12 +181,45 @@ func (r *Renderer) LogList(logs []*management.Log, silent, hasFilter bool) {
 	r.Results(res)
 }
 
-func (r *Renderer) LogTail(logs []*management.Log, ch <-chan []*management.Log, silent bool) {
+// LogTailFilter narrows the tailed logs down to the ones matching a regular expression,
+// or to the ones that don't when inverted. The pattern is matched against the whole log
+// event, in JSON, so that it can match any of its fields.
+type LogTailFilter struct {
+	Pattern *regexp.Regexp
+	Invert  bool
+}
+
+func (f *LogTailFilter) matches(l *management.Log) bool {
+	if f == nil {
+		return true
+	}
+
+	raw, err := json.Marshal(l)
+	if err != nil {
+		return true
+	}
+
+	return f.Pattern.Match(raw) != f.Invert
+}
+
+// highlight returns the pattern to highlight matches of, which
+// is none when inverted as the logs don't match it by then.
+func (f *LogTailFilter) highlight() *regexp.Regexp {
+	if f == nil || f.Invert {
+		return nil
+	}
+
+	return f.Pattern
+}
+
+func (r *Renderer) LogTail(logs []*management.Log, ch <-chan []*management.Log, silent bool, filter *LogTailFilter) {
 	r.Heading("logs")
 
 	var res []View
 	for _, l := range logs {
-		res = append(res, &logView{Log: l, silent: silent, raw: l})
+		if filter.matches(l) {
+			res = append(res, &logView{Log: l, silent: silent, raw: l, highlight: filter.highlight()})
+		}
 	}
 
 	viewChan := make(chan View)
@@ -184,7 +229,9 @@ func (r *Renderer) LogTail(logs []*management.Log, ch <-chan []*management.Log,
 
 		for list := range ch {
 			for _, l := range list {
-				viewChan <- &logView{Log: l, silent: silent, raw: l}
+				if filter.matches(l) {
+					viewChan <- &logView{Log: l, silent: silent, raw: l, highlight: filter.highlight()}
+				}
 			}
 		}
 	}()
@@ -3,12 +3,14 @@ package display
 import (
 	"bytes"
 	"io"
+	"regexp"
 	"sync"
 	"testing"
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/auth0/auth0-cli/internal/ansi"
 	"github.com/auth0/auth0-cli/internal/auth0"
 )
 
@@ -71,3 +73,48 @@ API Operation              Update tenant settings
 		stdout.Reset()
 	})
 }
+
+func TestLogTailFilter(t *testing.T) {
+	log := &management.Log{
+		LogID:       auth0.String("354234"),
+		Type:        auth0.String("sapi"),
+		Description: auth0.String("Update branding settings"),
+		IP:          auth0.String("10.0.0.1"),
+	}
+
+	t.Run("it matches any field of the log", func(t *testing.T) {
+		filter := &LogTailFilter{Pattern: regexp.MustCompile(`10\.0\.0\.1`)}
+		assert.True(t, filter.matches(log))
+	})
+
+	t.Run("it doesn't match when the pattern isn't found", func(t *testing.T) {
+		filter := &LogTailFilter{Pattern: regexp.MustCompile("tenant")}
+		assert.False(t, filter.matches(log))
+	})
+
+	t.Run("it inverts the match", func(t *testing.T) {
+		filter := &LogTailFilter{Pattern: regexp.MustCompile("tenant"), Invert: true}
+		assert.True(t, filter.matches(log))
+		assert.Nil(t, filter.highlight())
+	})
+
+	t.Run("it matches everything without a filter", func(t *testing.T) {
+		var filter *LogTailFilter
+		assert.True(t, filter.matches(log))
+		assert.Nil(t, filter.highlight())
+	})
+}
+
+func TestLogView_HighlightMatches(t *testing.T) {
+	ansi.ForceColors = true
+	ansi.Initialize(false)
+	t.Cleanup(func() {
+		ansi.ForceColors = false
+		ansi.Initialize(false)
+	})
+
+	view := &logView{highlight: regexp.MustCompile("brand(ing)?")}
+
+	assert.Equal(t, "Update \x1b[7mbranding\x1b[0m settings", view.highlightMatches("Update branding settings"))
+	assert.Equal(t, "Update tenant settings", view.highlightMatches("Update tenant settings"))
+}
@@ -0,0 +1,253 @@
+package display
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+// NetworkACLOutcome is the outcome of checking a request against a network ACL.
+type NetworkACLOutcome string
+
+const (
+	NetworkACLMatch         NetworkACLOutcome = "match"
+	NetworkACLPossibleMatch NetworkACLOutcome = "possible match"
+	NetworkACLNoMatch       NetworkACLOutcome = "no match"
+)
+
+// NetworkACLCheckResult holds the outcome of checking a request against a network ACL.
+type NetworkACLCheckResult struct {
+	NetworkACL *auth0.NetworkACL `json:"network_acl"`
+	Outcome    NetworkACLOutcome `json:"outcome"`
+
+	// OtherCriteria lists the criteria a possible match depends on,
+	// which can't be evaluated from the IP address alone.
+	OtherCriteria []string `json:"other_criteria,omitempty"`
+}
+
+type networkACLView struct {
+	ID          string
+	Description string
+	Priority    string
+	Active      string
+	Action      string
+	RedirectURI string
+	Scope       string
+	Match       string
+	NotMatch    string
+
+	raw interface{}
+}
+
+func (v *networkACLView) AsTableHeader() []string {
+	return []string{"ID", "Priority", "Description", "Action", "Scope", "Active"}
+}
+
+func (v *networkACLView) AsTableRow() []string {
+	return []string{
+		ansi.Faint(v.ID),
+		v.Priority,
+		v.Description,
+		v.Action,
+		v.Scope,
+		v.Active,
+	}
+}
+
+func (v *networkACLView) KeyValues() [][]string {
+	keyValues := [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"DESCRIPTION", v.Description},
+		{"PRIORITY", v.Priority},
+		{"ACTIVE", v.Active},
+		{"ACTION", v.Action},
+	}
+
+	if v.RedirectURI != "" {
+		keyValues = append(keyValues, []string{"REDIRECT URI", v.RedirectURI})
+	}
+
+	return append(keyValues,
+		[]string{"SCOPE", v.Scope},
+		[]string{"MATCH", v.Match},
+		[]string{"NOT MATCH", v.NotMatch},
+	)
+}
+
+func (v *networkACLView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) NetworkACLList(networkACLs []*auth0.NetworkACL) {
+	resource := "network ACLs"
+
+	r.Heading(resource)
+
+	if len(networkACLs) == 0 {
+		r.EmptyState(resource, "Use 'auth0 network-acls create' to add one")
+		return
+	}
+
+	var res []View
+	for _, networkACL := range networkACLs {
+		res = append(res, makeNetworkACLView(networkACL))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) NetworkACLShow(networkACL *auth0.NetworkACL) {
+	r.Heading("network ACL")
+	r.Result(makeNetworkACLView(networkACL))
+}
+
+func (r *Renderer) NetworkACLCreate(networkACL *auth0.NetworkACL) {
+	r.Heading("network ACL created")
+	r.Result(makeNetworkACLView(networkACL))
+}
+
+func (r *Renderer) NetworkACLUpdate(networkACL *auth0.NetworkACL) {
+	r.Heading("network ACL updated")
+	r.Result(makeNetworkACLView(networkACL))
+}
+
+func makeNetworkACLView(networkACL *auth0.NetworkACL) *networkACLView {
+	view := &networkACLView{
+		ID:          networkACL.GetID(),
+		Description: networkACL.GetDescription(),
+		Active:      boolean(networkACL.GetActive()),
+		raw:         networkACL,
+	}
+
+	if networkACL.Priority != nil {
+		view.Priority = strconv.Itoa(networkACL.GetPriority())
+	}
+
+	if rule := networkACL.GetRule(); rule != nil {
+		view.Action = rule.GetAction().Name()
+		view.Scope = rule.GetScope()
+		view.Match = networkACLMatchFor(rule.Match)
+		view.NotMatch = networkACLMatchFor(rule.NotMatch)
+
+		if rule.Action != nil && rule.Action.RedirectURI != nil {
+			view.RedirectURI = *rule.Action.RedirectURI
+		}
+	}
+
+	return view
+}
+
+func networkACLMatchFor(match *auth0.NetworkACLRuleMatch) string {
+	if match == nil {
+		return ""
+	}
+
+	var criteria []string
+	add := func(name string, values []string) {
+		if len(values) > 0 {
+			criteria = append(criteria, fmt.Sprintf("%s: %s", name, strings.Join(values, ", ")))
+		}
+	}
+
+	asns := make([]string, 0, len(match.ASNs))
+	for _, asn := range match.ASNs {
+		asns = append(asns, strconv.Itoa(asn))
+	}
+
+	add("asns", asns)
+	add("geo_country_codes", match.GeoCountryCodes)
+	add("geo_subdivision_codes", match.GeoSubdivisionCodes)
+	add("ipv4_cidrs", match.IPv4CIDRs)
+	add("ipv6_cidrs", match.IPv6CIDRs)
+	add("ja3_fingerprints", match.JA3Fingerprints)
+	add("ja4_fingerprints", match.JA4Fingerprints)
+	add("user_agents", match.UserAgents)
+
+	return strings.Join(criteria, "\n")
+}
+
+type networkACLCheckView struct {
+	ID          string
+	Priority    string
+	Description string
+	Action      string
+	Outcome     string
+
+	raw interface{}
+}
+
+func (v *networkACLCheckView) AsTableHeader() []string {
+	return []string{"ID", "Priority", "Description", "Action", "Outcome"}
+}
+
+func (v *networkACLCheckView) AsTableRow() []string {
+	return []string{
+		ansi.Faint(v.ID),
+		v.Priority,
+		v.Description,
+		v.Action,
+		v.Outcome,
+	}
+}
+
+func (v *networkACLCheckView) KeyValues() [][]string {
+	return [][]string{}
+}
+
+func (v *networkACLCheckView) Object() interface{} {
+	return v.raw
+}
+
+// NetworkACLCheck renders the network ACLs a request coming from the IP address
+// got checked against, and which of them would decide what happens to it.
+func (r *Renderer) NetworkACLCheck(ip string, results []NetworkACLCheckResult) {
+	r.Heading("network ACLs checked for", ip)
+
+	var res []View
+	var decidingACL *auth0.NetworkACL
+	for _, result := range results {
+		outcome := string(result.Outcome)
+		switch result.Outcome {
+		case NetworkACLMatch:
+			decidingACL = result.NetworkACL
+			outcome = ansi.Green(outcome)
+		case NetworkACLPossibleMatch:
+			outcome = fmt.Sprintf("%s (depends on %s)", ansi.Yellow(outcome), strings.Join(result.OtherCriteria, ", "))
+		}
+
+		res = append(res, &networkACLCheckView{
+			ID:          result.NetworkACL.GetID(),
+			Priority:    strconv.Itoa(result.NetworkACL.GetPriority()),
+			Description: result.NetworkACL.GetDescription(),
+			Action:      result.NetworkACL.GetRule().GetAction().Name(),
+			Outcome:     outcome,
+			raw:         result,
+		})
+	}
+
+	if len(res) > 0 {
+		r.Results(res)
+	} else if r.Format == OutputFormatJSON {
+		r.JSONResult([]interface{}{})
+	}
+
+	if r.Format == OutputFormatJSON || r.Format == OutputFormatCSV {
+		return
+	}
+
+	if decidingACL == nil {
+		r.Infof("No network ACL is certain to match a request coming from %s.", ip)
+		return
+	}
+
+	r.Infof(
+		"A request coming from %s would hit the network ACL %s (%s): %s.",
+		ip,
+		ansi.Bold(decidingACL.GetDescription()),
+		decidingACL.GetID(),
+		decidingACL.GetRule().GetAction().Name(),
+	)
+}
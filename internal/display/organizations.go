@@ -76,6 +76,65 @@ func (r *Renderer) OrganizationUpdate(organization *management.Organization) {
 	r.Result(makeOrganizationView(organization))
 }
 
+type organizationBrandingView struct {
+	ID              string
+	Name            string
+	LogoURL         string
+	AccentColor     string
+	BackgroundColor string
+	raw             interface{}
+}
+
+func (v *organizationBrandingView) AsTableHeader() []string {
+	return []string{}
+}
+
+func (v *organizationBrandingView) AsTableRow() []string {
+	return []string{}
+}
+
+func (v *organizationBrandingView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"LOGO URL", v.LogoURL},
+		{"ACCENT COLOR", v.AccentColor},
+		{"BACKGROUND COLOR", v.BackgroundColor},
+	}
+}
+
+func (v *organizationBrandingView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) OrganizationBrandingShow(organization *management.Organization) {
+	r.Heading("organization branding")
+	r.Result(makeOrganizationBrandingView(organization))
+}
+
+func (r *Renderer) OrganizationBrandingUpdate(organization *management.Organization) {
+	r.Heading("organization branding updated")
+	r.Result(makeOrganizationBrandingView(organization))
+}
+
+func makeOrganizationBrandingView(organization *management.Organization) *organizationBrandingView {
+	branding := organization.GetBranding()
+
+	raw := branding
+	if raw == nil {
+		raw = &management.OrganizationBranding{}
+	}
+
+	return &organizationBrandingView{
+		ID:              organization.GetID(),
+		Name:            organization.GetName(),
+		LogoURL:         branding.GetLogoURL(),
+		AccentColor:     branding.GetColors()["primary"],
+		BackgroundColor: branding.GetColors()["page_background"],
+		raw:             raw,
+	}
+}
+
 func makeOrganizationView(organization *management.Organization) *organizationView {
 	accentColor := ""
 	backgroundColor := ""
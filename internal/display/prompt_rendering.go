@@ -0,0 +1,72 @@
+package display
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+type promptRenderingView struct {
+	Prompt                  string
+	Screen                  string
+	RenderingMode           string
+	ContextConfiguration    []string
+	DefaultHeadTagsDisabled string
+	HeadTags                string
+
+	raw interface{}
+}
+
+func (v *promptRenderingView) AsTableHeader() []string {
+	return []string{}
+}
+
+func (v *promptRenderingView) AsTableRow() []string {
+	return []string{}
+}
+
+func (v *promptRenderingView) KeyValues() [][]string {
+	return [][]string{
+		{"PROMPT", v.Prompt},
+		{"SCREEN", v.Screen},
+		{"RENDERING MODE", v.RenderingMode},
+		{"CONTEXT CONFIGURATION", strings.Join(v.ContextConfiguration, ", ")},
+		{"DEFAULT HEAD TAGS DISABLED", v.DefaultHeadTagsDisabled},
+		{"HEAD TAGS", v.HeadTags},
+	}
+}
+
+func (v *promptRenderingView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) PromptRenderingShow(rendering *auth0.PromptRendering) {
+	r.Heading("rendering settings")
+	r.Result(makePromptRenderingView(rendering))
+}
+
+func (r *Renderer) PromptRenderingUpdate(rendering *auth0.PromptRendering) {
+	r.Heading("rendering settings updated")
+	r.Result(makePromptRenderingView(rendering))
+}
+
+func makePromptRenderingView(rendering *auth0.PromptRendering) *promptRenderingView {
+	var headTags string
+	if len(rendering.HeadTags) > 0 {
+		if headTagsJSON, err := json.Marshal(rendering.HeadTags); err == nil {
+			headTags = string(headTagsJSON)
+		}
+	}
+
+	return &promptRenderingView{
+		Prompt:                  auth0.StringValue(rendering.Prompt),
+		Screen:                  auth0.StringValue(rendering.Screen),
+		RenderingMode:           rendering.GetRenderingMode(),
+		ContextConfiguration:    rendering.GetContextConfiguration(),
+		DefaultHeadTagsDisabled: boolean(rendering.GetDefaultHeadTagsDisabled()),
+		HeadTags:                headTags,
+
+		raw: rendering,
+	}
+}
@@ -9,6 +9,17 @@ import (
 
 const qsBaseURL = "https://auth0.com"
 
+// quickstartMetadata is the quickstart as output in json, along with
+// the details needed to create an application and download its sample.
+type quickstartMetadata struct {
+	auth0.Quickstart
+
+	DocsURL         string `json:"docsUrl"`
+	SampleRepoURL   string `json:"sampleRepoUrl,omitempty"`
+	ApplicationType string `json:"applicationType,omitempty"`
+	DownloadCommand string `json:"downloadCommand"`
+}
+
 type quickstartView struct {
 	Stack   string
 	AppType string
@@ -41,7 +52,7 @@ func (r *Renderer) QuickstartList(quickstarts []auth0.Quickstart) {
 			Stack:   qs.Name,
 			AppType: ApplyColorToFriendlyAppType(qsAppTypeFor(qs.AppType)),
 			URL:     fmt.Sprintf("%s%s", qsBaseURL, qs.URL),
-			raw:     qs,
+			raw:     makeQuickstartMetadata(qs),
 		})
 	}
 
@@ -62,3 +73,17 @@ func qsAppTypeFor(s string) string {
 		return ""
 	}
 }
+
+func makeQuickstartMetadata(qs auth0.Quickstart) *quickstartMetadata {
+	// The sample repository is left out if it can't be
+	// derived, as the sample can still be downloaded.
+	sampleRepoURL, _ := qs.SampleRepoURL()
+
+	return &quickstartMetadata{
+		Quickstart:      qs,
+		DocsURL:         fmt.Sprintf("%s%s", qsBaseURL, qs.URL),
+		SampleRepoURL:   sampleRepoURL,
+		ApplicationType: qs.ApplicationType(),
+		DownloadCommand: fmt.Sprintf("auth0 quickstarts download <app-id> --stack %q", qs.Name),
+	}
+}
@@ -0,0 +1,44 @@
+package display
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+func TestQuickstartList(t *testing.T) {
+	result := &bytes.Buffer{}
+	renderer := &Renderer{
+		MessageWriter: io.Discard,
+		ResultWriter:  result,
+		Format:        OutputFormatJSON,
+	}
+
+	renderer.QuickstartList([]auth0.Quickstart{
+		{
+			Name:         "Express",
+			AppType:      "webapp",
+			URL:          "/docs/quickstart/webapp/express",
+			DownloadLink: "/docs/package/v2?repo=auth0-express-webapp-sample&branch=master&path=01-Login",
+		},
+	})
+
+	assert.JSONEq(t, `[
+		{
+			"name": "Express",
+			"appType": "webapp",
+			"url": "/docs/quickstart/webapp/express",
+			"logo": "",
+			"downloadLink": "/docs/package/v2?repo=auth0-express-webapp-sample&branch=master&path=01-Login",
+			"downloadInstructions": "",
+			"docsUrl": "https://auth0.com/docs/quickstart/webapp/express",
+			"sampleRepoUrl": "https://github.com/auth0-samples/auth0-express-webapp-sample/tree/master/01-Login",
+			"applicationType": "regular_web",
+			"downloadCommand": "auth0 quickstarts download <app-id> --stack \"Express\""
+		}
+	]`, result.String())
+}
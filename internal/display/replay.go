@@ -0,0 +1,65 @@
+package display
+
+import (
+	"strconv"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// ReplayedRequest is a recorded Management API request that got replayed.
+type ReplayedRequest struct {
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	StatusCode int    `json:"status_code"`
+}
+
+type replayedRequestView struct {
+	Method     string
+	Path       string
+	StatusCode string
+
+	raw interface{}
+}
+
+func (v *replayedRequestView) AsTableHeader() []string {
+	return []string{"Method", "Path", "Status"}
+}
+
+func (v *replayedRequestView) AsTableRow() []string {
+	return []string{
+		v.Method,
+		v.Path,
+		ansi.Green(v.StatusCode),
+	}
+}
+
+func (v *replayedRequestView) KeyValues() [][]string {
+	return [][]string{}
+}
+
+func (v *replayedRequestView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) ReplayedRequests(requests []ReplayedRequest) {
+	resource := "replayed requests"
+
+	r.Heading(resource)
+
+	if len(requests) == 0 {
+		r.EmptyState(resource, "The recording doesn't hold any request making changes to the tenant")
+		return
+	}
+
+	var res []View
+	for _, request := range requests {
+		res = append(res, &replayedRequestView{
+			Method:     request.Method,
+			Path:       request.Path,
+			StatusCode: strconv.Itoa(request.StatusCode),
+			raw:        request,
+		})
+	}
+
+	r.Results(res)
+}
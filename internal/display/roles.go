@@ -97,3 +97,62 @@ func makeRoleView(role *management.Role) *roleView {
 		raw:         role,
 	}
 }
+
+// RoleUser is a user holding a role, either assigned
+// to them directly or as a member of an organization.
+type RoleUser struct {
+	UserID           string `json:"user_id"`
+	Name             string `json:"name,omitempty"`
+	Email            string `json:"email,omitempty"`
+	OrganizationID   string `json:"organization_id,omitempty"`
+	OrganizationName string `json:"organization_name,omitempty"`
+}
+
+type roleUserView struct {
+	UserID       string
+	Name         string
+	Email        string
+	Organization string
+	raw          interface{}
+}
+
+func (v *roleUserView) AsTableHeader() []string {
+	return []string{"User ID", "Name", "Email", "Organization"}
+}
+
+func (v *roleUserView) AsTableRow() []string {
+	return []string{
+		ansi.Faint(v.UserID),
+		v.Name,
+		v.Email,
+		v.Organization,
+	}
+}
+
+func (v *roleUserView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) RoleUserList(role *management.Role, users []*RoleUser) {
+	resource := "role users"
+
+	r.Heading(fmt.Sprintf("%s of %s (%d)", resource, role.GetName(), len(users)))
+
+	if len(users) == 0 {
+		r.EmptyState(resource, "Use 'auth0 users roles assign' to assign the role to a user")
+		return
+	}
+
+	var res []View
+	for _, user := range users {
+		res = append(res, &roleUserView{
+			UserID:       user.UserID,
+			Name:         user.Name,
+			Email:        user.Email,
+			Organization: user.OrganizationName,
+			raw:          user,
+		})
+	}
+
+	r.Results(res)
+}
@@ -0,0 +1,80 @@
+package display
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// maskedSecret is shown instead of the secrets, unless they're revealed.
+const maskedSecret = "********"
+
+// secretFields are the fields holding secrets within the Management API payloads,
+// such as client secrets, the credentials of the log streams, email providers and connections, and
+// the tokens issued by the identity providers of the users.
+var secretFields = map[string]bool{
+	"client_secret":                  true,
+	"app_secret":                     true,
+	"access_token":                   true,
+	"access_token_secret":            true,
+	"refresh_token":                  true,
+	"api_key":                        true,
+	"accessKeyId":                    true,
+	"secretAccessKey":                true,
+	"smtp_pass":                      true,
+	"httpAuthorization":              true,
+	"datadogApiKey":                  true,
+	"segmentWriteKey":                true,
+	"splunkToken":                    true,
+	"sumoSourceAddress":              true,
+	"mixpanelServiceAccountPassword": true,
+}
+
+// MaskSecrets masks the values of the secret fields within the JSON payload.
+// The payload is returned as it is when it doesn't hold any secret.
+func MaskSecrets(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return data
+	}
+
+	if !maskSecrets(value) {
+		return data
+	}
+
+	masked, err := json.Marshal(value)
+	if err != nil {
+		return data
+	}
+
+	return masked
+}
+
+func maskSecrets(value interface{}) bool {
+	masked := false
+
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if maskSecrets(item) {
+				masked = true
+			}
+		}
+	case map[string]interface{}:
+		for key, item := range v {
+			if secret, ok := item.(string); ok && secretFields[key] && secret != "" {
+				v[key] = maskedSecret
+				masked = true
+				continue
+			}
+
+			if maskSecrets(item) {
+				masked = true
+			}
+		}
+	}
+
+	return masked
+}
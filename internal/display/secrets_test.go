@@ -0,0 +1,74 @@
+package display
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+func TestMaskSecrets(t *testing.T) {
+	t.Run("it masks the secret fields", func(t *testing.T) {
+		masked := MaskSecrets([]byte(`[
+			{"client_id": "abc", "client_secret": "s3cr3t", "jwt_configuration": {"lifetime_in_seconds": 36000}},
+			{"client_id": "def", "client_secret": ""},
+			{"sink": {"datadogRegion": "us", "datadogApiKey": "key"}},
+			{"identities": [{"provider": "github", "access_token": "gho_token"}]},
+			{"options": {"client_id": "com.example", "app_secret": "apple-key"}}
+		]`))
+
+		assert.JSONEq(t, `[
+			{"client_id": "abc", "client_secret": "********", "jwt_configuration": {"lifetime_in_seconds": 36000}},
+			{"client_id": "def", "client_secret": ""},
+			{"sink": {"datadogRegion": "us", "datadogApiKey": "********"}},
+			{"identities": [{"provider": "github", "access_token": "********"}]},
+			{"options": {"client_id": "com.example", "app_secret": "********"}}
+		]`, string(masked))
+	})
+
+	t.Run("it leaves the payloads without secrets as they are", func(t *testing.T) {
+		payload := []byte(`{"friendly_name": "Travel0",  "session_lifetime": 168}`)
+		assert.Equal(t, payload, MaskSecrets(payload))
+	})
+
+	t.Run("it leaves invalid JSON as it is", func(t *testing.T) {
+		payload := []byte(`not json`)
+		assert.Equal(t, payload, MaskSecrets(payload))
+	})
+}
+
+func TestLogStreamShow_MasksSecrets(t *testing.T) {
+	logStream := &management.LogStream{
+		ID:   auth0.String("lst_1"),
+		Name: auth0.String("Splunk"),
+		Type: auth0.String("splunk"),
+		Sink: &management.LogStreamSinkSplunk{
+			Domain: auth0.String("splunk.example.com"),
+			Token:  auth0.String("s3cr3t"),
+		},
+	}
+
+	for _, reveal := range []bool{false, true} {
+		var results bytes.Buffer
+		renderer := &Renderer{
+			MessageWriter: &bytes.Buffer{},
+			ResultWriter:  &results,
+			Format:        OutputFormatJSON,
+			RevealSecrets: reveal,
+		}
+
+		renderer.LogStreamShow(logStream)
+
+		require.Contains(t, results.String(), "splunk.example.com")
+		if reveal {
+			assert.Contains(t, results.String(), "s3cr3t")
+		} else {
+			assert.NotContains(t, results.String(), "s3cr3t")
+			assert.Contains(t, results.String(), maskedSecret)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package display
+
+import (
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// IPCheck tells whether an IP address is blocked by the attack protections. The brute-force
+// protection blocks are only checked for a given user, as they block an IP address for it alone.
+type IPCheck struct {
+	IP                            string                  `json:"ip"`
+	User                          string                  `json:"user,omitempty"`
+	SuspiciousIPThrottlingBlocked bool                    `json:"suspicious_ip_throttling_blocked"`
+	BruteForceBlocks              []*management.UserBlock `json:"brute_force_blocks,omitempty"`
+}
+
+func (r *Renderer) IPCheck(check *IPCheck) {
+	r.Heading("ip")
+
+	if r.Format == OutputFormatJSON {
+		r.JSONResult(check)
+		return
+	}
+
+	if check.SuspiciousIPThrottlingBlocked {
+		r.Infof("The IP %s is blocked by the Suspicious IP Throttling.", check.IP)
+		r.Infof(
+			"%s Run %s to unblock it.",
+			ansi.Faint("Hint:"),
+			ansi.Bold("`auth0 protection suspicious-ip-throttling ips unblock "+check.IP+"`"),
+		)
+	} else {
+		r.Infof("The IP %s is not blocked by the Suspicious IP Throttling.", check.IP)
+	}
+
+	if check.User == "" {
+		r.Infof(
+			"%s The Brute-force Protection blocks an IP address for a single user, check them with %s.",
+			ansi.Faint("Hint:"),
+			ansi.Bold("--user"),
+		)
+		return
+	}
+
+	if len(check.BruteForceBlocks) == 0 {
+		r.Infof("The IP %s is not blocked by the Brute-force Protection for %s.", check.IP, check.User)
+		return
+	}
+
+	r.Infof("The IP %s is blocked by the Brute-force Protection for %s.", check.IP, check.User)
+	r.Infof(
+		"%s Run %s to unblock it.",
+		ansi.Faint("Hint:"),
+		ansi.Bold("`auth0 users blocks unblock "+check.User+"`"),
+	)
+}
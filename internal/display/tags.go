@@ -0,0 +1,95 @@
+package display
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// TaggedResource is a resource along with the tags stored in its metadata.
+type TaggedResource struct {
+	Type string            `json:"type"`
+	ID   string            `json:"id"`
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags"`
+}
+
+type taggedResourceView struct {
+	Type string
+	ID   string
+	Name string
+	Tags string
+
+	raw interface{}
+}
+
+func (v *taggedResourceView) AsTableHeader() []string {
+	return []string{"Type", "ID", "Name", "Tags"}
+}
+
+func (v *taggedResourceView) AsTableRow() []string {
+	return []string{
+		v.Type,
+		ansi.Faint(v.ID),
+		v.Name,
+		v.Tags,
+	}
+}
+
+func (v *taggedResourceView) KeyValues() [][]string {
+	return [][]string{
+		{"TYPE", v.Type},
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"TAGS", v.Tags},
+	}
+}
+
+func (v *taggedResourceView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) TaggedResourceList(resources []*TaggedResource) {
+	resource := "tagged resources"
+
+	r.Heading(resource)
+
+	if len(resources) == 0 {
+		r.EmptyState(resource, "Use 'auth0 tags add' to tag one")
+		return
+	}
+
+	var res []View
+	for _, resource := range resources {
+		res = append(res, makeTaggedResourceView(resource))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) TaggedResourceShow(resource *TaggedResource) {
+	r.Heading("tags")
+	r.Result(makeTaggedResourceView(resource))
+}
+
+func (r *Renderer) TaggedResourceUpdate(resource *TaggedResource) {
+	r.Heading("tags updated")
+	r.Result(makeTaggedResourceView(resource))
+}
+
+func makeTaggedResourceView(resource *TaggedResource) *taggedResourceView {
+	tags := make([]string, 0, len(resource.Tags))
+	for key, value := range resource.Tags {
+		tags = append(tags, key+"="+value)
+	}
+	sort.Strings(tags)
+
+	return &taggedResourceView{
+		Type: resource.Type,
+		ID:   resource.ID,
+		Name: resource.Name,
+		Tags: strings.Join(tags, ", "),
+		raw:  resource,
+	}
+}
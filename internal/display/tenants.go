@@ -1,6 +1,12 @@
 package display
 
-import "github.com/auth0/auth0-cli/internal/ansi"
+import (
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
 
 type tenantView struct {
 	Active bool
@@ -45,3 +51,103 @@ func (r *Renderer) TenantList(data []string) {
 
 	r.Results(results)
 }
+
+type tenantErrorPageView struct {
+	URL         string
+	HTML        string
+	ShowLogLink string
+
+	raw interface{}
+}
+
+func (v *tenantErrorPageView) AsTableHeader() []string {
+	return []string{}
+}
+
+func (v *tenantErrorPageView) AsTableRow() []string {
+	return []string{}
+}
+
+func (v *tenantErrorPageView) KeyValues() [][]string {
+	return [][]string{
+		{ansi.Bold("URL"), v.URL},
+		{ansi.Bold("HTML"), v.HTML},
+		{ansi.Bold("SHOW_LOG_LINK"), v.ShowLogLink},
+	}
+}
+
+func (v *tenantErrorPageView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) TenantErrorPageShow(errorPage *management.TenantErrorPage) {
+	r.Heading("error page")
+	r.Result(makeTenantErrorPageView(errorPage))
+}
+
+func (r *Renderer) TenantErrorPageUpdate(errorPage *management.TenantErrorPage) {
+	r.Heading("error page updated")
+	r.Result(makeTenantErrorPageView(errorPage))
+}
+
+func makeTenantErrorPageView(errorPage *management.TenantErrorPage) *tenantErrorPageView {
+	if errorPage == nil {
+		errorPage = &management.TenantErrorPage{}
+	}
+
+	showLogLink := true // Auth0 shows the link unless disabled.
+	if errorPage.ShowLogLink != nil {
+		showLogLink = errorPage.GetShowLogLink()
+	}
+
+	return &tenantErrorPageView{
+		URL:         errorPage.GetURL(),
+		HTML:        errorPage.GetHTML(),
+		ShowLogLink: boolean(showLogLink),
+		raw:         errorPage,
+	}
+}
+
+type tenantLocalesView struct {
+	DefaultLocale  string   `json:"default_locale"`
+	EnabledLocales []string `json:"enabled_locales"`
+}
+
+func (v *tenantLocalesView) AsTableHeader() []string {
+	return []string{}
+}
+
+func (v *tenantLocalesView) AsTableRow() []string {
+	return []string{}
+}
+
+func (v *tenantLocalesView) KeyValues() [][]string {
+	return [][]string{
+		{ansi.Bold("DEFAULT_LOCALE"), v.DefaultLocale},
+		{ansi.Bold("ENABLED_LOCALES"), strings.Join(v.EnabledLocales, ", ")},
+	}
+}
+
+func (v *tenantLocalesView) Object() interface{} {
+	return v
+}
+
+func (r *Renderer) TenantLocalesShow(enabledLocales []string) {
+	r.Heading("locales")
+	r.Result(makeTenantLocalesView(enabledLocales))
+}
+
+func (r *Renderer) TenantLocalesUpdate(enabledLocales []string) {
+	r.Heading("locales updated")
+	r.Result(makeTenantLocalesView(enabledLocales))
+}
+
+// makeTenantLocalesView makes the view of the enabled locales, the first of which is the default one.
+func makeTenantLocalesView(enabledLocales []string) *tenantLocalesView {
+	view := &tenantLocalesView{EnabledLocales: enabledLocales}
+	if len(enabledLocales) > 0 {
+		view.DefaultLocale = enabledLocales[0]
+	}
+
+	return view
+}
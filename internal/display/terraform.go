@@ -0,0 +1,94 @@
+package display
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// TerraformResourceType is a resource type the Terraform config can be generated for,
+// along with how many resources of that type the tenant has, if they could be counted.
+type TerraformResourceType struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+	Count   *int   `json:"count"`
+}
+
+func (v *TerraformResourceType) AsTableHeader() []string {
+	return []string{"Resource Type", "Name", "Default", "Count"}
+}
+
+func (v *TerraformResourceType) AsTableRow() []string {
+	isDefault := ansi.Faint("no")
+	if v.Default {
+		isDefault = "yes"
+	}
+
+	count := ansi.Faint("unknown")
+	if v.Count != nil {
+		count = strconv.Itoa(*v.Count)
+		if *v.Count == 0 {
+			count = ansi.Faint(count)
+		}
+	}
+
+	return []string{v.Type, v.Name, isDefault, count}
+}
+
+func (v *TerraformResourceType) Object() interface{} {
+	return v
+}
+
+func (r *Renderer) TerraformResourceTypeList(resourceTypes []*TerraformResourceType) {
+	r.Heading("terraform resource types")
+
+	var res []View
+	for _, resourceType := range resourceTypes {
+		res = append(res, resourceType)
+	}
+
+	r.Results(res)
+}
+
+// terraformResourceNamesShown is how many names of the resources of a type get shown in the table.
+const terraformResourceNamesShown = 5
+
+// TerraformResourceSummary summarizes the resources of a type the Terraform config would be generated for.
+type TerraformResourceSummary struct {
+	Type  string   `json:"type"`
+	Count int      `json:"count"`
+	Names []string `json:"names"`
+}
+
+func (v *TerraformResourceSummary) AsTableHeader() []string {
+	return []string{"Resource Type", "Count", "Names"}
+}
+
+func (v *TerraformResourceSummary) AsTableRow() []string {
+	names := v.Names
+	if len(names) > terraformResourceNamesShown {
+		names = append(names[:terraformResourceNamesShown:terraformResourceNamesShown], ansi.Faint(
+			fmt.Sprintf("and %d more", len(v.Names)-terraformResourceNamesShown),
+		))
+	}
+
+	return []string{v.Type, strconv.Itoa(v.Count), strings.Join(names, ", ")}
+}
+
+func (v *TerraformResourceSummary) Object() interface{} {
+	return v
+}
+
+func (r *Renderer) TerraformDryRun(summaries []*TerraformResourceSummary) {
+	r.Heading("terraform resources to generate")
+
+	var res []View
+	for _, summary := range summaries {
+		res = append(res, summary)
+	}
+
+	r.Results(res)
+}
@@ -60,3 +60,70 @@ func (r *Renderer) TestToken(client *management.Client, t *authutil.TokenRespons
 		}
 	}
 }
+
+type silentAuthResult struct {
+	ClientID         string `json:"client_id"`
+	Domain           string `json:"domain"`
+	SSO              bool   `json:"sso"`
+	Error            string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+func (r *Renderer) TestSilentAuth(clientID, domain string, callbackErr *authutil.CallbackError) {
+	r.Heading("silent authentication")
+
+	result := &silentAuthResult{ClientID: clientID, Domain: domain, SSO: callbackErr == nil}
+	if callbackErr != nil {
+		result.Error = callbackErr.Code
+		result.ErrorDescription = callbackErr.Description
+	}
+
+	if r.Format == OutputFormatJSON {
+		r.JSONResult(result)
+		return
+	}
+
+	r.Output("  CLIENT ID   " + clientID)
+	r.Newline()
+	r.Output("  DOMAIN      " + domain)
+	r.Newline()
+
+	if callbackErr == nil {
+		r.Output("  SSO         " + ansi.Green("succeeded"))
+		r.Newline()
+		r.Newline()
+		r.Infof("The session of the browser was reused without prompting the user.")
+		return
+	}
+
+	r.Output("  SSO         " + ansi.Red("failed"))
+	r.Newline()
+	r.Output("  ERROR       " + callbackErr.Code)
+	r.Newline()
+	if callbackErr.Description != "" {
+		r.Output("  DESCRIPTION " + callbackErr.Description)
+		r.Newline()
+	}
+
+	if hint := silentAuthErrorHint(callbackErr.Code, clientID); hint != "" {
+		r.Newline()
+		r.Infof("%s %s", ansi.Faint("Hint:"), hint)
+	}
+}
+
+func silentAuthErrorHint(code, clientID string) string {
+	switch code {
+	case "login_required":
+		return fmt.Sprintf(
+			"The browser has no session with the tenant, or it expired. Log in first by running `auth0 test login %s`.",
+			clientID,
+		)
+	case "consent_required":
+		return "The user hasn't consented to the requested scopes. Consent can't be skipped when redirecting " +
+			"to localhost, so it's always required for the CLI when requesting an audience."
+	case "interaction_required":
+		return "The session requires the user to interact, such as to complete MFA or a redirect from an action."
+	default:
+		return ""
+	}
+}
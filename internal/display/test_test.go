@@ -0,0 +1,64 @@
+package display
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/auth0/auth0-cli/internal/auth/authutil"
+)
+
+func TestRenderer_TestSilentAuth(t *testing.T) {
+	t.Run("it renders a successful single sign-on as json", func(t *testing.T) {
+		stdout := &bytes.Buffer{}
+		mockRenderer := &Renderer{
+			MessageWriter: &bytes.Buffer{},
+			ResultWriter:  stdout,
+			Format:        OutputFormatJSON,
+		}
+
+		mockRenderer.TestSilentAuth("some-client-id", "example.auth0.com", nil)
+
+		assert.JSONEq(t, `{"client_id": "some-client-id", "domain": "example.auth0.com", "sso": true}`, stdout.String())
+	})
+
+	t.Run("it renders the error of a failed single sign-on as json", func(t *testing.T) {
+		stdout := &bytes.Buffer{}
+		mockRenderer := &Renderer{
+			MessageWriter: &bytes.Buffer{},
+			ResultWriter:  stdout,
+			Format:        OutputFormatJSON,
+		}
+
+		mockRenderer.TestSilentAuth(
+			"some-client-id",
+			"example.auth0.com",
+			&authutil.CallbackError{Code: "login_required", Description: "Login required"},
+		)
+
+		assert.JSONEq(
+			t,
+			`{"client_id": "some-client-id", "domain": "example.auth0.com", "sso": false, "error": "login_required", "error_description": "Login required"}`,
+			stdout.String(),
+		)
+	})
+
+	t.Run("it hints at how to solve the error", func(t *testing.T) {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		mockRenderer := &Renderer{
+			MessageWriter: stderr,
+			ResultWriter:  stdout,
+		}
+
+		mockRenderer.TestSilentAuth(
+			"some-client-id",
+			"example.auth0.com",
+			&authutil.CallbackError{Code: "login_required", Description: "Login required"},
+		)
+
+		assert.Contains(t, stdout.String(), "login_required")
+		assert.Contains(t, stderr.String(), "auth0 test login some-client-id")
+	})
+}
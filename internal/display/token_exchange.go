@@ -0,0 +1,86 @@
+package display
+
+import (
+	"github.com/auth0/auth0-cli/internal/ansi"
+	"github.com/auth0/auth0-cli/internal/auth0"
+)
+
+type tokenExchangeProfileView struct {
+	ID               string
+	Name             string
+	SubjectTokenType string
+	ActionID         string
+	Type             string
+	raw              interface{}
+}
+
+func (v *tokenExchangeProfileView) AsTableHeader() []string {
+	return []string{"ID", "Name", "Subject Token Type", "Action ID"}
+}
+
+func (v *tokenExchangeProfileView) AsTableRow() []string {
+	return []string{
+		ansi.Faint(v.ID),
+		v.Name,
+		v.SubjectTokenType,
+		ansi.Faint(v.ActionID),
+	}
+}
+
+func (v *tokenExchangeProfileView) KeyValues() [][]string {
+	return [][]string{
+		{"ID", ansi.Faint(v.ID)},
+		{"NAME", v.Name},
+		{"SUBJECT TOKEN TYPE", v.SubjectTokenType},
+		{"ACTION ID", v.ActionID},
+		{"TYPE", v.Type},
+	}
+}
+
+func (v *tokenExchangeProfileView) Object() interface{} {
+	return v.raw
+}
+
+func (r *Renderer) TokenExchangeProfileList(profiles []*auth0.TokenExchangeProfile) {
+	resource := "token exchange profiles"
+
+	r.Heading(resource)
+
+	if len(profiles) == 0 {
+		r.EmptyState(resource, "Use 'auth0 token-exchange create' to add one")
+		return
+	}
+
+	var res []View
+	for _, profile := range profiles {
+		res = append(res, makeTokenExchangeProfileView(profile))
+	}
+
+	r.Results(res)
+}
+
+func (r *Renderer) TokenExchangeProfileShow(profile *auth0.TokenExchangeProfile) {
+	r.Heading("token exchange profile")
+	r.Result(makeTokenExchangeProfileView(profile))
+}
+
+func (r *Renderer) TokenExchangeProfileCreate(profile *auth0.TokenExchangeProfile) {
+	r.Heading("token exchange profile created")
+	r.Result(makeTokenExchangeProfileView(profile))
+}
+
+func (r *Renderer) TokenExchangeProfileUpdate(profile *auth0.TokenExchangeProfile) {
+	r.Heading("token exchange profile updated")
+	r.Result(makeTokenExchangeProfileView(profile))
+}
+
+func makeTokenExchangeProfileView(profile *auth0.TokenExchangeProfile) *tokenExchangeProfileView {
+	return &tokenExchangeProfileView{
+		ID:               profile.GetID(),
+		Name:             profile.GetName(),
+		SubjectTokenType: profile.GetSubjectTokenType(),
+		ActionID:         profile.GetActionID(),
+		Type:             profile.GetType(),
+		raw:              profile,
+	}
+}
@@ -0,0 +1,94 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+// LoginTrace is the chronological trace of the log events a test login resulted in.
+type LoginTrace struct {
+	ClientID string             `json:"client_id"`
+	UserID   string             `json:"user_id,omitempty"`
+	Success  bool               `json:"success"`
+	Error    string             `json:"error,omitempty"`
+	Complete bool               `json:"complete"`
+	Events   []*LoginTraceEvent `json:"events"`
+}
+
+// LoginTraceEvent is a log event of the login, along with the steps it went through,
+// such as the prompts shown and the actions executed.
+type LoginTraceEvent struct {
+	LogID       string    `json:"log_id"`
+	Date        time.Time `json:"date"`
+	ElapsedMS   int64     `json:"elapsed_ms"`
+	Type        string    `json:"type"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Steps       []string  `json:"steps,omitempty"`
+}
+
+func (e *LoginTraceEvent) failed() bool {
+	return strings.HasPrefix(e.Type, "f")
+}
+
+func (r *Renderer) LoginTrace(trace *LoginTrace) {
+	r.Heading("login trace")
+
+	if r.Format == OutputFormatJSON {
+		r.JSONResult(trace)
+		return
+	}
+
+	if len(trace.Events) == 0 {
+		r.Warnf("No log events of the login were found.")
+	} else {
+		var b strings.Builder
+		for _, event := range trace.Events {
+			typ := ansi.Green(fmt.Sprintf("%-8s", event.Type))
+			if event.failed() {
+				typ = ansi.BrightRed(fmt.Sprintf("%-8s", event.Type))
+			}
+
+			elapsed := fmt.Sprintf("+%.3fs", float64(event.ElapsedMS)/1000)
+			fmt.Fprintf(&b, "  %s  %s %s", ansi.Faint(fmt.Sprintf("%-9s", elapsed)), typ, event.Name)
+			if event.Description != "" {
+				fmt.Fprintf(&b, " %s", ansi.Faint(event.Description))
+			}
+			b.WriteString("\n")
+
+			for i, step := range event.Steps {
+				branch := "├"
+				if i == len(event.Steps)-1 {
+					branch = "└"
+				}
+				fmt.Fprintf(&b, "  %s %s %s\n", strings.Repeat(" ", 18), ansi.Faint(branch), step)
+			}
+		}
+
+		r.Output(b.String())
+	}
+
+	if !trace.Complete {
+		r.Warnf("The log events of the login may not all be indexed yet, try again with a longer --wait.")
+	}
+
+	if trace.Success {
+		r.Infof("The login succeeded.")
+		return
+	}
+
+	r.Warnf("The login failed: %s", trace.Error)
+	for _, event := range trace.Events {
+		if event.failed() {
+			r.Infof(
+				"%s Run %s to find out more about the failure.",
+				ansi.Faint("Hint:"),
+				ansi.Bold(fmt.Sprintf("`auth0 explain %s`", event.Type)),
+			)
+			break
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package display
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/auth0/go-auth0"
@@ -15,6 +17,7 @@ type userView struct {
 	Email           string
 	Connection      string
 	Username        string
+	Identities      string
 	RequireUsername bool
 	raw             interface{}
 }
@@ -42,12 +45,14 @@ func (v *userView) KeyValues() [][]string {
 			{"EMAIL", v.Email},
 			{"CONNECTION", v.Connection},
 			{"USERNAME", v.Username},
+			{"IDENTITIES", v.Identities},
 		}
 	}
 	return [][]string{
 		{"ID", ansi.Faint(v.UserID)},
 		{"EMAIL", v.Email},
 		{"CONNECTION", v.Connection},
+		{"IDENTITIES", v.Identities},
 	}
 }
 
@@ -95,10 +100,111 @@ func makeUserView(user *management.User, requireUsername bool) *userView {
 		Email:           auth0.StringValue(user.Email),
 		Connection:      stringSliceToCommaSeparatedString(getUserConnection(user)),
 		Username:        auth0.StringValue(user.Username),
+		Identities:      strings.Join(userIdentities(user), "\n"),
 		raw:             user,
 	}
 }
 
+// userIdentities lists the identities linked to the user, as "<provider>|<user id> (<connection>)".
+func userIdentities(user *management.User) []string {
+	var identities []string
+	for _, identity := range user.Identities {
+		description := fmt.Sprintf("%s|%s (%s", identity.GetProvider(), identity.GetUserID(), identity.GetConnection())
+		if identity.GetIsSocial() {
+			description += ", social"
+		}
+		identities = append(identities, description+")")
+	}
+
+	return identities
+}
+
+type userIdentityView struct {
+	Provider      string
+	UserID        string
+	Connection    string
+	IsSocial      bool
+	ProfileData   string
+	AccessToken   string
+	RefreshToken  string
+	revealSecrets bool
+	raw           *management.UserIdentity
+}
+
+func (v *userIdentityView) AsTableHeader() []string {
+	return []string{"Provider", "User ID", "Connection"}
+}
+
+func (v *userIdentityView) AsTableRow() []string {
+	return []string{v.Provider, ansi.Faint(v.UserID), v.Connection}
+}
+
+func (v *userIdentityView) KeyValues() [][]string {
+	keyValues := [][]string{
+		{"PROVIDER", v.Provider},
+		{"USER ID", ansi.Faint(v.UserID)},
+		{"CONNECTION", v.Connection},
+		{"SOCIAL", strconv.FormatBool(v.IsSocial)},
+		{"PROFILE DATA", v.ProfileData},
+	}
+
+	// The tokens issued by the identity provider are only returned with the read:user_idp_tokens scope.
+	if v.AccessToken != "" {
+		keyValues = append(keyValues, []string{"ACCESS TOKEN", v.secret(v.AccessToken)})
+	}
+	if v.RefreshToken != "" {
+		keyValues = append(keyValues, []string{"REFRESH TOKEN", v.secret(v.RefreshToken)})
+	}
+
+	return keyValues
+}
+
+func (v *userIdentityView) secret(value string) string {
+	if v.revealSecrets {
+		return ansi.Italic(value)
+	}
+
+	return maskedSecret
+}
+
+func (v *userIdentityView) Object() interface{} {
+	if v.revealSecrets {
+		return v.raw
+	}
+
+	data, err := json.Marshal(v.raw)
+	if err != nil {
+		return v.raw
+	}
+
+	return json.RawMessage(MaskSecrets(data))
+}
+
+// UserIdentityShow shows one of the identities linked to the user, including the profile
+// the identity provider returned. Its tokens are masked, unless the secrets are revealed.
+func (r *Renderer) UserIdentityShow(identity *management.UserIdentity) {
+	r.Heading("user identity")
+
+	profileData := ""
+	if identity.ProfileData != nil {
+		if buf, err := json.MarshalIndent(identity.ProfileData, "", "    "); err == nil {
+			profileData = ansi.ColorizeJSON(string(buf))
+		}
+	}
+
+	r.Result(&userIdentityView{
+		Provider:      identity.GetProvider(),
+		UserID:        identity.GetUserID(),
+		Connection:    identity.GetConnection(),
+		IsSocial:      identity.GetIsSocial(),
+		ProfileData:   profileData,
+		AccessToken:   identity.GetAccessToken(),
+		RefreshToken:  identity.GetRefreshToken(),
+		revealSecrets: r.RevealSecrets,
+		raw:           identity,
+	})
+}
+
 func getUserConnection(users *management.User) []string {
 	var res []string
 	for _, i := range users.Identities {
@@ -0,0 +1,66 @@
+package display
+
+import (
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type versionView struct {
+	Version         string `json:"version"`
+	Revision        string `json:"revision"`
+	LatestVersion   string `json:"latest_version,omitempty"`
+	UpdateAvailable bool   `json:"update_available"`
+	ReleaseURL      string `json:"release_url,omitempty"`
+}
+
+func (v *versionView) AsTableHeader() []string {
+	return []string{}
+}
+
+func (v *versionView) AsTableRow() []string {
+	return []string{}
+}
+
+func (v *versionView) KeyValues() [][]string {
+	keyValues := [][]string{
+		{"VERSION", v.Version},
+		{"REVISION", v.Revision},
+	}
+
+	if v.LatestVersion != "" {
+		latest := v.LatestVersion
+		if v.UpdateAvailable {
+			latest = ansi.Yellow(latest + " (update available)")
+		}
+
+		keyValues = append(keyValues, []string{"LATEST VERSION", latest})
+	}
+
+	return keyValues
+}
+
+func (v *versionView) Object() interface{} {
+	return v
+}
+
+// Version renders the version of the CLI, along with its latest
+// release when it got checked, in which case latestVersion isn't empty.
+func (r *Renderer) Version(version, revision, latestVersion string, updateAvailable bool, releaseURL string) {
+	r.Heading("version")
+
+	r.Result(&versionView{
+		Version:         version,
+		Revision:        revision,
+		LatestVersion:   latestVersion,
+		UpdateAvailable: updateAvailable,
+		ReleaseURL:      releaseURL,
+	})
+
+	if updateAvailable && r.Format != OutputFormatJSON {
+		r.Newline()
+		r.Infof(
+			"A new version is available, run %s to upgrade or download it from %s",
+			ansi.Cyan("auth0 upgrade"),
+			ansi.URL(releaseURL),
+		)
+	}
+}
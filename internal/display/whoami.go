@@ -0,0 +1,72 @@
+package display
+
+import (
+	"strings"
+	"time"
+
+	"github.com/auth0/auth0-cli/internal/ansi"
+)
+
+type whoAmIView struct {
+	Tenant            string    `json:"tenant"`
+	Principal         string    `json:"principal"`
+	PrincipalType     string    `json:"principal_type"`
+	Scopes            []string  `json:"scopes"`
+	ExpiresAt         time.Time `json:"expires_at"`
+	CredentialStorage string    `json:"credential_storage"`
+	ReadOnly          bool      `json:"read_only"`
+}
+
+func (v *whoAmIView) AsTableHeader() []string {
+	return []string{}
+}
+
+func (v *whoAmIView) AsTableRow() []string {
+	return []string{}
+}
+
+func (v *whoAmIView) KeyValues() [][]string {
+	expiry := v.ExpiresAt.Format(time.RFC3339)
+	if time.Now().After(v.ExpiresAt) {
+		expiry = ansi.Red(expiry + " (expired)")
+	}
+
+	readOnly := "disabled"
+	if v.ReadOnly {
+		readOnly = ansi.Yellow("enabled")
+	}
+
+	return [][]string{
+		{"TENANT", v.Tenant},
+		{"PRINCIPAL", v.Principal},
+		{"PRINCIPAL TYPE", v.PrincipalType},
+		{"SCOPES", strings.Join(v.Scopes, " ")},
+		{"TOKEN EXPIRES AT", expiry},
+		{"CREDENTIAL STORAGE", v.CredentialStorage},
+		{"READ-ONLY MODE", readOnly},
+	}
+}
+
+func (v *whoAmIView) Object() interface{} {
+	return v
+}
+
+func (r *Renderer) WhoAmI(
+	tenant, principal, principalType string,
+	scopes []string,
+	expiresAt time.Time,
+	credentialStorage string,
+	readOnly bool,
+) {
+	r.Heading("current authentication context")
+
+	r.Result(&whoAmIView{
+		Tenant:            tenant,
+		Principal:         principal,
+		PrincipalType:     principalType,
+		Scopes:            scopes,
+		ExpiresAt:         expiresAt,
+		CredentialStorage: credentialStorage,
+		ReadOnly:          readOnly,
+	})
+}
@@ -0,0 +1,67 @@
+package qrcode
+
+import (
+	"strings"
+
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+// Code is a QR code made out of a square grid of dark and light modules.
+type Code struct {
+	Size int
+
+	modules [][]bool
+}
+
+// Encode encodes the content using the smallest QR code version that
+// can hold it and the low error correction level.
+func Encode(content string) (*Code, error) {
+	code, err := goqrcode.New(content, goqrcode.Low)
+	if err != nil {
+		return nil, err
+	}
+
+	// The quiet zone is drawn when rendering, as it's narrower than the default one.
+	code.DisableBorder = true
+	modules := code.Bitmap()
+
+	return &Code{Size: len(modules), modules: modules}, nil
+}
+
+// Dark reports whether the module at the given column and row is dark.
+func (c *Code) Dark(x, y int) bool {
+	return x >= 0 && x < c.Size && y >= 0 && y < c.Size && c.modules[y][x]
+}
+
+// Render draws the QR code as text, packing two rows of modules into each line
+// of text using half block characters and surrounding it with a quiet zone.
+//
+// Light modules are drawn and dark modules are left blank, so that the
+// QR code can be scanned from terminals using a dark background.
+func (c *Code) Render() string {
+	const quietZone = 2
+
+	var builder strings.Builder
+	for y := -quietZone; y < c.Size+quietZone; y += 2 {
+		for x := -quietZone; x < c.Size+quietZone; x++ {
+			top, bottom := !c.Dark(x, y), !c.Dark(x, y+1)
+			if y+1 >= c.Size+quietZone {
+				bottom = false
+			}
+
+			switch {
+			case top && bottom:
+				builder.WriteString("█")
+			case top:
+				builder.WriteString("▀")
+			case bottom:
+				builder.WriteString("▄")
+			default:
+				builder.WriteString(" ")
+			}
+		}
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
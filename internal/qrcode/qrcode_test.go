@@ -0,0 +1,67 @@
+package qrcode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	var testCases = []struct {
+		name         string
+		content      string
+		expectedSize int
+	}{
+		{
+			name:         "it uses the smallest version for short content",
+			content:      "hi",
+			expectedSize: 21,
+		},
+		{
+			name:         "it fits a device verification url",
+			content:      "https://auth0.auth0.com/activate?user_code=ABCD-EFGH",
+			expectedSize: 29,
+		},
+		{
+			name:         "it uses a larger version for longer content",
+			content:      strings.Repeat("x", 230),
+			expectedSize: 53,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			code, err := Encode(test.content)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSize, code.Size)
+
+			// The finder patterns sit in the top left, top right and bottom left corners.
+			for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+				assert.True(t, code.Dark(corner[0], corner[1]))
+				assert.False(t, code.Dark(corner[0]+1, corner[1]+1))
+				assert.True(t, code.Dark(corner[0]+3, corner[1]+3))
+			}
+		})
+	}
+
+	t.Run("it fails to encode content that is too long", func(t *testing.T) {
+		_, err := Encode(strings.Repeat("x", 3000))
+		assert.Error(t, err)
+	})
+}
+
+func TestCode_Render(t *testing.T) {
+	code, err := Encode("hi")
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(code.Render(), "\n"), "\n")
+
+	// Two rows of modules per line, plus a quiet zone of two modules on every side.
+	assert.Len(t, lines, 13)
+	for _, line := range lines {
+		assert.Equal(t, 25, len([]rune(line)))
+	}
+	assert.Equal(t, strings.Repeat("█", 25), lines[0])
+}